@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -9,8 +10,24 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/event"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/eventapi"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/httpclient"
+	"github.com/kyma-incubator/compass/components/director/internal/specdownload"
+	"github.com/kyma-incubator/compass/components/director/internal/specsync"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
@@ -43,6 +60,7 @@ type config struct {
 	Database                      persistence.DatabaseConfig
 	APIEndpoint                   string `envconfig:"default=/graphql"`
 	TenantMappingEndpoint         string `envconfig:"default=/tenant-mapping"`
+	SpecsEndpoint                 string `envconfig:"default=/specs"`
 	PlaygroundAPIEndpoint         string `envconfig:"default=/graphql"`
 	ScopesConfigurationFile       string
 	ScopesConfigurationFileReload time.Duration `envconfig:"default=1m"`
@@ -53,9 +71,19 @@ type config struct {
 
 	StaticUsersSrc string `envconfig:"default=/data/static-users.yaml"`
 
+	ClientTimeout time.Duration `envconfig:"default=60s"`
+
 	OneTimeToken onetimetoken.Config
 	OAuth20      oauth20.Config
 	Event        event.Config
+	FetchRequest fetchrequest.Config
+	SpecSync     specsync.Config
+
+	WebhookDelivery     webhookdelivery.Config
+	ApplicationDeletion application.DeletionConfig
+	ChangeFeed          changefeed.Config
+	HealthCheck         healthcheck.Config
+	RuntimeHeartbeat    runtime.HeartbeatConfig
 }
 
 func main() {
@@ -77,8 +105,13 @@ func main() {
 	stopCh := signal.SetupChannel()
 	scopeCfgProvider := createAndRunScopeConfigProvider(stopCh, cfg)
 
+	// outbound client is shared, because it caches OAuth tokens
+	outboundClient := httpclient.NewClient(&http.Client{Timeout: cfg.ClientTimeout})
+
+	changeFeedBroker := changefeed.NewBroker(transact, changefeed.NewRepository(changefeed.NewConverter()), cfg.ChangeFeed)
+
 	gqlCfg := graphql.Config{
-		Resolvers: domain.NewRootResolver(transact, scopeCfgProvider, cfg.OneTimeToken, cfg.OAuth20, cfg.Event),
+		Resolvers: domain.NewRootResolver(transact, scopeCfgProvider, cfg.OneTimeToken, cfg.OAuth20, cfg.Event, cfg.FetchRequest, outboundClient, changeFeedBroker),
 		Directives: graphql.DirectiveRoot{
 			HasScopes: scope.NewDirective(scopeCfgProvider).VerifyScopes,
 			Validate:  inputvalidation.NewDirective().Validate,
@@ -103,12 +136,128 @@ func main() {
 		go periodicExecutor.Run(stopCh)
 	}
 
+	if cfg.SpecSync.Period != 0 {
+		if cfg.SpecSync.LeaseDuration <= cfg.ClientTimeout {
+			exitOnError(errors.New("lease duration must be longer than client timeout"), "Error while configuring spec synchronization")
+		}
+
+		log.Infof("Spec synchronization enabled. Sync period: %v", cfg.SpecSync.Period)
+		specSynchronizer := createSpecSynchronizer(transact, outboundClient, cfg.FetchRequest, cfg.SpecSync)
+		periodicExecutor := executor.NewPeriodic(cfg.SpecSync.Period, func(stopCh <-chan struct{}) {
+			err := specSynchronizer.SynchronizeAll(context.Background())
+			if err != nil {
+				log.Error(errors.Wrap(err, "while synchronizing specs"))
+			}
+		})
+		go periodicExecutor.Run(stopCh)
+	}
+
+	if cfg.WebhookDelivery.Period != 0 {
+		if cfg.WebhookDelivery.SigningKey == "" {
+			exitOnError(errors.New("signing key must be provided"), "Error while configuring Webhook delivery")
+		}
+		if cfg.WebhookDelivery.LeaseDuration <= cfg.ClientTimeout {
+			exitOnError(errors.New("lease duration must be longer than client timeout"), "Error while configuring Webhook delivery")
+		}
+
+		log.Infof("Webhook delivery enabled. Delivery period: %v", cfg.WebhookDelivery.Period)
+		webhookDeliverer := createWebhookDeliverer(transact, outboundClient, cfg.WebhookDelivery)
+		periodicExecutor := executor.NewPeriodic(cfg.WebhookDelivery.Period, func(stopCh <-chan struct{}) {
+			err := webhookDeliverer.DeliverAll(context.Background())
+			if err != nil {
+				log.Error(errors.Wrap(err, "while delivering webhooks"))
+			}
+		})
+		go periodicExecutor.Run(stopCh)
+	}
+
+	if cfg.ApplicationDeletion.Period != 0 {
+		log.Infof("Application deletion timeout enabled. Check period: %v, timeout: %v", cfg.ApplicationDeletion.Period, cfg.ApplicationDeletion.Timeout)
+		deletionSweeper := createApplicationDeletionSweeper(transact, scopeCfgProvider, cfg.OAuth20, cfg.ApplicationDeletion)
+		periodicExecutor := executor.NewPeriodic(cfg.ApplicationDeletion.Period, func(stopCh <-chan struct{}) {
+			err := deletionSweeper.DeleteTimedOut(context.Background())
+			if err != nil {
+				log.Error(errors.Wrap(err, "while deleting timed out applications"))
+			}
+		})
+		go periodicExecutor.Run(stopCh)
+	}
+
+	if cfg.ChangeFeed.Period != 0 {
+		if cfg.ChangeFeed.GapTimeout >= cfg.ChangeFeed.Retention {
+			exitOnError(errors.New("gap timeout must be shorter than retention"), "Error while configuring change feed")
+		}
+
+		log.Infof("Change feed enabled. Poll period: %v, gap timeout: %v", cfg.ChangeFeed.Period, cfg.ChangeFeed.GapTimeout)
+		periodicExecutor := executor.NewPeriodic(cfg.ChangeFeed.Period, func(stopCh <-chan struct{}) {
+			err := changeFeedBroker.Poll(context.Background())
+			if err != nil {
+				log.Error(errors.Wrap(err, "while polling change events"))
+			}
+		})
+		go periodicExecutor.Run(stopCh)
+	}
+
+	if cfg.ChangeFeed.CleanupPeriod != 0 {
+		log.Infof("Change feed cleanup enabled. Cleanup period: %v, retention: %v", cfg.ChangeFeed.CleanupPeriod, cfg.ChangeFeed.Retention)
+		periodicExecutor := executor.NewPeriodic(cfg.ChangeFeed.CleanupPeriod, func(stopCh <-chan struct{}) {
+			err := changeFeedBroker.DeleteExpired(context.Background())
+			if err != nil {
+				log.Error(errors.Wrap(err, "while deleting expired change events"))
+			}
+		})
+		go periodicExecutor.Run(stopCh)
+	}
+
+	healthCheckProber := createHealthCheckProber(transact, outboundClient, cfg.HealthCheck)
+	if cfg.HealthCheck.Period != 0 {
+		log.Infof("Application health checks enabled. Check period: %v, timeout: %v", cfg.HealthCheck.Period, cfg.HealthCheck.Timeout)
+		periodicExecutor := executor.NewPeriodic(cfg.HealthCheck.Period, func(stopCh <-chan struct{}) {
+			err := healthCheckProber.ProbeAll(context.Background())
+			if err != nil {
+				log.Error(errors.Wrap(err, "while checking health of applications"))
+			}
+		})
+		go periodicExecutor.Run(stopCh)
+	}
+
+	if cfg.HealthCheck.CleanupPeriod != 0 {
+		log.Infof("Health check cleanup enabled. Cleanup period: %v, retention: %v", cfg.HealthCheck.CleanupPeriod, cfg.HealthCheck.Retention)
+		periodicExecutor := executor.NewPeriodic(cfg.HealthCheck.CleanupPeriod, func(stopCh <-chan struct{}) {
+			err := healthCheckProber.DeleteExpired(context.Background())
+			if err != nil {
+				log.Error(errors.Wrap(err, "while deleting expired health checks"))
+			}
+		})
+		go periodicExecutor.Run(stopCh)
+	}
+
+	if cfg.RuntimeHeartbeat.Period != 0 {
+		log.Infof("Runtime heartbeat timeout enabled. Check period: %v, timeout: %v", cfg.RuntimeHeartbeat.Period, cfg.RuntimeHeartbeat.Timeout)
+		heartbeatSweeper := runtime.NewHeartbeatSweeper(transact, runtime.NewRepository(), cfg.RuntimeHeartbeat.Timeout)
+		periodicExecutor := executor.NewPeriodic(cfg.RuntimeHeartbeat.Period, func(stopCh <-chan struct{}) {
+			err := heartbeatSweeper.MarkStaleAsFailed(context.Background())
+			if err != nil {
+				log.Error(errors.Wrap(err, "while marking runtimes with stale heartbeat as failed"))
+			}
+		})
+		go periodicExecutor.Run(stopCh)
+	}
+
 	mainRouter.HandleFunc("/", handler.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
 
 	gqlAPIRouter := mainRouter.PathPrefix(cfg.APIEndpoint).Subrouter()
 	gqlAPIRouter.Use(authMiddleware.Handler())
 	gqlAPIRouter.HandleFunc("", handler.GraphQL(executableSchema))
 
+	log.Infof("Registering Specs endpoint on %s...", cfg.SpecsEndpoint)
+	specDownloadHandler := createSpecDownloadHandler(transact, outboundClient, cfg.FetchRequest, scopeCfgProvider)
+
+	specsRouter := mainRouter.PathPrefix(cfg.SpecsEndpoint).Subrouter()
+	specsRouter.Use(authMiddleware.Handler())
+	specsRouter.HandleFunc(fmt.Sprintf("/api/{%s}", specdownload.IDVar), specDownloadHandler.APISpec)
+	specsRouter.HandleFunc(fmt.Sprintf("/eventapi/{%s}", specdownload.IDVar), specDownloadHandler.EventAPISpec)
+
 	log.Infof("Registering Tenant Mapping endpoint on %s...", cfg.TenantMappingEndpoint)
 	tenantMappingHandlerFunc, err := getTenantMappingHanderFunc(transact, cfg.StaticUsersSrc, scopeCfgProvider)
 	exitOnError(err, "Error while configuring tenant mapping handler")
@@ -165,10 +314,133 @@ func configureLogger() {
 	log.SetReportCaller(true)
 }
 
+type specSynchronizer interface {
+	SynchronizeAll(ctx context.Context) error
+}
+
+func createSpecSynchronizer(transact persistence.Transactioner, outboundClient fetchrequest.HTTPClient, fetchRequestCfg fetchrequest.Config, cfg specsync.Config) specSynchronizer {
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	apiConverter := api.NewConverter(authConverter, frConverter, versionConverter)
+	eventAPIConverter := eventapi.NewConverter(frConverter, versionConverter)
+	docConverter := document.NewConverter(frConverter)
+	webhookConverter := webhook.NewConverter(authConverter)
+	webhookDeliveryConverter := webhookdelivery.NewConverter()
+
+	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
+	apiRepo := api.NewRepository(apiConverter)
+	eventAPIRepo := eventapi.NewRepository(eventAPIConverter)
+	docRepo := document.NewRepository(docConverter)
+	webhookRepo := webhook.NewRepository(webhookConverter)
+	webhookDeliveryRepo := webhookdelivery.NewRepository(webhookDeliveryConverter)
+
+	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, outboundClient, fetchRequestCfg)
+	webhookDeliverySvc := webhookdelivery.NewService(webhookDeliveryRepo, webhookRepo, uid.NewService())
+
+	return specsync.NewSynchronizer(transact, fetchRequestRepo, fetchRequestSvc, apiRepo, eventAPIRepo, docRepo, webhookDeliverySvc, cfg.Period, cfg.LeaseDuration)
+}
+
+type webhookDeliverer interface {
+	DeliverAll(ctx context.Context) error
+}
+
+func createWebhookDeliverer(transact persistence.Transactioner, outboundClient webhookdelivery.HTTPClient, cfg webhookdelivery.Config) webhookDeliverer {
+	authConverter := auth.NewConverter()
+	webhookConverter := webhook.NewConverter(authConverter)
+	webhookDeliveryConverter := webhookdelivery.NewConverter()
+
+	webhookRepo := webhook.NewRepository(webhookConverter)
+	webhookDeliveryRepo := webhookdelivery.NewRepository(webhookDeliveryConverter)
+
+	return webhookdelivery.NewDeliverer(transact, webhookDeliveryRepo, webhookRepo, outboundClient, uid.NewService(), cfg)
+}
+
+type applicationDeletionSweeper interface {
+	DeleteTimedOut(ctx context.Context) error
+}
+
+func createApplicationDeletionSweeper(transact persistence.Transactioner, scopeProvider *scope.Provider, oAuth20Cfg oauth20.Config, cfg application.DeletionConfig) applicationDeletionSweeper {
+	uidSvc := uid.NewService()
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	webhookConverter := webhook.NewConverter(authConverter)
+	apiConverter := api.NewConverter(authConverter, frConverter, versionConverter)
+	eventAPIConverter := eventapi.NewConverter(frConverter, versionConverter)
+	docConverter := document.NewConverter(frConverter)
+	appConverter := application.NewConverter(webhookConverter, apiConverter, eventAPIConverter, docConverter, authConverter)
+	systemAuthConverter := systemauth.NewConverter(authConverter)
+
+	appRepo := application.NewRepository(appConverter)
+	systemAuthRepo := systemauth.NewRepository(systemAuthConverter)
+
+	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
+	oAuth20Svc := oauth20.NewService(scopeProvider, uidSvc, oAuth20Cfg)
+
+	return application.NewDeletionSweeper(transact, appRepo, systemAuthSvc, oAuth20Svc, cfg.Timeout)
+}
+
+type healthCheckProber interface {
+	ProbeAll(ctx context.Context) error
+	DeleteExpired(ctx context.Context) error
+}
+
+func createHealthCheckProber(transact persistence.Transactioner, outboundClient healthcheck.HTTPClient, cfg healthcheck.Config) healthCheckProber {
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	webhookConverter := webhook.NewConverter(authConverter)
+	apiConverter := api.NewConverter(authConverter, frConverter, versionConverter)
+	eventAPIConverter := eventapi.NewConverter(frConverter, versionConverter)
+	docConverter := document.NewConverter(frConverter)
+	appConverter := application.NewConverter(webhookConverter, apiConverter, eventAPIConverter, docConverter, authConverter)
+
+	appRepo := application.NewRepository(appConverter)
+	healthCheckRepo := healthcheck.NewRepository(healthcheck.NewConverter())
+
+	return healthcheck.NewProber(transact, healthCheckRepo, appRepo, outboundClient, uid.NewService(), cfg)
+}
+
+func createSpecDownloadHandler(transact persistence.Transactioner, outboundClient fetchrequest.HTTPClient, fetchRequestCfg fetchrequest.Config, scopeProvider *scope.Provider) *specdownload.Handler {
+	uidSvc := uid.NewService()
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	apiConverter := api.NewConverter(authConverter, frConverter, versionConverter)
+	eventAPIConverter := eventapi.NewConverter(frConverter, versionConverter)
+	webhookConverter := webhook.NewConverter(authConverter)
+
+	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
+	apiRepo := api.NewRepository(apiConverter)
+	eventAPIRepo := eventapi.NewRepository(eventAPIConverter)
+	labelRepo := label.NewRepository(label.NewConverter())
+	labelDefRepo := labeldef.NewRepository(labeldef.NewConverter())
+	webhookRepo := webhook.NewRepository(webhookConverter)
+	webhookDeliveryRepo := webhookdelivery.NewRepository(webhookdelivery.NewConverter())
+
+	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, outboundClient, fetchRequestCfg)
+	labelUpsertSvc := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidSvc)
+	webhookDeliverySvc := webhookdelivery.NewService(webhookDeliveryRepo, webhookRepo, uidSvc)
+	apiSvc := api.NewService(apiRepo, fetchRequestRepo, fetchRequestSvc, uidSvc, labelRepo, labelUpsertSvc, webhookDeliverySvc)
+	eventAPISvc := eventapi.NewService(eventAPIRepo, fetchRequestRepo, fetchRequestSvc, uidSvc, labelRepo, labelUpsertSvc, webhookDeliverySvc)
+
+	return specdownload.NewHandler(transact, apiSvc, eventAPISvc, scopeProvider)
+}
+
 func getTenantMappingHanderFunc(transact persistence.Transactioner, staticUsersSrc string, scopeProvider *scope.Provider) (func(writer http.ResponseWriter, request *http.Request), error) {
 	uidSvc := uid.NewService()
 	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	webhookConverter := webhook.NewConverter(authConverter)
+	apiConverter := api.NewConverter(authConverter, frConverter, versionConverter)
+	eventAPIConverter := eventapi.NewConverter(frConverter, versionConverter)
+	docConverter := document.NewConverter(frConverter)
+	appConverter := application.NewConverter(webhookConverter, apiConverter, eventAPIConverter, docConverter, authConverter)
 	systemAuthConverter := systemauth.NewConverter(authConverter)
+	appRepo := application.NewRepository(appConverter)
+	runtimeRepo := runtime.NewRepository()
 	systemAuthRepo := systemauth.NewRepository(systemAuthConverter)
 	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
 	staticUsersRepo, err := tenantmapping.NewStaticUserRepository(staticUsersSrc)
@@ -177,7 +449,7 @@ func getTenantMappingHanderFunc(transact persistence.Transactioner, staticUsersS
 	}
 
 	mapperForUser := tenantmapping.NewMapperForUser(staticUsersRepo)
-	mapperForSystemAuth := tenantmapping.NewMapperForSystemAuth(systemAuthSvc, scopeProvider)
+	mapperForSystemAuth := tenantmapping.NewMapperForSystemAuth(systemAuthSvc, scopeProvider, appRepo, runtimeRepo)
 
 	reqDataParser := tenantmapping.NewReqDataParser()
 
@@ -7,8 +7,10 @@ import (
 )
 
 type Claims struct {
-	Tenant string `json:"tenant"`
-	Scopes string `json:"scopes"`
+	Tenant       string `json:"tenant"`
+	Scopes       string `json:"scopes"`
+	ConsumerID   string `json:"consumerID"`
+	ConsumerType string `json:"consumerType"`
 	*jwt.StandardClaims
 }
 
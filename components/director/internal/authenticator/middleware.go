@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 
@@ -84,7 +85,8 @@ func (a *Authenticator) contextWithClaims(ctx context.Context, claims Claims) co
 	ctxWithTenant := tenant.SaveToContext(ctx, claims.Tenant)
 	scopesArray := strings.Split(claims.Scopes, " ")
 	ctxWithScopes := scope.SaveToContext(ctxWithTenant, scopesArray)
-	return ctxWithScopes
+	ctxWithConsumer := consumer.SaveToContext(ctxWithScopes, consumer.Consumer{ConsumerID: claims.ConsumerID, ConsumerType: consumer.ConsumerType(claims.ConsumerType)})
+	return ctxWithConsumer
 }
 
 func (a *Authenticator) getKeyFunc() func(token *jwt.Token) (interface{}, error) {
@@ -12,6 +12,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 
 	"github.com/dgrijalva/jwt-go"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,7 @@ import (
 )
 
 const tnt = "2a1502ba-aded-11e9-a2a3-2a2ae2dbcce4"
+const consumerID = "4d5d9a6e-4c5a-4b9b-8f0e-2c5d1d2f3a4b"
 const PublicJWKSURL = "file://testdata/jwks-public.json"
 const PrivateJWKSURL = "file://testdata/jwks-private.json"
 const PrivateJWKS2URL = "file://testdata/jwks-private2.json"
@@ -167,15 +169,19 @@ func TestAuthenticator_Handler(t *testing.T) {
 }
 
 type jwtTokenClaims struct {
-	Scopes string `json:"scopes"`
-	Tenant string `json:"tenant"`
+	Scopes       string `json:"scopes"`
+	Tenant       string `json:"tenant"`
+	ConsumerID   string `json:"consumerID"`
+	ConsumerType string `json:"consumerType"`
 	jwt.StandardClaims
 }
 
 func createNotSingedToken(t *testing.T, tenant string, scopes string) string {
 	token := jwt.NewWithClaims(jwt.SigningMethodNone, jwtTokenClaims{
-		Tenant: tenant,
-		Scopes: scopes,
+		Tenant:       tenant,
+		Scopes:       scopes,
+		ConsumerID:   consumerID,
+		ConsumerType: string(consumer.Runtime),
 	})
 
 	signedToken, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
@@ -186,8 +192,10 @@ func createNotSingedToken(t *testing.T, tenant string, scopes string) string {
 
 func createTokenWithSigningMethod(t *testing.T, tnt string, scopes string, key jwk.Key) string {
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwtTokenClaims{
-		Tenant: tnt,
-		Scopes: scopes,
+		Tenant:       tnt,
+		Scopes:       scopes,
+		ConsumerID:   consumerID,
+		ConsumerType: string(consumer.Runtime),
 	})
 
 	materializedKey, err := key.Materialize()
@@ -215,6 +223,9 @@ func testHandler(t *testing.T, expectedTenant string, scopes string) http.Handle
 		require.Equal(t, expectedTenant, tenantFromContext)
 		scopesArray := strings.Split(scopes, " ")
 		require.ElementsMatch(t, scopesArray, scopesFromContext)
+		consumerFromContext, err := consumer.LoadFromContext(r.Context())
+		require.NoError(t, err)
+		require.Equal(t, consumer.Consumer{ConsumerID: consumerID, ConsumerType: consumer.Runtime}, consumerFromContext)
 
 		_, err = w.Write([]byte("OK"))
 		require.NoError(t, err)
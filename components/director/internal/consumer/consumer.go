@@ -0,0 +1,57 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type ConsumerType string
+
+const (
+	Runtime           ConsumerType = "Runtime"
+	Application       ConsumerType = "Application"
+	IntegrationSystem ConsumerType = "Integration System"
+	User              ConsumerType = "Static User"
+)
+
+// Consumer is the object on behalf of which the request is made, as determined by the Tenant Mapping Service.
+type Consumer struct {
+	ConsumerID   string
+	ConsumerType ConsumerType
+}
+
+type key int
+
+const ConsumerContextKey key = iota
+
+var NoConsumerError = errors.New("cannot read consumer from context")
+
+func LoadFromContext(ctx context.Context) (Consumer, error) {
+	value := ctx.Value(ConsumerContextKey)
+
+	c, ok := value.(Consumer)
+
+	if !ok {
+		return Consumer{}, NoConsumerError
+	}
+
+	return c, nil
+}
+
+func SaveToContext(ctx context.Context, c Consumer) context.Context {
+	return context.WithValue(ctx, ConsumerContextKey, c)
+}
+
+// CanActOn returns true if the consumer is the given object itself, or a User or an Integration System, which can act on any object their scopes allow.
+// Consumers of any other type, including the ones with an empty type, cannot act on any object.
+func (c Consumer) CanActOn(objectType ConsumerType, objectID string) bool {
+	switch c.ConsumerType {
+	case Application, Runtime:
+		return c.ConsumerType == objectType && c.ConsumerID == objectID
+	case User, IntegrationSystem:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package consumer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFromContext(t *testing.T) {
+	value := consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Runtime}
+
+	testCases := []struct {
+		Name    string
+		Context context.Context
+
+		ExpectedResult     consumer.Consumer
+		ExpectedErrMessage string
+	}{
+		{
+			Name:               "Success",
+			Context:            context.WithValue(context.TODO(), consumer.ConsumerContextKey, value),
+			ExpectedResult:     value,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name:               "Error",
+			Context:            context.TODO(),
+			ExpectedResult:     consumer.Consumer{},
+			ExpectedErrMessage: "cannot read consumer from context",
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result, err := consumer.LoadFromContext(testCase.Context)
+
+			// then
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedErrMessage, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedResult, result)
+		})
+	}
+}
+
+func TestSaveToLoadFromContext(t *testing.T) {
+	// given
+	value := consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Application}
+	ctx := context.TODO()
+
+	// when
+	result, err := consumer.LoadFromContext(consumer.SaveToContext(ctx, value))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, value, result)
+}
+
+func TestConsumer_CanActOn(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Consumer consumer.Consumer
+		Expected bool
+	}{
+		{
+			Name:     "Runtime acting on itself",
+			Consumer: consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Runtime},
+			Expected: true,
+		},
+		{
+			Name:     "Runtime acting on other Runtime",
+			Consumer: consumer.Consumer{ConsumerID: "bar", ConsumerType: consumer.Runtime},
+			Expected: false,
+		},
+		{
+			Name:     "Application acting on Runtime with the same ID",
+			Consumer: consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Application},
+			Expected: false,
+		},
+		{
+			Name:     "Integration System",
+			Consumer: consumer.Consumer{ConsumerID: "bar", ConsumerType: consumer.IntegrationSystem},
+			Expected: true,
+		},
+		{
+			Name:     "User",
+			Consumer: consumer.Consumer{ConsumerID: "admin", ConsumerType: consumer.User},
+			Expected: true,
+		},
+		{
+			Name:     "Consumer with unknown type",
+			Consumer: consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.ConsumerType("Unknown")},
+			Expected: false,
+		},
+		{
+			Name:     "Consumer without type",
+			Consumer: consumer.Consumer{ConsumerID: "foo"},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert.Equal(t, testCase.Expected, testCase.Consumer.CanActOn(consumer.Runtime, "foo"))
+		})
+	}
+}
@@ -5,6 +5,7 @@ package automock
 import (
 	context "context"
 
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -137,13 +138,13 @@ func (_m *APIRepository) GetForApplication(ctx context.Context, tenant string, i
 	return r0, r1
 }
 
-// ListByApplicationID provides a mock function with given fields: ctx, tenantID, applicationID, pageSize, cursor
-func (_m *APIRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
-	ret := _m.Called(ctx, tenantID, applicationID, pageSize, cursor)
+// ListByApplicationID provides a mock function with given fields: ctx, tenantID, applicationID, filter, pageSize, cursor
+func (_m *APIRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, applicationID, filter, pageSize, cursor)
 
 	var r0 *model.APIDefinitionPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.APIDefinitionPage); ok {
-		r0 = rf(ctx, tenantID, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []*labelfilter.LabelFilter, int, string) *model.APIDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, applicationID, filter, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.APIDefinitionPage)
@@ -151,8 +152,8 @@ func (_m *APIRepository) ListByApplicationID(ctx context.Context, tenantID strin
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
-		r1 = rf(ctx, tenantID, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, tenantID, applicationID, filter, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
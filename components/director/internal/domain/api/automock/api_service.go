@@ -2,9 +2,12 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // APIService is an autogenerated mock type for the APIService type
 type APIService struct {
@@ -46,6 +49,20 @@ func (_m *APIService) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
+// DeleteLabel provides a mock function with given fields: ctx, apiDefID, key
+func (_m *APIService) DeleteLabel(ctx context.Context, apiDefID string, key string) error {
+	ret := _m.Called(ctx, apiDefID, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, apiDefID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *APIService) Get(ctx context.Context, id string) (*model.APIDefinition, error) {
 	ret := _m.Called(ctx, id)
@@ -92,6 +109,52 @@ func (_m *APIService) GetFetchRequest(ctx context.Context, apiDefID string) (*mo
 	return r0, r1
 }
 
+// GetLabel provides a mock function with given fields: ctx, apiDefID, key
+func (_m *APIService) GetLabel(ctx context.Context, apiDefID string, key string) (*model.Label, error) {
+	ret := _m.Called(ctx, apiDefID, key)
+
+	var r0 *model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Label); ok {
+		r0 = rf(ctx, apiDefID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, apiDefID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLabels provides a mock function with given fields: ctx, apiDefID
+func (_m *APIService) ListLabels(ctx context.Context, apiDefID string) (map[string]*model.Label, error) {
+	ret := _m.Called(ctx, apiDefID)
+
+	var r0 map[string]*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]*model.Label); ok {
+		r0 = rf(ctx, apiDefID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, apiDefID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RefetchAPISpec provides a mock function with given fields: ctx, id
 func (_m *APIService) RefetchAPISpec(ctx context.Context, id string) (*model.APISpec, error) {
 	ret := _m.Called(ctx, id)
@@ -115,6 +178,20 @@ func (_m *APIService) RefetchAPISpec(ctx context.Context, id string) (*model.API
 	return r0, r1
 }
 
+// SetLabel provides a mock function with given fields: ctx, labelInput
+func (_m *APIService) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
+	ret := _m.Called(ctx, labelInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LabelInput) error); ok {
+		r0 = rf(ctx, labelInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *APIService) Update(ctx context.Context, id string, in model.APIDefinitionInput) error {
 	ret := _m.Called(ctx, id, in)
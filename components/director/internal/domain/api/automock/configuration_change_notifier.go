@@ -0,0 +1,29 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConfigurationChangeNotifier is an autogenerated mock type for the ConfigurationChangeNotifier type
+type ConfigurationChangeNotifier struct {
+	mock.Mock
+}
+
+// NotifyConfigurationChanged provides a mock function with given fields: ctx, applicationID, change
+func (_m *ConfigurationChangeNotifier) NotifyConfigurationChanged(ctx context.Context, applicationID string, change model.ConfigurationChange) error {
+	ret := _m.Called(ctx, applicationID, change)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ConfigurationChange) error); ok {
+		r0 = rf(ctx, applicationID, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
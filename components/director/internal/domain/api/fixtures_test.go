@@ -14,12 +14,16 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
 	apiDefID = "ddddddddd-dddd-dddd-dddd-dddddddddddd"
 	appID    = "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
 	tenantID = "ttttttttt-tttt-tttt-tttt-tttttttttttt"
+
+	openAPISpec    = "swagger: '2.0'\ninfo:\n  title: Foo\n  version: v1\npaths: {}\n"
+	invalidAPISpec = "swagger: '2.0'\npaths: {}\n"
 )
 
 func fixAPIDefinitionModel(id, appId, name, targetURL string) *model.APIDefinition {
@@ -365,6 +369,13 @@ func fixModelFetchRequest(id, url string, timestamp time.Time) *model.FetchReque
 	}
 }
 
+// fixSpecValidator matches the function validating fetched Spec, which accepts the valid and rejects the invalid data
+func fixSpecValidator(valid, invalid string) interface{} {
+	return mock.MatchedBy(func(validate func(data string) error) bool {
+		return validate(valid) == nil && validate(invalid) != nil
+	})
+}
+
 func fixGQLFetchRequest(url string, timestamp time.Time) *graphql.FetchRequest {
 	return &graphql.FetchRequest{
 		Filter: nil,
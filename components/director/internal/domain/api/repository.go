@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/pkg/errors"
@@ -55,10 +58,24 @@ func (r APIDefCollection) Len() int {
 	return len(r)
 }
 
-func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
-	appCond := fmt.Sprintf("%s = '%s'", "app_id", applicationID)
+func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
+	conditions := []string{fmt.Sprintf("%s = '%s'", "app_id", applicationID)}
+	var args []interface{}
+	if len(filter) > 0 {
+		tenantUUID, err := uuid.Parse(tenantID)
+		if err != nil {
+			return nil, errors.Wrap(err, "while parsing tenant as UUID")
+		}
+		var filterSubquery string
+		filterSubquery, args, err = label.FilterQuery(model.APIDefinitionLabelableObject, label.IntersectSet, tenantUUID, filter, 2)
+		if err != nil {
+			return nil, errors.Wrap(err, "while building filter query")
+		}
+		conditions = append(conditions, fmt.Sprintf(`"id" IN (%s)`, filterSubquery))
+	}
+
 	var apiDefCollection APIDefCollection
-	page, totalCount, err := r.pageableQuerier.List(ctx, tenantID, pageSize, cursor, "id", &apiDefCollection, appCond)
+	page, totalCount, err := r.pageableQuerier.ListWithArgs(ctx, tenantID, pageSize, cursor, "id", &apiDefCollection, conditions, args)
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
@@ -187,7 +188,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock.On("FromEntity", secondApiDefEntity).Return(model.APIDefinition{ID: secondApiDefID}, nil)
 		pgRepository := api.NewRepository(convMock)
 		// WHEN
-		modelAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		modelAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, nil, inputPageSize, inputCursor)
 		//THEN
 		require.NoError(t, err)
 		require.Len(t, modelAPIDef.Data, 2)
@@ -199,6 +200,36 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		sqlMock.AssertExpectations(t)
 	})
 
+	t.Run("success with label filter", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		tenantUUID := "dddddddd-dddd-dddd-dddd-dddddddddddd"
+		filter := []*labelfilter.LabelFilter{{Key: "domain"}}
+		filterQuery := `"id" IN (SELECT "api_def_id" FROM public.labels WHERE "api_def_id" IS NOT NULL AND "tenant_id" = '` + tenantUUID + `' AND "key" = $2)`
+		rows := sqlmock.NewRows(fixAPIDefinitionColumns()).
+			AddRow(fixAPIDefinitionRow(firstApiDefID, "placeholder")...)
+
+		sqlMock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`FROM "public"."api_definitions" WHERE tenant_id=$1 AND app_id = '%s' AND %s ORDER BY id LIMIT %d OFFSET %d`, appID, filterQuery, ExpectedLimit, ExpectedOffset))).
+			WithArgs(tenantUUID, "domain").
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(`SELECT COUNT(*) FROM "public"."api_definitions" WHERE tenant_id=$1 AND app_id = '%s' AND %s`, appID, filterQuery))).
+			WithArgs(tenantUUID, "domain").
+			WillReturnRows(testdb.RowCount(1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.APIDefinitionConverter{}
+		convMock.On("FromEntity", firstApiDefEntity).Return(model.APIDefinition{ID: firstApiDefID}, nil)
+		pgRepository := api.NewRepository(convMock)
+		// WHEN
+		modelAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantUUID, appID, filter, inputPageSize, inputCursor)
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelAPIDef.Data, 1)
+		assert.Equal(t, firstApiDefID, modelAPIDef.Data[0].ID)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
 	t.Run("returns error when conversion from entity to model failed", func(t *testing.T) {
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		testErr := errors.New("test error")
@@ -218,7 +249,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock.On("FromEntity", firstApiDefEntity).Return(model.APIDefinition{}, testErr).Once()
 		pgRepository := api.NewRepository(convMock)
 		//WHEN
-		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, nil, inputPageSize, inputCursor)
 		//THEN
 		require.Error(t, err)
 		require.Contains(t, err.Error(), testErr.Error())
@@ -3,8 +3,11 @@ package api
 import (
 	"context"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/specformat"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/pkg/errors"
@@ -18,6 +21,10 @@ type APIService interface {
 	Delete(ctx context.Context, id string) error
 	RefetchAPISpec(ctx context.Context, id string) (*model.APISpec, error)
 	GetFetchRequest(ctx context.Context, apiDefID string) (*model.FetchRequest, error)
+	SetLabel(ctx context.Context, labelInput *model.LabelInput) error
+	GetLabel(ctx context.Context, apiDefID string, key string) (*model.Label, error)
+	ListLabels(ctx context.Context, apiDefID string) (map[string]*model.Label, error)
+	DeleteLabel(ctx context.Context, apiDefID string, key string) error
 }
 
 //go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
@@ -58,6 +65,11 @@ type APIRuntimeAuthService interface {
 	Delete(ctx context.Context, apiID string, runtimeID string) error
 }
 
+//go:generate mockery -name=SpecPrefetcher -output=automock -outpkg=automock -case=underscore
+type SpecPrefetcher interface {
+	Prefetch(ctx context.Context, fetchRequests []*model.FetchRequest) context.Context
+}
+
 type Resolver struct {
 	transact            persistence.Transactioner
 	svc                 APIService
@@ -68,9 +80,11 @@ type Resolver struct {
 	authConverter       AuthConverter
 	frConverter         FetchRequestConverter
 	apiRtmAuthConverter APIRuntimeAuthConverter
+	prefetcher          SpecPrefetcher
+	labels              *label.ObjectResolver
 }
 
-func NewResolver(transact persistence.Transactioner, svc APIService, appSvc ApplicationService, rtmSvc RuntimeService, apiRtmAuthSvc APIRuntimeAuthService, converter APIConverter, authConverter AuthConverter, frConverter FetchRequestConverter, apiRtmAuthConverter APIRuntimeAuthConverter) *Resolver {
+func NewResolver(transact persistence.Transactioner, svc APIService, appSvc ApplicationService, rtmSvc RuntimeService, apiRtmAuthSvc APIRuntimeAuthService, converter APIConverter, authConverter AuthConverter, frConverter FetchRequestConverter, apiRtmAuthConverter APIRuntimeAuthConverter, prefetcher SpecPrefetcher) *Resolver {
 	return &Resolver{
 		transact:            transact,
 		svc:                 svc,
@@ -81,10 +95,17 @@ func NewResolver(transact persistence.Transactioner, svc APIService, appSvc Appl
 		frConverter:         frConverter,
 		authConverter:       authConverter,
 		apiRtmAuthConverter: apiRtmAuthConverter,
+		prefetcher:          prefetcher,
+		labels:              label.NewObjectResolver(transact, svc, model.APIDefinitionLabelableObject),
 	}
 }
 
 func (r *Resolver) AddAPI(ctx context.Context, applicationID string, in graphql.APIDefinitionInput) (*graphql.APIDefinition, error) {
+	convertedIn := r.converter.InputFromGraphQL(&in)
+
+	// the specification is downloaded before the transaction is started, so that the transaction is not kept open during the download
+	ctx = r.prefetcher.Prefetch(ctx, model.FetchRequestsFromInputs([]*model.FetchRequestInput{convertedIn.FetchRequestInput()}))
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -93,8 +114,6 @@ func (r *Resolver) AddAPI(ctx context.Context, applicationID string, in graphql.
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	convertedIn := r.converter.InputFromGraphQL(&in)
-
 	found, err := r.appSvc.Exist(ctx, applicationID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while checking existence of Application")
@@ -124,6 +143,10 @@ func (r *Resolver) AddAPI(ctx context.Context, applicationID string, in graphql.
 	return gqlAPI, nil
 }
 func (r *Resolver) UpdateAPI(ctx context.Context, id string, in graphql.APIDefinitionInput) (*graphql.APIDefinition, error) {
+	convertedIn := r.converter.InputFromGraphQL(&in)
+
+	ctx = r.prefetcher.Prefetch(ctx, model.FetchRequestsFromInputs([]*model.FetchRequestInput{convertedIn.FetchRequestInput()}))
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -132,8 +155,6 @@ func (r *Resolver) UpdateAPI(ctx context.Context, id string, in graphql.APIDefin
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	convertedIn := r.converter.InputFromGraphQL(&in)
-
 	err = r.svc.Update(ctx, id, *convertedIn)
 	if err != nil {
 		return nil, err
@@ -179,6 +200,11 @@ func (r *Resolver) DeleteAPI(ctx context.Context, id string) (*graphql.APIDefini
 	return r.converter.ToGraphQL(api), nil
 }
 func (r *Resolver) RefetchAPISpec(ctx context.Context, apiID string) (*graphql.APISpec, error) {
+	ctx, err := r.prefetchSpec(ctx, apiID)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -189,6 +215,12 @@ func (r *Resolver) RefetchAPISpec(ctx context.Context, apiID string) (*graphql.A
 
 	spec, err := r.svc.RefetchAPISpec(ctx, apiID)
 	if err != nil {
+		if apperrors.IsFetchFailed(err) {
+			// the failed FetchRequest status is persisted while the stored spec stays untouched
+			if commitErr := tx.Commit(); commitErr != nil {
+				return nil, commitErr
+			}
+		}
 		return nil, err
 	}
 
@@ -201,6 +233,31 @@ func (r *Resolver) RefetchAPISpec(ctx context.Context, apiID string) (*graphql.A
 	return convertedOut.Spec, nil
 }
 
+// prefetchSpec downloads the specification of the API in a separate transaction, so that the transaction which stores it is not kept open during the download
+func (r *Resolver) prefetchSpec(ctx context.Context, apiID string) (context.Context, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	fr, err := r.svc.GetFetchRequest(persistence.SaveToContext(ctx, tx), apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if fr == nil {
+		return ctx, nil
+	}
+
+	return r.prefetcher.Prefetch(ctx, []*model.FetchRequest{fr}), nil
+}
+
 func (r *Resolver) Auth(ctx context.Context, obj *graphql.APIDefinition, runtimeID string) (*graphql.APIRuntimeAuth, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -352,3 +409,37 @@ func (r *Resolver) FetchRequest(ctx context.Context, obj *graphql.APISpec) (*gra
 	frGQL := r.frConverter.ToGraphQL(fr)
 	return frGQL, nil
 }
+
+func (r *Resolver) Data(ctx context.Context, obj *graphql.APISpec, format *graphql.SpecFormat) (*graphql.CLOB, error) {
+	if obj == nil {
+		return nil, errors.New("API Spec cannot be empty")
+	}
+
+	if obj.Data == nil || format == nil {
+		return obj.Data, nil
+	}
+
+	data, err := specformat.Convert(string(*obj.Data), model.SpecFormat(obj.Format), model.SpecFormat(*format))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting API Spec to %s", *format)
+	}
+
+	clob := graphql.CLOB(data)
+	return &clob, nil
+}
+
+func (r *Resolver) SetAPILabel(ctx context.Context, apiID string, key string, value interface{}) (*graphql.Label, error) {
+	return r.labels.SetLabel(ctx, apiID, key, value)
+}
+
+func (r *Resolver) DeleteAPILabel(ctx context.Context, apiID string, key string) (*graphql.Label, error) {
+	return r.labels.DeleteLabel(ctx, apiID, key)
+}
+
+func (r *Resolver) Labels(ctx context.Context, obj *graphql.APIDefinition, key *string) (graphql.Labels, error) {
+	if obj == nil {
+		return nil, errors.New("API Definition cannot be empty")
+	}
+
+	return r.labels.Labels(ctx, obj.ID)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/stretchr/testify/assert"
@@ -79,6 +80,7 @@ func TestResolver_AddAPI(t *testing.T) {
 			},
 			ConverterFn: func() *automock.APIConverter {
 				conv := &automock.APIConverter{}
+				conv.On("InputFromGraphQL", gqlAPIInput).Return(modelAPIInput).Once()
 				return conv
 			},
 			ExpectedAPI: nil,
@@ -198,8 +200,10 @@ func TestResolver_AddAPI(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 			appSvc := testCase.AppServiceFn()
+			prefetcher := &automock.SpecPrefetcher{}
+			prefetcher.On("Prefetch", context.TODO(), model.FetchRequestsFromInputs([]*model.FetchRequestInput{modelAPIInput.Spec.FetchRequest})).Return(context.TODO()).Once()
 
-			resolver := api.NewResolver(transact, svc, appSvc, nil, nil, converter, nil, nil, nil)
+			resolver := api.NewResolver(transact, svc, appSvc, nil, nil, converter, nil, nil, nil, prefetcher)
 
 			// when
 			result, err := resolver.AddAPI(context.TODO(), appId, *gqlAPIInput)
@@ -218,6 +222,7 @@ func TestResolver_AddAPI(t *testing.T) {
 			svc.AssertExpectations(t)
 			appSvc.AssertExpectations(t)
 			converter.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
 		})
 	}
 }
@@ -327,7 +332,7 @@ func TestResolver_DeleteAPI(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := api.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil)
+			resolver := api.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.DeleteAPI(context.TODO(), id)
@@ -395,6 +400,7 @@ func TestResolver_UpdateAPI(t *testing.T) {
 			},
 			ConverterFn: func() *automock.APIConverter {
 				conv := &automock.APIConverter{}
+				conv.On("InputFromGraphQL", gqlAPIDefinitionInput).Return(modelAPIDefinitionInput).Once()
 				return conv
 			},
 			InputWebhookID:        id,
@@ -466,8 +472,10 @@ func TestResolver_UpdateAPI(t *testing.T) {
 			persist, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
+			prefetcher := &automock.SpecPrefetcher{}
+			prefetcher.On("Prefetch", context.TODO(), model.FetchRequestsFromInputs([]*model.FetchRequestInput{modelAPIDefinitionInput.Spec.FetchRequest})).Return(context.TODO()).Once()
 
-			resolver := api.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil)
+			resolver := api.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, prefetcher)
 
 			// when
 			result, err := resolver.UpdateAPI(context.TODO(), id, *gqlAPIDefinitionInput)
@@ -480,6 +488,7 @@ func TestResolver_UpdateAPI(t *testing.T) {
 			transact.AssertExpectations(t)
 			svc.AssertExpectations(t)
 			converter.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
 		})
 	}
 }
@@ -618,7 +627,7 @@ func TestResolver_Auth(t *testing.T) {
 			apiRtmAuthConv := testCase.APIRtmAuthConvFn()
 			persist, transact := testCase.TransactionerFn()
 
-			resolver := api.NewResolver(transact, nil, nil, rtmSvc, apiRtmAuthSvc, nil, nil, nil, apiRtmAuthConv)
+			resolver := api.NewResolver(transact, nil, nil, rtmSvc, apiRtmAuthSvc, nil, nil, nil, apiRtmAuthConv, nil)
 
 			// WHEN
 			ra, err := resolver.Auth(ctx, parentAPI, rtmID)
@@ -744,7 +753,7 @@ func TestResolver_Auths(t *testing.T) {
 			apiRtmAuthConv := testCase.APIRtmAuthConvFn()
 			persist, transact := testCase.TransactionerFn()
 
-			resolver := api.NewResolver(transact, nil, nil, nil, apiRtmAuthSvc, nil, nil, nil, apiRtmAuthConv)
+			resolver := api.NewResolver(transact, nil, nil, nil, apiRtmAuthSvc, nil, nil, nil, apiRtmAuthConv, nil)
 
 			// WHEN
 			ra, err := resolver.Auths(ctx, parentAPI)
@@ -904,7 +913,7 @@ func TestResolver_SetAPIAuth(t *testing.T) {
 			conv := testCase.AuthConvFn()
 			persist, transact := testCase.TransactionerFn()
 
-			resolver := api.NewResolver(transact, nil, nil, nil, apiRtmAuthSvc, nil, conv, nil, nil)
+			resolver := api.NewResolver(transact, nil, nil, nil, apiRtmAuthSvc, nil, conv, nil, nil, nil)
 
 			// when
 			result, err := resolver.SetAPIAuth(ctx, apiID, runtimeID, *gqlAuthInput)
@@ -1043,7 +1052,7 @@ func TestResolver_DeleteAPIAuth(t *testing.T) {
 			apiRtmAuthSvc := testCase.APIRtmAuthSvcFn()
 			authConv := testCase.AuthConvFn()
 			persist, transact := testCase.TransactionerFn()
-			resolver := api.NewResolver(transact, nil, nil, nil, apiRtmAuthSvc, nil, authConv, nil, nil)
+			resolver := api.NewResolver(transact, nil, nil, nil, apiRtmAuthSvc, nil, authConv, nil, nil, nil)
 
 			// when
 			result, err := resolver.DeleteAPIAuth(ctx, apiID, runtimeID)
@@ -1089,6 +1098,15 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 		Spec: gqlAPISpec,
 	}
 
+	fetchFailedErr := apperrors.NewFetchFailedError("while fetching Spec: unexpected status code 404")
+
+	storedFetchRequest := &model.FetchRequest{ID: "frID", URL: "foo.bar", Mode: model.FetchModeSingle}
+	prefetcherThatSucceeds := func() *automock.SpecPrefetcher {
+		prefetcher := &automock.SpecPrefetcher{}
+		prefetcher.On("Prefetch", context.TODO(), []*model.FetchRequest{storedFetchRequest}).Return(context.TODO()).Once()
+		return prefetcher
+	}
+
 	txGen := txtest.NewTransactionContextGenerator(testErr)
 
 	testCases := []struct {
@@ -1096,14 +1114,18 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn       func() *automock.APIService
 		ConvFn          func() *automock.APIConverter
+		PrefetcherFn    func() *automock.SpecPrefetcher
 		ExpectedAPISpec *graphql.APISpec
 		ExpectedErr     error
 	}{
 		{
-			Name:            "Success",
-			TransactionerFn: txGen.ThatSucceeds,
+			Name: "Success",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(storedFetchRequest, nil).Once()
 				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(modelAPISpec, nil).Once()
 				return svc
 			},
@@ -1112,6 +1134,7 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 				conv.On("ToGraphQL", modelAPIDefinition).Return(gqlAPIDefinition).Once()
 				return conv
 			},
+			PrefetcherFn:    prefetcherThatSucceeds,
 			ExpectedAPISpec: gqlAPISpec,
 			ExpectedErr:     nil,
 		},
@@ -1130,10 +1153,32 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 			ExpectedErr:     testErr,
 		},
 		{
-			Name:            "Returns error when refetching api spec failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			Name: "Returns error and persists FetchRequest status when fetching spec failed",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(storedFetchRequest, nil).Once()
+				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(nil, fetchFailedErr).Once()
+				return svc
+			},
+			ConvFn: func() *automock.APIConverter {
+				conv := &automock.APIConverter{}
+				return conv
+			},
+			PrefetcherFn:    prefetcherThatSucceeds,
+			ExpectedAPISpec: nil,
+			ExpectedErr:     fetchFailedErr,
+		},
+		{
+			Name: "Returns error when refetching api spec failed",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommitAfterSucceeding(1)
+			},
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(storedFetchRequest, nil).Once()
 				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(nil, testErr).Once()
 				return svc
 			},
@@ -1141,14 +1186,18 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 				conv := &automock.APIConverter{}
 				return conv
 			},
+			PrefetcherFn:    prefetcherThatSucceeds,
 			ExpectedAPISpec: nil,
 			ExpectedErr:     testErr,
 		},
 		{
-			Name:            "Returns error when commit transaction failed",
-			TransactionerFn: txGen.ThatFailsOnCommit,
+			Name: "Returns error when commit transaction failed",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatFailsOnCommitAfterSucceeding(1)
+			},
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(storedFetchRequest, nil).Once()
 				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(modelAPISpec, nil).Once()
 				return svc
 			},
@@ -1156,6 +1205,40 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 				conv := &automock.APIConverter{}
 				return conv
 			},
+			PrefetcherFn:    prefetcherThatSucceeds,
+			ExpectedAPISpec: nil,
+			ExpectedErr:     testErr,
+		},
+		{
+			Name: "Success when API has no FetchRequest",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(nil, nil).Once()
+				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(modelAPISpec, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.APIConverter {
+				conv := &automock.APIConverter{}
+				conv.On("ToGraphQL", modelAPIDefinition).Return(gqlAPIDefinition).Once()
+				return conv
+			},
+			ExpectedAPISpec: gqlAPISpec,
+			ExpectedErr:     nil,
+		},
+		{
+			Name:            "Returns error when getting FetchRequest failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConvFn: func() *automock.APIConverter {
+				return &automock.APIConverter{}
+			},
 			ExpectedAPISpec: nil,
 			ExpectedErr:     testErr,
 		},
@@ -1167,7 +1250,11 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 			svc := testCase.ServiceFn()
 			conv := testCase.ConvFn()
 			persist, transact := testCase.TransactionerFn()
-			resolver := api.NewResolver(transact, svc, nil, nil, nil, conv, nil, nil, nil)
+			prefetcher := &automock.SpecPrefetcher{}
+			if testCase.PrefetcherFn != nil {
+				prefetcher = testCase.PrefetcherFn()
+			}
+			resolver := api.NewResolver(transact, svc, nil, nil, nil, conv, nil, nil, nil, prefetcher)
 
 			// when
 			result, err := resolver.RefetchAPISpec(context.TODO(), apiID)
@@ -1180,6 +1267,7 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 			transact.AssertExpectations(t)
 			svc.AssertExpectations(t)
 			conv.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
 		})
 	}
 }
@@ -1303,7 +1391,7 @@ func TestResolver_FetchRequest(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := api.NewResolver(transact, svc, nil, nil, nil, nil, nil, converter, nil)
+			resolver := api.NewResolver(transact, svc, nil, nil, nil, nil, nil, converter, nil, nil)
 
 			// when
 			result, err := resolver.FetchRequest(context.TODO(), &graphql.APISpec{DefinitionID: id})
@@ -1319,3 +1407,259 @@ func TestResolver_FetchRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_Data(t *testing.T) {
+	// given
+	yamlData := graphql.CLOB("openapi: 3.0.0\n")
+	jsonData := graphql.CLOB("{\n  \"openapi\": \"3.0.0\"\n}")
+	jsonFormat := graphql.SpecFormatJSON
+	xmlFormat := graphql.SpecFormatXML
+
+	testCases := []struct {
+		Name           string
+		Spec           *graphql.APISpec
+		Format         *graphql.SpecFormat
+		ExpectedResult *graphql.CLOB
+		ExpectedErr    string
+	}{
+		{
+			Name:           "Returns data as it is when format not specified",
+			Spec:           &graphql.APISpec{Data: &yamlData, Format: graphql.SpecFormatYaml},
+			ExpectedResult: &yamlData,
+		},
+		{
+			Name:           "Returns data in requested format",
+			Spec:           &graphql.APISpec{Data: &yamlData, Format: graphql.SpecFormatYaml},
+			Format:         &jsonFormat,
+			ExpectedResult: &jsonData,
+		},
+		{
+			Name:   "Returns nil when there is no data",
+			Spec:   &graphql.APISpec{Format: graphql.SpecFormatYaml},
+			Format: &jsonFormat,
+		},
+		{
+			Name:        "Returns error when conversion is not supported",
+			Spec:        &graphql.APISpec{Data: &yamlData, Format: graphql.SpecFormatYaml},
+			Format:      &xmlFormat,
+			ExpectedErr: "while converting API Spec to XML",
+		},
+		{
+			Name:        "Returns error when parent object is nil",
+			Format:      &jsonFormat,
+			ExpectedErr: "API Spec cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			resolver := api.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.Data(context.TODO(), testCase.Spec, testCase.Format)
+
+			// then
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+		})
+	}
+}
+
+func TestResolver_SetAPILabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	labelKey := "domain"
+	labelValue := "payments"
+	labelInput := &model.LabelInput{
+		Key:        labelKey,
+		Value:      labelValue,
+		ObjectType: model.APIDefinitionLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.APIService
+		ExpectedResult  *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.Label{
+				Key:   labelKey,
+				Value: labelValue,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := api.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.SetAPILabel(context.TODO(), objID, labelKey, labelValue)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_DeleteAPILabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	labelKey := "domain"
+	labelValue := "payments"
+	modelLabel := &model.Label{
+		ID:         "1",
+		Key:        labelKey,
+		Value:      labelValue,
+		ObjectType: model.APIDefinitionLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.APIService
+		ExpectedResult  *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), objID, labelKey).Return(modelLabel, nil).Once()
+				svc.On("DeleteLabel", txtest.CtxWithDBMatcher(), objID, labelKey).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.Label{
+				Key:   labelKey,
+				Value: labelValue,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := api.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.DeleteAPILabel(context.TODO(), objID, labelKey)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_Labels(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	modelLabels := map[string]*model.Label{
+		"domain": {
+			ID:         "1",
+			Key:        "domain",
+			Value:      "payments",
+			ObjectType: model.APIDefinitionLabelableObject,
+			ObjectID:   objID,
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.APIService
+		ExpectedResult  graphql.Labels
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), objID).Return(modelLabels, nil).Once()
+				return svc
+			},
+			ExpectedResult: graphql.Labels{"domain": "payments"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := api.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.Labels(context.TODO(), &graphql.APIDefinition{ID: objID}, nil)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when API Definition is nil", func(t *testing.T) {
+		resolver := api.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.Labels(context.TODO(), nil, nil)
+		// then
+		require.EqualError(t, err, "API Definition cannot be empty")
+	})
+}
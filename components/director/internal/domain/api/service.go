@@ -6,7 +6,10 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/pkg/errors"
@@ -17,7 +20,7 @@ type APIRepository interface {
 	GetByID(ctx context.Context, tenantID, id string) (*model.APIDefinition, error)
 	GetForApplication(ctx context.Context, tenant string, id string, applicationID string) (*model.APIDefinition, error)
 	Exists(ctx context.Context, tenant, id string) (bool, error)
-	ListByApplicationID(ctx context.Context, tenantID, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error)
+	ListByApplicationID(ctx context.Context, tenantID, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.APIDefinitionPage, error)
 	CreateMany(ctx context.Context, item []*model.APIDefinition) error
 	Create(ctx context.Context, item *model.APIDefinition) error
 	Update(ctx context.Context, item *model.APIDefinition) error
@@ -32,27 +35,61 @@ type FetchRequestRepository interface {
 	DeleteByReferenceObjectID(ctx context.Context, tenant string, objectType model.FetchRequestReferenceObjectType, objectID string) error
 }
 
+//go:generate mockery -name=FetchRequestService -output=automock -outpkg=automock -case=underscore
+type FetchRequestService interface {
+	HandleSpec(ctx context.Context, fr *model.FetchRequest, validate func(data string) error) (*string, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
+}
+
+//go:generate mockery -name=LabelUpsertService -output=automock -outpkg=automock -case=underscore
+type LabelUpsertService interface {
+	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
+}
+
+//go:generate mockery -name=ConfigurationChangeNotifier -output=automock -outpkg=automock -case=underscore
+type ConfigurationChangeNotifier interface {
+	NotifyConfigurationChanged(ctx context.Context, applicationID string, change model.ConfigurationChange) error
+}
+
 type service struct {
-	repo             APIRepository
-	fetchRequestRepo FetchRequestRepository
-	uidService       UIDService
-	timestampGen     timestamp.Generator
+	*label.ObjectService
+
+	repo                APIRepository
+	fetchRequestRepo    FetchRequestRepository
+	fetchRequestService FetchRequestService
+	uidService          UIDService
+	notifier            ConfigurationChangeNotifier
+	timestampGen        timestamp.Generator
 }
 
-func NewService(repo APIRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService) *service {
-	return &service{repo: repo,
-		fetchRequestRepo: fetchRequestRepo,
-		uidService:       uidService,
-		timestampGen:     timestamp.DefaultGenerator(),
+func NewService(repo APIRepository, fetchRequestRepo FetchRequestRepository, fetchRequestService FetchRequestService, uidService UIDService, labelRepo LabelRepository, labelUpsertService LabelUpsertService, notifier ConfigurationChangeNotifier) *service {
+	exists := func(ctx context.Context, tnt, id string) (bool, error) {
+		return repo.Exists(ctx, tnt, id)
+	}
+
+	return &service{
+		ObjectService:       label.NewObjectService(model.APIDefinitionLabelableObject, "API Definition", exists, labelRepo, labelUpsertService),
+		repo:                repo,
+		fetchRequestRepo:    fetchRequestRepo,
+		fetchRequestService: fetchRequestService,
+		uidService:          uidService,
+		notifier:            notifier,
+		timestampGen:        timestamp.DefaultGenerator(),
 	}
 }
 
-func (s *service) List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
+func (s *service) List(ctx context.Context, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -62,7 +99,7 @@ func (s *service) List(ctx context.Context, applicationID string, pageSize int,
 		return nil, errors.New("page size must be between 1 and 100")
 	}
 
-	return s.repo.ListByApplicationID(ctx, tnt, applicationID, pageSize, cursor)
+	return s.repo.ListByApplicationID(ctx, tnt, applicationID, filter, pageSize, cursor)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.APIDefinition, error) {
@@ -102,16 +139,40 @@ func (s *service) Create(ctx context.Context, applicationID string, in model.API
 	id := s.uidService.Generate()
 
 	api := in.ToAPIDefinition(id, applicationID, tnt)
+	err = specvalidation.ValidateAPISpec(api.Spec)
+	if err != nil {
+		return "", errors.Wrapf(err, "while validating Spec for APIDefinition %s", id)
+	}
+
 	err = s.repo.Create(ctx, api)
 	if err != nil {
 		return "", err
 	}
 
 	if in.Spec != nil && in.Spec.FetchRequest != nil {
-		_, err = s.createFetchRequest(ctx, tnt, *in.Spec.FetchRequest, id)
+		fr, err := s.createFetchRequest(ctx, tnt, *in.Spec.FetchRequest, id)
 		if err != nil {
 			return "", errors.Wrapf(err, "while creating FetchRequest for APIDefinition %s", id)
 		}
+
+		data, err := s.fetchRequestService.HandleSpec(ctx, fr, specvalidation.APISpecValidator(api.Spec))
+		if err != nil {
+			return "", errors.Wrapf(err, "while fetching Spec for APIDefinition %s", id)
+		}
+		// the Spec provided in the input is kept when the fetch fails or the fetched Spec is invalid
+		if data != nil {
+			api.Spec.Data = data
+		}
+
+		err = s.repo.Update(ctx, api)
+		if err != nil {
+			return "", errors.Wrapf(err, "while updating APIDefinition %s with fetched Spec", id)
+		}
+	}
+
+	err = s.notifier.NotifyConfigurationChanged(ctx, applicationID, apiChange(id, model.ConfigurationChangeOperationCreated))
+	if err != nil {
+		return "", errors.Wrapf(err, "while notifying about creation of APIDefinition %s", id)
 	}
 
 	return id, nil
@@ -133,20 +194,37 @@ func (s *service) Update(ctx context.Context, id string, in model.APIDefinitionI
 		return errors.Wrapf(err, "while deleting FetchRequest for APIDefinition %s", id)
 	}
 
+	api = in.ToAPIDefinition(id, api.ApplicationID, tnt)
+	err = specvalidation.ValidateAPISpec(api.Spec)
+	if err != nil {
+		return errors.Wrapf(err, "while validating Spec for APIDefinition %s", id)
+	}
+
 	if in.Spec != nil && in.Spec.FetchRequest != nil {
-		_, err = s.createFetchRequest(ctx, tnt, *in.Spec.FetchRequest, id)
+		fr, err := s.createFetchRequest(ctx, tnt, *in.Spec.FetchRequest, id)
 		if err != nil {
 			return errors.Wrapf(err, "while creating FetchRequest for APIDefinition %s", id)
 		}
-	}
 
-	api = in.ToAPIDefinition(id, api.ApplicationID, tnt)
+		data, err := s.fetchRequestService.HandleSpec(ctx, fr, specvalidation.APISpecValidator(api.Spec))
+		if err != nil {
+			return errors.Wrapf(err, "while fetching Spec for APIDefinition %s", id)
+		}
+		if data != nil {
+			api.Spec.Data = data
+		}
+	}
 
 	err = s.repo.Update(ctx, api)
 	if err != nil {
 		return errors.Wrapf(err, "while updating APIDefinition with ID %s", id)
 	}
 
+	err = s.notifier.NotifyConfigurationChanged(ctx, api.ApplicationID, apiChange(id, model.ConfigurationChangeOperationUpdated))
+	if err != nil {
+		return errors.Wrapf(err, "while notifying about update of APIDefinition %s", id)
+	}
+
 	return nil
 }
 
@@ -156,11 +234,21 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	api, err := s.repo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return err
+	}
+
 	err = s.repo.Delete(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting APIDefinition with ID %s", id)
 	}
 
+	err = s.notifier.NotifyConfigurationChanged(ctx, api.ApplicationID, apiChange(id, model.ConfigurationChangeOperationDeleted))
+	if err != nil {
+		return errors.Wrapf(err, "while notifying about deletion of APIDefinition %s", id)
+	}
+
 	return nil
 }
 
@@ -175,6 +263,43 @@ func (s *service) RefetchAPISpec(ctx context.Context, id string) (*model.APISpec
 		return nil, err
 	}
 
+	fetchRequest, err := s.fetchRequestRepo.GetByReferenceObjectID(ctx, tnt, model.APIFetchRequestReference, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return api.Spec, nil
+		}
+		return nil, errors.Wrapf(err, "while getting FetchRequest by API Definition ID %s", id)
+	}
+
+	if api.Spec == nil {
+		api.Spec = &model.APISpec{}
+	}
+
+	data, err := s.fetchRequestService.HandleSpec(ctx, fetchRequest, specvalidation.APISpecValidator(api.Spec))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while refetching Spec for API Definition with ID %s", id)
+	}
+
+	if fetchRequest.Status != nil && fetchRequest.Status.Condition == model.FetchRequestStatusConditionFailed {
+		reason := "unknown reason"
+		if fetchRequest.Status.Message != nil {
+			reason = *fetchRequest.Status.Message
+		}
+		return nil, apperrors.NewFetchFailedError(reason)
+	}
+
+	api.Spec.Data = data
+
+	err = s.repo.Update(ctx, api)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while updating API Definition with ID %s", id)
+	}
+
+	err = s.notifier.NotifyConfigurationChanged(ctx, api.ApplicationID, apiChange(id, model.ConfigurationChangeOperationUpdated))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while notifying about update of API Definition %s", id)
+	}
+
 	return api.Spec, nil
 }
 
@@ -203,7 +328,7 @@ func (s *service) GetFetchRequest(ctx context.Context, apiDefID string) (*model.
 	return fetchRequest, nil
 }
 
-func (s *service) createFetchRequest(ctx context.Context, tenant string, in model.FetchRequestInput, parentObjectID string) (*string, error) {
+func (s *service) createFetchRequest(ctx context.Context, tenant string, in model.FetchRequestInput, parentObjectID string) (*model.FetchRequest, error) {
 	id := s.uidService.Generate()
 	fr := in.ToFetchRequest(s.timestampGen(), id, tenant, model.APIFetchRequestReference, parentObjectID)
 	err := s.fetchRequestRepo.Create(ctx, fr)
@@ -211,5 +336,13 @@ func (s *service) createFetchRequest(ctx context.Context, tenant string, in mode
 		return nil, errors.Wrapf(err, "while creating FetchRequest for %s with ID %s", model.APIFetchRequestReference, parentObjectID)
 	}
 
-	return &id, nil
+	return fr, nil
+}
+
+func apiChange(id string, operation model.ConfigurationChangeOperation) model.ConfigurationChange {
+	return model.ConfigurationChange{
+		ResourceType: model.ConfigurationChangeResourceTypeAPI,
+		ResourceID:   id,
+		Operation:    operation,
+	}
 }
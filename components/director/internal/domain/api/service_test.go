@@ -15,6 +15,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/pkg/errors"
@@ -70,7 +71,7 @@ func TestService_Get(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := api.NewService(repo, nil, nil)
+			svc := api.NewService(repo, nil, nil, nil, nil, nil, nil)
 
 			// when
 			document, err := svc.Get(ctx, testCase.InputID)
@@ -88,7 +89,7 @@ func TestService_Get(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Get(context.TODO(), "")
 		// THEN
@@ -149,7 +150,7 @@ func TestService_GetForApplication(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := api.NewService(repo, nil, nil)
+			svc := api.NewService(repo, nil, nil, nil, nil, nil, nil)
 
 			// when
 			document, err := svc.GetForApplication(ctx, testCase.InputID, testCase.ApplicationID)
@@ -167,7 +168,7 @@ func TestService_GetForApplication(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForApplication(context.TODO(), "", "")
 		// THEN
@@ -201,6 +202,7 @@ func TestService_List(t *testing.T) {
 	}
 
 	after := "test"
+	filter := []*labelfilter.LabelFilter{{Key: "domain"}}
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
@@ -216,7 +218,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
-				repo.On("ListByApplicationID", ctx, tenantID, applicationID, 2, after).Return(apiDefinitionPage, nil).Once()
+				repo.On("ListByApplicationID", ctx, tenantID, applicationID, filter, 2, after).Return(apiDefinitionPage, nil).Once()
 				return repo
 			},
 			PageSize:           2,
@@ -247,7 +249,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when APIDefinition listing failed",
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
-				repo.On("ListByApplicationID", ctx, tenantID, applicationID, 2, after).Return(nil, testErr).Once()
+				repo.On("ListByApplicationID", ctx, tenantID, applicationID, filter, 2, after).Return(nil, testErr).Once()
 				return repo
 			},
 			PageSize:           2,
@@ -260,10 +262,10 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := api.NewService(repo, nil, nil)
+			svc := api.NewService(repo, nil, nil, nil, nil, nil, nil)
 
 			// when
-			docs, err := svc.List(ctx, applicationID, testCase.PageSize, after)
+			docs, err := svc.List(ctx, applicationID, filter, testCase.PageSize, after)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -278,9 +280,9 @@ func TestService_List(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
-		_, err := svc.List(context.TODO(), "", 5, "")
+		_, err := svc.List(context.TODO(), "", nil, 5, "")
 		// THEN
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot read tenant from context")
@@ -304,6 +306,8 @@ func TestService_Create(t *testing.T) {
 		Name:      name,
 		TargetURL: targetUrl,
 		Spec: &model.APISpecInput{
+			Type:   model.APISpecTypeOpenAPI,
+			Format: model.SpecFormatYaml,
 			FetchRequest: &model.FetchRequestInput{
 				URL: frURL,
 			},
@@ -317,26 +321,43 @@ func TestService_Create(t *testing.T) {
 		Tenant:        tenantID,
 		Name:          name,
 		TargetURL:     targetUrl,
-		Spec:          &model.APISpec{},
+		Spec:          &model.APISpec{Type: model.APISpecTypeOpenAPI, Format: model.SpecFormatYaml},
+		Version:       &model.Version{},
+	}
+
+	spec := openAPISpec
+	invalidSpec := invalidAPISpec
+	modelAPIDefinitionWithSpec := &model.APIDefinition{
+		ID:            id,
+		ApplicationID: applicationID,
+		Tenant:        tenantID,
+		Name:          name,
+		TargetURL:     targetUrl,
+		Spec:          &model.APISpec{Data: &spec, Type: model.APISpecTypeOpenAPI, Format: model.SpecFormatYaml},
 		Version:       &model.Version{},
 	}
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
 
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeAPI, ResourceID: id, Operation: model.ConfigurationChangeOperationCreated}
+
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.APIRepository
-		FetchRequestRepoFn func() *automock.FetchRequestRepository
-		UIDServiceFn       func() *automock.UIDService
-		Input              model.APIDefinitionInput
-		ExpectedErr        error
+		Name                  string
+		RepositoryFn          func() *automock.APIRepository
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		FetchRequestServiceFn func() *automock.FetchRequestService
+		UIDServiceFn          func() *automock.UIDService
+		NotifierFn            func() *automock.ConfigurationChangeNotifier
+		Input                 model.APIDefinitionInput
+		ExpectedErr           error
 	}{
 		{
 			Name: "Success",
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
 				repo.On("Create", ctx, modelAPIDefinition).Return(nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithSpec).Return(nil).Once()
 				return repo
 			},
 			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
@@ -344,6 +365,11 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
@@ -352,6 +378,43 @@ func TestService_Create(t *testing.T) {
 			},
 			Input:       modelInput,
 			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Error - Notifying about creation",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("Create", ctx, modelAPIDefinition).Return(nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithSpec).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input: modelInput,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErr: testErr,
 		},
 		{
 			Name: "Error - API Creation",
@@ -364,6 +427,9 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
@@ -384,6 +450,35 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(testErr).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - Fetching Spec",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("Create", ctx, modelAPIDefinition).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(nil, testErr).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
@@ -393,6 +488,91 @@ func TestService_Create(t *testing.T) {
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
+		{
+			Name: "Error - API Update with fetched Spec",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("Create", ctx, modelAPIDefinition).Return(nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithSpec).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Success when fetched Spec is invalid keeps the provided Spec",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("Create", ctx, modelAPIDefinition).Return(nil).Once()
+				repo.On("Update", ctx, modelAPIDefinition).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), fixSpecValidator(openAPISpec, invalidAPISpec)).Return(nil, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input:       modelInput,
+			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Error - invalid Spec",
+			RepositoryFn: func() *automock.APIRepository {
+				return &automock.APIRepository{}
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				return svc
+			},
+			Input: model.APIDefinitionInput{
+				Name: name,
+				Spec: &model.APISpecInput{
+					Data:   &invalidSpec,
+					Type:   model.APISpecTypeOpenAPI,
+					Format: model.SpecFormatJSON,
+				},
+			},
+			ExpectedErr: errors.New("while validating Spec for APIDefinition foo: while parsing JSON spec: line 1, column 1"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -400,9 +580,15 @@ func TestService_Create(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			fetchRequestService := testCase.FetchRequestServiceFn()
 			uidService := testCase.UIDServiceFn()
 
-			svc := api.NewService(repo, fetchRequestRepo, uidService)
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+
+			svc := api.NewService(repo, fetchRequestRepo, fetchRequestService, uidService, nil, nil, notifier)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -418,11 +604,13 @@ func TestService_Create(t *testing.T) {
 
 			repo.AssertExpectations(t)
 			fetchRequestRepo.AssertExpectations(t)
+			fetchRequestService.AssertExpectations(t)
 			uidService.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Create(context.TODO(), "", model.APIDefinitionInput{})
 		// THEN
@@ -444,6 +632,8 @@ func TestService_Update(t *testing.T) {
 		Name:      "Foo",
 		TargetURL: "https://test-url.com",
 		Spec: &model.APISpecInput{
+			Type:   model.APISpecTypeOpenAPI,
+			Format: model.SpecFormatYaml,
 			FetchRequest: &model.FetchRequestInput{
 				URL: frURL,
 			},
@@ -452,10 +642,6 @@ func TestService_Update(t *testing.T) {
 		Version:     &model.VersionInput{},
 	}
 
-	inputAPIDefinitionModel := mock.MatchedBy(func(api *model.APIDefinition) bool {
-		return api.Name == modelInput.Name
-	})
-
 	apiDefinitionModel := &model.APIDefinition{
 		Name:          "Bar",
 		ApplicationID: "id",
@@ -465,17 +651,43 @@ func TestService_Update(t *testing.T) {
 		Version:       &model.Version{},
 	}
 
+	spec := openAPISpec
+	invalidSpec := invalidAPISpec
+	inputAPIDefinitionModel := mock.MatchedBy(func(api *model.APIDefinition) bool {
+		return api.Name == modelInput.Name && api.Spec.Data != nil && *api.Spec.Data == spec
+	})
+
+	modelInputWithSpec := modelInput
+	modelInputWithSpec.Spec = &model.APISpecInput{
+		Data:         &spec,
+		Type:         model.APISpecTypeOpenAPI,
+		Format:       model.SpecFormatYaml,
+		FetchRequest: &model.FetchRequestInput{URL: frURL},
+	}
+
+	modelInputWithInvalidSpec := modelInput
+	modelInputWithInvalidSpec.Spec = &model.APISpecInput{
+		Data:         &invalidSpec,
+		Type:         model.APISpecTypeOpenAPI,
+		Format:       model.SpecFormatYaml,
+		FetchRequest: &model.FetchRequestInput{URL: frURL},
+	}
+
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
 
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeAPI, ResourceID: id, Operation: model.ConfigurationChangeOperationUpdated}
+
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.APIRepository
-		FetchRequestRepoFn func() *automock.FetchRequestRepository
-		UIDServiceFn       func() *automock.UIDService
-		Input              model.APIDefinitionInput
-		InputID            string
-		ExpectedErr        error
+		Name                  string
+		RepositoryFn          func() *automock.APIRepository
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		FetchRequestServiceFn func() *automock.FetchRequestService
+		UIDServiceFn          func() *automock.UIDService
+		NotifierFn            func() *automock.ConfigurationChangeNotifier
+		Input                 model.APIDefinitionInput
+		InputID               string
+		ExpectedErr           error
 	}{
 		{
 			Name: "Success",
@@ -491,6 +703,11 @@ func TestService_Update(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(frID).Once()
@@ -499,6 +716,77 @@ func TestService_Update(t *testing.T) {
 			InputID:     "foo",
 			Input:       modelInput,
 			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, apiDefinitionModel.ApplicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Notifying Error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(apiDefinitionModel, nil).Once()
+				repo.On("Update", ctx, inputAPIDefinitionModel).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, id).Return(nil).Once()
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			InputID: "foo",
+			Input:   modelInput,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, apiDefinitionModel.ApplicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Success when fetching Spec failed keeps the provided Spec",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(apiDefinitionModel, nil).Once()
+				repo.On("Update", ctx, inputAPIDefinitionModel).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, id).Return(nil).Once()
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), fixSpecValidator(openAPISpec, invalidAPISpec)).Return(nil, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			InputID:     "foo",
+			Input:       modelInputWithSpec,
+			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, apiDefinitionModel.ApplicationID, change).Return(nil).Once()
+				return notifier
+			},
 		},
 		{
 			Name: "Update Error",
@@ -514,6 +802,11 @@ func TestService_Update(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(frID).Once()
@@ -535,6 +828,9 @@ func TestService_Update(t *testing.T) {
 				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, id).Return(testErr).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				return svc
@@ -556,6 +852,36 @@ func TestService_Update(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(testErr).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			InputID:     "foo",
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Fetching Spec Error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, "foo").Return(apiDefinitionModel, nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, id).Return(nil).Once()
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(nil, testErr).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(frID).Once()
@@ -565,12 +891,37 @@ func TestService_Update(t *testing.T) {
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
+		{
+			Name: "Invalid Spec Error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, "foo").Return(apiDefinitionModel, nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, id).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			InputID:     "foo",
+			Input:       modelInputWithInvalidSpec,
+			ExpectedErr: errors.New("while validating Spec for APIDefinition foo: invalid OpenAPI spec: root (line 1, column 1): info is required"),
+		},
 		{
 			Name: "Get Error",
 			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				return svc
@@ -591,9 +942,15 @@ func TestService_Update(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			fetchRequestService := testCase.FetchRequestServiceFn()
 			uidSvc := testCase.UIDServiceFn()
 
-			svc := api.NewService(repo, fetchRequestRepo, uidSvc)
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+
+			svc := api.NewService(repo, fetchRequestRepo, fetchRequestService, uidSvc, nil, nil, notifier)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -609,11 +966,13 @@ func TestService_Update(t *testing.T) {
 
 			repo.AssertExpectations(t)
 			fetchRequestRepo.AssertExpectations(t)
+			fetchRequestService.AssertExpectations(t)
 			uidSvc.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Update(context.TODO(), "", model.APIDefinitionInput{})
 		// THEN
@@ -627,13 +986,18 @@ func TestService_Delete(t *testing.T) {
 	testErr := errors.New("Test error")
 
 	id := "foo"
+	applicationID := "bar"
+	apiDefinitionModel := &model.APIDefinition{ID: id, ApplicationID: applicationID, Tenant: tenantID}
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
 
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeAPI, ResourceID: id, Operation: model.ConfigurationChangeOperationDeleted}
+
 	testCases := []struct {
 		Name         string
 		RepositoryFn func() *automock.APIRepository
+		NotifierFn   func() *automock.ConfigurationChangeNotifier
 		Input        model.APIDefinitionInput
 		InputID      string
 		ExpectedErr  error
@@ -642,16 +1006,49 @@ func TestService_Delete(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(apiDefinitionModel, nil).Once()
 				repo.On("Delete", ctx, tenantID, id).Return(nil).Once()
 				return repo
 			},
 			InputID:     id,
 			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Notifying Error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(apiDefinitionModel, nil).Once()
+				repo.On("Delete", ctx, tenantID, id).Return(nil).Once()
+				return repo
+			},
+			InputID: id,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Get Error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:     id,
+			ExpectedErr: testErr,
 		},
 		{
 			Name: "Delete Error",
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(apiDefinitionModel, nil).Once()
 				repo.On("Delete", ctx, tenantID, id).Return(testErr).Once()
 				return repo
 			},
@@ -665,7 +1062,12 @@ func TestService_Delete(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := api.NewService(repo, nil, nil)
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+
+			svc := api.NewService(repo, nil, nil, nil, nil, nil, notifier)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -679,10 +1081,11 @@ func TestService_Delete(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Delete(context.TODO(), "")
 		// THEN
@@ -696,34 +1099,154 @@ func TestService_RefetchAPISpec(t *testing.T) {
 	testErr := errors.New("Test error")
 
 	apiID := "foo"
+	frID := "fr-id"
+	frURL := "foo.bar"
+	timestamp := time.Now()
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
 
-	dataBytes := "data"
-	modelAPISpec := &model.APISpec{
-		Data: &dataBytes,
+	dataBytes := openAPISpec
+	refetchedDataBytes := "swagger: '2.0'\ninfo:\n  title: Foo\n  version: v2\npaths: {}\n"
+
+	modelAPIDefinitionFn := func() *model.APIDefinition {
+		return &model.APIDefinition{
+			ID:     apiID,
+			Tenant: tenantID,
+			Spec: &model.APISpec{
+				Data:   &dataBytes,
+				Type:   model.APISpecTypeOpenAPI,
+				Format: model.SpecFormatYaml,
+			},
+		}
 	}
 
-	modelAPIDefinition := &model.APIDefinition{
-		Spec: modelAPISpec,
+	modelAPIDefinitionWithRefetchedSpec := &model.APIDefinition{
+		ID:     apiID,
+		Tenant: tenantID,
+		Spec: &model.APISpec{
+			Data:   &refetchedDataBytes,
+			Type:   model.APISpecTypeOpenAPI,
+			Format: model.SpecFormatYaml,
+		},
+	}
+
+	fetchRequest := fixModelFetchRequest(frID, frURL, timestamp)
+
+	failedMessage := "while fetching Spec: unexpected status code 404"
+	failedFetchRequest := fixModelFetchRequest(frID, frURL, timestamp)
+	failedFetchRequest.Status = &model.FetchRequestStatus{
+		Condition: model.FetchRequestStatusConditionFailed,
+		Message:   &failedMessage,
+		Timestamp: timestamp,
 	}
 
+	invalidMessage := "invalid OpenAPI spec: root (line 1, column 1): info is required"
+	invalidFetchRequest := fixModelFetchRequest(frID, frURL, timestamp)
+	invalidFetchRequest.Status = &model.FetchRequestStatus{
+		Condition: model.FetchRequestStatusConditionFailed,
+		Message:   &invalidMessage,
+		Timestamp: timestamp,
+	}
+
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeAPI, ResourceID: apiID, Operation: model.ConfigurationChangeOperationUpdated}
+
 	testCases := []struct {
-		Name            string
-		RepositoryFn    func() *automock.APIRepository
-		ExpectedAPISpec *model.APISpec
-		ExpectedErr     error
+		Name                  string
+		RepositoryFn          func() *automock.APIRepository
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		FetchRequestServiceFn func() *automock.FetchRequestService
+		NotifierFn            func() *automock.ConfigurationChangeNotifier
+		ExpectedAPISpec       *model.APISpec
+		ExpectedErrMessage    string
+		ExpectedFetchFailed   bool
 	}{
 		{
 			Name: "Success",
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
-				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinition, nil).Once()
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithRefetchedSpec).Return(nil).Once()
 				return repo
 			},
-			ExpectedAPISpec: modelAPISpec,
-			ExpectedErr:     nil,
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, apiID).Return(fetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fetchRequest, mock.Anything).Return(&refetchedDataBytes, nil).Once()
+				return svc
+			},
+			ExpectedAPISpec: modelAPIDefinitionWithRefetchedSpec.Spec,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, modelAPIDefinitionFn().ApplicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Notifying error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithRefetchedSpec).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, apiID).Return(fetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fetchRequest, mock.Anything).Return(&refetchedDataBytes, nil).Once()
+				return svc
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, modelAPIDefinitionFn().ApplicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Success when there is no FetchRequest",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, apiID).Return(nil, apperrors.NewNotFoundError("")).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedAPISpec: modelAPIDefinitionFn().Spec,
+		},
+		{
+			Name: "Error when fetching Spec failed",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, apiID).Return(failedFetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, failedFetchRequest, mock.Anything).Return(nil, nil).Once()
+				return svc
+			},
+			ExpectedErrMessage:  "fetching Spec failed: while fetching Spec: unexpected status code 404",
+			ExpectedFetchFailed: true,
 		},
 		{
 			Name: "Get from repository error",
@@ -732,8 +1255,89 @@ func TestService_RefetchAPISpec(t *testing.T) {
 				repo.On("GetByID", ctx, tenantID, apiID).Return(nil, testErr).Once()
 				return repo
 			},
-			ExpectedAPISpec: nil,
-			ExpectedErr:     testErr,
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Get FetchRequest error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, apiID).Return(nil, testErr).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Handle Spec error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, apiID).Return(fetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fetchRequest, mock.Anything).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Update error",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithRefetchedSpec).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, apiID).Return(fetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fetchRequest, mock.Anything).Return(&refetchedDataBytes, nil).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Error when refetched Spec is invalid",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.APIFetchRequestReference, apiID).Return(invalidFetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, invalidFetchRequest, fixSpecValidator(openAPISpec, invalidAPISpec)).Return(nil, nil).Once()
+				return svc
+			},
+			ExpectedErrMessage:  "fetching Spec failed: " + invalidMessage,
+			ExpectedFetchFailed: true,
 		},
 	}
 
@@ -741,21 +1345,37 @@ func TestService_RefetchAPISpec(t *testing.T) {
 		t.Run(fmt.Sprintf("%s", testCase.Name), func(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
+			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			fetchRequestService := testCase.FetchRequestServiceFn()
 
-			svc := api.NewService(repo, nil, nil)
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+
+			svc := api.NewService(repo, fetchRequestRepo, fetchRequestService, nil, nil, nil, notifier)
 
 			// when
 			result, err := svc.RefetchAPISpec(ctx, apiID)
 
 			// then
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Equal(t, testCase.ExpectedFetchFailed, apperrors.IsFetchFailed(err))
+			} else {
+				require.NoError(t, err)
+			}
 			assert.Equal(t, testCase.ExpectedAPISpec, result)
-			assert.Equal(t, testCase.ExpectedErr, err)
 
 			repo.AssertExpectations(t)
+			fetchRequestRepo.AssertExpectations(t)
+			fetchRequestService.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.RefetchAPISpec(context.TODO(), "")
 		// THEN
@@ -869,7 +1489,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := api.NewService(repo, fetchRequestRepo, nil)
+			svc := api.NewService(repo, fetchRequestRepo, nil, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.GetFetchRequest(ctx, testCase.InputAPIDefID)
@@ -889,9 +1509,78 @@ func TestService_GetFetchRequest(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := api.NewService(nil, nil, nil)
+		svc := api.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.GetFetchRequest(context.TODO(), "dd")
 		assert.Equal(t, tenant.NoTenantError, err)
 	})
 }
+
+func TestService_SetLabel(t *testing.T) {
+	// given
+	tnt := "tenant"
+	ctx := tenant.SaveToContext(context.TODO(), tnt)
+
+	objID := "foo"
+	labelInput := &model.LabelInput{
+		Key:        "domain",
+		Value:      "payments",
+		ObjectType: model.APIDefinitionLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name                 string
+		RepositoryFn         func() *automock.APIRepository
+		LabelUpsertServiceFn func() *automock.LabelUpsertService
+		ExpectedErrMessage   string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("Exists", ctx, tnt, objID).Return(true, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, labelInput).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Returns error when API Definition does not exist",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("Exists", ctx, tnt, objID).Return(false, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			ExpectedErrMessage: "API Definition with ID foo doesn't exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelUpsertSvc := testCase.LabelUpsertServiceFn()
+			svc := api.NewService(repo, nil, nil, nil, nil, labelUpsertSvc, nil)
+
+			// when
+			err := svc.SetLabel(ctx, labelInput)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelUpsertSvc.AssertExpectations(t)
+		})
+	}
+}
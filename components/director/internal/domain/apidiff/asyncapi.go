@@ -0,0 +1,100 @@
+package apidiff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+var asyncAPIOperations = []string{"publish", "subscribe"}
+
+// diffAsyncAPI compares operations, messages and schemas of two AsyncAPI v1 or v2 documents
+func diffAsyncAPI(from, to map[string]interface{}) []*model.APIChange {
+	c := &changes{}
+	c.diffCollections(model.APIChangeKindOperation, "operation", "", asyncAPIChannelOperations(from), asyncAPIChannelOperations(to), c.diffAsyncAPIOperation)
+	c.diffCollections(model.APIChangeKindMessage, "message", "#/components/messages/", object(field(from, "components", "messages")), object(field(to, "components", "messages")), func(path string, from, to map[string]interface{}) {
+		c.diffMessage(model.APIChangeKindMessage, path, "", from, to)
+	})
+	c.diffSchemas("#/components/schemas/", object(field(from, "components", "schemas")), object(field(to, "components", "schemas")))
+	return c.items
+}
+
+// asyncAPIChannelOperations returns operations identified by type and channel, or topic in AsyncAPI v1
+func asyncAPIChannelOperations(doc map[string]interface{}) map[string]interface{} {
+	operations := make(map[string]interface{})
+	for _, channelsKey := range []string{"channels", "topics"} {
+		for channelName, value := range object(doc[channelsKey]) {
+			channel := object(value)
+			for _, operationType := range asyncAPIOperations {
+				if operation, ok := channel[operationType]; ok {
+					operations[fmt.Sprintf("%s %s", strings.ToUpper(operationType), channelName)] = operation
+				}
+			}
+		}
+	}
+
+	return operations
+}
+
+func (c *changes) diffAsyncAPIOperation(path string, from, to map[string]interface{}) {
+	c.diffMessage(model.APIChangeKindOperation, path, "message", operationMessage(from), operationMessage(to))
+}
+
+// operationMessage returns message of AsyncAPI v2 operation; in AsyncAPI v1 the operation is the message itself
+func operationMessage(operation map[string]interface{}) map[string]interface{} {
+	if message, ok := operation["message"]; ok {
+		return object(message)
+	}
+	return operation
+}
+
+func (c *changes) diffMessage(kind model.APIChangeKind, path, scope string, from, to map[string]interface{}) {
+	fromRef, toRef := stringField(from, "$ref"), stringField(to, "$ref")
+	if fromRef != toRef {
+		c.addScoped(kind, path, scope, true, "reference changed from %s to %s", describeValue(fromRef), describeValue(toRef))
+		return
+	}
+
+	fromContentType, toContentType := stringField(from, "contentType"), stringField(to, "contentType")
+	if fromContentType != toContentType {
+		c.addScoped(kind, path, scope, true, "content type changed from %s to %s", describeValue(fromContentType), describeValue(toContentType))
+	}
+
+	c.diffMessageVariants(kind, path, scope, from["oneOf"], to["oneOf"])
+	c.diffSchema(kind, anyDirection, path, joinScope(scope, "headers"), "", object(from["headers"]), object(to["headers"]))
+	c.diffSchema(kind, anyDirection, path, joinScope(scope, "payload"), "", object(from["payload"]), object(to["payload"]))
+}
+
+// diffMessageVariants compares referenced messages of the oneOf list
+func (c *changes) diffMessageVariants(kind model.APIChangeKind, path, scope string, from, to interface{}) {
+	fromSet, toSet := variantSet(from), variantSet(to)
+	for _, ref := range sortedKeysOf(fromSet) {
+		if !toSet[ref] {
+			c.addScoped(kind, path, scope, true, "message %s removed from oneOf", ref)
+		}
+	}
+	for _, ref := range sortedKeysOf(toSet) {
+		if !fromSet[ref] {
+			c.addScoped(kind, path, scope, false, "message %s added to oneOf", ref)
+		}
+	}
+}
+
+func variantSet(value interface{}) map[string]bool {
+	set := make(map[string]bool)
+	items, _ := value.([]interface{})
+	for _, item := range items {
+		if ref := stringField(object(item), "$ref"); ref != "" {
+			set[ref] = true
+		}
+	}
+	return set
+}
+
+func joinScope(scope, part string) string {
+	if scope == "" {
+		return part
+	}
+	return scope + " " + part
+}
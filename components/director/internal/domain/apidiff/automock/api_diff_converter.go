@@ -0,0 +1,30 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIDiffConverter is an autogenerated mock type for the APIDiffConverter type
+type APIDiffConverter struct {
+	mock.Mock
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *APIDiffConverter) ToGraphQL(in *model.APIDiff) *graphql.APIDiff {
+	ret := _m.Called(in)
+
+	var r0 *graphql.APIDiff
+	if rf, ok := ret.Get(0).(func(*model.APIDiff) *graphql.APIDiff); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.APIDiff)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIDiffService is an autogenerated mock type for the APIDiffService type
+type APIDiffService struct {
+	mock.Mock
+}
+
+// APIDiff provides a mock function with given fields: ctx, fromID, toID
+func (_m *APIDiffService) APIDiff(ctx context.Context, fromID string, toID string) (*model.APIDiff, error) {
+	ret := _m.Called(ctx, fromID, toID)
+
+	var r0 *model.APIDiff
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.APIDiff); ok {
+		r0 = rf(ctx, fromID, toID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, fromID, toID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventAPIDiff provides a mock function with given fields: ctx, fromID, toID
+func (_m *APIDiffService) EventAPIDiff(ctx context.Context, fromID string, toID string) (*model.APIDiff, error) {
+	ret := _m.Called(ctx, fromID, toID)
+
+	var r0 *model.APIDiff
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.APIDiff); ok {
+		r0 = rf(ctx, fromID, toID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, fromID, toID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,38 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIRepository is an autogenerated mock type for the APIRepository type
+type APIRepository struct {
+	mock.Mock
+}
+
+// GetByID provides a mock function with given fields: ctx, tenantID, id
+func (_m *APIRepository) GetByID(ctx context.Context, tenantID string, id string) (*model.APIDefinition, error) {
+	ret := _m.Called(ctx, tenantID, id)
+
+	var r0 *model.APIDefinition
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.APIDefinition); ok {
+		r0 = rf(ctx, tenantID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIDefinition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenantID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,86 @@
+package apidiff
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+type changes struct {
+	items []*model.APIChange
+}
+
+func (c *changes) add(kind model.APIChangeKind, changeType model.APIChangeType, path string, breaking bool, format string, args ...interface{}) {
+	c.items = append(c.items, &model.APIChange{
+		Kind:        kind,
+		Type:        changeType,
+		Path:        path,
+		Breaking:    breaking,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// addScoped adds change with description prefixed with the scope, for example the operation parameter it concerns
+func (c *changes) addScoped(kind model.APIChangeKind, path, scope string, breaking bool, format string, args ...interface{}) {
+	if scope != "" {
+		format = "%s: " + format
+		args = append([]interface{}{scope}, args...)
+	}
+	c.add(kind, model.APIChangeTypeChanged, path, breaking, format, args...)
+}
+
+// diffCollections reports removed and added elements of the collection and calls compareFn for elements present in both.
+// Removing an element is always a breaking change, adding one never is.
+func (c *changes) diffCollections(kind model.APIChangeKind, name, prefix string, from, to map[string]interface{}, compareFn func(path string, from, to map[string]interface{})) {
+	for _, key := range sortedUnion(from, to) {
+		path := prefix + key
+		fromItem, inFrom := from[key]
+		toItem, inTo := to[key]
+		switch {
+		case inFrom && !inTo:
+			c.add(kind, model.APIChangeTypeRemoved, path, true, "%s removed", name)
+		case !inFrom && inTo:
+			c.add(kind, model.APIChangeTypeAdded, path, false, "%s added", name)
+		default:
+			compareFn(path, object(fromItem), object(toItem))
+		}
+	}
+}
+
+func object(value interface{}) map[string]interface{} {
+	obj, _ := value.(map[string]interface{})
+	return obj
+}
+
+func field(obj map[string]interface{}, keys ...string) interface{} {
+	var value interface{} = obj
+	for _, key := range keys {
+		value = object(value)[key]
+	}
+	return value
+}
+
+func stringField(obj map[string]interface{}, keys ...string) string {
+	value, _ := field(obj, keys...).(string)
+	return value
+}
+
+func boolField(obj map[string]interface{}, keys ...string) bool {
+	value, _ := field(obj, keys...).(bool)
+	return value
+}
+
+func sortedUnion(from, to map[string]interface{}) []string {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
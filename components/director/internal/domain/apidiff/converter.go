@@ -0,0 +1,40 @@
+package apidiff
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.APIDiff) *graphql.APIDiff {
+	if in == nil {
+		return nil
+	}
+
+	changes := make([]*graphql.APIChange, 0, len(in.Changes))
+	for _, change := range in.Changes {
+		if change == nil {
+			continue
+		}
+
+		changes = append(changes, &graphql.APIChange{
+			Kind:        graphql.APIChangeKind(change.Kind),
+			Type:        graphql.APIChangeType(change.Type),
+			Path:        change.Path,
+			Breaking:    change.Breaking,
+			Description: change.Description,
+		})
+	}
+
+	return &graphql.APIDiff{
+		FromID:   in.FromID,
+		ToID:     in.ToID,
+		Breaking: in.Breaking,
+		Changes:  changes,
+	}
+}
@@ -0,0 +1,50 @@
+package apidiff_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apidiff"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	// GIVEN
+	testCases := []struct {
+		Name     string
+		Input    *model.APIDiff
+		Expected *graphql.APIDiff
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixModelAPIDiff(),
+			Expected: fixGQLAPIDiff(),
+		},
+		{
+			Name:  "No changes",
+			Input: model.NewAPIDiff(fromID, toID, nil),
+			Expected: &graphql.APIDiff{
+				FromID:  fromID,
+				ToID:    toID,
+				Changes: []*graphql.APIChange{},
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			converter := apidiff.NewConverter()
+			res := converter.ToGraphQL(testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
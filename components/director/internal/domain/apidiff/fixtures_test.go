@@ -0,0 +1,294 @@
+package apidiff_test
+
+import (
+	"errors"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	testTenant = "tenant"
+	fromID     = "from"
+	toID       = "to"
+	appID      = "app"
+	group      = "pets"
+)
+
+var testErr = errors.New("test error")
+
+const fromOpenAPISpec = `{
+  "openapi": "3.0.0",
+  "info": {"title": "Pets", "version": "1.0.0"},
+  "paths": {
+    "/pets": {
+      "get": {
+        "parameters": [{"name": "limit", "in": "query", "schema": {"type": "integer"}}],
+        "responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}}}
+      },
+      "post": {
+        "requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}},
+        "responses": {"201": {"description": "Created"}}
+      }
+    },
+    "/pets/{id}": {
+      "delete": {"responses": {"204": {"description": "Deleted"}}}
+    }
+  },
+  "components": {
+    "schemas": {
+      "Pet": {
+        "type": "object",
+        "required": ["id"],
+        "properties": {
+          "id": {"type": "integer"},
+          "name": {"type": "string"},
+          "status": {"type": "string", "enum": ["available", "sold"]}
+        }
+      },
+      "Error": {"type": "object"}
+    }
+  }
+}`
+
+// fromDirectionsOpenAPISpec uses the same schema in request and response, so that a change of it is classified for both directions
+const fromDirectionsOpenAPISpec = `{
+  "openapi": "3.0.0",
+  "info": {"title": "Orders", "version": "1.0.0"},
+  "paths": {
+    "/orders": {
+      "get": {
+        "responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {
+          "type": "object",
+          "required": ["name"],
+          "properties": {"name": {"type": "string"}, "status": {"type": "string", "enum": ["open", "closed"]}}
+        }}}}}
+      },
+      "post": {
+        "requestBody": {"content": {"application/json": {"schema": {
+          "type": "object",
+          "required": ["name"],
+          "properties": {"name": {"type": "string"}, "status": {"type": "string", "enum": ["open", "closed"]}}
+        }}}},
+        "responses": {"201": {"description": "Created"}}
+      }
+    }
+  }
+}`
+
+const toDirectionsOpenAPISpec = `{
+  "openapi": "3.0.0",
+  "info": {"title": "Orders", "version": "2.0.0"},
+  "paths": {
+    "/orders": {
+      "get": {
+        "responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {
+          "type": "object",
+          "required": ["id"],
+          "properties": {"id": {"type": "string"}, "name": {"type": "string"}, "status": {"type": "string", "enum": ["open", "cancelled"]}}
+        }}}}}
+      },
+      "post": {
+        "requestBody": {"content": {"application/json": {"schema": {
+          "type": "object",
+          "required": ["id"],
+          "properties": {"id": {"type": "string"}, "name": {"type": "string"}, "status": {"type": "string", "enum": ["open", "cancelled"]}}
+        }}}},
+        "responses": {"201": {"description": "Created"}}
+      }
+    }
+  }
+}`
+
+const toOpenAPISpec = `{
+  "openapi": "3.0.0",
+  "info": {"title": "Pets", "version": "2.0.0"},
+  "paths": {
+    "/pets": {
+      "get": {
+        "parameters": [
+          {"name": "limit", "in": "query", "required": true, "schema": {"type": "integer"}},
+          {"name": "tag", "in": "query", "schema": {"type": "string"}}
+        ],
+        "responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}}}
+      },
+      "post": {
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}},
+        "responses": {"201": {"description": "Created"}}
+      }
+    },
+    "/pets/{id}": {
+      "get": {"responses": {"200": {"description": "OK"}}}
+    }
+  },
+  "components": {
+    "schemas": {
+      "Pet": {
+        "type": "object",
+        "required": ["id", "name"],
+        "properties": {
+          "id": {"type": "string"},
+          "name": {"type": "string"},
+          "owner": {"type": "string"},
+          "status": {"type": "string", "enum": ["available"]}
+        }
+      },
+      "Owner": {"type": "object"}
+    }
+  }
+}`
+
+const fromAsyncAPISpec = `asyncapi: '2.0.0'
+info:
+  title: Orders
+  version: '1.0.0'
+channels:
+  orders/created:
+    subscribe:
+      message:
+        $ref: '#/components/messages/OrderCreated'
+  orders/cancelled:
+    publish:
+      message:
+        contentType: application/json
+        payload:
+          type: object
+          properties:
+            id:
+              type: string
+components:
+  messages:
+    OrderCreated:
+      payload:
+        type: object
+        required: [id]
+        properties:
+          id:
+            type: string
+`
+
+const toAsyncAPISpec = `asyncapi: '2.0.0'
+info:
+  title: Orders
+  version: '2.0.0'
+channels:
+  orders/created:
+    subscribe:
+      message:
+        $ref: '#/components/messages/OrderCreated'
+  orders/cancelled:
+    publish:
+      message:
+        contentType: application/xml
+        payload:
+          type: object
+          required: [reason]
+          properties:
+            id:
+              type: string
+            reason:
+              type: string
+  orders/shipped:
+    subscribe:
+      message:
+        $ref: '#/components/messages/OrderShipped'
+components:
+  messages:
+    OrderCreated:
+      payload:
+        type: object
+        required: [id]
+        properties:
+          id:
+            type: string
+          total:
+            type: number
+    OrderShipped:
+      payload:
+        type: object
+`
+
+func fixAPIDefinition(id, appID string, group *string, spec *model.APISpec) *model.APIDefinition {
+	return &model.APIDefinition{
+		ID:            id,
+		Tenant:        testTenant,
+		ApplicationID: appID,
+		Name:          "Pets",
+		Group:         group,
+		Spec:          spec,
+	}
+}
+
+func fixOpenAPISpec(data string) *model.APISpec {
+	return &model.APISpec{
+		Data:   &data,
+		Type:   model.APISpecTypeOpenAPI,
+		Format: model.SpecFormatJSON,
+	}
+}
+
+func fixEventAPIDefinition(id, appID string, group *string, spec *model.EventAPISpec) *model.EventAPIDefinition {
+	return &model.EventAPIDefinition{
+		ID:            id,
+		Tenant:        testTenant,
+		ApplicationID: appID,
+		Name:          "Orders",
+		Group:         group,
+		Spec:          spec,
+	}
+}
+
+func fixAsyncAPISpec(data string) *model.EventAPISpec {
+	return &model.EventAPISpec{
+		Data:   &data,
+		Type:   model.EventAPISpecTypeAsyncAPI,
+		Format: model.SpecFormatYaml,
+	}
+}
+
+func fixModelAPIDiff() *model.APIDiff {
+	return model.NewAPIDiff(fromID, toID, []*model.APIChange{
+		{
+			Kind:        model.APIChangeKindOperation,
+			Type:        model.APIChangeTypeRemoved,
+			Path:        "DELETE /pets/{id}",
+			Breaking:    true,
+			Description: "operation removed",
+		},
+		{
+			Kind:        model.APIChangeKindSchema,
+			Type:        model.APIChangeTypeAdded,
+			Path:        "#/components/schemas/Owner",
+			Breaking:    false,
+			Description: "schema added",
+		},
+	})
+}
+
+func fixGQLAPIDiff() *graphql.APIDiff {
+	return &graphql.APIDiff{
+		FromID:   fromID,
+		ToID:     toID,
+		Breaking: true,
+		Changes: []*graphql.APIChange{
+			{
+				Kind:        graphql.APIChangeKindOperation,
+				Type:        graphql.APIChangeTypeRemoved,
+				Path:        "DELETE /pets/{id}",
+				Breaking:    true,
+				Description: "operation removed",
+			},
+			{
+				Kind:        graphql.APIChangeKindSchema,
+				Type:        graphql.APIChangeTypeAdded,
+				Path:        "#/components/schemas/Owner",
+				Breaking:    false,
+				Description: "schema added",
+			},
+		},
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,158 @@
+package apidiff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+var openAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// diffOpenAPI compares operations and schemas of two OpenAPI v2 or v3 documents
+func diffOpenAPI(from, to map[string]interface{}) []*model.APIChange {
+	c := &changes{}
+	c.diffCollections(model.APIChangeKindOperation, "operation", "", openAPIOperations(from), openAPIOperations(to), c.diffOpenAPIOperation)
+	c.diffSchemas("#/definitions/", object(from["definitions"]), object(to["definitions"]))
+	c.diffSchemas("#/components/schemas/", object(field(from, "components", "schemas")), object(field(to, "components", "schemas")))
+	return c.items
+}
+
+// openAPIOperations returns operations identified by method and path, with parameters defined on the path level included
+func openAPIOperations(doc map[string]interface{}) map[string]interface{} {
+	operations := make(map[string]interface{})
+	for pathName, value := range object(doc["paths"]) {
+		item := object(value)
+		for _, method := range openAPIMethods {
+			operation := object(item[method])
+			if operation == nil {
+				continue
+			}
+
+			merged := make(map[string]interface{}, len(operation))
+			for key, value := range operation {
+				merged[key] = value
+			}
+			merged["parameters"] = mergeParameters(item["parameters"], operation["parameters"])
+
+			operations[fmt.Sprintf("%s %s", strings.ToUpper(method), pathName)] = merged
+		}
+	}
+
+	return operations
+}
+
+// mergeParameters returns parameters by their location and name; operation parameters override the path ones
+func mergeParameters(pathParameters, operationParameters interface{}) map[string]interface{} {
+	parameters := make(map[string]interface{})
+	for _, list := range []interface{}{pathParameters, operationParameters} {
+		items, _ := list.([]interface{})
+		for _, item := range items {
+			parameter := object(item)
+			if ref := stringField(parameter, "$ref"); ref != "" {
+				parameters["parameter "+ref] = parameter
+				continue
+			}
+			parameters[fmt.Sprintf("%s parameter %s", stringField(parameter, "in"), stringField(parameter, "name"))] = parameter
+		}
+	}
+
+	return parameters
+}
+
+func (c *changes) diffOpenAPIOperation(path string, from, to map[string]interface{}) {
+	if !boolField(from, "deprecated") && boolField(to, "deprecated") {
+		c.addScoped(model.APIChangeKindOperation, path, "", false, "operation deprecated")
+	}
+
+	c.diffParameters(path, object(from["parameters"]), object(to["parameters"]))
+	c.diffRequestBody(path, object(from["requestBody"]), object(to["requestBody"]))
+	c.diffResponses(path, object(from["responses"]), object(to["responses"]))
+}
+
+func (c *changes) diffParameters(path string, from, to map[string]interface{}) {
+	for _, name := range sortedUnion(from, to) {
+		fromParameter, toParameter := object(from[name]), object(to[name])
+		fromRequired, toRequired := boolField(fromParameter, "required"), boolField(toParameter, "required")
+		switch {
+		case fromParameter == nil && toRequired:
+			c.addScoped(model.APIChangeKindOperation, path, "", true, "required %s added", name)
+		case fromParameter == nil:
+			c.addScoped(model.APIChangeKindOperation, path, "", false, "optional %s added", name)
+		case toParameter == nil:
+			c.addScoped(model.APIChangeKindOperation, path, "", true, "%s removed", name)
+		default:
+			if !fromRequired && toRequired {
+				c.addScoped(model.APIChangeKindOperation, path, "", true, "%s became required", name)
+			}
+			if fromRequired && !toRequired {
+				c.addScoped(model.APIChangeKindOperation, path, "", false, "%s became optional", name)
+			}
+			c.diffSchema(model.APIChangeKindOperation, requestDirection, path, name, "", parameterSchema(fromParameter), parameterSchema(toParameter))
+		}
+	}
+}
+
+// parameterSchema returns schema of OpenAPI v3 or v2 body parameter, other OpenAPI v2 parameters describe the type on their own
+func parameterSchema(parameter map[string]interface{}) map[string]interface{} {
+	if schema := object(parameter["schema"]); schema != nil {
+		return schema
+	}
+	return parameter
+}
+
+func (c *changes) diffRequestBody(path string, from, to map[string]interface{}) {
+	fromRequired, toRequired := boolField(from, "required"), boolField(to, "required")
+	switch {
+	case from == nil && to == nil:
+		return
+	case from == nil && toRequired:
+		c.addScoped(model.APIChangeKindOperation, path, "", true, "required request body added")
+		return
+	case from == nil:
+		c.addScoped(model.APIChangeKindOperation, path, "", false, "optional request body added")
+		return
+	case to == nil:
+		c.addScoped(model.APIChangeKindOperation, path, "", true, "request body removed")
+		return
+	}
+
+	if !fromRequired && toRequired {
+		c.addScoped(model.APIChangeKindOperation, path, "", true, "request body became required")
+	}
+	c.diffContent(path, requestDirection, "request body", object(from["content"]), object(to["content"]))
+}
+
+func (c *changes) diffResponses(path string, from, to map[string]interface{}) {
+	for _, code := range sortedUnion(from, to) {
+		fromResponse, inFrom := from[code]
+		toResponse, inTo := to[code]
+		switch {
+		case !inTo:
+			c.addScoped(model.APIChangeKindOperation, path, "", true, "response %s removed", code)
+		case !inFrom:
+			c.addScoped(model.APIChangeKindOperation, path, "", false, "response %s added", code)
+		default:
+			scope := "response " + code
+			fromObj, toObj := object(fromResponse), object(toResponse)
+			c.diffSchema(model.APIChangeKindOperation, responseDirection, path, scope, "", object(fromObj["schema"]), object(toObj["schema"]))
+			c.diffContent(path, responseDirection, scope, object(fromObj["content"]), object(toObj["content"]))
+		}
+	}
+}
+
+// diffContent compares OpenAPI v3 media types with their schemas
+func (c *changes) diffContent(path string, dir direction, scope string, from, to map[string]interface{}) {
+	for _, mediaType := range sortedUnion(from, to) {
+		fromMedia, inFrom := from[mediaType]
+		toMedia, inTo := to[mediaType]
+		switch {
+		case !inTo:
+			c.addScoped(model.APIChangeKindOperation, path, scope, true, "media type %s removed", mediaType)
+		case !inFrom:
+			c.addScoped(model.APIChangeKindOperation, path, scope, false, "media type %s added", mediaType)
+		default:
+			c.diffSchema(model.APIChangeKindOperation, dir, path, scope+" "+mediaType, "", object(field(object(fromMedia), "schema")), object(field(object(toMedia), "schema")))
+		}
+	}
+}
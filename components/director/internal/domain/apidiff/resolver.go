@@ -0,0 +1,64 @@
+package apidiff
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+//go:generate mockery -name=APIDiffService -output=automock -outpkg=automock -case=underscore
+type APIDiffService interface {
+	APIDiff(ctx context.Context, fromID, toID string) (*model.APIDiff, error)
+	EventAPIDiff(ctx context.Context, fromID, toID string) (*model.APIDiff, error)
+}
+
+//go:generate mockery -name=APIDiffConverter -output=automock -outpkg=automock -case=underscore
+type APIDiffConverter interface {
+	ToGraphQL(in *model.APIDiff) *graphql.APIDiff
+}
+
+type Resolver struct {
+	transact  persistence.Transactioner
+	svc       APIDiffService
+	converter APIDiffConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc APIDiffService, converter APIDiffConverter) *Resolver {
+	return &Resolver{
+		transact:  transact,
+		svc:       svc,
+		converter: converter,
+	}
+}
+
+func (r *Resolver) APIDiff(ctx context.Context, fromID string, toID string) (*graphql.APIDiff, error) {
+	return r.diff(ctx, fromID, toID, r.svc.APIDiff)
+}
+
+func (r *Resolver) EventAPIDiff(ctx context.Context, fromID string, toID string) (*graphql.APIDiff, error) {
+	return r.diff(ctx, fromID, toID, r.svc.EventAPIDiff)
+}
+
+func (r *Resolver) diff(ctx context.Context, fromID, toID string, diffFn func(ctx context.Context, fromID, toID string) (*model.APIDiff, error)) (*graphql.APIDiff, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	diff, err := diffFn(ctx, fromID, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(diff), nil
+}
@@ -0,0 +1,126 @@
+package apidiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apidiff"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apidiff/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_APIDiff(t *testing.T) {
+	testResolverDiff(t, "APIDiff", func(resolver *apidiff.Resolver) func(ctx context.Context, fromID, toID string) (*graphql.APIDiff, error) {
+		return resolver.APIDiff
+	})
+}
+
+func TestResolver_EventAPIDiff(t *testing.T) {
+	testResolverDiff(t, "EventAPIDiff", func(resolver *apidiff.Resolver) func(ctx context.Context, fromID, toID string) (*graphql.APIDiff, error) {
+		return resolver.EventAPIDiff
+	})
+}
+
+func testResolverDiff(t *testing.T, method string, resolverFn func(resolver *apidiff.Resolver) func(ctx context.Context, fromID, toID string) (*graphql.APIDiff, error)) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelDiff := fixModelAPIDiff()
+	gqlDiff := fixGQLAPIDiff()
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.APIDiffService
+		ConverterFn    func() *automock.APIDiffConverter
+		ExpectedOutput *graphql.APIDiff
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.APIDiffService {
+				svc := &automock.APIDiffService{}
+				svc.On(method, txtest.CtxWithDBMatcher(), fromID, toID).Return(modelDiff, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIDiffConverter {
+				conv := &automock.APIDiffConverter{}
+				conv.On("ToGraphQL", modelDiff).Return(gqlDiff).Once()
+				return conv
+			},
+			ExpectedOutput: gqlDiff,
+		},
+		{
+			Name: "Returns error when comparing failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.APIDiffService {
+				svc := &automock.APIDiffService{}
+				svc.On(method, txtest.CtxWithDBMatcher(), fromID, toID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIDiffConverter {
+				return &automock.APIDiffConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.APIDiffService {
+				return &automock.APIDiffService{}
+			},
+			ConverterFn: func() *automock.APIDiffConverter {
+				return &automock.APIDiffConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.APIDiffService {
+				svc := &automock.APIDiffService{}
+				svc.On(method, txtest.CtxWithDBMatcher(), fromID, toID).Return(modelDiff, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIDiffConverter {
+				return &automock.APIDiffConverter{}
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := apidiff.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolverFn(resolver)(ctx, fromID, toID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package apidiff
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// direction tells whether the schema describes data sent to the API or returned by it, which decides if a change of the schema is breaking
+type direction int
+
+const (
+	// anyDirection is used for schemas which can describe both, like named schemas referenced from requests and responses
+	anyDirection direction = iota
+	requestDirection
+	responseDirection
+)
+
+// breaking returns whether the change is breaking in the direction, given if it breaks requests and if it breaks responses
+func (d direction) breaking(inRequest, inResponse bool) bool {
+	switch d {
+	case requestDirection:
+		return inRequest
+	case responseDirection:
+		return inResponse
+	}
+	return inRequest || inResponse
+}
+
+// diffSchemas compares named schemas, like OpenAPI definitions or AsyncAPI components schemas
+func (c *changes) diffSchemas(prefix string, from, to map[string]interface{}) {
+	c.diffCollections(model.APIChangeKindSchema, "schema", prefix, from, to, func(path string, from, to map[string]interface{}) {
+		c.diffSchema(model.APIChangeKindSchema, anyDirection, path, "", "", from, to)
+	})
+}
+
+// diffSchema compares two JSON schemas. Location is the dotted path of the compared property, empty for the root schema.
+// References are not resolved, the referenced schemas are compared on their own.
+//
+// Changes restricting the data are breaking for requests, while changes extending it are breaking for responses,
+// for example adding an enum value does not break clients sending requests, but may break clients reading responses.
+func (c *changes) diffSchema(kind model.APIChangeKind, dir direction, path, scope, location string, from, to map[string]interface{}) {
+	subject := describeLocation(location)
+
+	fromRef, toRef := stringField(from, "$ref"), stringField(to, "$ref")
+	if fromRef != toRef {
+		c.addScoped(kind, path, scope, true, "%s reference changed from %s to %s", subject, describeValue(fromRef), describeValue(toRef))
+		return
+	}
+
+	fromType, toType := stringField(from, "type"), stringField(to, "type")
+	if fromType != toType {
+		c.addScoped(kind, path, scope, true, "%s type changed from %s to %s", subject, describeValue(fromType), describeValue(toType))
+		return
+	}
+
+	c.diffEnum(kind, dir, path, scope, subject, from["enum"], to["enum"])
+	c.diffProperties(kind, dir, path, scope, location, from, to)
+
+	fromItems, toItems := object(from["items"]), object(to["items"])
+	if fromItems != nil || toItems != nil {
+		c.diffSchema(kind, dir, path, scope, location+"[]", fromItems, toItems)
+	}
+}
+
+func (c *changes) diffProperties(kind model.APIChangeKind, dir direction, path, scope, location string, from, to map[string]interface{}) {
+	fromProperties, toProperties := object(from["properties"]), object(to["properties"])
+	fromRequired, toRequired := stringSet(from["required"]), stringSet(to["required"])
+
+	for _, name := range sortedUnion(fromProperties, toProperties) {
+		propertyLocation := name
+		if location != "" {
+			propertyLocation = location + "." + name
+		}
+
+		fromProperty, inFrom := fromProperties[name]
+		toProperty, inTo := toProperties[name]
+		switch {
+		case inFrom && !inTo:
+			c.addScoped(kind, path, scope, true, "property %s removed", propertyLocation)
+		case !inFrom && inTo && toRequired[name]:
+			c.addScoped(kind, path, scope, dir.breaking(true, false), "required property %s added", propertyLocation)
+		case !inFrom && inTo:
+			c.addScoped(kind, path, scope, false, "optional property %s added", propertyLocation)
+		default:
+			if !fromRequired[name] && toRequired[name] {
+				c.addScoped(kind, path, scope, dir.breaking(true, false), "property %s became required", propertyLocation)
+			}
+			if fromRequired[name] && !toRequired[name] {
+				c.addScoped(kind, path, scope, dir.breaking(false, true), "property %s became optional", propertyLocation)
+			}
+			c.diffSchema(kind, dir, path, scope, propertyLocation, object(fromProperty), object(toProperty))
+		}
+	}
+}
+
+func (c *changes) diffEnum(kind model.APIChangeKind, dir direction, path, scope, subject string, from, to interface{}) {
+	fromValues, fromOK := from.([]interface{})
+	toValues, toOK := to.([]interface{})
+	switch {
+	case !fromOK && !toOK:
+		return
+	case !fromOK:
+		c.addScoped(kind, path, scope, dir.breaking(true, false), "%s restricted to enum values", subject)
+		return
+	case !toOK:
+		c.addScoped(kind, path, scope, dir.breaking(false, true), "%s no longer restricted to enum values", subject)
+		return
+	}
+
+	fromSet, toSet := valueSet(fromValues), valueSet(toValues)
+	for _, value := range sortedKeysOf(fromSet) {
+		if !toSet[value] {
+			c.addScoped(kind, path, scope, dir.breaking(true, false), "%s enum value %s removed", subject, value)
+		}
+	}
+	for _, value := range sortedKeysOf(toSet) {
+		if !fromSet[value] {
+			c.addScoped(kind, path, scope, dir.breaking(false, true), "%s enum value %s added", subject, value)
+		}
+	}
+}
+
+func describeLocation(location string) string {
+	if location == "" {
+		return "schema"
+	}
+	return "property " + location
+}
+
+func describeValue(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
+
+func stringSet(value interface{}) map[string]bool {
+	set := make(map[string]bool)
+	items, _ := value.([]interface{})
+	for _, item := range items {
+		if str, ok := item.(string); ok {
+			set[str] = true
+		}
+	}
+	return set
+}
+
+func valueSet(values []interface{}) map[string]bool {
+	set := make(map[string]bool)
+	for _, value := range values {
+		set[fmt.Sprint(value)] = true
+	}
+	return set
+}
+
+func sortedKeysOf(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
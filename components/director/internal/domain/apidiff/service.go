@@ -0,0 +1,156 @@
+package apidiff
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=APIRepository -output=automock -outpkg=automock -case=underscore
+type APIRepository interface {
+	GetByID(ctx context.Context, tenantID, id string) (*model.APIDefinition, error)
+}
+
+//go:generate mockery -name=EventAPIRepository -output=automock -outpkg=automock -case=underscore
+type EventAPIRepository interface {
+	GetByID(ctx context.Context, tenantID string, id string) (*model.EventAPIDefinition, error)
+}
+
+type service struct {
+	apiRepo      APIRepository
+	eventAPIRepo EventAPIRepository
+}
+
+func NewService(apiRepo APIRepository, eventAPIRepo EventAPIRepository) *service {
+	return &service{
+		apiRepo:      apiRepo,
+		eventAPIRepo: eventAPIRepo,
+	}
+}
+
+// APIDiff compares OpenAPI specs of two API Definitions from the same Application and group
+func (s *service) APIDiff(ctx context.Context, fromID, toID string) (*model.APIDiff, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.apiRepo.GetByID(ctx, tnt, fromID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting API Definition with ID %s", fromID)
+	}
+
+	to, err := s.apiRepo.GetByID(ctx, tnt, toID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting API Definition with ID %s", toID)
+	}
+
+	err = checkComparable("API Definitions", from.ApplicationID, to.ApplicationID, from.Group, to.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	fromDoc, err := parseAPISpec(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toDoc, err := parseAPISpec(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NewAPIDiff(fromID, toID, diffOpenAPI(fromDoc, toDoc)), nil
+}
+
+// EventAPIDiff compares AsyncAPI specs of two Event API Definitions from the same Application and group
+func (s *service) EventAPIDiff(ctx context.Context, fromID, toID string) (*model.APIDiff, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.eventAPIRepo.GetByID(ctx, tnt, fromID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Event API Definition with ID %s", fromID)
+	}
+
+	to, err := s.eventAPIRepo.GetByID(ctx, tnt, toID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Event API Definition with ID %s", toID)
+	}
+
+	err = checkComparable("Event API Definitions", from.ApplicationID, to.ApplicationID, from.Group, to.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	fromDoc, err := parseEventAPISpec(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toDoc, err := parseEventAPISpec(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NewAPIDiff(fromID, toID, diffAsyncAPI(fromDoc, toDoc)), nil
+}
+
+func checkComparable(kind, fromAppID, toAppID string, fromGroup, toGroup *string) error {
+	if fromAppID != toAppID {
+		return fmt.Errorf("%s have to belong to the same Application", kind)
+	}
+
+	if stringValue(fromGroup) != stringValue(toGroup) {
+		return fmt.Errorf("%s have to belong to the same group", kind)
+	}
+
+	return nil
+}
+
+func parseAPISpec(api *model.APIDefinition) (map[string]interface{}, error) {
+	if api.Spec == nil || api.Spec.Data == nil {
+		return nil, fmt.Errorf("API Definition %s has no spec", api.ID)
+	}
+
+	if api.Spec.Type != model.APISpecTypeOpenAPI {
+		return nil, fmt.Errorf("only %s specs can be compared, API Definition %s has %s spec", model.APISpecTypeOpenAPI, api.ID, api.Spec.Type)
+	}
+
+	doc, err := specvalidation.ParseDocument(api.Spec.Format, *api.Spec.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing spec of API Definition %s", api.ID)
+	}
+
+	return doc, nil
+}
+
+func parseEventAPISpec(eventAPI *model.EventAPIDefinition) (map[string]interface{}, error) {
+	if eventAPI.Spec == nil || eventAPI.Spec.Data == nil {
+		return nil, fmt.Errorf("Event API Definition %s has no spec", eventAPI.ID)
+	}
+
+	if eventAPI.Spec.Type != model.EventAPISpecTypeAsyncAPI {
+		return nil, fmt.Errorf("only %s specs can be compared, Event API Definition %s has %s spec", model.EventAPISpecTypeAsyncAPI, eventAPI.ID, eventAPI.Spec.Type)
+	}
+
+	doc, err := specvalidation.ParseDocument(eventAPI.Spec.Format, *eventAPI.Spec.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing spec of Event API Definition %s", eventAPI.ID)
+	}
+
+	return doc, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
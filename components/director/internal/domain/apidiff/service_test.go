@@ -0,0 +1,258 @@
+package apidiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apidiff"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apidiff/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_APIDiff(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	from := fixAPIDefinition(fromID, appID, strPtr(group), fixOpenAPISpec(fromOpenAPISpec))
+	to := fixAPIDefinition(toID, appID, strPtr(group), fixOpenAPISpec(toOpenAPISpec))
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.APIRepository
+		ExpectedChanges    []*model.APIChange
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(to, nil).Once()
+				return repo
+			},
+			ExpectedChanges: []*model.APIChange{
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeRemoved, Path: "DELETE /pets/{id}", Breaking: true, Description: "operation removed"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "GET /pets", Breaking: true, Description: "query parameter limit became required"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "GET /pets", Breaking: false, Description: "optional query parameter tag added"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeAdded, Path: "GET /pets/{id}", Breaking: false, Description: "operation added"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "POST /pets", Breaking: true, Description: "request body became required"},
+				{Kind: model.APIChangeKindSchema, Type: model.APIChangeTypeRemoved, Path: "#/components/schemas/Error", Breaking: true, Description: "schema removed"},
+				{Kind: model.APIChangeKindSchema, Type: model.APIChangeTypeAdded, Path: "#/components/schemas/Owner", Breaking: false, Description: "schema added"},
+				{Kind: model.APIChangeKindSchema, Type: model.APIChangeTypeChanged, Path: "#/components/schemas/Pet", Breaking: true, Description: "property id type changed from integer to string"},
+				{Kind: model.APIChangeKindSchema, Type: model.APIChangeTypeChanged, Path: "#/components/schemas/Pet", Breaking: true, Description: "property name became required"},
+				{Kind: model.APIChangeKindSchema, Type: model.APIChangeTypeChanged, Path: "#/components/schemas/Pet", Breaking: false, Description: "optional property owner added"},
+				{Kind: model.APIChangeKindSchema, Type: model.APIChangeTypeChanged, Path: "#/components/schemas/Pet", Breaking: true, Description: "property status enum value sold removed"},
+			},
+		},
+		{
+			Name: "Success when the same schema changes in request and response",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(fixAPIDefinition(fromID, appID, strPtr(group), fixOpenAPISpec(fromDirectionsOpenAPISpec)), nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(fixAPIDefinition(toID, appID, strPtr(group), fixOpenAPISpec(toDirectionsOpenAPISpec)), nil).Once()
+				return repo
+			},
+			ExpectedChanges: []*model.APIChange{
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "GET /orders", Breaking: false, Description: "response 200 application/json: required property id added"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "GET /orders", Breaking: true, Description: "response 200 application/json: property name became optional"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "GET /orders", Breaking: false, Description: "response 200 application/json: property status enum value closed removed"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "GET /orders", Breaking: true, Description: "response 200 application/json: property status enum value cancelled added"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "POST /orders", Breaking: true, Description: "request body application/json: required property id added"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "POST /orders", Breaking: false, Description: "request body application/json: property name became optional"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "POST /orders", Breaking: true, Description: "request body application/json: property status enum value closed removed"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "POST /orders", Breaking: false, Description: "request body application/json: property status enum value cancelled added"},
+			},
+		},
+		{
+			Name: "Success when specs are the same",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(fixAPIDefinition(toID, appID, strPtr(group), fixOpenAPISpec(fromOpenAPISpec)), nil).Once()
+				return repo
+			},
+			ExpectedChanges: []*model.APIChange{},
+		},
+		{
+			Name: "Returns error when getting API Definition failed",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when API Definitions belong to different Applications",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(fixAPIDefinition(toID, "other", strPtr(group), fixOpenAPISpec(toOpenAPISpec)), nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "API Definitions have to belong to the same Application",
+		},
+		{
+			Name: "Returns error when API Definitions belong to different groups",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(fixAPIDefinition(toID, appID, nil, fixOpenAPISpec(toOpenAPISpec)), nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "API Definitions have to belong to the same group",
+		},
+		{
+			Name: "Returns error when API Definition has no spec",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(fixAPIDefinition(toID, appID, strPtr(group), nil), nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "API Definition to has no spec",
+		},
+		{
+			Name: "Returns error when spec is not OpenAPI",
+			RepositoryFn: func() *automock.APIRepository {
+				spec := fixOpenAPISpec(toOpenAPISpec)
+				spec.Type = model.APISpecTypeOdata
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(fixAPIDefinition(toID, appID, strPtr(group), spec), nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "only OPEN_API specs can be compared, API Definition to has ODATA spec",
+		},
+		{
+			Name: "Returns error when spec can't be parsed",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(fixAPIDefinition(fromID, appID, strPtr(group), fixOpenAPISpec("{")), nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(to, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while parsing spec of API Definition from",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := apidiff.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.APIDiff(ctx, fromID, toID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, model.NewAPIDiff(fromID, toID, testCase.ExpectedChanges), result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when tenant not in context", func(t *testing.T) {
+		svc := apidiff.NewService(nil, nil)
+
+		// WHEN
+		_, err := svc.APIDiff(context.TODO(), fromID, toID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Equal(t, tenant.NoTenantError, err)
+	})
+}
+
+func TestService_EventAPIDiff(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	from := fixEventAPIDefinition(fromID, appID, nil, fixAsyncAPISpec(fromAsyncAPISpec))
+	to := fixEventAPIDefinition(toID, appID, nil, fixAsyncAPISpec(toAsyncAPISpec))
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.EventAPIRepository
+		ExpectedChanges    []*model.APIChange
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(to, nil).Once()
+				return repo
+			},
+			ExpectedChanges: []*model.APIChange{
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "PUBLISH orders/cancelled", Breaking: true, Description: "message: content type changed from application/json to application/xml"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeChanged, Path: "PUBLISH orders/cancelled", Breaking: true, Description: "message payload: required property reason added"},
+				{Kind: model.APIChangeKindOperation, Type: model.APIChangeTypeAdded, Path: "SUBSCRIBE orders/shipped", Breaking: false, Description: "operation added"},
+				{Kind: model.APIChangeKindMessage, Type: model.APIChangeTypeChanged, Path: "#/components/messages/OrderCreated", Breaking: false, Description: "payload: optional property total added"},
+				{Kind: model.APIChangeKindMessage, Type: model.APIChangeTypeAdded, Path: "#/components/messages/OrderShipped", Breaking: false, Description: "message added"},
+			},
+		},
+		{
+			Name: "Returns error when getting Event API Definition failed",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when Event API Definitions belong to different Applications",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(from, nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(fixEventAPIDefinition(toID, "other", nil, fixAsyncAPISpec(toAsyncAPISpec)), nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "Event API Definitions have to belong to the same Application",
+		},
+		{
+			Name: "Returns error when spec is not AsyncAPI",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				spec := fixAsyncAPISpec(fromAsyncAPISpec)
+				spec.Type = "OTHER"
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, testTenant, fromID).Return(fixEventAPIDefinition(fromID, appID, nil, spec), nil).Once()
+				repo.On("GetByID", ctx, testTenant, toID).Return(to, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "only ASYNC_API specs can be compared, Event API Definition from has OTHER spec",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := apidiff.NewService(nil, repo)
+
+			// WHEN
+			result, err := svc.EventAPIDiff(ctx, fromID, toID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, model.NewAPIDiff(fromID, toID, testCase.ExpectedChanges), result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -2,9 +2,13 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // APIRepository is an autogenerated mock type for the APIRepository type
 type APIRepository struct {
@@ -25,6 +29,20 @@ func (_m *APIRepository) Create(ctx context.Context, item *model.APIDefinition)
 	return r0
 }
 
+// Delete provides a mock function with given fields: ctx, tenantID, id
+func (_m *APIRepository) Delete(ctx context.Context, tenantID string, id string) error {
+	ret := _m.Called(ctx, tenantID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenantID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteAllByApplicationID provides a mock function with given fields: ctx, tenant, id
 func (_m *APIRepository) DeleteAllByApplicationID(ctx context.Context, tenant string, id string) error {
 	ret := _m.Called(ctx, tenant, id)
@@ -39,13 +57,13 @@ func (_m *APIRepository) DeleteAllByApplicationID(ctx context.Context, tenant st
 	return r0
 }
 
-// ListByApplicationID provides a mock function with given fields: ctx, tenant, applicationID, pageSize, cursor
-func (_m *APIRepository) ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
-	ret := _m.Called(ctx, tenant, applicationID, pageSize, cursor)
+// ListByApplicationID provides a mock function with given fields: ctx, tenant, applicationID, filter, pageSize, cursor
+func (_m *APIRepository) ListByApplicationID(ctx context.Context, tenant string, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
+	ret := _m.Called(ctx, tenant, applicationID, filter, pageSize, cursor)
 
 	var r0 *model.APIDefinitionPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.APIDefinitionPage); ok {
-		r0 = rf(ctx, tenant, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []*labelfilter.LabelFilter, int, string) *model.APIDefinitionPage); ok {
+		r0 = rf(ctx, tenant, applicationID, filter, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.APIDefinitionPage)
@@ -53,11 +71,25 @@ func (_m *APIRepository) ListByApplicationID(ctx context.Context, tenant string,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
-		r1 = rf(ctx, tenant, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, tenant, applicationID, filter, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *APIRepository) Update(ctx context.Context, item *model.APIDefinition) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.APIDefinition) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
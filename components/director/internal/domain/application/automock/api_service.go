@@ -5,6 +5,7 @@ package automock
 import (
 	context "context"
 
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -95,13 +96,13 @@ func (_m *APIService) GetForApplication(ctx context.Context, id string, applicat
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, applicationID, pageSize, cursor
-func (_m *APIService) List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
-	ret := _m.Called(ctx, applicationID, pageSize, cursor)
+// List provides a mock function with given fields: ctx, applicationID, filter, pageSize, cursor
+func (_m *APIService) List(ctx context.Context, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
+	ret := _m.Called(ctx, applicationID, filter, pageSize, cursor)
 
 	var r0 *model.APIDefinitionPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.APIDefinitionPage); ok {
-		r0 = rf(ctx, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.APIDefinitionPage); ok {
+		r0 = rf(ctx, applicationID, filter, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.APIDefinitionPage)
@@ -109,8 +110,8 @@ func (_m *APIService) List(ctx context.Context, applicationID string, pageSize i
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
-		r1 = rf(ctx, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, applicationID, filter, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -191,6 +191,20 @@ func (_m *ApplicationService) SetLabel(ctx context.Context, label *model.LabelIn
 	return r0
 }
 
+// SetStatusCondition provides a mock function with given fields: ctx, id, condition
+func (_m *ApplicationService) SetStatusCondition(ctx context.Context, id string, condition model.ApplicationStatusCondition) error {
+	ret := _m.Called(ctx, id, condition)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationStatusCondition) error); ok {
+		r0 = rf(ctx, id, condition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *ApplicationService) Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -0,0 +1,67 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+	time "time"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DeletionRepository is an autogenerated mock type for the DeletionRepository type
+type DeletionRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, tenant, id
+func (_m *DeletionRepository) Delete(ctx context.Context, tenant string, id string) error {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockNextByStatusCondition provides a mock function with given fields: ctx, condition, changedBefore
+func (_m *DeletionRepository) LockNextByStatusCondition(ctx context.Context, condition model.ApplicationStatusCondition, changedBefore time.Time) (*model.Application, error) {
+	ret := _m.Called(ctx, condition, changedBefore)
+
+	var r0 *model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, model.ApplicationStatusCondition, time.Time) *model.Application); ok {
+		r0 = rf(ctx, condition, changedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.ApplicationStatusCondition, time.Time) error); ok {
+		r1 = rf(ctx, condition, changedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStatusIfCondition provides a mock function with given fields: ctx, tenant, id, status, currentConditions
+func (_m *DeletionRepository) UpdateStatusIfCondition(ctx context.Context, tenant string, id string, status model.ApplicationStatus, currentConditions []model.ApplicationStatusCondition) error {
+	ret := _m.Called(ctx, tenant, id, status, currentConditions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ApplicationStatus, []model.ApplicationStatusCondition) error); ok {
+		r0 = rf(ctx, tenant, id, status, currentConditions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -2,9 +2,12 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // DocumentRepository is an autogenerated mock type for the DocumentRepository type
 type DocumentRepository struct {
@@ -25,6 +28,20 @@ func (_m *DocumentRepository) Create(ctx context.Context, item *model.Document)
 	return r0
 }
 
+// Delete provides a mock function with given fields: ctx, tenant, id
+func (_m *DocumentRepository) Delete(ctx context.Context, tenant string, id string) error {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteAllByApplicationID provides a mock function with given fields: ctx, tenant, applicationID
 func (_m *DocumentRepository) DeleteAllByApplicationID(ctx context.Context, tenant string, applicationID string) error {
 	ret := _m.Called(ctx, tenant, applicationID)
@@ -38,3 +55,40 @@ func (_m *DocumentRepository) DeleteAllByApplicationID(ctx context.Context, tena
 
 	return r0
 }
+
+// ListByApplicationID provides a mock function with given fields: ctx, tenant, applicationID, pageSize, cursor
+func (_m *DocumentRepository) ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error) {
+	ret := _m.Called(ctx, tenant, applicationID, pageSize, cursor)
+
+	var r0 *model.DocumentPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.DocumentPage); ok {
+		r0 = rf(ctx, tenant, applicationID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DocumentPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
+		r1 = rf(ctx, tenant, applicationID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *DocumentRepository) Update(ctx context.Context, item *model.Document) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Document) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -2,9 +2,11 @@
 
 package automock
 
-import application "github.com/kyma-incubator/compass/components/director/internal/domain/application"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	application "github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // EntityConverter is an autogenerated mock type for the EntityConverter type
 type EntityConverter struct {
@@ -12,7 +14,7 @@ type EntityConverter struct {
 }
 
 // FromEntity provides a mock function with given fields: entity
-func (_m *EntityConverter) FromEntity(entity *application.Entity) *model.Application {
+func (_m *EntityConverter) FromEntity(entity *application.Entity) (*model.Application, error) {
 	ret := _m.Called(entity)
 
 	var r0 *model.Application
@@ -24,7 +26,14 @@ func (_m *EntityConverter) FromEntity(entity *application.Entity) *model.Applica
 		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*application.Entity) error); ok {
+		r1 = rf(entity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // ToEntity provides a mock function with given fields: in
@@ -2,9 +2,12 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // FetchRequestRepository is an autogenerated mock type for the FetchRequestRepository type
 type FetchRequestRepository struct {
@@ -24,3 +27,17 @@ func (_m *FetchRequestRepository) Create(ctx context.Context, item *model.FetchR
 
 	return r0
 }
+
+// DeleteByReferenceObjectID provides a mock function with given fields: ctx, tenant, objectType, objectID
+func (_m *FetchRequestRepository) DeleteByReferenceObjectID(ctx context.Context, tenant string, objectType model.FetchRequestReferenceObjectType, objectID string) error {
+	ret := _m.Called(ctx, tenant, objectType, objectID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.FetchRequestReferenceObjectType, string) error); ok {
+		r0 = rf(ctx, tenant, objectType, objectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,31 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SpecPrefetcher is an autogenerated mock type for the SpecPrefetcher type
+type SpecPrefetcher struct {
+	mock.Mock
+}
+
+// Prefetch provides a mock function with given fields: ctx, fetchRequests
+func (_m *SpecPrefetcher) Prefetch(ctx context.Context, fetchRequests []*model.FetchRequest) context.Context {
+	ret := _m.Called(ctx, fetchRequests)
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.FetchRequest) context.Context); ok {
+		r0 = rf(ctx, fetchRequests)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
@@ -2,15 +2,32 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // WebhookRepository is an autogenerated mock type for the WebhookRepository type
 type WebhookRepository struct {
 	mock.Mock
 }
 
+// Create provides a mock function with given fields: ctx, item
+func (_m *WebhookRepository) Create(ctx context.Context, item *model.Webhook) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Webhook) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateMany provides a mock function with given fields: ctx, items
 func (_m *WebhookRepository) CreateMany(ctx context.Context, items []*model.Webhook) error {
 	ret := _m.Called(ctx, items)
@@ -25,6 +42,20 @@ func (_m *WebhookRepository) CreateMany(ctx context.Context, items []*model.Webh
 	return r0
 }
 
+// Delete provides a mock function with given fields: ctx, tenant, id
+func (_m *WebhookRepository) Delete(ctx context.Context, tenant string, id string) error {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteAllByApplicationID provides a mock function with given fields: ctx, tenant, id
 func (_m *WebhookRepository) DeleteAllByApplicationID(ctx context.Context, tenant string, id string) error {
 	ret := _m.Called(ctx, tenant, id)
@@ -61,3 +92,17 @@ func (_m *WebhookRepository) ListByApplicationID(ctx context.Context, tenant str
 
 	return r0, r1
 }
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *WebhookRepository) Update(ctx context.Context, item *model.Webhook) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Webhook) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
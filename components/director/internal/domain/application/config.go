@@ -0,0 +1,8 @@
+package application
+
+import "time"
+
+type DeletionConfig struct {
+	Period  time.Duration `envconfig:"default=1m"`
+	Timeout time.Duration `envconfig:"default=1h"`
+}
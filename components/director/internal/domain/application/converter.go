@@ -1,6 +1,9 @@
 package application
 
 import (
+	"database/sql"
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -12,10 +15,11 @@ type converter struct {
 	api      APIConverter
 	eventAPI EventAPIConverter
 	document DocumentConverter
+	auth     AuthConverter
 }
 
-func NewConverter(webhook WebhookConverter, api APIConverter, eventAPI EventAPIConverter, document DocumentConverter) *converter {
-	return &converter{webhook: webhook, api: api, eventAPI: eventAPI, document: document}
+func NewConverter(webhook WebhookConverter, api APIConverter, eventAPI EventAPIConverter, document DocumentConverter, auth AuthConverter) *converter {
+	return &converter{webhook: webhook, api: api, eventAPI: eventAPI, document: document, auth: auth}
 }
 
 func (c *converter) ToEntity(in *model.Application) (*Entity, error) {
@@ -27,21 +31,45 @@ func (c *converter) ToEntity(in *model.Application) (*Entity, error) {
 		return nil, errors.New("invalid input model")
 	}
 
+	templateValues, err := c.templateValuesToEntity(in.ApplicationTemplateValues)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Application Template values")
+	}
+
+	healthCheckAuth, err := c.healthCheckAuthToEntity(in.HealthCheckAuth)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting health check auth")
+	}
+
 	return &Entity{
-		ID:                  in.ID,
-		TenantID:            in.Tenant,
-		Name:                in.Name,
-		Description:         repo.NewNullableString(in.Description),
-		StatusCondition:     string(in.Status.Condition),
-		StatusTimestamp:     in.Status.Timestamp,
-		HealthCheckURL:      repo.NewNullableString(in.HealthCheckURL),
-		IntegrationSystemID: repo.NewNullableString(in.IntegrationSystemID),
+		ID:                         in.ID,
+		TenantID:                   in.Tenant,
+		Name:                       in.Name,
+		Description:                repo.NewNullableString(in.Description),
+		StatusCondition:            string(in.Status.Condition),
+		StatusTimestamp:            in.Status.Timestamp,
+		HealthCheckURL:             repo.NewNullableString(in.HealthCheckURL),
+		HealthCheckAuth:            healthCheckAuth,
+		IntegrationSystemID:        repo.NewNullableString(in.IntegrationSystemID),
+		ApplicationTemplateID:      repo.NewNullableString(in.ApplicationTemplateID),
+		ApplicationTemplateVersion: repo.NewNullableInt(in.ApplicationTemplateVersion),
+		ApplicationTemplateValues:  templateValues,
 	}, nil
 }
 
-func (c *converter) FromEntity(entity *Entity) *model.Application {
+func (c *converter) FromEntity(entity *Entity) (*model.Application, error) {
 	if entity == nil {
-		return nil
+		return nil, nil
+	}
+
+	templateValues, err := c.templateValuesFromEntity(entity.ApplicationTemplateValues)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting Application Template values of Application with ID %s", entity.ID)
+	}
+
+	healthCheckAuth, err := c.healthCheckAuthFromEntity(entity.HealthCheckAuth)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting health check auth of Application with ID %s", entity.ID)
 	}
 
 	return &model.Application{
@@ -53,9 +81,13 @@ func (c *converter) FromEntity(entity *Entity) *model.Application {
 			Condition: model.ApplicationStatusCondition(entity.StatusCondition),
 			Timestamp: entity.StatusTimestamp,
 		},
-		IntegrationSystemID: repo.StringPtrFromNullableString(entity.IntegrationSystemID),
-		HealthCheckURL:      repo.StringPtrFromNullableString(entity.HealthCheckURL),
-	}
+		IntegrationSystemID:        repo.StringPtrFromNullableString(entity.IntegrationSystemID),
+		ApplicationTemplateID:      repo.StringPtrFromNullableString(entity.ApplicationTemplateID),
+		ApplicationTemplateVersion: repo.IntPtrFromNullableInt(entity.ApplicationTemplateVersion),
+		ApplicationTemplateValues:  templateValues,
+		HealthCheckURL:             repo.StringPtrFromNullableString(entity.HealthCheckURL),
+		HealthCheckAuth:            healthCheckAuth,
+	}, nil
 }
 
 func (c *converter) ToGraphQL(in *model.Application) *graphql.Application {
@@ -64,12 +96,15 @@ func (c *converter) ToGraphQL(in *model.Application) *graphql.Application {
 	}
 
 	return &graphql.Application{
-		ID:                  in.ID,
-		Status:              c.statusToGraphQL(in.Status),
-		Name:                in.Name,
-		Description:         in.Description,
-		HealthCheckURL:      in.HealthCheckURL,
-		IntegrationSystemID: in.IntegrationSystemID,
+		ID:                         in.ID,
+		Status:                     c.statusToGraphQL(in.Status),
+		Name:                       in.Name,
+		Description:                in.Description,
+		HealthCheckURL:             in.HealthCheckURL,
+		HealthCheckAuth:            c.auth.ToGraphQL(in.HealthCheckAuth),
+		IntegrationSystemID:        in.IntegrationSystemID,
+		ApplicationTemplateID:      in.ApplicationTemplateID,
+		ApplicationTemplateVersion: in.ApplicationTemplateVersion,
 	}
 }
 
@@ -97,6 +132,7 @@ func (c *converter) CreateInputFromGraphQL(in graphql.ApplicationCreateInput) mo
 		Description:         in.Description,
 		Labels:              labels,
 		HealthCheckURL:      in.HealthCheckURL,
+		HealthCheckAuth:     c.auth.InputFromGraphQL(in.HealthCheckAuth),
 		IntegrationSystemID: in.IntegrationSystemID,
 		Webhooks:            c.webhook.MultipleInputFromGraphQL(in.Webhooks),
 		Documents:           c.document.MultipleInputFromGraphQL(in.Documents),
@@ -110,6 +146,7 @@ func (c *converter) UpdateInputFromGraphQL(in graphql.ApplicationUpdateInput) mo
 		Name:                in.Name,
 		Description:         in.Description,
 		HealthCheckURL:      in.HealthCheckURL,
+		HealthCheckAuth:     c.auth.InputFromGraphQL(in.HealthCheckAuth),
 		IntegrationSystemID: in.IntegrationSystemID,
 	}
 }
@@ -126,10 +163,14 @@ func (c *converter) statusToGraphQL(in *model.ApplicationStatus) *graphql.Applic
 	switch in.Condition {
 	case model.ApplicationStatusConditionInitial:
 		condition = graphql.ApplicationStatusConditionInitial
+	case model.ApplicationStatusConditionUnknown:
+		condition = graphql.ApplicationStatusConditionUnknown
 	case model.ApplicationStatusConditionFailed:
 		condition = graphql.ApplicationStatusConditionFailed
 	case model.ApplicationStatusConditionReady:
 		condition = graphql.ApplicationStatusConditionReady
+	case model.ApplicationStatusConditionDeleting:
+		condition = graphql.ApplicationStatusConditionDeleting
 	default:
 		condition = graphql.ApplicationStatusConditionInitial
 	}
@@ -139,3 +180,55 @@ func (c *converter) statusToGraphQL(in *model.ApplicationStatus) *graphql.Applic
 		Timestamp: graphql.Timestamp(in.Timestamp),
 	}
 }
+
+func (c *converter) templateValuesToEntity(in []*model.ApplicationTemplateValueInput) (sql.NullString, error) {
+	if in == nil {
+		return sql.NullString{}, nil
+	}
+
+	values, err := json.Marshal(in)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return repo.NewValidNullableString(string(values)), nil
+}
+
+func (c *converter) templateValuesFromEntity(in sql.NullString) ([]*model.ApplicationTemplateValueInput, error) {
+	if !in.Valid {
+		return nil, nil
+	}
+
+	var values []*model.ApplicationTemplateValueInput
+	if err := json.Unmarshal([]byte(in.String), &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func (c *converter) healthCheckAuthToEntity(in *model.Auth) (sql.NullString, error) {
+	if in == nil {
+		return sql.NullString{}, nil
+	}
+
+	auth, err := json.Marshal(in)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return repo.NewValidNullableString(string(auth)), nil
+}
+
+func (c *converter) healthCheckAuthFromEntity(in sql.NullString) (*model.Auth, error) {
+	if !in.Valid || in.String == "" {
+		return nil, nil
+	}
+
+	auth := &model.Auth{}
+	if err := json.Unmarshal([]byte(in.String), auth); err != nil {
+		return nil, err
+	}
+
+	return auth, nil
+}
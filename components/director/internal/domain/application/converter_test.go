@@ -1,15 +1,19 @@
 package application_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConverter_ToGraphQL(t *testing.T) {
@@ -43,7 +47,7 @@ func TestConverter_ToGraphQL(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// when
-			converter := application.NewConverter(nil, nil, nil, nil)
+			converter := application.NewConverter(nil, nil, nil, nil, auth.NewConverter())
 			res := converter.ToGraphQL(testCase.Input)
 
 			// then
@@ -71,7 +75,7 @@ func TestConverter_MultipleToGraphQL(t *testing.T) {
 	}
 
 	// when
-	converter := application.NewConverter(nil, nil, nil, nil)
+	converter := application.NewConverter(nil, nil, nil, nil, auth.NewConverter())
 	res := converter.MultipleToGraphQL(input)
 
 	// then
@@ -152,6 +156,7 @@ func TestConverter_CreateInputFromGraphQL(t *testing.T) {
 				testCase.APIConverterFn(),
 				testCase.EventAPIConverterFn(),
 				testCase.DocumentConverterFn(),
+				auth.NewConverter(),
 			)
 			res := converter.CreateInputFromGraphQL(testCase.Input)
 
@@ -186,7 +191,7 @@ func TestConverter_UpdateInputFromGraphQL(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// when
-			converter := application.NewConverter(nil, nil, nil, nil)
+			converter := application.NewConverter(nil, nil, nil, nil, auth.NewConverter())
 			res := converter.UpdateInputFromGraphQL(testCase.Input)
 
 			// then
@@ -196,7 +201,7 @@ func TestConverter_UpdateInputFromGraphQL(t *testing.T) {
 }
 
 func TestConverter_ToEntity(t *testing.T) {
-	conv := application.NewConverter(nil, nil, nil, nil)
+	conv := application.NewConverter(nil, nil, nil, nil, auth.NewConverter())
 
 	t.Run("All properties given", func(t *testing.T) {
 		// GIVEN
@@ -236,24 +241,26 @@ func TestConverter_ToEntity(t *testing.T) {
 }
 
 func TestConverter_FromEntity(t *testing.T) {
-	conv := application.NewConverter(nil, nil, nil, nil)
+	conv := application.NewConverter(nil, nil, nil, nil, auth.NewConverter())
 
 	t.Run("All properties given", func(t *testing.T) {
 		// GIVEN
 		appEntity := fixDetailedEntityApplication(t, givenID(), givenTenant(), "app-name", "app-description")
 
 		// WHEN
-		appModel := conv.FromEntity(appEntity)
+		appModel, err := conv.FromEntity(appEntity)
 
 		// THEN
+		require.NoError(t, err)
 		assertApplicationDefinition(t, appModel, appEntity)
 	})
 
 	t.Run("Nil", func(t *testing.T) {
 		// WHEN
-		appModel := conv.FromEntity(nil)
+		appModel, err := conv.FromEntity(nil)
 
 		// THEN
+		require.NoError(t, err)
 		assert.Nil(t, appModel)
 	})
 
@@ -262,11 +269,25 @@ func TestConverter_FromEntity(t *testing.T) {
 		appEntity := &application.Entity{}
 
 		// WHEN
-		appModel := conv.FromEntity(appEntity)
+		appModel, err := conv.FromEntity(appEntity)
 
 		// THEN
+		require.NoError(t, err)
 		assertApplicationDefinition(t, appModel, appEntity)
 	})
+
+	t.Run("Error when Application Template values are invalid", func(t *testing.T) {
+		// GIVEN
+		appEntity := fixDetailedEntityApplication(t, givenID(), givenTenant(), "app-name", "app-description")
+		appEntity.ApplicationTemplateValues = repo.NewValidNullableString("{")
+
+		// WHEN
+		_, err := conv.FromEntity(appEntity)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while converting Application Template values")
+	})
 }
 
 func assertApplicationDefinition(t *testing.T, appModel *model.Application, entity *application.Entity) {
@@ -283,6 +304,19 @@ func assertApplicationDefinition(t *testing.T, appModel *model.Application, enti
 
 	testdb.AssertSqlNullString(t, entity.Description, appModel.Description)
 	testdb.AssertSqlNullString(t, entity.HealthCheckURL, appModel.HealthCheckURL)
+	if appModel.HealthCheckAuth != nil {
+		var healthCheckAuth model.Auth
+		require.NoError(t, json.Unmarshal([]byte(entity.HealthCheckAuth.String), &healthCheckAuth))
+		assert.Equal(t, *appModel.HealthCheckAuth, healthCheckAuth)
+	} else {
+		assert.False(t, entity.HealthCheckAuth.Valid)
+	}
+	assert.Equal(t, repo.IntPtrFromNullableInt(entity.ApplicationTemplateVersion), appModel.ApplicationTemplateVersion)
+	if appModel.ApplicationTemplateValues != nil {
+		assert.JSONEq(t, `[{"Placeholder":"name","Value":"foo"}]`, entity.ApplicationTemplateValues.String)
+	} else {
+		assert.False(t, entity.ApplicationTemplateValues.Valid)
+	}
 }
 
 func givenID() string {
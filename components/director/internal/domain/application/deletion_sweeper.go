@@ -0,0 +1,145 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=DeletionRepository -output=automock -outpkg=automock -case=underscore
+type DeletionRepository interface {
+	LockNextByStatusCondition(ctx context.Context, condition model.ApplicationStatusCondition, changedBefore time.Time) (*model.Application, error)
+	Delete(ctx context.Context, tenant, id string) error
+	UpdateStatusIfCondition(ctx context.Context, tenant, id string, status model.ApplicationStatus, currentConditions []model.ApplicationStatusCondition) error
+}
+
+type deletionSweeper struct {
+	transact     persistence.Transactioner
+	repo         DeletionRepository
+	sysAuthSvc   SystemAuthService
+	oAuth20Svc   OAuth20Service
+	timeout      time.Duration
+	timestampGen timestamp.Generator
+}
+
+func NewDeletionSweeper(transact persistence.Transactioner, repo DeletionRepository, sysAuthSvc SystemAuthService, oAuth20Svc OAuth20Service, timeout time.Duration) *deletionSweeper {
+	return &deletionSweeper{
+		transact:     transact,
+		repo:         repo,
+		sysAuthSvc:   sysAuthSvc,
+		oAuth20Svc:   oAuth20Svc,
+		timeout:      timeout,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// DeleteTimedOut removes every Application which has been waiting for the deletion confirmation longer than the timeout,
+// each one in a separate transaction.
+//
+// If an Application cannot be deleted, its status timestamp is bumped, so that it is retried after another timeout
+// and does not block the deletion of the other Applications. All such failures are returned together.
+func (s *deletionSweeper) DeleteTimedOut(ctx context.Context) error {
+	deletingBefore := s.timestampGen().Add(-s.timeout)
+
+	var failures []string
+	for {
+		app, err := s.deleteNext(ctx, deletingBefore)
+		if err != nil {
+			if app == nil {
+				return err
+			}
+
+			log.Errorf("Deleting timed out Application with ID %s failed: %s", app.ID, err)
+			failures = append(failures, err.Error())
+
+			err = s.postpone(ctx, app)
+			if err != nil {
+				return errors.Wrapf(err, "while postponing deletion of Application with ID %s", app.ID)
+			}
+			continue
+		}
+		if app == nil {
+			break
+		}
+	}
+
+	if len(failures) > 0 {
+		return errors.Errorf("deleting %d Applications failed: [%s]", len(failures), strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// deleteNext returns the deleted Application, or nil if there is nothing left to delete.
+// If the error is returned together with the Application, the transaction was rolled back after the Application was locked.
+func (s *deletionSweeper) deleteNext(ctx context.Context, deletingBefore time.Time) (*model.Application, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening transaction")
+	}
+	defer s.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	app, err := s.repo.LockNextByStatusCondition(ctx, model.ApplicationStatusConditionDeleting, deletingBefore)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "while getting Application to delete")
+	}
+
+	ctx = tenant.SaveToContext(ctx, app.Tenant)
+
+	auths, err := s.sysAuthSvc.ListForObject(ctx, model.ApplicationReference, app.ID)
+	if err != nil {
+		return app, errors.Wrapf(err, "while listing System Auths of Application with ID %s", app.ID)
+	}
+
+	err = s.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths)
+	if err != nil {
+		return app, errors.Wrapf(err, "while deleting client credentials of Application with ID %s", app.ID)
+	}
+
+	err = s.repo.Delete(ctx, app.Tenant, app.ID)
+	if err != nil {
+		return app, errors.Wrapf(err, "while deleting Application with ID %s", app.ID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return app, errors.Wrap(err, "while committing transaction")
+	}
+
+	log.Infof("Application with ID %s deleted, because its deletion was not confirmed within %s", app.ID, s.timeout)
+	return app, nil
+}
+
+func (s *deletionSweeper) postpone(ctx context.Context, app *model.Application) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer s.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	status := model.ApplicationStatus{
+		Condition: model.ApplicationStatusConditionDeleting,
+		Timestamp: s.timestampGen(),
+	}
+	err = s.repo.UpdateStatusIfCondition(ctx, app.Tenant, app.ID, status, []model.ApplicationStatusCondition{model.ApplicationStatusConditionDeleting})
+	if err != nil {
+		return errors.Wrap(err, "while updating Application status")
+	}
+
+	return tx.Commit()
+}
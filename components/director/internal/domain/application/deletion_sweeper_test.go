@@ -0,0 +1,175 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletionSweeper_DeleteTimedOut(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	now := time.Date(2019, 11, 25, 12, 0, 0, 0, time.UTC)
+	timeout := time.Hour
+	deletingBefore := now.Add(-timeout)
+	app := fixModelApplication("foo", "tenant-foo", "Foo", "Bar")
+	app.Status.Condition = model.ApplicationStatusConditionDeleting
+	testAuths := fixOAuths()
+
+	ctxWithTenant := mock.MatchedBy(func(ctx context.Context) bool {
+		tnt, err := tenant.LoadFromContext(ctx)
+		return err == nil && tnt == app.Tenant
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		deletionTx := txtest.PersistenceContextThatExpectsCommit()
+		emptyTx := txtest.PersistenceContextThatDoesntExpectCommit()
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(deletionTx, nil).Once()
+		transact.On("Begin").Return(emptyTx, nil).Once()
+		transact.On("RollbackUnlessCommited", deletionTx).Return().Once()
+		transact.On("RollbackUnlessCommited", emptyTx).Return().Once()
+
+		repo := &automock.DeletionRepository{}
+		repo.On("LockNextByStatusCondition", txtest.CtxWithDBMatcher(), model.ApplicationStatusConditionDeleting, deletingBefore).Return(app, nil).Once()
+		repo.On("Delete", ctxWithTenant, app.Tenant, app.ID).Return(nil).Once()
+		repo.On("LockNextByStatusCondition", txtest.CtxWithDBMatcher(), model.ApplicationStatusConditionDeleting, deletingBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForObject", ctxWithTenant, model.ApplicationReference, app.ID).Return(testAuths, nil).Once()
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteMultipleClientCredentials", ctxWithTenant, testAuths).Return(nil).Once()
+
+		sweeper := application.NewDeletionSweeper(transact, repo, sysAuthSvc, oAuth20Svc, timeout)
+		sweeper.SetTimestampGen(func() time.Time { return now })
+
+		// when
+		err := sweeper.DeleteTimedOut(context.TODO())
+
+		// then
+		require.NoError(t, err)
+
+		deletionTx.AssertExpectations(t)
+		emptyTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+		sysAuthSvc.AssertExpectations(t)
+		oAuth20Svc.AssertExpectations(t)
+	})
+
+	t.Run("Postpones Application which failed to be deleted and deletes the next one", func(t *testing.T) {
+		otherApp := fixModelApplication("bar", app.Tenant, "Bar", "Foo")
+		otherApp.Status.Condition = model.ApplicationStatusConditionDeleting
+		postponedStatus := model.ApplicationStatus{Condition: model.ApplicationStatusConditionDeleting, Timestamp: now}
+
+		failedTx := txtest.PersistenceContextThatDoesntExpectCommit()
+		postponeTx := txtest.PersistenceContextThatExpectsCommit()
+		deletionTx := txtest.PersistenceContextThatExpectsCommit()
+		emptyTx := txtest.PersistenceContextThatDoesntExpectCommit()
+		transact := &persistenceautomock.Transactioner{}
+		for _, tx := range []*persistenceautomock.PersistenceTx{failedTx, postponeTx, deletionTx, emptyTx} {
+			transact.On("Begin").Return(tx, nil).Once()
+			transact.On("RollbackUnlessCommited", tx).Return().Once()
+		}
+
+		repo := &automock.DeletionRepository{}
+		repo.On("LockNextByStatusCondition", txtest.CtxWithDBMatcher(), model.ApplicationStatusConditionDeleting, deletingBefore).Return(app, nil).Once()
+		repo.On("UpdateStatusIfCondition", txtest.CtxWithDBMatcher(), app.Tenant, app.ID, postponedStatus, []model.ApplicationStatusCondition{model.ApplicationStatusConditionDeleting}).Return(nil).Once()
+		repo.On("LockNextByStatusCondition", txtest.CtxWithDBMatcher(), model.ApplicationStatusConditionDeleting, deletingBefore).Return(otherApp, nil).Once()
+		repo.On("Delete", ctxWithTenant, otherApp.Tenant, otherApp.ID).Return(nil).Once()
+		repo.On("LockNextByStatusCondition", txtest.CtxWithDBMatcher(), model.ApplicationStatusConditionDeleting, deletingBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForObject", ctxWithTenant, model.ApplicationReference, app.ID).Return(testAuths, nil).Once()
+		sysAuthSvc.On("ListForObject", ctxWithTenant, model.ApplicationReference, otherApp.ID).Return(nil, nil).Once()
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteMultipleClientCredentials", ctxWithTenant, testAuths).Return(testErr).Once()
+		oAuth20Svc.On("DeleteMultipleClientCredentials", ctxWithTenant, []model.SystemAuth(nil)).Return(nil).Once()
+
+		sweeper := application.NewDeletionSweeper(transact, repo, sysAuthSvc, oAuth20Svc, timeout)
+		sweeper.SetTimestampGen(func() time.Time { return now })
+
+		// when
+		err := sweeper.DeleteTimedOut(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while deleting client credentials of Application with ID foo")
+		assert.Contains(t, err.Error(), testErr.Error())
+
+		failedTx.AssertExpectations(t)
+		postponeTx.AssertExpectations(t)
+		deletionTx.AssertExpectations(t)
+		emptyTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+		sysAuthSvc.AssertExpectations(t)
+		oAuth20Svc.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when postponing Application failed", func(t *testing.T) {
+		postponedStatus := model.ApplicationStatus{Condition: model.ApplicationStatusConditionDeleting, Timestamp: now}
+
+		persistTx := txtest.PersistenceContextThatDoesntExpectCommit()
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Twice()
+		transact.On("RollbackUnlessCommited", persistTx).Return().Twice()
+
+		repo := &automock.DeletionRepository{}
+		repo.On("LockNextByStatusCondition", txtest.CtxWithDBMatcher(), model.ApplicationStatusConditionDeleting, deletingBefore).Return(app, nil).Once()
+		repo.On("UpdateStatusIfCondition", txtest.CtxWithDBMatcher(), app.Tenant, app.ID, postponedStatus, []model.ApplicationStatusCondition{model.ApplicationStatusConditionDeleting}).Return(testErr).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForObject", ctxWithTenant, model.ApplicationReference, app.ID).Return(nil, testErr).Once()
+
+		sweeper := application.NewDeletionSweeper(transact, repo, sysAuthSvc, nil, timeout)
+		sweeper.SetTimestampGen(func() time.Time { return now })
+
+		// when
+		err := sweeper.DeleteTimedOut(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while postponing deletion of Application with ID foo")
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+		sysAuthSvc.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when locking Application failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+
+		repo := &automock.DeletionRepository{}
+		repo.On("LockNextByStatusCondition", txtest.CtxWithDBMatcher(), model.ApplicationStatusConditionDeleting, deletingBefore).Return(nil, testErr).Once()
+
+		sweeper := application.NewDeletionSweeper(transact, repo, nil, nil, timeout)
+		sweeper.SetTimestampGen(func() time.Time { return now })
+
+		// when
+		err := sweeper.DeleteTimedOut(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+}
@@ -6,14 +6,18 @@ import (
 )
 
 type Entity struct {
-	ID                  string         `db:"id"`
-	TenantID            string         `db:"tenant_id"`
-	Name                string         `db:"name"`
-	Description         sql.NullString `db:"description"`
-	StatusCondition     string         `db:"status_condition"`
-	StatusTimestamp     time.Time      `db:"status_timestamp"`
-	HealthCheckURL      sql.NullString `db:"healthcheck_url"`
-	IntegrationSystemID sql.NullString `db:"integration_system_id"`
+	ID                         string         `db:"id"`
+	TenantID                   string         `db:"tenant_id"`
+	Name                       string         `db:"name"`
+	Description                sql.NullString `db:"description"`
+	StatusCondition            string         `db:"status_condition"`
+	StatusTimestamp            time.Time      `db:"status_timestamp"`
+	HealthCheckURL             sql.NullString `db:"healthcheck_url"`
+	HealthCheckAuth            sql.NullString `db:"healthcheck_auth"`
+	IntegrationSystemID        sql.NullString `db:"integration_system_id"`
+	ApplicationTemplateID      sql.NullString `db:"app_template_id"`
+	ApplicationTemplateVersion sql.NullInt64  `db:"app_template_version"`
+	ApplicationTemplateValues  sql.NullString `db:"app_template_values"`
 }
 
 type EntityCollection []Entity
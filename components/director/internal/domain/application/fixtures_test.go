@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
@@ -14,8 +15,15 @@ import (
 )
 
 var (
-	testURL  = "https://foo.bar"
-	intSysID = "iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii"
+	testURL       = "https://foo.bar"
+	intSysID      = "iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii"
+	appTemplateID = "tttttttt-tttt-tttt-tttt-tttttttttttt"
+
+	appTemplateVersion = 2
+	appTemplateValues  = []*model.ApplicationTemplateValueInput{{Placeholder: "name", Value: "foo"}}
+
+	healthCheckAuth      = &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "user", Password: "pass"}}}
+	healthCheckAuthInput = &model.AuthInput{Credential: &model.CredentialDataInput{Basic: &model.BasicCredentialDataInput{Username: "user", Password: "pass"}}}
 )
 
 func fixApplicationPage(applications []*model.Application) *model.ApplicationPage {
@@ -65,6 +73,7 @@ func fixModelApplicationWithAllUpdatableFields(id, tenant, name, description, ur
 		Name:                name,
 		Description:         &description,
 		HealthCheckURL:      &url,
+		HealthCheckAuth:     healthCheckAuth,
 	}
 }
 
@@ -89,11 +98,15 @@ func fixDetailedModelApplication(t *testing.T, id, tenant, name, description str
 			Condition: model.ApplicationStatusConditionInitial,
 			Timestamp: time,
 		},
-		Name:                name,
-		Description:         &description,
-		Tenant:              tenant,
-		HealthCheckURL:      &testURL,
-		IntegrationSystemID: &intSysID,
+		Name:                       name,
+		Description:                &description,
+		Tenant:                     tenant,
+		HealthCheckURL:             &testURL,
+		HealthCheckAuth:            healthCheckAuth,
+		IntegrationSystemID:        &intSysID,
+		ApplicationTemplateID:      &appTemplateID,
+		ApplicationTemplateVersion: &appTemplateVersion,
+		ApplicationTemplateValues:  appTemplateValues,
 	}
 }
 
@@ -107,10 +120,15 @@ func fixDetailedGQLApplication(t *testing.T, id, name, description string) *grap
 			Condition: graphql.ApplicationStatusConditionInitial,
 			Timestamp: graphql.Timestamp(time),
 		},
-		Name:                name,
-		Description:         &description,
-		HealthCheckURL:      &testURL,
-		IntegrationSystemID: &intSysID,
+		Name:           name,
+		Description:    &description,
+		HealthCheckURL: &testURL,
+		HealthCheckAuth: &graphql.Auth{
+			Credential: graphql.BasicCredentialData{Username: "user", Password: "pass"},
+		},
+		IntegrationSystemID:        &intSysID,
+		ApplicationTemplateID:      &appTemplateID,
+		ApplicationTemplateVersion: &appTemplateVersion,
 	}
 }
 
@@ -119,14 +137,18 @@ func fixDetailedEntityApplication(t *testing.T, id, tenant, name, description st
 	require.NoError(t, err)
 
 	return &application.Entity{
-		ID:                  id,
-		TenantID:            tenant,
-		Name:                name,
-		Description:         repo.NewValidNullableString(description),
-		StatusCondition:     string(model.ApplicationStatusConditionInitial),
-		StatusTimestamp:     ts,
-		HealthCheckURL:      repo.NewValidNullableString(testURL),
-		IntegrationSystemID: repo.NewNullableString(&intSysID),
+		ID:                         id,
+		TenantID:                   tenant,
+		Name:                       name,
+		Description:                repo.NewValidNullableString(description),
+		StatusCondition:            string(model.ApplicationStatusConditionInitial),
+		StatusTimestamp:            ts,
+		HealthCheckURL:             repo.NewValidNullableString(testURL),
+		HealthCheckAuth:            repo.NewValidNullableString(`{"Credential":{"Basic":{"Username":"user","Password":"pass"}}}`),
+		IntegrationSystemID:        repo.NewNullableString(&intSysID),
+		ApplicationTemplateID:      repo.NewNullableString(&appTemplateID),
+		ApplicationTemplateVersion: repo.NewNullableInt(&appTemplateVersion),
+		ApplicationTemplateValues:  repo.NewValidNullableString(`[{"Placeholder":"name","Value":"foo"}]`),
 	}
 }
 
@@ -140,6 +162,7 @@ func fixModelApplicationCreateInput(name, description string) model.ApplicationC
 			"test": []string{"val", "val2"},
 		},
 		HealthCheckURL:      &testURL,
+		HealthCheckAuth:     healthCheckAuthInput,
 		IntegrationSystemID: &intSysID,
 		Webhooks: []*model.WebhookInput{
 			{URL: "webhook1.foo.bar"},
@@ -165,6 +188,7 @@ func fixModelApplicationUpdateInput(name, description, url string) model.Applica
 		Name:                name,
 		Description:         &description,
 		HealthCheckURL:      &url,
+		HealthCheckAuth:     healthCheckAuthInput,
 		IntegrationSystemID: &intSysID,
 	}
 }
@@ -180,6 +204,7 @@ func fixGQLApplicationCreateInput(name, description string) graphql.ApplicationC
 		Description:         &description,
 		Labels:              &labels,
 		HealthCheckURL:      &testURL,
+		HealthCheckAuth:     fixGQLHealthCheckAuthInput(),
 		IntegrationSystemID: &intSysID,
 		Webhooks: []*graphql.WebhookInput{
 			{URL: "webhook1.foo.bar"},
@@ -205,10 +230,19 @@ func fixGQLApplicationUpdateInput(name, description, url string) graphql.Applica
 		Name:                name,
 		Description:         &description,
 		HealthCheckURL:      &url,
+		HealthCheckAuth:     fixGQLHealthCheckAuthInput(),
 		IntegrationSystemID: &intSysID,
 	}
 }
 
+func fixGQLHealthCheckAuthInput() *graphql.AuthInput {
+	return &graphql.AuthInput{
+		Credential: &graphql.CredentialDataInput{
+			Basic: &graphql.BasicCredentialDataInput{Username: "user", Password: "pass"},
+		},
+	}
+}
+
 var (
 	docKind  = "fookind"
 	docTitle = "footitle"
@@ -411,3 +445,19 @@ func fixLabelInput(key string, value string, objectID string, objectType model.L
 		ObjectType: objectType,
 	}
 }
+
+func fixUnusedNotifier() *automock.WebhookNotifier {
+	return &automock.WebhookNotifier{}
+}
+
+func fixSyncDeletionNotifier() *automock.WebhookNotifier {
+	notifier := &automock.WebhookNotifier{}
+	notifier.On("NotifyApplicationLifecycle", contextParam, "foo", model.WebhookTypeUnregisterApplication).Return(false, nil).Once()
+	return notifier
+}
+
+func fixAsyncDeletionNotifier() *automock.WebhookNotifier {
+	notifier := &automock.WebhookNotifier{}
+	notifier.On("NotifyApplicationLifecycle", contextParam, "foo", model.WebhookTypeUnregisterApplication).Return(true, nil).Once()
+	return notifier
+}
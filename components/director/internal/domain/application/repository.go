@@ -2,27 +2,33 @@ package application
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
 const applicationTable string = `public.applications`
 
 var (
-	applicationColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}
+	applicationColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "healthcheck_auth", "integration_system_id", "app_template_id", "app_template_version", "app_template_values"}
 	tenantColumn       = "tenant_id"
 )
 
 //go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
 type EntityConverter interface {
 	ToEntity(in *model.Application) (*Entity, error)
-	FromEntity(entity *Entity) *model.Application
+	FromEntity(entity *Entity) (*model.Application, error)
 }
 
 type pgRepository struct {
@@ -42,7 +48,7 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		deleter:         repo.NewDeleter(applicationTable, tenantColumn),
 		pageableQuerier: repo.NewPageableQuerier(applicationTable, tenantColumn, applicationColumns),
 		creator:         repo.NewCreator(applicationTable, applicationColumns),
-		updater:         repo.NewUpdater(applicationTable, []string{"name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}, tenantColumn, []string{"id"}),
+		updater:         repo.NewUpdater(applicationTable, []string{"name", "description", "status_condition", "status_timestamp", "healthcheck_url", "healthcheck_auth", "integration_system_id", "app_template_id", "app_template_version", "app_template_values"}, tenantColumn, []string{"id"}),
 		conv:            conv,
 	}
 }
@@ -61,7 +67,10 @@ func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.A
 		return nil, err
 	}
 
-	appModel := r.conv.FromEntity(&appEnt)
+	appModel, err := r.conv.FromEntity(&appEnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Application entity")
+	}
 
 	return appModel, nil
 }
@@ -72,7 +81,7 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 	if err != nil {
 		return nil, errors.Wrap(err, "while parsing tenant as UUID")
 	}
-	filterSubquery, err := label.FilterQuery(model.ApplicationLabelableObject, label.IntersectSet, tenantID, filter)
+	filterSubquery, args, err := label.FilterQuery(model.ApplicationLabelableObject, label.IntersectSet, tenantID, filter, 2)
 	if err != nil {
 		return nil, errors.Wrap(err, "while building filter query")
 	}
@@ -81,7 +90,7 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		additionalConditions = append(additionalConditions, fmt.Sprintf(`"id" IN (%s)`, filterSubquery))
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &appsCollection, additionalConditions...)
+	page, totalCount, err := r.pageableQuerier.ListWithArgs(ctx, tenant, pageSize, cursor, "id", &appsCollection, additionalConditions, args)
 
 	if err != nil {
 		return nil, err
@@ -90,7 +99,10 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 	var items []*model.Application
 
 	for _, appEnt := range appsCollection {
-		m := r.conv.FromEntity(&appEnt)
+		m, err := r.conv.FromEntity(&appEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Application entity")
+		}
 		items = append(items, m)
 	}
 	return &model.ApplicationPage{
@@ -105,11 +117,10 @@ func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, sc
 	var scenariosFilers []*labelfilter.LabelFilter
 
 	for _, scenarioValue := range scenarios {
-		query := fmt.Sprintf(`$[*] ? (@ == "%s")`, scenarioValue)
-		scenariosFilers = append(scenariosFilers, &labelfilter.LabelFilter{Key: model.ScenariosKey, Query: &query})
+		scenariosFilers = append(scenariosFilers, labelfilter.NewForArrayElement(model.ScenariosKey, scenarioValue))
 	}
 
-	scenariosSubquery, err := label.FilterQuery(model.ApplicationLabelableObject, label.UnionSet, tenant, scenariosFilers)
+	scenariosSubquery, args, err := label.FilterQuery(model.ApplicationLabelableObject, label.UnionSet, tenant, scenariosFilers, 2)
 	if err != nil {
 		return nil, errors.Wrap(err, "while creating scenarios filter query")
 	}
@@ -118,7 +129,7 @@ func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, sc
 		additionalConditions = append(additionalConditions, fmt.Sprintf(`"id" IN (%s)`, scenariosSubquery))
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, tenant.String(), pageSize, cursor, "id", &appsCollection, additionalConditions...)
+	page, totalCount, err := r.pageableQuerier.ListWithArgs(ctx, tenant.String(), pageSize, cursor, "id", &appsCollection, additionalConditions, args)
 
 	if err != nil {
 		return nil, err
@@ -127,7 +138,10 @@ func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, sc
 	var items []*model.Application
 
 	for _, appEnt := range appsCollection {
-		m := r.conv.FromEntity(&appEnt)
+		m, err := r.conv.FromEntity(&appEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Application entity")
+		}
 		items = append(items, m)
 	}
 	return &model.ApplicationPage{
@@ -162,3 +176,82 @@ func (r *pgRepository) Update(ctx context.Context, model *model.Application) err
 
 	return r.updater.UpdateSingle(ctx, appEnt)
 }
+
+// LockNextByStatusCondition returns an Application of any tenant that has had the given status condition since before the given time
+// and locks it until the end of the transaction. Applications locked by other transactions are skipped.
+func (r *pgRepository) LockNextByStatusCondition(ctx context.Context, condition model.ApplicationStatusCondition, changedBefore time.Time) (*model.Application, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE status_condition = $1 AND status_timestamp <= $2
+		ORDER BY status_timestamp LIMIT 1 FOR UPDATE SKIP LOCKED`, strings.Join(applicationColumns, ", "), applicationTable)
+
+	var appEnt Entity
+	err = persist.Get(&appEnt, stmt, string(condition), changedBefore)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, apperrors.NewNotFoundError("")
+	case err != nil:
+		return nil, errors.Wrap(err, "while getting Application from DB")
+	}
+
+	appModel, err := r.conv.FromEntity(&appEnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Application entity")
+	}
+
+	return appModel, nil
+}
+
+// GetNextForHealthCheck returns a random Application of any tenant with the health check URL that has not been checked since the given time.
+// Applications being deleted are skipped.
+func (r *pgRepository) GetNextForHealthCheck(ctx context.Context, checkedBefore time.Time) (*model.Application, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s a WHERE a.healthcheck_url IS NOT NULL AND a.status_condition <> $1
+		AND NOT EXISTS (SELECT 1 FROM public.health_checks h WHERE h.origin = a.id AND h.timestamp > $2)
+		ORDER BY random() LIMIT 1`, strings.Join(applicationColumns, ", "), applicationTable)
+
+	var appEnt Entity
+	err = persist.Get(&appEnt, stmt, string(model.ApplicationStatusConditionDeleting), checkedBefore)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, apperrors.NewNotFoundError("")
+	case err != nil:
+		return nil, errors.Wrap(err, "while getting Application from DB")
+	}
+
+	appModel, err := r.conv.FromEntity(&appEnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Application entity")
+	}
+
+	return appModel, nil
+}
+
+// UpdateStatusIfCondition sets the status of the Application only if its current status condition is one of the given conditions
+func (r *pgRepository) UpdateStatusIfCondition(ctx context.Context, tenant, id string, status model.ApplicationStatus, currentConditions []model.ApplicationStatusCondition) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	conditions := make([]string, 0, len(currentConditions))
+	for _, condition := range currentConditions {
+		conditions = append(conditions, string(condition))
+	}
+
+	stmt := fmt.Sprintf(`UPDATE %s SET status_condition = $1, status_timestamp = $2 WHERE tenant_id = $3 AND id = $4 AND status_condition::text = ANY($5)`, applicationTable)
+
+	_, err = persist.Exec(stmt, string(status.Condition), status.Timestamp, tenant, id, pq.Array(conditions))
+	if err != nil {
+		return errors.Wrap(err, "while updating Application status")
+	}
+
+	return nil
+}
@@ -2,22 +2,27 @@ package application_test
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"regexp"
 	"testing"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -94,8 +99,8 @@ func TestRepository_Create(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.applications ( id, tenant_id, name, description, status_condition, status_timestamp, healthcheck_url, integration_system_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )`)).
-			WithArgs(givenID(), givenTenant(), appModel.Name, appModel.Description, appModel.Status.Condition, appModel.Status.Timestamp, appModel.HealthCheckURL, appModel.IntegrationSystemID).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.applications ( id, tenant_id, name, description, status_condition, status_timestamp, healthcheck_url, healthcheck_auth, integration_system_id, app_template_id, app_template_version, app_template_values ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+			WithArgs(givenID(), givenTenant(), appModel.Name, appModel.Description, appModel.Status.Condition, appModel.Status.Timestamp, appModel.HealthCheckURL, appEntity.HealthCheckAuth, appModel.IntegrationSystemID, appModel.ApplicationTemplateID, appModel.ApplicationTemplateVersion, appEntity.ApplicationTemplateValues).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -150,7 +155,7 @@ func TestRepository_Create(t *testing.T) {
 }
 
 func TestRepository_Update(t *testing.T) {
-	updateStmt := `UPDATE public\.applications SET name = \?, description = \?, status_condition = \?, status_timestamp = \?, healthcheck_url = \?, integration_system_id = \? WHERE tenant_id = \? AND id = \?`
+	updateStmt := `UPDATE public\.applications SET name = \?, description = \?, status_condition = \?, status_timestamp = \?, healthcheck_url = \?, healthcheck_auth = \?, integration_system_id = \?, app_template_id = \?, app_template_version = \?, app_template_values = \? WHERE tenant_id = \? AND id = \?`
 
 	t.Run("Success", func(t *testing.T) {
 		// given
@@ -165,7 +170,7 @@ func TestRepository_Update(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		dbMock.ExpectExec(updateStmt).
-			WithArgs(appModel.Name, appModel.Description, appModel.Status.Condition, appModel.Status.Timestamp, appModel.HealthCheckURL, appModel.IntegrationSystemID, givenTenant(), givenID()).
+			WithArgs(appModel.Name, appModel.Description, appModel.Status.Condition, appModel.Status.Timestamp, appModel.HealthCheckURL, appEntity.HealthCheckAuth, appModel.IntegrationSystemID, appModel.ApplicationTemplateID, appModel.ApplicationTemplateVersion, appEntity.ApplicationTemplateValues, givenTenant(), givenID()).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -234,8 +239,8 @@ func TestRepository_GetByID(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}).
-			AddRow(givenID(), givenTenant(), appEntity.Name, appEntity.Description, appEntity.StatusCondition, appEntity.StatusTimestamp, appEntity.HealthCheckURL, appEntity.IntegrationSystemID)
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "healthcheck_auth", "integration_system_id", "app_template_id", "app_template_version", "app_template_values"}).
+			AddRow(givenID(), givenTenant(), appEntity.Name, appEntity.Description, appEntity.StatusCondition, appEntity.StatusTimestamp, appEntity.HealthCheckURL, appEntity.HealthCheckAuth, appEntity.IntegrationSystemID, appEntity.ApplicationTemplateID, appEntity.ApplicationTemplateVersion, appEntity.ApplicationTemplateValues)
 
 		dbMock.ExpectQuery(`^SELECT (.+) FROM public.applications WHERE tenant_id = \$1 AND id = \$2$`).
 			WithArgs(givenTenant(), givenID()).
@@ -271,6 +276,200 @@ func TestRepository_GetByID(t *testing.T) {
 	})
 }
 
+func TestRepository_LockNextByStatusCondition(t *testing.T) {
+	stmt := regexp.QuoteMeta(`SELECT id, tenant_id, name, description, status_condition, status_timestamp, healthcheck_url, healthcheck_auth, integration_system_id, app_template_id, app_template_version, app_template_values FROM public.applications WHERE status_condition = $1 AND status_timestamp <= $2
+		ORDER BY status_timestamp LIMIT 1 FOR UPDATE SKIP LOCKED`)
+	changedBefore := time.Date(2019, 11, 25, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		appModel := fixDetailedModelApplication(t, givenID(), givenTenant(), "Test app", "Test app description")
+		appEntity := fixDetailedEntityApplication(t, givenID(), givenTenant(), "Test app", "Test app description")
+
+		mockConverter := &automock.EntityConverter{}
+		mockConverter.On("FromEntity", appEntity).Return(appModel, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		repo := application.NewRepository(mockConverter)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "healthcheck_auth", "integration_system_id", "app_template_id", "app_template_version", "app_template_values"}).
+			AddRow(givenID(), givenTenant(), appEntity.Name, appEntity.Description, appEntity.StatusCondition, appEntity.StatusTimestamp, appEntity.HealthCheckURL, appEntity.HealthCheckAuth, appEntity.IntegrationSystemID, appEntity.ApplicationTemplateID, appEntity.ApplicationTemplateVersion, appEntity.ApplicationTemplateValues)
+
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.ApplicationStatusConditionDeleting), changedBefore).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		actual, err := repo.LockNextByStatusCondition(ctx, model.ApplicationStatusConditionDeleting, changedBefore)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, appModel, actual)
+	})
+
+	t.Run("Returns not found error when there are no matching Applications", func(t *testing.T) {
+		// given
+		repo := application.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.ApplicationStatusConditionDeleting), changedBefore).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		_, err := repo.LockNextByStatusCondition(ctx, model.ApplicationStatusConditionDeleting, changedBefore)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		repo := application.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.ApplicationStatusConditionDeleting), changedBefore).
+			WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		_, err := repo.LockNextByStatusCondition(ctx, model.ApplicationStatusConditionDeleting, changedBefore)
+
+		// then
+		require.EqualError(t, err, "while getting Application from DB: some error")
+	})
+}
+
+func TestRepository_GetNextForHealthCheck(t *testing.T) {
+	stmt := regexp.QuoteMeta(`SELECT id, tenant_id, name, description, status_condition, status_timestamp, healthcheck_url, healthcheck_auth, integration_system_id, app_template_id, app_template_version, app_template_values FROM public.applications a WHERE a.healthcheck_url IS NOT NULL AND a.status_condition <> $1
+		AND NOT EXISTS (SELECT 1 FROM public.health_checks h WHERE h.origin = a.id AND h.timestamp > $2)
+		ORDER BY random() LIMIT 1`)
+	checkedBefore := time.Date(2019, 11, 28, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		appModel := fixDetailedModelApplication(t, givenID(), givenTenant(), "Test app", "Test app description")
+		appEntity := fixDetailedEntityApplication(t, givenID(), givenTenant(), "Test app", "Test app description")
+
+		mockConverter := &automock.EntityConverter{}
+		mockConverter.On("FromEntity", appEntity).Return(appModel, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		repo := application.NewRepository(mockConverter)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "healthcheck_auth", "integration_system_id", "app_template_id", "app_template_version", "app_template_values"}).
+			AddRow(givenID(), givenTenant(), appEntity.Name, appEntity.Description, appEntity.StatusCondition, appEntity.StatusTimestamp, appEntity.HealthCheckURL, appEntity.HealthCheckAuth, appEntity.IntegrationSystemID, appEntity.ApplicationTemplateID, appEntity.ApplicationTemplateVersion, appEntity.ApplicationTemplateValues)
+
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.ApplicationStatusConditionDeleting), checkedBefore).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		actual, err := repo.GetNextForHealthCheck(ctx, checkedBefore)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, appModel, actual)
+	})
+
+	t.Run("Returns not found error when there are no Applications to check", func(t *testing.T) {
+		// given
+		repo := application.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.ApplicationStatusConditionDeleting), checkedBefore).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		_, err := repo.GetNextForHealthCheck(ctx, checkedBefore)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		repo := application.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.ApplicationStatusConditionDeleting), checkedBefore).
+			WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		_, err := repo.GetNextForHealthCheck(ctx, checkedBefore)
+
+		// then
+		require.EqualError(t, err, "while getting Application from DB: some error")
+	})
+}
+
+func TestRepository_UpdateStatusIfCondition(t *testing.T) {
+	stmt := regexp.QuoteMeta(`UPDATE public.applications SET status_condition = $1, status_timestamp = $2 WHERE tenant_id = $3 AND id = $4 AND status_condition::text = ANY($5)`)
+	timestamp := time.Date(2019, 11, 27, 12, 0, 0, 0, time.UTC)
+	status := model.ApplicationStatus{Condition: model.ApplicationStatusConditionReady, Timestamp: timestamp}
+	currentConditions := []model.ApplicationStatusCondition{model.ApplicationStatusConditionInitial, model.ApplicationStatusConditionUnknown}
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		repo := application.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(stmt).
+			WithArgs(string(model.ApplicationStatusConditionReady), timestamp, givenTenant(), givenID(), pq.Array([]string{"INITIAL", "UNKNOWN"})).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		err := repo.UpdateStatusIfCondition(ctx, givenTenant(), givenID(), status, currentConditions)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		repo := application.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(stmt).WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		err := repo.UpdateStatusIfCondition(ctx, givenTenant(), givenID(), status, currentConditions)
+
+		// then
+		require.EqualError(t, err, "while updating Application status: some error")
+	})
+}
+
 func TestPgRepository_List(t *testing.T) {
 	app1ID := "aec0e9c5-06da-4625-9f8a-bda17ab8c3b9"
 	app2ID := "ccdbef8f-b97a-490c-86e2-2bab2862a6e4"
@@ -289,9 +488,9 @@ func TestPgRepository_List(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		// given
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}).
-			AddRow(appEntity1.ID, appEntity1.TenantID, appEntity1.Name, appEntity1.Description, appEntity1.StatusCondition, appEntity1.StatusTimestamp, appEntity1.HealthCheckURL, appEntity1.IntegrationSystemID).
-			AddRow(appEntity2.ID, appEntity2.TenantID, appEntity2.Name, appEntity2.Description, appEntity2.StatusCondition, appEntity2.StatusTimestamp, appEntity2.HealthCheckURL, appEntity2.IntegrationSystemID)
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "healthcheck_auth", "integration_system_id", "app_template_id", "app_template_version", "app_template_values"}).
+			AddRow(appEntity1.ID, appEntity1.TenantID, appEntity1.Name, appEntity1.Description, appEntity1.StatusCondition, appEntity1.StatusTimestamp, appEntity1.HealthCheckURL, appEntity1.HealthCheckAuth, appEntity1.IntegrationSystemID, appEntity1.ApplicationTemplateID, appEntity1.ApplicationTemplateVersion, appEntity1.ApplicationTemplateValues).
+			AddRow(appEntity2.ID, appEntity2.TenantID, appEntity2.Name, appEntity2.Description, appEntity2.StatusCondition, appEntity2.StatusTimestamp, appEntity2.HealthCheckURL, appEntity2.HealthCheckAuth, appEntity2.IntegrationSystemID, appEntity2.ApplicationTemplateID, appEntity2.ApplicationTemplateVersion, appEntity2.ApplicationTemplateValues)
 
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		defer sqlMock.AssertExpectations(t)
@@ -306,8 +505,8 @@ func TestPgRepository_List(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 
 		conv := &automock.EntityConverter{}
-		conv.On("FromEntity", appEntity2).Return(appModel2).Once()
-		conv.On("FromEntity", appEntity1).Return(appModel1).Once()
+		conv.On("FromEntity", appEntity2).Return(appModel2, nil).Once()
+		conv.On("FromEntity", appEntity1).Return(appModel1, nil).Once()
 		defer conv.AssertExpectations(t)
 
 		pgRepository := application.NewRepository(conv)
@@ -358,16 +557,13 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 	assert.NoError(t, err)
 
 	runtimeScenarios := []string{"Java", "Go", "Elixir"}
-	scenariosQuery := fmt.Sprintf(`SELECT "app_id" FROM public.labels
+	scenarioQuery := func(keyIdx int) string {
+		return regexp.QuoteMeta(fmt.Sprintf(`SELECT "app_id" FROM public.labels
 					WHERE "app_id" IS NOT NULL AND "tenant_id" = '%s'
-						AND "key" = 'scenarios' AND "value" @> '["Java"]'
-					UNION SELECT "app_id" FROM public.labels
-						WHERE "app_id" IS NOT NULL AND "tenant_id" = '%s'
-						AND "key" = 'scenarios' AND "value" @> '["Go"]'
-					UNION SELECT "app_id" FROM public.labels
-						WHERE "app_id" IS NOT NULL AND "tenant_id" = '%s'
-						AND "key" = 'scenarios' AND "value" @> '["Elixir"]'`, tenantID, tenantID, tenantID)
-	applicationScenarioQuery := regexp.QuoteMeta(scenariosQuery)
+						AND "key" = $%d AND `, tenantID, keyIdx)) + `EXISTS .+`
+	}
+	applicationScenarioQuery := scenarioQuery(2) + ` UNION ` + scenarioQuery(4) + ` UNION ` + scenarioQuery(6)
+	queryArgs := []driver.Value{tenantID, "scenarios", `"Java"`, "scenarios", `"Go"`, "scenarios", `"Elixir"`}
 
 	pagableQuery := fmt.Sprintf(`SELECT (.+) FROM public\.applications WHERE tenant_id=\$1 AND "id" IN \(%s\) ORDER BY id LIMIT %d OFFSET %d`,
 		applicationScenarioQuery,
@@ -376,7 +572,7 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 
 	countQuery := fmt.Sprintf(`SELECT COUNT\(\*\) FROM public\.applications WHERE tenant_id=\$1 AND "id" IN \(%s\)$`, applicationScenarioQuery)
 
-	conv := application.NewConverter(nil, nil, nil, nil)
+	conv := application.NewConverter(nil, nil, nil, nil, auth.NewConverter())
 	intSysID := repo.NewValidNullableString("iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii")
 
 	testCases := []struct {
@@ -387,15 +583,15 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 	}{
 		{
 			Name: "Success",
-			ExpectedApplicationRows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}).
-				AddRow(app1ID, tenantID, "App ABC", "Description for application ABC", "INITIAL", timestamp, "http://domain.local/app1", intSysID).
-				AddRow(app2ID, tenantID, "App XYZ", "Description for application XYZ", "INITIAL", timestamp, "http://domain.local/app2", intSysID),
+			ExpectedApplicationRows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "healthcheck_auth", "integration_system_id", "app_template_id", "app_template_version", "app_template_values"}).
+				AddRow(app1ID, tenantID, "App ABC", "Description for application ABC", "INITIAL", timestamp, "http://domain.local/app1", nil, intSysID, nil, nil, nil).
+				AddRow(app2ID, tenantID, "App XYZ", "Description for application XYZ", "INITIAL", timestamp, "http://domain.local/app2", nil, intSysID, nil, nil, nil),
 			TotalCount:    2,
 			ExpectedError: nil,
 		},
 		{
 			Name:                    "Return empty page when no application match",
-			ExpectedApplicationRows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}),
+			ExpectedApplicationRows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "healthcheck_auth", "integration_system_id", "app_template_id", "app_template_version", "app_template_values"}),
 			TotalCount:              0,
 			ExpectedError:           nil,
 		},
@@ -407,12 +603,12 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 			sqlxDB, sqlMock := testdb.MockDatabase(t)
 			if testCase.ExpectedApplicationRows != nil {
 				sqlMock.ExpectQuery(pagableQuery).
-					WithArgs(tenantID).
+					WithArgs(queryArgs...).
 					WillReturnRows(testCase.ExpectedApplicationRows)
 
 				countRow := sqlMock.NewRows([]string{"count"}).AddRow(testCase.TotalCount)
 				sqlMock.ExpectQuery(countQuery).
-					WithArgs(tenantID).
+					WithArgs(queryArgs...).
 					WillReturnRows(countRow)
 			}
 			repository := application.NewRepository(conv)
@@ -2,8 +2,10 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/google/uuid"
@@ -22,6 +24,7 @@ type ApplicationService interface {
 	Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error
 	Get(ctx context.Context, id string) (*model.Application, error)
 	Delete(ctx context.Context, id string) error
+	SetStatusCondition(ctx context.Context, id string, condition model.ApplicationStatusCondition) error
 	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
 	ListByRuntimeID(ctx context.Context, runtimeUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
@@ -40,7 +43,7 @@ type ApplicationConverter interface {
 
 //go:generate mockery -name=APIService -output=automock -outpkg=automock -case=underscore
 type APIService interface {
-	List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error)
+	List(ctx context.Context, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.APIDefinitionPage, error)
 	Create(ctx context.Context, applicationID string, in model.APIDefinitionInput) (string, error)
 	Update(ctx context.Context, id string, in model.APIDefinitionInput) error
 	Delete(ctx context.Context, id string) error
@@ -60,7 +63,7 @@ type APIConverter interface {
 type EventAPIService interface {
 	Get(ctx context.Context, id string) (*model.EventAPIDefinition, error)
 	GetForApplication(ctx context.Context, id string, applicationID string) (*model.EventAPIDefinition, error)
-	List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error)
+	List(ctx context.Context, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error)
 	Create(ctx context.Context, applicationID string, in model.EventAPIDefinitionInput) (string, error)
 	Update(ctx context.Context, id string, in model.EventAPIDefinitionInput) error
 	Delete(ctx context.Context, id string) error
@@ -112,11 +115,32 @@ type SystemAuthConverter interface {
 	ToGraphQL(in *model.SystemAuth) *graphql.SystemAuth
 }
 
+//go:generate mockery -name=AuthConverter -output=automock -outpkg=automock -case=underscore
+type AuthConverter interface {
+	ToGraphQL(in *model.Auth) *graphql.Auth
+	InputFromGraphQL(in *graphql.AuthInput) *model.AuthInput
+}
+
 //go:generate mockery -name=OAuth20Service -output=automock -outpkg=automock -case=underscore
 type OAuth20Service interface {
 	DeleteMultipleClientCredentials(ctx context.Context, auths []model.SystemAuth) error
 }
 
+//go:generate mockery -name=WebhookNotifier -output=automock -outpkg=automock -case=underscore
+type WebhookNotifier interface {
+	NotifyApplicationLifecycle(ctx context.Context, applicationID string, webhookType model.WebhookType) (bool, error)
+}
+
+//go:generate mockery -name=EventService -output=automock -outpkg=automock -case=underscore
+type EventService interface {
+	GetEventURL(ctx context.Context, applicationID string) (string, error)
+}
+
+//go:generate mockery -name=SpecPrefetcher -output=automock -outpkg=automock -case=underscore
+type SpecPrefetcher interface {
+	Prefetch(ctx context.Context, fetchRequests []*model.FetchRequest) context.Context
+}
+
 type Resolver struct {
 	transact persistence.Transactioner
 
@@ -135,7 +159,9 @@ type Resolver struct {
 	apiConverter      APIConverter
 	eventApiConverter EventAPIConverter
 	sysAuthConv       SystemAuthConverter
-	defaultEventURL   string
+	eventSvc          EventService
+	notifier          WebhookNotifier
+	prefetcher        SpecPrefetcher
 }
 
 func NewResolver(transact persistence.Transactioner,
@@ -152,7 +178,9 @@ func NewResolver(transact persistence.Transactioner,
 	apiConverter APIConverter,
 	eventAPIConverter EventAPIConverter,
 	sysAuthConv SystemAuthConverter,
-	defaultEventURL string) *Resolver {
+	eventSvc EventService,
+	notifier WebhookNotifier,
+	prefetcher SpecPrefetcher) *Resolver {
 	return &Resolver{
 		transact:          transact,
 		appSvc:            svc,
@@ -168,12 +196,17 @@ func NewResolver(transact persistence.Transactioner,
 		apiConverter:      apiConverter,
 		eventApiConverter: eventAPIConverter,
 		sysAuthConv:       sysAuthConv,
-		defaultEventURL:   defaultEventURL,
+		eventSvc:          eventSvc,
+		notifier:          notifier,
+		prefetcher:        prefetcher,
 	}
 }
 
-func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
-	labelFilter := labelfilter.MultipleFromGraphQL(filter)
+func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, filterExpression *graphql.LabelFilterExpression, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	labelFilter, err := labelfilter.MultipleWithExpressionFromGraphQL(filter, filterExpression)
+	if err != nil {
+		return nil, err
+	}
 
 	var cursor string
 	if after != nil {
@@ -292,6 +325,11 @@ func (r *Resolver) ApplicationsForRuntime(ctx context.Context, runtimeID string,
 }
 
 func (r *Resolver) CreateApplication(ctx context.Context, in graphql.ApplicationCreateInput) (*graphql.Application, error) {
+	convertedIn := r.appConverter.CreateInputFromGraphQL(in)
+
+	// the specifications are downloaded before the transaction is started, so that the transaction is not kept open during the downloads
+	ctx = r.prefetcher.Prefetch(ctx, model.FetchRequestsFromInputs(convertedIn.FetchRequestInputs()))
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -300,7 +338,6 @@ func (r *Resolver) CreateApplication(ctx context.Context, in graphql.Application
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	convertedIn := r.appConverter.CreateInputFromGraphQL(in)
 	id, err := r.appSvc.Create(ctx, convertedIn)
 	if err != nil {
 		return nil, err
@@ -311,6 +348,11 @@ func (r *Resolver) CreateApplication(ctx context.Context, in graphql.Application
 		return nil, err
 	}
 
+	_, err = r.notifier.NotifyApplicationLifecycle(ctx, id, model.WebhookTypeRegisterApplication)
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -363,16 +405,76 @@ func (r *Resolver) DeleteApplication(ctx context.Context, id string) (*graphql.A
 		return nil, err
 	}
 
-	auths, err := r.sysAuthSvc.ListForObject(ctx, model.ApplicationReference, app.ID)
+	if isDeleting(app) {
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+
+		return r.appConverter.ToGraphQL(app), nil
+	}
+
+	// The Application is removed only after the external system confirms the deletion or the deletion times out
+	async, err := r.notifier.NotifyApplicationLifecycle(ctx, id, model.WebhookTypeUnregisterApplication)
 	if err != nil {
 		return nil, err
 	}
 
-	err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths)
+	if async {
+		err = r.appSvc.SetStatusCondition(ctx, id, model.ApplicationStatusConditionDeleting)
+		if err != nil {
+			return nil, err
+		}
+
+		app, err = r.appSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = r.deleteApplication(ctx, app)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
-	err = r.appSvc.Delete(ctx, id)
+
+	deletedApp := r.appConverter.ToGraphQL(app)
+
+	return deletedApp, nil
+}
+
+func (r *Resolver) ConfirmApplicationDeletion(ctx context.Context, id string) (*graphql.Application, error) {
+	c, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	app, err := r.appSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canConfirmDeletion(c, app) {
+		return nil, errors.Errorf("%s with ID %s cannot confirm deletion of Application with ID %s", c.ConsumerType, c.ConsumerID, id)
+	}
+
+	if !isDeleting(app) {
+		return nil, fmt.Errorf("application with ID %s is not being deleted", id)
+	}
+
+	err = r.deleteApplication(ctx, app)
 	if err != nil {
 		return nil, err
 	}
@@ -386,6 +488,77 @@ func (r *Resolver) DeleteApplication(ctx context.Context, id string) (*graphql.A
 
 	return deletedApp, nil
 }
+
+func (r *Resolver) deleteApplication(ctx context.Context, app *model.Application) error {
+	auths, err := r.sysAuthSvc.ListForObject(ctx, model.ApplicationReference, app.ID)
+	if err != nil {
+		return err
+	}
+
+	err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths)
+	if err != nil {
+		return err
+	}
+
+	return r.appSvc.Delete(ctx, app.ID)
+}
+
+// canConfirmDeletion returns true only if the consumer is the Application itself or the Integration System managing it
+func canConfirmDeletion(c consumer.Consumer, app *model.Application) bool {
+	switch c.ConsumerType {
+	case consumer.Application:
+		return c.ConsumerID == app.ID
+	case consumer.IntegrationSystem:
+		return app.IntegrationSystemID != nil && *app.IntegrationSystemID == c.ConsumerID
+	}
+
+	return false
+}
+
+func isDeleting(app *model.Application) bool {
+	return app.Status != nil && app.Status.Condition == model.ApplicationStatusConditionDeleting
+}
+
+func (r *Resolver) ReportApplicationStatus(ctx context.Context, id string, condition graphql.ApplicationStatusCondition) (*graphql.Application, error) {
+	c, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.CanActOn(consumer.Application, id) {
+		return nil, errors.Errorf("%s with ID %s cannot report status of Application with ID %s", c.ConsumerType, c.ConsumerID, id)
+	}
+
+	if condition == graphql.ApplicationStatusConditionDeleting {
+		return nil, errors.New("Application status cannot be reported as DELETING, use deleteApplication instead")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = r.appSvc.SetStatusCondition(ctx, id, model.ApplicationStatusCondition(condition))
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := r.appSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.appConverter.ToGraphQL(app), nil
+}
+
 func (r *Resolver) SetApplicationLabel(ctx context.Context, applicationID string, key string, value interface{}) (*graphql.Label, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -446,7 +619,7 @@ func (r *Resolver) DeleteApplicationLabel(ctx context.Context, applicationID str
 	}, nil
 }
 
-func (r *Resolver) Apis(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
+func (r *Resolver) Apis(ctx context.Context, obj *graphql.Application, group *string, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -464,7 +637,8 @@ func (r *Resolver) Apis(ctx context.Context, obj *graphql.Application, group *st
 		return nil, errors.New("missing required parameter 'first'")
 	}
 
-	apisPage, err := r.apiSvc.List(ctx, obj.ID, *first, cursor)
+	labelFilter := labelfilter.MultipleFromGraphQL(filter)
+	apisPage, err := r.apiSvc.List(ctx, obj.ID, labelFilter, *first, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -487,7 +661,7 @@ func (r *Resolver) Apis(ctx context.Context, obj *graphql.Application, group *st
 		},
 	}, nil
 }
-func (r *Resolver) EventAPIs(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor) (*graphql.EventAPIDefinitionPage, error) {
+func (r *Resolver) EventAPIs(ctx context.Context, obj *graphql.Application, group *string, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.EventAPIDefinitionPage, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -504,7 +678,8 @@ func (r *Resolver) EventAPIs(ctx context.Context, obj *graphql.Application, grou
 		return nil, errors.New("missing required parameter 'first'")
 	}
 
-	eventAPIPage, err := r.eventAPISvc.List(ctx, obj.ID, *first, cursor)
+	labelFilter := labelfilter.MultipleFromGraphQL(filter)
+	eventAPIPage, err := r.eventAPISvc.List(ctx, obj.ID, labelFilter, *first, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -712,11 +887,33 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.Application) ([]*grap
 }
 
 func (r *Resolver) EventConfiguration(ctx context.Context, obj *graphql.Application) (*graphql.ApplicationEventConfiguration, error) {
-	if r.defaultEventURL == "" {
+	if obj == nil {
+		return nil, errors.New("Application cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	eventURL, err := r.eventSvc.GetEventURL(ctx, obj.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Event URL for Application with ID %s", obj.ID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if eventURL == "" {
 		return nil, nil
 	}
+
 	return &graphql.ApplicationEventConfiguration{
-		DefaultURL: r.defaultEventURL,
+		DefaultURL: eventURL,
 	}, nil
-
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
 
 	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
@@ -36,6 +37,12 @@ func TestResolver_CreateApplication(t *testing.T) {
 	modelInput := model.ApplicationCreateInput{
 		Name:        "Foo",
 		Description: &desc,
+		Apis: []*model.APIDefinitionInput{
+			{Name: "api", Spec: &model.APISpecInput{FetchRequest: &model.FetchRequestInput{URL: "foo.bar"}}},
+		},
+	}
+	prefetchedFetchRequests := []*model.FetchRequest{
+		{URL: "foo.bar", Mode: model.FetchModeSingle, Status: &model.FetchRequestStatus{Condition: model.FetchRequestStatusConditionInitial}},
 	}
 	txGen := txtest.NewTransactionContextGenerator(testErr)
 
@@ -44,6 +51,7 @@ func TestResolver_CreateApplication(t *testing.T) {
 		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn           func() *automock.ApplicationService
 		ConverterFn         func() *automock.ApplicationConverter
+		NotifierFn          func() *automock.WebhookNotifier
 		Input               graphql.ApplicationCreateInput
 		ExpectedApplication *graphql.Application
 		ExpectedErr         error
@@ -63,6 +71,11 @@ func TestResolver_CreateApplication(t *testing.T) {
 				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
 				return conv
 			},
+			NotifierFn: func() *automock.WebhookNotifier {
+				notifier := &automock.WebhookNotifier{}
+				notifier.On("NotifyApplicationLifecycle", contextParam, "foo", model.WebhookTypeRegisterApplication).Return(false, nil).Once()
+				return notifier
+			},
 			Input:               gqlInput,
 			ExpectedApplication: gqlApplication,
 			ExpectedErr:         nil,
@@ -81,6 +94,11 @@ func TestResolver_CreateApplication(t *testing.T) {
 				conv.On("CreateInputFromGraphQL", gqlInput).Return(modelInput).Once()
 				return conv
 			},
+			NotifierFn: func() *automock.WebhookNotifier {
+				notifier := &automock.WebhookNotifier{}
+				notifier.On("NotifyApplicationLifecycle", contextParam, "foo", model.WebhookTypeRegisterApplication).Return(false, nil).Once()
+				return notifier
+			},
 			Input:               gqlInput,
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -98,6 +116,7 @@ func TestResolver_CreateApplication(t *testing.T) {
 				conv.On("CreateInputFromGraphQL", gqlInput).Return(modelInput).Once()
 				return conv
 			},
+			NotifierFn:          fixUnusedNotifier,
 			Input:               gqlInput,
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -116,6 +135,30 @@ func TestResolver_CreateApplication(t *testing.T) {
 				conv.On("CreateInputFromGraphQL", gqlInput).Return(modelInput).Once()
 				return conv
 			},
+			NotifierFn:          fixUnusedNotifier,
+			Input:               gqlInput,
+			ExpectedApplication: nil,
+			ExpectedErr:         testErr,
+		},
+		{
+			Name:            "Returns error when notifying Webhooks failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Create", contextParam, modelInput).Return("foo", nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("CreateInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			NotifierFn: func() *automock.WebhookNotifier {
+				notifier := &automock.WebhookNotifier{}
+				notifier.On("NotifyApplicationLifecycle", contextParam, "foo", model.WebhookTypeRegisterApplication).Return(false, testErr).Once()
+				return notifier
+			},
 			Input:               gqlInput,
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -127,7 +170,10 @@ func TestResolver_CreateApplication(t *testing.T) {
 			persistTx, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			notifier := testCase.NotifierFn()
+			prefetcher := &automock.SpecPrefetcher{}
+			prefetcher.On("Prefetch", context.TODO(), prefetchedFetchRequests).Return(context.TODO()).Once()
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, notifier, prefetcher)
 			resolver.SetConverter(converter)
 
 			// when
@@ -141,6 +187,8 @@ func TestResolver_CreateApplication(t *testing.T) {
 			converter.AssertExpectations(t)
 			transact.AssertExpectations(t)
 			persistTx.AssertExpectations(t)
+			notifier.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
 		})
 	}
 }
@@ -274,7 +322,7 @@ func TestResolver_UpdateApplication(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			resolver.SetConverter(converter)
 
 			// when
@@ -298,6 +346,10 @@ func TestResolver_DeleteApplication(t *testing.T) {
 	gqlApplication := fixGQLApplication("foo", "Foo", "Bar")
 	testErr := errors.New("Test error")
 	testAuths := fixOAuths()
+	deletingApplication := fixModelApplication("foo", "tenant-foo", "Foo", "Bar")
+	deletingApplication.Status.Condition = model.ApplicationStatusConditionDeleting
+	gqlDeletingApplication := fixGQLApplication("foo", "Foo", "Bar")
+	gqlDeletingApplication.Status.Condition = graphql.ApplicationStatusConditionDeleting
 	txGen := txtest.NewTransactionContextGenerator(testErr)
 
 	testCases := []struct {
@@ -307,6 +359,7 @@ func TestResolver_DeleteApplication(t *testing.T) {
 		ConverterFn         func() *automock.ApplicationConverter
 		SysAuthServiceFn    func() *automock.SystemAuthService
 		OAuth20ServiceFn    func() *automock.OAuth20Service
+		NotifierFn          func() *automock.WebhookNotifier
 		InputID             string
 		ExpectedApplication *graphql.Application
 		ExpectedErr         error
@@ -335,6 +388,7 @@ func TestResolver_DeleteApplication(t *testing.T) {
 				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil)
 				return svc
 			},
+			NotifierFn:          fixSyncDeletionNotifier,
 			InputID:             "foo",
 			ExpectedApplication: gqlApplication,
 			ExpectedErr:         nil,
@@ -363,6 +417,7 @@ func TestResolver_DeleteApplication(t *testing.T) {
 
 				return svc
 			},
+			NotifierFn:          fixSyncDeletionNotifier,
 			InputID:             "foo",
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -391,6 +446,7 @@ func TestResolver_DeleteApplication(t *testing.T) {
 
 				return svc
 			},
+			NotifierFn:          fixSyncDeletionNotifier,
 			InputID:             "foo",
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -415,6 +471,7 @@ func TestResolver_DeleteApplication(t *testing.T) {
 				svc := &automock.OAuth20Service{}
 				return svc
 			},
+			NotifierFn:          fixUnusedNotifier,
 			InputID:             "foo",
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -438,6 +495,7 @@ func TestResolver_DeleteApplication(t *testing.T) {
 				svc := &automock.OAuth20Service{}
 				return svc
 			},
+			NotifierFn:          fixUnusedNotifier,
 			InputID:             "foo",
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -463,6 +521,7 @@ func TestResolver_DeleteApplication(t *testing.T) {
 				svc := &automock.OAuth20Service{}
 				return svc
 			},
+			NotifierFn:          fixSyncDeletionNotifier,
 			InputID:             "foo",
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -489,6 +548,106 @@ func TestResolver_DeleteApplication(t *testing.T) {
 				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(testErr)
 				return svc
 			},
+			NotifierFn:          fixSyncDeletionNotifier,
+			InputID:             "foo",
+			ExpectedApplication: nil,
+			ExpectedErr:         testErr,
+		},
+		{
+			Name:            "Success when Application has UNREGISTER_APPLICATION Webhooks",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				svc.On("SetStatusCondition", contextParam, "foo", model.ApplicationStatusConditionDeleting).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(deletingApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", deletingApplication).Return(gqlDeletingApplication).Once()
+				return conv
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			NotifierFn:          fixAsyncDeletionNotifier,
+			InputID:             "foo",
+			ExpectedApplication: gqlDeletingApplication,
+			ExpectedErr:         nil,
+		},
+		{
+			Name:            "Success when Application is already being deleted",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(deletingApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", deletingApplication).Return(gqlDeletingApplication).Once()
+				return conv
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			NotifierFn:          fixUnusedNotifier,
+			InputID:             "foo",
+			ExpectedApplication: gqlDeletingApplication,
+			ExpectedErr:         nil,
+		},
+		{
+			Name:            "Returns error when notifying Webhooks failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			NotifierFn: func() *automock.WebhookNotifier {
+				notifier := &automock.WebhookNotifier{}
+				notifier.On("NotifyApplicationLifecycle", contextParam, "foo", model.WebhookTypeUnregisterApplication).Return(false, testErr).Once()
+				return notifier
+			},
+			InputID:             "foo",
+			ExpectedApplication: nil,
+			ExpectedErr:         testErr,
+		},
+		{
+			Name:            "Returns error when setting status failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				svc.On("SetStatusCondition", contextParam, "foo", model.ApplicationStatusConditionDeleting).Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			NotifierFn:          fixAsyncDeletionNotifier,
 			InputID:             "foo",
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -502,7 +661,8 @@ func TestResolver_DeleteApplication(t *testing.T) {
 			persistTx, transact := testCase.TransactionerFn()
 			sysAuthSvc := testCase.SysAuthServiceFn()
 			oAuth20Svc := testCase.OAuth20ServiceFn()
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, sysAuthSvc, oAuth20Svc, nil, nil, nil, nil, nil, nil, "")
+			notifier := testCase.NotifierFn()
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, sysAuthSvc, oAuth20Svc, nil, nil, nil, nil, nil, nil, nil, notifier, nil)
 			resolver.SetConverter(converter)
 
 			// when
@@ -521,10 +681,449 @@ func TestResolver_DeleteApplication(t *testing.T) {
 			transact.AssertExpectations(t)
 			sysAuthSvc.AssertExpectations(t)
 			oAuth20Svc.AssertExpectations(t)
+			notifier.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_ConfirmApplicationDeletion(t *testing.T) {
+	// given
+	modelApplication := fixModelApplication("foo", "tenant-foo", "Foo", "Bar")
+	modelApplication.Status.Condition = model.ApplicationStatusConditionDeleting
+	modelApplication.IntegrationSystemID = &intSysID
+	gqlApplication := fixGQLApplication("foo", "Foo", "Bar")
+	gqlApplication.Status.Condition = graphql.ApplicationStatusConditionDeleting
+	testErr := errors.New("Test error")
+	testAuths := fixOAuths()
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	appConsumer := consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Application}
+
+	testCases := []struct {
+		Name                string
+		Consumer            consumer.Consumer
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn           func() *automock.ApplicationService
+		ConverterFn         func() *automock.ApplicationConverter
+		SysAuthServiceFn    func() *automock.SystemAuthService
+		OAuth20ServiceFn    func() *automock.OAuth20Service
+		ExpectedApplication *graphql.Application
+		ExpectedErr         error
+	}{
+		{
+			Consumer:        appConsumer,
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
+				return conv
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObject", contextParam, model.ApplicationReference, modelApplication.ID).Return(testAuths, nil).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil).Once()
+				return svc
+			},
+			ExpectedApplication: gqlApplication,
+		},
+		{
+			Consumer:        consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem},
+			Name:            "Success when called by Integration System of the Application",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
+				return conv
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObject", contextParam, model.ApplicationReference, modelApplication.ID).Return(testAuths, nil).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil).Once()
+				return svc
+			},
+			ExpectedApplication: gqlApplication,
+		},
+		{
+			Consumer:        consumer.Consumer{ConsumerID: "bar", ConsumerType: consumer.Application},
+			Name:            "Returns error when called by other Application",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			ExpectedErr: errors.New("Application with ID bar cannot confirm deletion of Application with ID foo"),
+		},
+		{
+			Consumer:        consumer.Consumer{ConsumerID: "other-int-sys", ConsumerType: consumer.IntegrationSystem},
+			Name:            "Returns error when called by other Integration System",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			ExpectedErr: errors.New("Integration System with ID other-int-sys cannot confirm deletion of Application with ID foo"),
+		},
+		{
+			Consumer:        consumer.Consumer{ConsumerID: "admin", ConsumerType: consumer.User},
+			Name:            "Returns error when called by User",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			ExpectedErr: errors.New("Static User with ID admin cannot confirm deletion of Application with ID foo"),
+		},
+		{
+			Consumer:        appConsumer,
+			Name:            "Returns error when Application is not being deleted",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(fixModelApplication("foo", "tenant-foo", "Foo", "Bar"), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			ExpectedErr: errors.New("application with ID foo is not being deleted"),
+		},
+		{
+			Consumer:        appConsumer,
+			Name:            "Returns error when application retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Consumer:        appConsumer,
+			Name:            "Returns error when application deletion failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				svc.On("Delete", contextParam, "foo").Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObject", contextParam, model.ApplicationReference, modelApplication.ID).Return(testAuths, nil).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Consumer:        appConsumer,
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObject", contextParam, model.ApplicationReference, modelApplication.ID).Return(testAuths, nil).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+			persistTx, transact := testCase.TransactionerFn()
+			sysAuthSvc := testCase.SysAuthServiceFn()
+			oAuth20Svc := testCase.OAuth20ServiceFn()
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, sysAuthSvc, oAuth20Svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver.SetConverter(converter)
+
+			// when
+			ctx := consumer.SaveToContext(context.TODO(), testCase.Consumer)
+			result, err := resolver.ConfirmApplicationDeletion(ctx, "foo")
+
+			// then
+			assert.Equal(t, testCase.ExpectedApplication, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+			persistTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			sysAuthSvc.AssertExpectations(t)
+			oAuth20Svc.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns error when consumer is missing", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.ConfirmApplicationDeletion(context.TODO(), "foo")
+		// then
+		require.EqualError(t, err, consumer.NoConsumerError.Error())
+	})
 }
 
+func TestResolver_ReportApplicationStatus(t *testing.T) {
+	// given
+	modelApplication := fixModelApplication("foo", "tenant-foo", "Foo", "Bar")
+	gqlApplication := fixGQLApplication("foo", "Foo", "Bar")
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	appConsumer := consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Application}
+
+	testCases := []struct {
+		Name                string
+		Consumer            consumer.Consumer
+		Condition           graphql.ApplicationStatusCondition
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn           func() *automock.ApplicationService
+		ConverterFn         func() *automock.ApplicationConverter
+		ExpectedApplication *graphql.Application
+		ExpectedErr         string
+	}{
+		{
+			Name:            "Success",
+			Consumer:        appConsumer,
+			Condition:       graphql.ApplicationStatusConditionFailed,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetStatusCondition", contextParam, "foo", model.ApplicationStatusConditionFailed).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
+				return conv
+			},
+			ExpectedApplication: gqlApplication,
+		},
+		{
+			Name:            "Success when Integration System reports status",
+			Consumer:        consumer.Consumer{ConsumerID: "bar", ConsumerType: consumer.IntegrationSystem},
+			Condition:       graphql.ApplicationStatusConditionFailed,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetStatusCondition", contextParam, "foo", model.ApplicationStatusConditionFailed).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
+				return conv
+			},
+			ExpectedApplication: gqlApplication,
+		},
+		{
+			Name:            "Returns error when other Application reports status",
+			Consumer:        consumer.Consumer{ConsumerID: "bar", ConsumerType: consumer.Application},
+			Condition:       graphql.ApplicationStatusConditionFailed,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: "Application with ID bar cannot report status of Application with ID foo",
+		},
+		{
+			Name:            "Returns error when consumer without type reports status",
+			Consumer:        consumer.Consumer{ConsumerID: "foo"},
+			Condition:       graphql.ApplicationStatusConditionFailed,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: " with ID foo cannot report status of Application with ID foo",
+		},
+		{
+			Name:            "Returns error when DELETING is reported",
+			Consumer:        appConsumer,
+			Condition:       graphql.ApplicationStatusConditionDeleting,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: "Application status cannot be reported as DELETING",
+		},
+		{
+			Name:            "Returns error when setting status failed",
+			Consumer:        appConsumer,
+			Condition:       graphql.ApplicationStatusConditionFailed,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetStatusCondition", contextParam, "foo", model.ApplicationStatusConditionFailed).Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			Consumer:        appConsumer,
+			Condition:       graphql.ApplicationStatusConditionFailed,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetStatusCondition", contextParam, "foo", model.ApplicationStatusConditionFailed).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+			persistTx, transact := testCase.TransactionerFn()
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver.SetConverter(converter)
+			ctx := consumer.SaveToContext(context.TODO(), testCase.Consumer)
+
+			// when
+			result, err := resolver.ReportApplicationStatus(ctx, "foo", testCase.Condition)
+
+			// then
+			assert.Equal(t, testCase.ExpectedApplication, result)
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+			persistTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when consumer is missing", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntStartTransaction()
+		resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.ReportApplicationStatus(context.TODO(), "foo", graphql.ApplicationStatusConditionFailed)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, consumer.NoConsumerError, err)
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+	})
+}
 func TestResolver_Application(t *testing.T) {
 	// given
 	modelApplication := fixModelApplication("foo", "tenant-foo", "Foo", "Bar")
@@ -587,7 +1186,7 @@ func TestResolver_Application(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			resolver.SetConverter(converter)
 
 			// when
@@ -628,14 +1227,15 @@ func TestResolver_Applications(t *testing.T) {
 	testErr := errors.New("Test error")
 
 	testCases := []struct {
-		Name              string
-		PersistenceFn     func() *persistenceautomock.PersistenceTx
-		TransactionerFn   func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
-		ServiceFn         func() *automock.ApplicationService
-		ConverterFn       func() *automock.ApplicationConverter
-		InputLabelFilters []*graphql.LabelFilter
-		ExpectedResult    *graphql.ApplicationPage
-		ExpectedErr       error
+		Name                  string
+		PersistenceFn         func() *persistenceautomock.PersistenceTx
+		TransactionerFn       func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
+		ServiceFn             func() *automock.ApplicationService
+		ConverterFn           func() *automock.ApplicationConverter
+		InputLabelFilters     []*graphql.LabelFilter
+		InputFilterExpression *graphql.LabelFilterExpression
+		ExpectedResult        *graphql.ApplicationPage
+		ExpectedErr           error
 	}{
 		{
 			Name:            "Success",
@@ -655,6 +1255,32 @@ func TestResolver_Applications(t *testing.T) {
 			ExpectedResult:    fixGQLApplicationPage(gqlApplications),
 			ExpectedErr:       nil,
 		},
+		{
+			Name:            "Success with filter expression",
+			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				expressionFilter := []*labelfilter.LabelFilter{
+					{Or: []*labelfilter.LabelFilter{{Key: "foo"}, {Key: "bar"}}},
+				}
+				svc := &automock.ApplicationService{}
+				svc.On("List", contextParam, expressionFilter, first, after).Return(fixApplicationPage(modelApplications), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("MultipleToGraphQL", modelApplications).Return(gqlApplications).Once()
+				return conv
+			},
+			InputFilterExpression: &graphql.LabelFilterExpression{
+				Or: []*graphql.LabelFilterExpression{
+					{Label: &graphql.LabelFilter{Key: "foo"}},
+					{Label: &graphql.LabelFilter{Key: "bar"}},
+				},
+			},
+			ExpectedResult: fixGQLApplicationPage(gqlApplications),
+			ExpectedErr:    nil,
+		},
 		{
 			Name:            "Returns error when application listing failed",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
@@ -681,11 +1307,11 @@ func TestResolver_Applications(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			resolver.SetConverter(converter)
 
 			// when
-			result, err := resolver.Applications(context.TODO(), testCase.InputLabelFilters, &first, &gqlAfter)
+			result, err := resolver.Applications(context.TODO(), testCase.InputLabelFilters, testCase.InputFilterExpression, &first, &gqlAfter)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -815,7 +1441,7 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 			applicationConverter := testCase.AppConverterFn()
 			persistTx, transact := testCase.TransactionerFn()
 
-			resolver := application.NewResolver(transact, applicationSvc, nil, nil, nil, nil, nil, nil, applicationConverter, nil, nil, nil, nil, nil, "")
+			resolver := application.NewResolver(transact, applicationSvc, nil, nil, nil, nil, nil, nil, applicationConverter, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			//WHEN
 			result, err := resolver.ApplicationsForRuntime(context.TODO(), testCase.InputRuntimeID, &first, &gqlAfter)
@@ -911,7 +1537,7 @@ func TestResolver_SetApplicationLabel(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transactioner := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			resolver.SetConverter(converter)
 
 			// when
@@ -1026,7 +1652,7 @@ func TestResolver_DeleteApplicationLabel(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transactioner := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			resolver.SetConverter(converter)
 
 			// when
@@ -1113,7 +1739,7 @@ func TestResolver_Documents(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, nil, nil, converter, nil, nil, nil, nil, "")
+			resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, nil, nil, converter, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.Documents(context.TODO(), app, &first, &gqlAfter)
@@ -1229,7 +1855,7 @@ func TestResolver_Webhooks(t *testing.T) {
 			mockPersistence := testCase.PersistenceFn()
 			mockTransactioner := testCase.TransactionerFn(mockPersistence)
 
-			resolver := application.NewResolver(mockTransactioner, nil, nil, nil, nil, svc, nil, nil, nil, nil, converter, nil, nil, nil, "")
+			resolver := application.NewResolver(mockTransactioner, nil, nil, nil, nil, svc, nil, nil, nil, nil, converter, nil, nil, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.Webhooks(context.TODO(), app)
@@ -1269,6 +1895,8 @@ func TestResolver_Apis(t *testing.T) {
 	first := 2
 	gqlAfter := graphql.PageCursor("test")
 	after := "test"
+	filter := []*labelfilter.LabelFilter{{Key: "domain"}}
+	gqlFilter := []*graphql.LabelFilter{{Key: "domain"}}
 
 	testCases := []struct {
 		Name            string
@@ -1283,7 +1911,7 @@ func TestResolver_Apis(t *testing.T) {
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("List", txtest.CtxWithDBMatcher(), applicationID, first, after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
+				svc.On("List", txtest.CtxWithDBMatcher(), applicationID, filter, first, after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
@@ -1313,7 +1941,7 @@ func TestResolver_Apis(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("List", txtest.CtxWithDBMatcher(), applicationID, first, after).Return(nil, testErr).Once()
+				svc.On("List", txtest.CtxWithDBMatcher(), applicationID, filter, first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
@@ -1328,7 +1956,7 @@ func TestResolver_Apis(t *testing.T) {
 			TransactionerFn: txGen.ThatFailsOnCommit,
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("List", txtest.CtxWithDBMatcher(), applicationID, first, after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
+				svc.On("List", txtest.CtxWithDBMatcher(), applicationID, filter, first, after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
@@ -1347,9 +1975,9 @@ func TestResolver_Apis(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, svc, nil, nil, nil, nil, nil, nil, nil, nil, converter, nil, nil, "")
+			resolver := application.NewResolver(transact, nil, svc, nil, nil, nil, nil, nil, nil, nil, nil, converter, nil, nil, nil, nil, nil)
 			// when
-			result, err := resolver.Apis(context.TODO(), app, &group, &first, &gqlAfter)
+			result, err := resolver.Apis(context.TODO(), app, &group, gqlFilter, &first, &gqlAfter)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1386,6 +2014,8 @@ func TestResolver_EventAPIs(t *testing.T) {
 	first := 2
 	gqlAfter := graphql.PageCursor("test")
 	after := "test"
+	filter := []*labelfilter.LabelFilter{{Key: "domain"}}
+	gqlFilter := []*graphql.LabelFilter{{Key: "domain"}}
 
 	testCases := []struct {
 		Name            string
@@ -1402,7 +2032,7 @@ func TestResolver_EventAPIs(t *testing.T) {
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.EventAPIService {
 				svc := &automock.EventAPIService{}
-				svc.On("List", contextParam, applicationID, first, after).Return(fixEventAPIDefinitionPage(modelEventAPIDefinitions), nil).Once()
+				svc.On("List", contextParam, applicationID, filter, first, after).Return(fixEventAPIDefinitionPage(modelEventAPIDefinitions), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.EventAPIConverter {
@@ -1420,7 +2050,7 @@ func TestResolver_EventAPIs(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.EventAPIService {
 				svc := &automock.EventAPIService{}
-				svc.On("List", contextParam, applicationID, first, after).Return(nil, testErr).Once()
+				svc.On("List", contextParam, applicationID, filter, first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.EventAPIConverter {
@@ -1441,9 +2071,9 @@ func TestResolver_EventAPIs(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, nil, svc, nil, nil, nil, nil, nil, nil, nil, nil, converter, nil, "")
+			resolver := application.NewResolver(transact, nil, nil, svc, nil, nil, nil, nil, nil, nil, nil, nil, converter, nil, nil, nil, nil)
 			// when
-			result, err := resolver.EventAPIs(context.TODO(), app, &group, testCase.InputFirst, testCase.InputAfter)
+			result, err := resolver.EventAPIs(context.TODO(), app, &group, gqlFilter, testCase.InputFirst, testCase.InputAfter)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1574,7 +2204,7 @@ func TestResolver_EventAPI(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, nil, svc, nil, nil, nil, nil, nil, nil, nil, nil, converter, nil, "")
+			resolver := application.NewResolver(transact, nil, nil, svc, nil, nil, nil, nil, nil, nil, nil, nil, converter, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.EventAPI(context.TODO(), testCase.InputID, testCase.Application)
@@ -1708,7 +2338,7 @@ func TestResolver_API(t *testing.T) {
 				svc := testCase.ServiceFn()
 				converter := testCase.ConverterFn()
 
-				resolver := application.NewResolver(transact, nil, svc, nil, nil, nil, nil, nil, nil, nil, nil, converter, nil, nil, "")
+				resolver := application.NewResolver(transact, nil, svc, nil, nil, nil, nil, nil, nil, nil, nil, converter, nil, nil, nil, nil, nil)
 
 				// when
 				result, err := resolver.API(context.TODO(), testCase.InputID, testCase.Application)
@@ -1806,7 +2436,7 @@ func TestResolver_Labels(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.Labels(context.TODO(), gqlApp, &testCase.InputKey)
@@ -1914,7 +2544,7 @@ func TestResolver_Auths(t *testing.T) {
 			persist, transact := testCase.TransactionerFn()
 			conv := testCase.SysAuthConvFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, svc, nil, nil, nil, nil, nil, nil, conv, "")
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, svc, nil, nil, nil, nil, nil, nil, conv, nil, nil, nil)
 
 			// when
 			result, err := resolver.Auths(context.TODO(), testCase.InputApp)
@@ -1931,7 +2561,7 @@ func TestResolver_Auths(t *testing.T) {
 	}
 
 	t.Run("Returns error when application is nil", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		//WHEN
 		_, err := resolver.Auths(context.TODO(), nil)
 		//THEN
@@ -1940,27 +2570,93 @@ func TestResolver_Auths(t *testing.T) {
 	})
 }
 
-func TestApplicationEventConfiguration(t *testing.T) {
-	t.Run("Returns default event URL when it is provided", func(t *testing.T) {
-		// GIVEN
-		givenEventURL := "http://default.event.url"
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, givenEventURL)
-		// WHEN
-		actualConfig, err := resolver.EventConfiguration(context.TODO(), &graphql.Application{})
-		// THEN
-		require.NoError(t, err)
-		require.NotNil(t, actualConfig)
-		assert.Equal(t, givenEventURL, actualConfig.DefaultURL)
-	})
+func TestResolver_EventConfiguration(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	app := fixGQLApplication("foo", "bar", "baz")
+	eventURL := "http://event.url"
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		EventSvcFn     func() *automock.EventService
+		ExpectedOutput *graphql.ApplicationEventConfiguration
+		ExpectedErr    error
+	}{
+		{
+			Name: "Returns Event URL",
+			TxFn: txGen.ThatSucceeds,
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("GetEventURL", txtest.CtxWithDBMatcher(), app.ID).Return(eventURL, nil).Once()
+				return svc
+			},
+			ExpectedOutput: &graphql.ApplicationEventConfiguration{DefaultURL: eventURL},
+		},
+		{
+			Name: "Returns nil when Event URL is not configured",
+			TxFn: txGen.ThatSucceeds,
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("GetEventURL", txtest.CtxWithDBMatcher(), app.ID).Return("", nil).Once()
+				return svc
+			},
+			ExpectedOutput: nil,
+		},
+		{
+			Name: "Returns error when getting Event URL failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("GetEventURL", txtest.CtxWithDBMatcher(), app.ID).Return("", testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when committing transaction failed",
+			TxFn: txGen.ThatFailsOnCommit,
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("GetEventURL", txtest.CtxWithDBMatcher(), app.ID).Return(eventURL, nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx, transact := testCase.TxFn()
+			eventSvc := testCase.EventSvcFn()
+
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, eventSvc, nil, nil)
+
+			// when
+			result, err := resolver.EventConfiguration(context.TODO(), app)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persistTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			eventSvc.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := resolver.EventConfiguration(context.TODO(), nil)
 
-	t.Run("Returns null when default event URL when it is not provided", func(t *testing.T) {
-		// GIVEN
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
-		// WHEN
-		actualConfig, err := resolver.EventConfiguration(context.TODO(), &graphql.Application{})
-		// THEN
-		require.NoError(t, err)
-		require.Nil(t, actualConfig)
+		require.EqualError(t, err, "Application cannot be empty")
 	})
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
@@ -40,28 +41,38 @@ type LabelRepository interface {
 
 //go:generate mockery -name=DocumentRepository -output=automock -outpkg=automock -case=underscore
 type DocumentRepository interface {
+	ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error)
 	Create(ctx context.Context, item *model.Document) error
+	Update(ctx context.Context, item *model.Document) error
+	Delete(ctx context.Context, tenant, id string) error
 	DeleteAllByApplicationID(ctx context.Context, tenant string, applicationID string) error
 }
 
 //go:generate mockery -name=WebhookRepository -output=automock -outpkg=automock -case=underscore
 type WebhookRepository interface {
 	ListByApplicationID(ctx context.Context, tenant, applicationID string) ([]*model.Webhook, error)
+	Create(ctx context.Context, item *model.Webhook) error
 	CreateMany(ctx context.Context, items []*model.Webhook) error
+	Update(ctx context.Context, item *model.Webhook) error
+	Delete(ctx context.Context, tenant, id string) error
 	DeleteAllByApplicationID(ctx context.Context, tenant, id string) error
 }
 
 //go:generate mockery -name=APIRepository -output=automock -outpkg=automock -case=underscore
 type APIRepository interface {
-	ListByApplicationID(ctx context.Context, tenant, applicationID string, pageSize int, cursor string) (*model.APIDefinitionPage, error)
+	ListByApplicationID(ctx context.Context, tenant, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.APIDefinitionPage, error)
 	Create(ctx context.Context, item *model.APIDefinition) error
+	Update(ctx context.Context, item *model.APIDefinition) error
+	Delete(ctx context.Context, tenantID string, id string) error
 	DeleteAllByApplicationID(ctx context.Context, tenant, id string) error
 }
 
 //go:generate mockery -name=EventAPIRepository -output=automock -outpkg=automock -case=underscore
 type EventAPIRepository interface {
-	ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error)
+	ListByApplicationID(ctx context.Context, tenantID string, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error)
 	Create(ctx context.Context, items *model.EventAPIDefinition) error
+	Update(ctx context.Context, item *model.EventAPIDefinition) error
+	Delete(ctx context.Context, tenantID string, id string) error
 	DeleteAllByApplicationID(ctx context.Context, tenantID string, appID string) error
 }
 
@@ -73,6 +84,7 @@ type RuntimeRepository interface {
 //go:generate mockery -name=FetchRequestRepository -output=automock -outpkg=automock -case=underscore
 type FetchRequestRepository interface {
 	Create(ctx context.Context, item *model.FetchRequest) error
+	DeleteByReferenceObjectID(ctx context.Context, tenant string, objectType model.FetchRequestReferenceObjectType, objectID string) error
 }
 
 //go:generate mockery -name=IntegrationSystemRepository -output=automock -outpkg=automock -case=underscore
@@ -91,11 +103,26 @@ type ScenariosService interface {
 	EnsureScenariosLabelDefinitionExists(ctx context.Context, tenant string) error
 }
 
+//go:generate mockery -name=ScenarioAssignmentEngine -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentEngine interface {
+	EvaluateForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) error
+}
+
+//go:generate mockery -name=FetchRequestService -output=automock -outpkg=automock -case=underscore
+type FetchRequestService interface {
+	HandleSpec(ctx context.Context, fr *model.FetchRequest, validate func(data string) error) (*string, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=ConfigurationChangeNotifier -output=automock -outpkg=automock -case=underscore
+type ConfigurationChangeNotifier interface {
+	NotifyConfigurationChanged(ctx context.Context, applicationID string, change model.ConfigurationChange) error
+}
+
 type service struct {
 	appRepo          ApplicationRepository
 	apiRepo          APIRepository
@@ -107,27 +134,33 @@ type service struct {
 	fetchRequestRepo FetchRequestRepository
 	intSystemRepo    IntegrationSystemRepository
 
-	labelUpsertService LabelUpsertService
-	scenariosService   ScenariosService
-	uidService         UIDService
-	timestampGen       timestamp.Generator
+	labelUpsertService       LabelUpsertService
+	scenariosService         ScenariosService
+	scenarioAssignmentEngine ScenarioAssignmentEngine
+	fetchRequestService      FetchRequestService
+	uidService               UIDService
+	notifier                 ConfigurationChangeNotifier
+	timestampGen             timestamp.Generator
 }
 
-func NewService(app ApplicationRepository, webhook WebhookRepository, api APIRepository, eventAPI EventAPIRepository, documentRepo DocumentRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, fetchRequestRepo FetchRequestRepository, intSystemRepo IntegrationSystemRepository, labelUpsertService LabelUpsertService, scenariosService ScenariosService, uidService UIDService) *service {
+func NewService(app ApplicationRepository, webhook WebhookRepository, api APIRepository, eventAPI EventAPIRepository, documentRepo DocumentRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, fetchRequestRepo FetchRequestRepository, intSystemRepo IntegrationSystemRepository, labelUpsertService LabelUpsertService, scenariosService ScenariosService, fetchRequestService FetchRequestService, uidService UIDService, scenarioAssignmentEngine ScenarioAssignmentEngine, notifier ConfigurationChangeNotifier) *service {
 	return &service{
-		appRepo:            app,
-		webhookRepo:        webhook,
-		apiRepo:            api,
-		eventAPIRepo:       eventAPI,
-		documentRepo:       documentRepo,
-		runtimeRepo:        runtimeRepo,
-		labelRepo:          labelRepo,
-		intSystemRepo:      intSystemRepo,
-		labelUpsertService: labelUpsertService,
-		scenariosService:   scenariosService,
-		uidService:         uidService,
-		fetchRequestRepo:   fetchRequestRepo,
-		timestampGen:       timestamp.DefaultGenerator(),
+		appRepo:                  app,
+		webhookRepo:              webhook,
+		apiRepo:                  api,
+		eventAPIRepo:             eventAPI,
+		documentRepo:             documentRepo,
+		runtimeRepo:              runtimeRepo,
+		labelRepo:                labelRepo,
+		intSystemRepo:            intSystemRepo,
+		labelUpsertService:       labelUpsertService,
+		scenariosService:         scenariosService,
+		scenarioAssignmentEngine: scenarioAssignmentEngine,
+		fetchRequestService:      fetchRequestService,
+		uidService:               uidService,
+		fetchRequestRepo:         fetchRequestRepo,
+		notifier:                 notifier,
+		timestampGen:             timestamp.DefaultGenerator(),
 	}
 }
 
@@ -273,6 +306,12 @@ func (s *service) Create(ctx context.Context, in model.ApplicationCreateInput) (
 	if err != nil {
 		return id, errors.Wrapf(err, "while creating multiple labels for Application")
 	}
+
+	err = s.scenarioAssignmentEngine.EvaluateForObject(ctx, appTenant, model.ApplicationLabelableObject, id)
+	if err != nil {
+		return "", errors.Wrap(err, "while evaluating Scenario Assignments for Application")
+	}
+
 	err = s.createRelatedResources(ctx, in, app.Tenant, app.ID)
 	if err != nil {
 		return "", errors.Wrap(err, "while creating related Application resources")
@@ -304,6 +343,7 @@ func (s *service) Update(ctx context.Context, id string, in model.ApplicationUpd
 	app.Name = in.Name
 	app.Description = in.Description
 	app.HealthCheckURL = in.HealthCheckURL
+	app.HealthCheckAuth = in.HealthCheckAuth.ToAuth()
 	app.IntegrationSystemID = in.IntegrationSystemID
 
 	err = s.appRepo.Update(ctx, app)
@@ -337,6 +377,38 @@ func (s *service) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+func (s *service) SetStatusCondition(ctx context.Context, id string, condition model.ApplicationStatusCondition) error {
+	app, err := s.Get(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "while getting Application")
+	}
+
+	currentCondition := model.ApplicationStatusConditionInitial
+	if app.Status != nil {
+		currentCondition = app.Status.Condition
+	}
+
+	if currentCondition == condition {
+		return nil
+	}
+
+	if !currentCondition.CanTransitionTo(condition) {
+		return errors.Errorf("Application status cannot change from %s to %s", currentCondition, condition)
+	}
+
+	app.Status = &model.ApplicationStatus{
+		Condition: condition,
+		Timestamp: s.timestampGen(),
+	}
+
+	err = s.appRepo.Update(ctx, app)
+	if err != nil {
+		return errors.Wrap(err, "while updating Application")
+	}
+
+	return nil
+}
+
 func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -356,6 +428,16 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		return errors.Wrapf(err, "while creating label for Application")
 	}
 
+	err = s.scenarioAssignmentEngine.EvaluateForObject(ctx, appTenant, model.ApplicationLabelableObject, labelInput.ObjectID)
+	if err != nil {
+		return errors.Wrap(err, "while evaluating Scenario Assignments for Application")
+	}
+
+	err = s.notifier.NotifyConfigurationChanged(ctx, labelInput.ObjectID, model.NewLabelConfigurationChange(labelInput.Key, model.ConfigurationChangeOperationUpdated))
+	if err != nil {
+		return errors.Wrap(err, "while notifying about Application label change")
+	}
+
 	return nil
 }
 
@@ -428,6 +510,16 @@ func (s *service) DeleteLabel(ctx context.Context, applicationID string, key str
 		return errors.Wrapf(err, "while deleting Application label")
 	}
 
+	err = s.scenarioAssignmentEngine.EvaluateForObject(ctx, appTenant, model.ApplicationLabelableObject, applicationID)
+	if err != nil {
+		return errors.Wrap(err, "while evaluating Scenario Assignments for Application")
+	}
+
+	err = s.notifier.NotifyConfigurationChanged(ctx, applicationID, model.NewLabelConfigurationChange(key, model.ConfigurationChangeOperationDeleted))
+	if err != nil {
+		return errors.Wrap(err, "while notifying about Application label change")
+	}
+
 	return nil
 }
 
@@ -443,49 +535,146 @@ func (s *service) createRelatedResources(ctx context.Context, in model.Applicati
 	}
 
 	for _, item := range in.Apis {
-		apiDefID := s.uidService.Generate()
-		err = s.apiRepo.Create(ctx, item.ToAPIDefinition(apiDefID, applicationID, tenant))
+		err = s.createAPI(ctx, tenant, applicationID, item)
 		if err != nil {
-			return errors.Wrapf(err, "while creating APIs for application")
-		}
-
-		if item.Spec != nil && item.Spec.FetchRequest != nil {
-			_, err = s.createFetchRequest(ctx, tenant, item.Spec.FetchRequest, model.APIFetchRequestReference, apiDefID)
-			if err != nil {
-				return err
-			}
+			return err
 		}
 	}
 
 	for _, item := range in.EventAPIs {
-		eventAPIDefID := s.uidService.Generate()
-		err = s.eventAPIRepo.Create(ctx, item.ToEventAPIDefinition(eventAPIDefID, applicationID, tenant))
+		err = s.createEventAPI(ctx, tenant, applicationID, item)
 		if err != nil {
-			return errors.Wrapf(err, "while creating EventAPIs for application")
-		}
-
-		if item.Spec != nil && item.Spec.FetchRequest != nil {
-			_, err = s.createFetchRequest(ctx, tenant, item.Spec.FetchRequest, model.EventAPIFetchRequestReference, eventAPIDefID)
-			if err != nil {
-				return err
-			}
+			return err
 		}
 	}
 
 	for _, item := range in.Documents {
-		documentID := s.uidService.Generate()
-
-		err = s.documentRepo.Create(ctx, item.ToDocument(documentID, tenant, applicationID))
+		err = s.createDocument(ctx, tenant, applicationID, item)
 		if err != nil {
-			return errors.Wrapf(err, "while creating Document for application")
+			return err
 		}
+	}
 
-		if item.FetchRequest != nil {
-			_, err = s.createFetchRequest(ctx, tenant, item.FetchRequest, model.DocumentFetchRequestReference, documentID)
-			if err != nil {
-				return err
-			}
-		}
+	return nil
+}
+
+func (s *service) createAPI(ctx context.Context, tenant, applicationID string, in *model.APIDefinitionInput) error {
+	apiDefID := s.uidService.Generate()
+	api := in.ToAPIDefinition(apiDefID, applicationID, tenant)
+	err := specvalidation.ValidateAPISpec(api.Spec)
+	if err != nil {
+		return errors.Wrapf(err, "while validating Spec for APIDefinition %s", apiDefID)
+	}
+
+	err = s.apiRepo.Create(ctx, api)
+	if err != nil {
+		return errors.Wrapf(err, "while creating APIs for application")
+	}
+
+	return s.fetchAPISpec(ctx, tenant, api, in)
+}
+
+func (s *service) fetchAPISpec(ctx context.Context, tenant string, api *model.APIDefinition, in *model.APIDefinitionInput) error {
+	if in.Spec == nil || in.Spec.FetchRequest == nil {
+		return nil
+	}
+
+	fr, err := s.createFetchRequest(ctx, tenant, in.Spec.FetchRequest, model.APIFetchRequestReference, api.ID)
+	if err != nil {
+		return err
+	}
+
+	data, err := s.fetchRequestService.HandleSpec(ctx, fr, specvalidation.APISpecValidator(api.Spec))
+	if err != nil {
+		return errors.Wrapf(err, "while fetching Spec for APIDefinition %s", api.ID)
+	}
+	// the Spec provided in the input is kept when the fetch fails or the fetched Spec is invalid
+	if data != nil {
+		api.Spec.Data = data
+	}
+
+	err = s.apiRepo.Update(ctx, api)
+	if err != nil {
+		return errors.Wrapf(err, "while updating APIDefinition %s with fetched Spec", api.ID)
+	}
+
+	return nil
+}
+
+func (s *service) createEventAPI(ctx context.Context, tenant, applicationID string, in *model.EventAPIDefinitionInput) error {
+	eventAPIDefID := s.uidService.Generate()
+	eventAPI := in.ToEventAPIDefinition(eventAPIDefID, applicationID, tenant)
+	err := specvalidation.ValidateEventAPISpec(eventAPI.Spec)
+	if err != nil {
+		return errors.Wrapf(err, "while validating Spec for EventAPIDefinition %s", eventAPIDefID)
+	}
+
+	err = s.eventAPIRepo.Create(ctx, eventAPI)
+	if err != nil {
+		return errors.Wrapf(err, "while creating EventAPIs for application")
+	}
+
+	return s.fetchEventAPISpec(ctx, tenant, eventAPI, in)
+}
+
+func (s *service) fetchEventAPISpec(ctx context.Context, tenant string, eventAPI *model.EventAPIDefinition, in *model.EventAPIDefinitionInput) error {
+	if in.Spec == nil || in.Spec.FetchRequest == nil {
+		return nil
+	}
+
+	fr, err := s.createFetchRequest(ctx, tenant, in.Spec.FetchRequest, model.EventAPIFetchRequestReference, eventAPI.ID)
+	if err != nil {
+		return err
+	}
+
+	data, err := s.fetchRequestService.HandleSpec(ctx, fr, specvalidation.EventAPISpecValidator(eventAPI.Spec))
+	if err != nil {
+		return errors.Wrapf(err, "while fetching Spec for EventAPIDefinition %s", eventAPI.ID)
+	}
+	if data != nil {
+		eventAPI.Spec.Data = data
+	}
+
+	err = s.eventAPIRepo.Update(ctx, eventAPI)
+	if err != nil {
+		return errors.Wrapf(err, "while updating EventAPIDefinition %s with fetched Spec", eventAPI.ID)
+	}
+
+	return nil
+}
+
+func (s *service) createDocument(ctx context.Context, tenant, applicationID string, in *model.DocumentInput) error {
+	documentID := s.uidService.Generate()
+	document := in.ToDocument(documentID, tenant, applicationID)
+	err := s.documentRepo.Create(ctx, document)
+	if err != nil {
+		return errors.Wrapf(err, "while creating Document for application")
+	}
+
+	return s.fetchDocumentData(ctx, tenant, document, in)
+}
+
+func (s *service) fetchDocumentData(ctx context.Context, tenant string, document *model.Document, in *model.DocumentInput) error {
+	if in.FetchRequest == nil {
+		return nil
+	}
+
+	fr, err := s.createFetchRequest(ctx, tenant, in.FetchRequest, model.DocumentFetchRequestReference, document.ID)
+	if err != nil {
+		return err
+	}
+
+	data, err := s.fetchRequestService.HandleSpec(ctx, fr, nil)
+	if err != nil {
+		return errors.Wrapf(err, "while fetching data for Document %s", document.ID)
+	}
+	if data != nil {
+		document.Data = data
+	}
+
+	err = s.documentRepo.Update(ctx, document)
+	if err != nil {
+		return errors.Wrapf(err, "while updating Document %s with fetched data", document.ID)
 	}
 
 	return nil
@@ -517,7 +706,7 @@ func (s *service) deleteRelatedResources(ctx context.Context, tenant, applicatio
 	return nil
 }
 
-func (s *service) createFetchRequest(ctx context.Context, tenant string, in *model.FetchRequestInput, objectType model.FetchRequestReferenceObjectType, objectID string) (*string, error) {
+func (s *service) createFetchRequest(ctx context.Context, tenant string, in *model.FetchRequestInput, objectType model.FetchRequestReferenceObjectType, objectID string) (*model.FetchRequest, error) {
 	if in == nil {
 		return nil, nil
 	}
@@ -529,7 +718,7 @@ func (s *service) createFetchRequest(ctx context.Context, tenant string, in *mod
 		return nil, errors.Wrapf(err, "while creating FetchRequest for %s with ID %s", objectType, objectID)
 	}
 
-	return &id, nil
+	return fr, nil
 }
 
 func getScenariosValues(labels interface{}) ([]string, error) {
@@ -39,13 +39,13 @@ func TestService_Create(t *testing.T) {
 		Apis: []*model.APIDefinitionInput{
 			{
 				Name: "foo",
-				Spec: &model.APISpecInput{FetchRequest: &model.FetchRequestInput{URL: "api.foo.bar"}},
+				Spec: &model.APISpecInput{Type: model.APISpecTypeOpenAPI, Format: model.SpecFormatYaml, FetchRequest: &model.FetchRequestInput{URL: "api.foo.bar"}},
 			}, {Name: "bar"},
 		},
 		EventAPIs: []*model.EventAPIDefinitionInput{
 			{
 				Name: "foo",
-				Spec: &model.EventAPISpecInput{FetchRequest: &model.FetchRequestInput{URL: "eventapi.foo.bar"}},
+				Spec: &model.EventAPISpecInput{EventSpecType: model.EventAPISpecTypeAsyncAPI, Format: model.SpecFormatYaml, FetchRequest: &model.FetchRequestInput{URL: "eventapi.foo.bar"}},
 			}, {Name: "bar"},
 		},
 		Labels: map[string]interface{}{
@@ -68,6 +68,10 @@ func TestService_Create(t *testing.T) {
 	tnt := "tenant"
 	appModel := modelFromInput(modelInput, tnt, id)
 
+	spec := "spec"
+	apiSpec := "{swagger: '2.0', info: {title: foo, version: v1}, paths: {}}"
+	eventAPISpec := "{asyncapi: '2.0.0', info: {title: foo, version: v1}, channels: {}}"
+
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tnt)
 
@@ -79,19 +83,21 @@ func TestService_Create(t *testing.T) {
 	}
 
 	testCases := []struct {
-		Name               string
-		AppRepoFn          func() *automock.ApplicationRepository
-		WebhookRepoFn      func() *automock.WebhookRepository
-		APIRepoFn          func() *automock.APIRepository
-		EventAPIRepoFn     func() *automock.EventAPIRepository
-		DocumentRepoFn     func() *automock.DocumentRepository
-		FetchRequestRepoFn func() *automock.FetchRequestRepository
-		IntSysRepoFn       func() *automock.IntegrationSystemRepository
-		ScenariosServiceFn func() *automock.ScenariosService
-		LabelServiceFn     func() *automock.LabelUpsertService
-		UIDServiceFn       func() *automock.UIDService
-		Input              model.ApplicationCreateInput
-		ExpectedErr        error
+		Name                       string
+		AppRepoFn                  func() *automock.ApplicationRepository
+		WebhookRepoFn              func() *automock.WebhookRepository
+		APIRepoFn                  func() *automock.APIRepository
+		EventAPIRepoFn             func() *automock.EventAPIRepository
+		DocumentRepoFn             func() *automock.DocumentRepository
+		FetchRequestRepoFn         func() *automock.FetchRequestRepository
+		FetchRequestServiceFn      func() *automock.FetchRequestService
+		IntSysRepoFn               func() *automock.IntegrationSystemRepository
+		ScenariosServiceFn         func() *automock.ScenariosService
+		LabelServiceFn             func() *automock.LabelUpsertService
+		UIDServiceFn               func() *automock.UIDService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		Input                      model.ApplicationCreateInput
+		ExpectedErr                error
 	}{
 		{
 			Name: "Success",
@@ -107,19 +113,22 @@ func TestService_Create(t *testing.T) {
 			},
 			APIRepoFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
-				repo.On("Create", ctx, &model.APIDefinition{ID: "foo", ApplicationID: "foo", Tenant: tnt, Name: "foo", Spec: &model.APISpec{}}).Return(nil).Once()
+				repo.On("Create", ctx, &model.APIDefinition{ID: "foo", ApplicationID: "foo", Tenant: tnt, Name: "foo", Spec: &model.APISpec{Type: model.APISpecTypeOpenAPI, Format: model.SpecFormatYaml}}).Return(nil).Once()
+				repo.On("Update", ctx, &model.APIDefinition{ID: "foo", ApplicationID: "foo", Tenant: tnt, Name: "foo", Spec: &model.APISpec{Data: &apiSpec, Type: model.APISpecTypeOpenAPI, Format: model.SpecFormatYaml}}).Return(nil).Once()
 				repo.On("Create", ctx, &model.APIDefinition{ID: "foo", ApplicationID: "foo", Tenant: tnt, Name: "bar"}).Return(nil).Once()
 				return repo
 			},
 			EventAPIRepoFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
-				repo.On("Create", ctx, &model.EventAPIDefinition{ID: "foo", ApplicationID: "foo", Tenant: tnt, Name: "foo", Spec: &model.EventAPISpec{}}).Return(nil).Once()
+				repo.On("Create", ctx, &model.EventAPIDefinition{ID: "foo", ApplicationID: "foo", Tenant: tnt, Name: "foo", Spec: &model.EventAPISpec{Type: model.EventAPISpecTypeAsyncAPI, Format: model.SpecFormatYaml}}).Return(nil).Once()
+				repo.On("Update", ctx, &model.EventAPIDefinition{ID: "foo", ApplicationID: "foo", Tenant: tnt, Name: "foo", Spec: &model.EventAPISpec{Data: &eventAPISpec, Type: model.EventAPISpecTypeAsyncAPI, Format: model.SpecFormatYaml}}).Return(nil).Once()
 				repo.On("Create", ctx, &model.EventAPIDefinition{ID: "foo", ApplicationID: "foo", Tenant: tnt, Name: "bar"}).Return(nil).Once()
 				return repo
 			},
 			DocumentRepoFn: func() *automock.DocumentRepository {
 				repo := &automock.DocumentRepository{}
 				repo.On("Create", ctx, mock.Anything).Return(nil).Times(2)
+				repo.On("Update", ctx, mock.Anything).Return(nil).Once()
 				return repo
 			},
 			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
@@ -129,6 +138,13 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, fixFetchRequest("eventapi.foo.bar", model.EventAPIFetchRequestReference, timestamp)).Return(nil).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixFetchRequest("api.foo.bar", model.APIFetchRequestReference, timestamp), mock.Anything).Return(&apiSpec, nil).Once()
+				svc.On("HandleSpec", ctx, fixFetchRequest("eventapi.foo.bar", model.EventAPIFetchRequestReference, timestamp), mock.Anything).Return(&eventAPISpec, nil).Once()
+				svc.On("HandleSpec", ctx, fixFetchRequest("doc.foo.bar", model.DocumentFetchRequestReference, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				repo := &automock.IntegrationSystemRepository{}
 				repo.On("Exists", ctx, intSysID).Return(true, nil).Once()
@@ -149,6 +165,11 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id)
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: nil,
 		},
@@ -180,6 +201,10 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				return svc
+			},
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				repo := &automock.IntegrationSystemRepository{}
 				repo.On("Exists", ctx, intSysID).Return(true, nil).Once()
@@ -201,6 +226,11 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id)
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
 			Input:       model.ApplicationCreateInput{Name: "test"},
 			ExpectedErr: nil,
 		},
@@ -232,6 +262,10 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				return svc
+			},
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				repo := &automock.IntegrationSystemRepository{}
 				repo.On("Exists", ctx, intSysID).Return(true, nil).Once()
@@ -253,6 +287,11 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id)
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
 			Input: model.ApplicationCreateInput{
 				Name:   "test",
 				Labels: defaultLabelsWithoutIntSys,
@@ -286,6 +325,10 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				return svc
+			},
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				repo := &automock.IntegrationSystemRepository{}
 				repo.On("Exists", ctx, intSysID).Return(true, nil).Once()
@@ -306,6 +349,10 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id)
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
@@ -336,6 +383,10 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				return svc
+			},
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				repo := &automock.IntegrationSystemRepository{}
 				repo.On("Exists", ctx, intSysID).Return(true, nil).Once()
@@ -355,6 +406,10 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
@@ -384,6 +439,10 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				return svc
+			},
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				repo := &automock.IntegrationSystemRepository{}
 				repo.On("Exists", ctx, intSysID).Return(false, nil).Once()
@@ -401,6 +460,10 @@ func TestService_Create(t *testing.T) {
 				svc := &automock.UIDService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: errors.New("does not exist"),
 		},
@@ -430,6 +493,10 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				return svc
+			},
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				repo := &automock.IntegrationSystemRepository{}
 				repo.On("Exists", ctx, intSysID).Return(false, testErr).Once()
@@ -447,6 +514,10 @@ func TestService_Create(t *testing.T) {
 				svc := &automock.UIDService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
@@ -460,11 +531,13 @@ func TestService_Create(t *testing.T) {
 			eventAPIRepo := testCase.EventAPIRepoFn()
 			documentRepo := testCase.DocumentRepoFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			fetchRequestSvc := testCase.FetchRequestServiceFn()
 			scenariosSvc := testCase.ScenariosServiceFn()
 			labelSvc := testCase.LabelServiceFn()
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := application.NewService(appRepo, webhookRepo, apiRepo, eventAPIRepo, documentRepo, nil, nil, fetchRequestRepo, intSysRepo, labelSvc, scenariosSvc, uidSvc)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := application.NewService(appRepo, webhookRepo, apiRepo, eventAPIRepo, documentRepo, nil, nil, fetchRequestRepo, intSysRepo, labelSvc, scenariosSvc, fetchRequestSvc, uidSvc, engine, nil)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -485,13 +558,15 @@ func TestService_Create(t *testing.T) {
 			eventAPIRepo.AssertExpectations(t)
 			documentRepo.AssertExpectations(t)
 			fetchRequestRepo.AssertExpectations(t)
+			fetchRequestSvc.AssertExpectations(t)
 			scenariosSvc.AssertExpectations(t)
 			uidSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), model.ApplicationCreateInput{})
 		assert.Equal(t, tenant.NoTenantError, err)
@@ -520,7 +595,7 @@ func TestService_CreateWithInvalidNames(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			//WHEN
 			_, err := svc.Create(ctx, testCase.Input)
@@ -546,17 +621,20 @@ func TestService_Update(t *testing.T) {
 	applicationModelBefore := fixModelApplicationWithAllUpdatableFields(id, tnt, "initialn", "initiald", "initialu")
 	applicationModelAfter := fixModelApplicationWithAllUpdatableFields(id, tnt, updatedName, updatedDescription, updatedURL)
 	intSysLabel := fixLabelInput("integration-system-id", intSysID, id, model.ApplicationLabelableObject)
+	change := model.NewLabelConfigurationChange("integration-system-id", model.ConfigurationChangeOperationUpdated)
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tnt)
 
 	testCases := []struct {
-		Name               string
-		AppRepoFn          func() *automock.ApplicationRepository
-		IntSysRepoFn       func() *automock.IntegrationSystemRepository
-		LabelUpsertSvcFn   func() *automock.LabelUpsertService
-		Input              model.ApplicationUpdateInput
-		InputID            string
-		ExpectedErrMessage string
+		Name                       string
+		AppRepoFn                  func() *automock.ApplicationRepository
+		IntSysRepoFn               func() *automock.IntegrationSystemRepository
+		LabelUpsertSvcFn           func() *automock.LabelUpsertService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		NotifierFn                 func() *automock.ConfigurationChangeNotifier
+		Input                      model.ApplicationUpdateInput
+		InputID                    string
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -577,6 +655,16 @@ func TestService_Update(t *testing.T) {
 				svc.On("UpsertLabel", ctx, tnt, intSysLabel).Return(nil).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, id, change).Return(nil).Once()
+				return notifier
+			},
 			InputID:            "foo",
 			Input:              updateInput,
 			ExpectedErrMessage: "",
@@ -598,6 +686,10 @@ func TestService_Update(t *testing.T) {
 				svc := &automock.LabelUpsertService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              updateInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -618,6 +710,10 @@ func TestService_Update(t *testing.T) {
 				svc := &automock.LabelUpsertService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              updateInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -637,6 +733,10 @@ func TestService_Update(t *testing.T) {
 				svc := &automock.LabelUpsertService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              updateInput,
 			ExpectedErrMessage: errors.New("does not exist").Error(),
@@ -656,6 +756,10 @@ func TestService_Update(t *testing.T) {
 				svc := &automock.LabelUpsertService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              updateInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -679,6 +783,10 @@ func TestService_Update(t *testing.T) {
 				svc.On("UpsertLabel", ctx, tnt, intSysLabel).Return(testErr).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              updateInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -701,6 +809,10 @@ func TestService_Update(t *testing.T) {
 				svc := &automock.LabelUpsertService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              updateInput,
 			ExpectedErrMessage: "doesn't exist",
@@ -723,6 +835,10 @@ func TestService_Update(t *testing.T) {
 				svc := &automock.LabelUpsertService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              updateInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -734,7 +850,12 @@ func TestService_Update(t *testing.T) {
 			appRepo := testCase.AppRepoFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			lblUpsrtSvc := testCase.LabelUpsertSvcFn()
-			svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, intSysRepo, lblUpsrtSvc, nil, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+			svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, intSysRepo, lblUpsrtSvc, nil, nil, nil, engine, notifier)
 
 			// when
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -750,6 +871,8 @@ func TestService_Update(t *testing.T) {
 			appRepo.AssertExpectations(t)
 			intSysRepo.AssertExpectations(t)
 			lblUpsrtSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 }
@@ -783,7 +906,7 @@ func TestService_UpdateWithInvalidNames(t *testing.T) {
 
 	for i, testCase := range testCases {
 		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
-			svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			//WHEN
 			err := svc.Update(ctx, appID, testCase.Input)
@@ -843,7 +966,7 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appRepo := testCase.AppRepoFn()
-			svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -861,6 +984,113 @@ func TestService_Delete(t *testing.T) {
 	}
 }
 
+func TestService_SetStatusCondition(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	id := "foo"
+	tnt := "tenant"
+	timestamp := time.Now()
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	applicationModel := func() *model.Application {
+		return &model.Application{
+			ID:     id,
+			Name:   "foo",
+			Tenant: tnt,
+			Status: &model.ApplicationStatus{Condition: model.ApplicationStatusConditionReady},
+		}
+	}
+	deletingModel := applicationModel()
+	deletingModel.Status = &model.ApplicationStatus{
+		Condition: model.ApplicationStatusConditionDeleting,
+		Timestamp: timestamp,
+	}
+
+	testCases := []struct {
+		Name               string
+		AppRepoFn          func() *automock.ApplicationRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(applicationModel(), nil).Once()
+				repo.On("Update", ctx, deletingModel).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Returns error when getting application failed",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when updating application failed",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(applicationModel(), nil).Once()
+				repo.On("Update", ctx, deletingModel).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := testCase.AppRepoFn()
+			svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			// when
+			err := svc.SetStatusCondition(ctx, id, model.ApplicationStatusConditionDeleting)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			appRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Keeps timestamp when condition does not change", func(t *testing.T) {
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetByID", ctx, tnt, id).Return(deletingModel, nil).Once()
+		svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := svc.SetStatusCondition(ctx, id, model.ApplicationStatusConditionDeleting)
+
+		// then
+		require.NoError(t, err)
+		appRepo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when transition is not allowed", func(t *testing.T) {
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetByID", ctx, tnt, id).Return(deletingModel, nil).Once()
+		svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := svc.SetStatusCondition(ctx, id, model.ApplicationStatusConditionReady)
+
+		// then
+		require.EqualError(t, err, "Application status cannot change from DELETING to READY")
+		appRepo.AssertExpectations(t)
+	})
+}
+
 func TestService_Get(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -915,7 +1145,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			app, err := svc.Get(ctx, testCase.InputID)
@@ -1020,7 +1250,7 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			app, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, after)
@@ -1240,7 +1470,7 @@ func TestService_ListByRuntimeID(t *testing.T) {
 			runtimeRepository := testCase.RuntimeRepositoryFn()
 			labelRepository := testCase.LabelRepositoryFn()
 			appRepository := testCase.AppRepositoryFn()
-			svc := application.NewService(appRepository, nil, nil, nil, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, nil)
+			svc := application.NewService(appRepository, nil, nil, nil, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			//WHEN
 			results, err := svc.ListByRuntimeID(ctx, testCase.Input, first, cursor)
@@ -1315,7 +1545,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//GIVEN
 			appRepo := testCase.RepositoryFn()
-			svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputApplicationID)
@@ -1349,14 +1579,17 @@ func TestService_SetLabel(t *testing.T) {
 		ObjectID:   applicationID,
 		ObjectType: model.ApplicationLabelableObject,
 	}
+	change := model.NewLabelConfigurationChange("key", model.ConfigurationChangeOperationUpdated)
 
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.ApplicationRepository
-		LabelServiceFn     func() *automock.LabelUpsertService
-		InputApplicationID string
-		InputLabel         *model.LabelInput
-		ExpectedErrMessage string
+		Name                       string
+		RepositoryFn               func() *automock.ApplicationRepository
+		LabelServiceFn             func() *automock.LabelUpsertService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		NotifierFn                 func() *automock.ConfigurationChangeNotifier
+		InputApplicationID         string
+		InputLabel                 *model.LabelInput
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -1371,10 +1604,69 @@ func TestService_SetLabel(t *testing.T) {
 				svc.On("UpsertLabel", ctx, tnt, label).Return(nil).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, applicationID).Return(nil).Once()
+				return engine
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
 			InputApplicationID: applicationID,
 			InputLabel:         label,
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Returns error when notifying about label change failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, applicationID).Return(true, nil).Once()
+
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, label).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, applicationID).Return(nil).Once()
+				return engine
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			InputApplicationID: applicationID,
+			InputLabel:         label,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when evaluating Scenario Assignments failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, applicationID).Return(true, nil).Once()
+
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, label).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, applicationID).Return(testErr).Once()
+				return engine
+			},
+			InputApplicationID: applicationID,
+			InputLabel:         label,
+			ExpectedErrMessage: testErr.Error(),
+		},
 		{
 			Name: "Returns error when label set failed",
 			RepositoryFn: func() *automock.ApplicationRepository {
@@ -1388,6 +1680,10 @@ func TestService_SetLabel(t *testing.T) {
 				svc.On("UpsertLabel", ctx, tnt, label).Return(testErr).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputApplicationID: applicationID,
 			InputLabel:         label,
 			ExpectedErrMessage: testErr.Error(),
@@ -1404,6 +1700,10 @@ func TestService_SetLabel(t *testing.T) {
 				svc := &automock.LabelUpsertService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputApplicationID: applicationID,
 			InputLabel:         label,
 			ExpectedErrMessage: testErr.Error(),
@@ -1414,7 +1714,12 @@ func TestService_SetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelSvc := testCase.LabelServiceFn()
-			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, labelSvc, nil, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, labelSvc, nil, nil, nil, engine, notifier)
 
 			// when
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -1427,6 +1732,8 @@ func TestService_SetLabel(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			engine.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 }
@@ -1525,7 +1832,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(repo, nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.GetLabel(ctx, testCase.InputApplicationID, testCase.InputLabel.Key)
@@ -1639,7 +1946,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(repo, nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.ListLabels(ctx, testCase.InputApplicationID)
@@ -1669,14 +1976,17 @@ func TestService_DeleteLabel(t *testing.T) {
 	applicationID := "foo"
 
 	labelKey := "key"
+	change := model.NewLabelConfigurationChange(labelKey, model.ConfigurationChangeOperationDeleted)
 
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.ApplicationRepository
-		LabelRepositoryFn  func() *automock.LabelRepository
-		InputApplicationID string
-		InputKey           string
-		ExpectedErrMessage string
+		Name                       string
+		RepositoryFn               func() *automock.ApplicationRepository
+		LabelRepositoryFn          func() *automock.LabelRepository
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		NotifierFn                 func() *automock.ConfigurationChangeNotifier
+		InputApplicationID         string
+		InputKey                   string
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -1690,10 +2000,46 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo.On("Delete", ctx, tnt, model.ApplicationLabelableObject, applicationID, labelKey).Return(nil).Once()
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, applicationID).Return(nil).Once()
+				return engine
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
 			InputApplicationID: applicationID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Returns error when notifying about label change failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, applicationID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tnt, model.ApplicationLabelableObject, applicationID, labelKey).Return(nil).Once()
+				return repo
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, applicationID).Return(nil).Once()
+				return engine
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			InputApplicationID: applicationID,
+			InputKey:           labelKey,
+			ExpectedErrMessage: testErr.Error(),
+		},
 		{
 			Name: "Returns error when label delete failed",
 			RepositoryFn: func() *automock.ApplicationRepository {
@@ -1706,6 +2052,10 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo.On("Delete", ctx, tnt, model.ApplicationLabelableObject, applicationID, labelKey).Return(testErr).Once()
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputApplicationID: applicationID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: testErr.Error(),
@@ -1721,6 +2071,10 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo := &automock.LabelRepository{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputApplicationID: applicationID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: testErr.Error(),
@@ -1735,6 +2089,10 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo := &automock.LabelRepository{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputApplicationID: applicationID,
 			InputKey:           model.ScenariosKey,
 			ExpectedErrMessage: "can not be deleted from application",
@@ -1745,7 +2103,12 @@ func TestService_DeleteLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(repo, nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+			svc := application.NewService(repo, nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, engine, notifier)
 
 			// when
 			err := svc.DeleteLabel(ctx, testCase.InputApplicationID, testCase.InputKey)
@@ -1759,6 +2122,8 @@ func TestService_DeleteLabel(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			engine.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 }
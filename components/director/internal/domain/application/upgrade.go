@@ -0,0 +1,534 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+)
+
+const listPageSize = 100
+
+// keyedInput is an input of the resource rendered from Application Template, with the key identifying the resource within Application
+type keyedInput struct {
+	key   string
+	input interface{}
+}
+
+// currentResources maps keys of the resources of Application to their IDs, remembering keys shared by more than one resource
+type currentResources struct {
+	ids        map[string]string
+	duplicated map[string]struct{}
+}
+
+func newCurrentResources() *currentResources {
+	return &currentResources{
+		ids:        make(map[string]string),
+		duplicated: make(map[string]struct{}),
+	}
+}
+
+func (c *currentResources) add(key, id string) {
+	if _, ok := c.ids[key]; ok {
+		c.duplicated[key] = struct{}{}
+	}
+	c.ids[key] = id
+}
+
+// get returns ID of the resource with given key. As the template cannot tell which of the resources sharing the key it refers to, it returns an error for them.
+func (c *currentResources) get(key string) (string, bool, error) {
+	if _, ok := c.duplicated[key]; ok {
+		return "", false, fmt.Errorf("more than one resource of the Application matches key '%s'", key)
+	}
+
+	id, ok := c.ids[key]
+	return id, ok, nil
+}
+
+// UpgradeFromTemplate applies to the Application changes between its inputs rendered from the previous and the current version of its Application Template.
+// Fields, labels and related resources not changed in the template are left untouched, so modifications made directly on the Application are kept.
+// When the previous input is unknown, the whole desired input is applied and nothing is removed.
+func (s *service) UpgradeFromTemplate(ctx context.Context, id string, previous *model.ApplicationCreateInput, desired model.ApplicationCreateInput) error {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	err = desired.Validate()
+	if err != nil {
+		return errors.Wrap(err, "while validating Application input")
+	}
+
+	if previous == nil {
+		previous = &model.ApplicationCreateInput{}
+	}
+
+	exists, err := s.ensureIntSysExists(ctx, desired.IntegrationSystemID)
+	if err != nil {
+		return errors.Wrap(err, "while ensuring integration system exists")
+	}
+
+	if !exists {
+		return fmt.Errorf("while ensuring integration system exists: Integration System with ID: %s does not exist", *desired.IntegrationSystemID)
+	}
+
+	app, err := s.appRepo.GetByID(ctx, appTenant, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting Application with ID %s", id)
+	}
+
+	if previous.Name != desired.Name {
+		app.Name = desired.Name
+	}
+	if !reflect.DeepEqual(previous.Description, desired.Description) {
+		app.Description = desired.Description
+	}
+	if !reflect.DeepEqual(previous.HealthCheckURL, desired.HealthCheckURL) {
+		app.HealthCheckURL = desired.HealthCheckURL
+	}
+	if !reflect.DeepEqual(previous.HealthCheckAuth, desired.HealthCheckAuth) {
+		app.HealthCheckAuth = desired.HealthCheckAuth.ToAuth()
+	}
+	intSysChanged := !reflect.DeepEqual(previous.IntegrationSystemID, desired.IntegrationSystemID)
+	if intSysChanged {
+		app.IntegrationSystemID = desired.IntegrationSystemID
+	}
+	app.ApplicationTemplateID = desired.ApplicationTemplateID
+	app.ApplicationTemplateVersion = desired.ApplicationTemplateVersion
+	app.ApplicationTemplateValues = desired.ApplicationTemplateValues
+
+	err = s.appRepo.Update(ctx, app)
+	if err != nil {
+		return errors.Wrap(err, "while updating Application")
+	}
+
+	err = s.upgradeLabels(ctx, appTenant, id, previous.Labels, desired.Labels)
+	if err != nil {
+		return errors.Wrap(err, "while upgrading labels")
+	}
+
+	if intSysChanged {
+		intSysLabel := createLabel(intSysKey, "", id)
+		if desired.IntegrationSystemID != nil {
+			intSysLabel = createLabel(intSysKey, *desired.IntegrationSystemID, id)
+		}
+
+		err = s.labelUpsertService.UpsertLabel(ctx, appTenant, intSysLabel)
+		if err != nil {
+			return errors.Wrap(err, "while setting the integration system label")
+		}
+	}
+
+	err = s.scenarioAssignmentEngine.EvaluateForObject(ctx, appTenant, model.ApplicationLabelableObject, id)
+	if err != nil {
+		return errors.Wrap(err, "while evaluating Scenario Assignments for Application")
+	}
+
+	err = s.upgradeWebhooks(ctx, appTenant, id, previous.Webhooks, desired.Webhooks)
+	if err != nil {
+		return errors.Wrap(err, "while upgrading Webhooks")
+	}
+
+	err = s.upgradeAPIs(ctx, appTenant, id, previous.Apis, desired.Apis)
+	if err != nil {
+		return errors.Wrap(err, "while upgrading APIs")
+	}
+
+	err = s.upgradeEventAPIs(ctx, appTenant, id, previous.EventAPIs, desired.EventAPIs)
+	if err != nil {
+		return errors.Wrap(err, "while upgrading EventAPIs")
+	}
+
+	err = s.upgradeDocuments(ctx, appTenant, id, previous.Documents, desired.Documents)
+	if err != nil {
+		return errors.Wrap(err, "while upgrading Documents")
+	}
+
+	err = s.notifier.NotifyConfigurationChanged(ctx, id, model.ConfigurationChange{
+		ResourceType: model.ConfigurationChangeResourceTypeApplication,
+		ResourceID:   id,
+		Operation:    model.ConfigurationChangeOperationUpdated,
+	})
+	if err != nil {
+		return errors.Wrap(err, "while notifying about Application change")
+	}
+
+	return nil
+}
+
+func (s *service) upgradeLabels(ctx context.Context, tenant, applicationID string, previous, desired map[string]interface{}) error {
+	for _, key := range sortedLabelKeys(previous) {
+		if _, ok := desired[key]; ok || key == model.ScenariosKey {
+			continue
+		}
+
+		err := s.labelRepo.Delete(ctx, tenant, model.ApplicationLabelableObject, applicationID, key)
+		if err != nil {
+			return errors.Wrapf(err, "while deleting label %s", key)
+		}
+	}
+
+	changed := make(map[string]interface{})
+	for key, value := range desired {
+		if previousValue, ok := previous[key]; ok && reflect.DeepEqual(previousValue, value) {
+			continue
+		}
+		changed[key] = value
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return s.labelUpsertService.UpsertMultipleLabels(ctx, tenant, model.ApplicationLabelableObject, applicationID, changed)
+}
+
+func (s *service) upgradeWebhooks(ctx context.Context, tenant, applicationID string, previous, desired []*model.WebhookInput) error {
+	current, err := s.webhookRepo.ListByApplicationID(ctx, tenant, applicationID)
+	if err != nil {
+		return errors.Wrap(err, "while listing Webhooks")
+	}
+
+	currentIDs := newCurrentResources()
+	for _, webhook := range current {
+		currentIDs.add(webhookKey(webhook.Type, webhook.URL), webhook.ID)
+	}
+
+	var previousInputs, desiredInputs []keyedInput
+	for _, in := range previous {
+		previousInputs = append(previousInputs, keyedInput{key: webhookKey(in.Type, in.URL), input: in})
+	}
+	for _, in := range desired {
+		desiredInputs = append(desiredInputs, keyedInput{key: webhookKey(in.Type, in.URL), input: in})
+	}
+
+	removed, changed, err := diffInputs(previousInputs, desiredInputs)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range removed {
+		id, ok, err := currentIDs.get(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			err = s.webhookRepo.Delete(ctx, tenant, id)
+			if err != nil {
+				return errors.Wrapf(err, "while deleting Webhook %s", id)
+			}
+		}
+	}
+
+	for _, idx := range changed {
+		in := desired[idx]
+		id, ok, err := currentIDs.get(desiredInputs[idx].key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			err = s.webhookRepo.Update(ctx, in.ToWebhook(id, tenant, applicationID))
+			if err != nil {
+				return errors.Wrapf(err, "while updating Webhook %s", id)
+			}
+			continue
+		}
+
+		err = s.webhookRepo.Create(ctx, in.ToWebhook(s.uidService.Generate(), tenant, applicationID))
+		if err != nil {
+			return errors.Wrap(err, "while creating Webhook")
+		}
+	}
+
+	return nil
+}
+
+func (s *service) upgradeAPIs(ctx context.Context, tenant, applicationID string, previous, desired []*model.APIDefinitionInput) error {
+	currentIDs := newCurrentResources()
+	cursor := ""
+	for {
+		page, err := s.apiRepo.ListByApplicationID(ctx, tenant, applicationID, nil, listPageSize, cursor)
+		if err != nil {
+			return errors.Wrap(err, "while listing APIs")
+		}
+		for _, api := range page.Data {
+			currentIDs.add(api.Name, api.ID)
+		}
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			break
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+
+	var previousInputs, desiredInputs []keyedInput
+	for _, in := range previous {
+		previousInputs = append(previousInputs, keyedInput{key: in.Name, input: in})
+	}
+	for _, in := range desired {
+		desiredInputs = append(desiredInputs, keyedInput{key: in.Name, input: in})
+	}
+
+	removed, changed, err := diffInputs(previousInputs, desiredInputs)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range removed {
+		id, ok, err := currentIDs.get(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			err = s.apiRepo.Delete(ctx, tenant, id)
+			if err != nil {
+				return errors.Wrapf(err, "while deleting APIDefinition %s", id)
+			}
+		}
+	}
+
+	for _, idx := range changed {
+		in := desired[idx]
+		id, ok, err := currentIDs.get(desiredInputs[idx].key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if err := s.createAPI(ctx, tenant, applicationID, in); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = s.fetchRequestRepo.DeleteByReferenceObjectID(ctx, tenant, model.APIFetchRequestReference, id)
+		if err != nil {
+			return errors.Wrapf(err, "while deleting FetchRequest for APIDefinition %s", id)
+		}
+
+		api := in.ToAPIDefinition(id, applicationID, tenant)
+		err = specvalidation.ValidateAPISpec(api.Spec)
+		if err != nil {
+			return errors.Wrapf(err, "while validating Spec for APIDefinition %s", id)
+		}
+
+		err = s.apiRepo.Update(ctx, api)
+		if err != nil {
+			return errors.Wrapf(err, "while updating APIDefinition %s", id)
+		}
+
+		err = s.fetchAPISpec(ctx, tenant, api, in)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) upgradeEventAPIs(ctx context.Context, tenant, applicationID string, previous, desired []*model.EventAPIDefinitionInput) error {
+	currentIDs := newCurrentResources()
+	cursor := ""
+	for {
+		page, err := s.eventAPIRepo.ListByApplicationID(ctx, tenant, applicationID, nil, listPageSize, cursor)
+		if err != nil {
+			return errors.Wrap(err, "while listing EventAPIs")
+		}
+		for _, eventAPI := range page.Data {
+			currentIDs.add(eventAPI.Name, eventAPI.ID)
+		}
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			break
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+
+	var previousInputs, desiredInputs []keyedInput
+	for _, in := range previous {
+		previousInputs = append(previousInputs, keyedInput{key: in.Name, input: in})
+	}
+	for _, in := range desired {
+		desiredInputs = append(desiredInputs, keyedInput{key: in.Name, input: in})
+	}
+
+	removed, changed, err := diffInputs(previousInputs, desiredInputs)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range removed {
+		id, ok, err := currentIDs.get(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			err = s.eventAPIRepo.Delete(ctx, tenant, id)
+			if err != nil {
+				return errors.Wrapf(err, "while deleting EventAPIDefinition %s", id)
+			}
+		}
+	}
+
+	for _, idx := range changed {
+		in := desired[idx]
+		id, ok, err := currentIDs.get(desiredInputs[idx].key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if err := s.createEventAPI(ctx, tenant, applicationID, in); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = s.fetchRequestRepo.DeleteByReferenceObjectID(ctx, tenant, model.EventAPIFetchRequestReference, id)
+		if err != nil {
+			return errors.Wrapf(err, "while deleting FetchRequest for EventAPIDefinition %s", id)
+		}
+
+		eventAPI := in.ToEventAPIDefinition(id, applicationID, tenant)
+		err = specvalidation.ValidateEventAPISpec(eventAPI.Spec)
+		if err != nil {
+			return errors.Wrapf(err, "while validating Spec for EventAPIDefinition %s", id)
+		}
+
+		err = s.eventAPIRepo.Update(ctx, eventAPI)
+		if err != nil {
+			return errors.Wrapf(err, "while updating EventAPIDefinition %s", id)
+		}
+
+		err = s.fetchEventAPISpec(ctx, tenant, eventAPI, in)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) upgradeDocuments(ctx context.Context, tenant, applicationID string, previous, desired []*model.DocumentInput) error {
+	currentIDs := newCurrentResources()
+	cursor := ""
+	for {
+		page, err := s.documentRepo.ListByApplicationID(ctx, tenant, applicationID, listPageSize, cursor)
+		if err != nil {
+			return errors.Wrap(err, "while listing Documents")
+		}
+		for _, document := range page.Data {
+			currentIDs.add(document.Title, document.ID)
+		}
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			break
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+
+	var previousInputs, desiredInputs []keyedInput
+	for _, in := range previous {
+		previousInputs = append(previousInputs, keyedInput{key: in.Title, input: in})
+	}
+	for _, in := range desired {
+		desiredInputs = append(desiredInputs, keyedInput{key: in.Title, input: in})
+	}
+
+	removed, changed, err := diffInputs(previousInputs, desiredInputs)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range removed {
+		id, ok, err := currentIDs.get(key)
+		if err != nil {
+			return err
+		}
+		if ok {
+			err = s.documentRepo.Delete(ctx, tenant, id)
+			if err != nil {
+				return errors.Wrapf(err, "while deleting Document %s", id)
+			}
+		}
+	}
+
+	for _, idx := range changed {
+		in := desired[idx]
+		id, ok, err := currentIDs.get(desiredInputs[idx].key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if err := s.createDocument(ctx, tenant, applicationID, in); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = s.fetchRequestRepo.DeleteByReferenceObjectID(ctx, tenant, model.DocumentFetchRequestReference, id)
+		if err != nil {
+			return errors.Wrapf(err, "while deleting FetchRequest for Document %s", id)
+		}
+
+		document := in.ToDocument(id, tenant, applicationID)
+		err = s.documentRepo.Update(ctx, document)
+		if err != nil {
+			return errors.Wrapf(err, "while updating Document %s", id)
+		}
+
+		err = s.fetchDocumentData(ctx, tenant, document, in)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffInputs returns keys of the inputs removed from the template and indexes of the desired inputs which were added to it or changed.
+// Inputs are matched by their keys, so it returns an error if a key appears more than once in any of the versions.
+func diffInputs(previous, desired []keyedInput) ([]string, []int, error) {
+	previousInputs := make(map[string]interface{}, len(previous))
+	for _, item := range previous {
+		if _, ok := previousInputs[item.key]; ok {
+			return nil, nil, fmt.Errorf("key '%s' appears more than once in the previous version of the template", item.key)
+		}
+		previousInputs[item.key] = item.input
+	}
+
+	desiredKeys := make(map[string]struct{}, len(desired))
+	var changed []int
+	for idx, item := range desired {
+		if _, ok := desiredKeys[item.key]; ok {
+			return nil, nil, fmt.Errorf("key '%s' appears more than once in the current version of the template", item.key)
+		}
+		desiredKeys[item.key] = struct{}{}
+		if previousInput, ok := previousInputs[item.key]; ok && reflect.DeepEqual(previousInput, item.input) {
+			continue
+		}
+		changed = append(changed, idx)
+	}
+
+	var removed []string
+	for _, item := range previous {
+		if _, ok := desiredKeys[item.key]; !ok {
+			removed = append(removed, item.key)
+		}
+	}
+
+	return removed, changed, nil
+}
+
+func webhookKey(webhookType model.WebhookType, url string) string {
+	return fmt.Sprintf("%s %s", webhookType, url)
+}
+
+func sortedLabelKeys(labels map[string]interface{}) []string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
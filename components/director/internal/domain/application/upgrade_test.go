@@ -0,0 +1,512 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_UpgradeFromTemplate(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	tnt := "tenant"
+	templateID := "template"
+	version := 2
+	ctx := tenant.SaveToContext(context.TODO(), tnt)
+
+	webhookURL := "http://webhook.url"
+	changedTargetURL := "http://changed.url"
+
+	previous := &model.ApplicationCreateInput{
+		Name: "foo",
+		Labels: map[string]interface{}{
+			"unchanged":        "value",
+			"removed":          "value",
+			model.ScenariosKey: []interface{}{"DEFAULT"},
+		},
+		Webhooks: []*model.WebhookInput{
+			{Type: model.WebhookTypeConfigurationChanged, URL: webhookURL},
+		},
+		Apis: []*model.APIDefinitionInput{
+			{Name: "changed", TargetURL: "http://target.url"},
+			{Name: "removed", TargetURL: "http://target.url"},
+			{Name: "unchanged", TargetURL: "http://target.url"},
+		},
+	}
+	desired := model.ApplicationCreateInput{
+		Name: "bar",
+		Labels: map[string]interface{}{
+			"unchanged": "value",
+			"added":     "value",
+		},
+		Webhooks: []*model.WebhookInput{
+			{Type: model.WebhookTypeConfigurationChanged, URL: webhookURL},
+		},
+		Apis: []*model.APIDefinitionInput{
+			{Name: "changed", TargetURL: changedTargetURL},
+			{Name: "added", TargetURL: "http://target.url"},
+			{Name: "unchanged", TargetURL: "http://target.url"},
+		},
+		ApplicationTemplateID:      &templateID,
+		ApplicationTemplateVersion: &version,
+	}
+
+	currentAPIs := &model.APIDefinitionPage{
+		Data: []*model.APIDefinition{
+			{ID: "changed-id", Name: "changed"},
+			{ID: "removed-id", Name: "removed"},
+			{ID: "unchanged-id", Name: "unchanged"},
+		},
+		PageInfo: &pagination.Page{HasNextPage: false},
+	}
+	duplicatedAPIs := &model.APIDefinitionPage{
+		Data: []*model.APIDefinition{
+			{ID: "changed-id", Name: "changed"},
+			{ID: "removed-id", Name: "removed"},
+			{ID: "duplicated-id", Name: "removed"},
+		},
+		PageInfo: &pagination.Page{HasNextPage: false},
+	}
+	emptyAPIs := &model.APIDefinitionPage{PageInfo: &pagination.Page{}}
+	emptyEventAPIs := &model.EventAPIDefinitionPage{PageInfo: &pagination.Page{}}
+	emptyDocuments := &model.DocumentPage{PageInfo: &pagination.Page{}}
+
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeApplication, ResourceID: id, Operation: model.ConfigurationChangeOperationUpdated}
+	notifierThatSucceeds := func() *automock.ConfigurationChangeNotifier {
+		notifier := &automock.ConfigurationChangeNotifier{}
+		notifier.On("NotifyConfigurationChanged", ctx, id, change).Return(nil).Once()
+		return notifier
+	}
+
+	testCases := []struct {
+		Name                       string
+		Previous                   *model.ApplicationCreateInput
+		Desired                    model.ApplicationCreateInput
+		AppRepoFn                  func() *automock.ApplicationRepository
+		LabelRepoFn                func() *automock.LabelRepository
+		LabelUpsertSvcFn           func() *automock.LabelUpsertService
+		WebhookRepoFn              func() *automock.WebhookRepository
+		APIRepoFn                  func() *automock.APIRepository
+		EventAPIRepoFn             func() *automock.EventAPIRepository
+		DocumentRepoFn             func() *automock.DocumentRepository
+		FetchRequestRepoFn         func() *automock.FetchRequestRepository
+		UIDServiceFn               func() *automock.UIDService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		NotifierFn                 func() *automock.ConfigurationChangeNotifier
+		ExpectedErrMessage         string
+	}{
+		{
+			Name:     "Success",
+			Previous: previous,
+			Desired:  desired,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(&model.Application{ID: id, Tenant: tnt, Name: "foo"}, nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(app *model.Application) bool {
+					return app.Name == "bar" && *app.ApplicationTemplateID == templateID && *app.ApplicationTemplateVersion == version
+				})).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tnt, model.ApplicationLabelableObject, id, "removed").Return(nil).Once()
+				return repo
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertMultipleLabels", ctx, tnt, model.ApplicationLabelableObject, id, map[string]interface{}{"added": "value"}).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return([]*model.Webhook{{ID: "webhook-id", Type: model.WebhookTypeConfigurationChanged, URL: webhookURL}}, nil).Once()
+				return repo
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, []*labelfilter.LabelFilter(nil), 100, "").Return(currentAPIs, nil).Once()
+				repo.On("Delete", ctx, tnt, "removed-id").Return(nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(api *model.APIDefinition) bool {
+					return api.ID == "changed-id" && api.TargetURL == changedTargetURL
+				})).Return(nil).Once()
+				repo.On("Create", ctx, mock.MatchedBy(func(api *model.APIDefinition) bool {
+					return api.ID == "added-id" && api.Name == "added"
+				})).Return(nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, []*labelfilter.LabelFilter(nil), 100, "").Return(emptyEventAPIs, nil).Once()
+				return repo
+			},
+			DocumentRepoFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, 100, "").Return(emptyDocuments, nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tnt, model.APIFetchRequestReference, "changed-id").Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return("added-id").Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
+			NotifierFn: notifierThatSucceeds,
+		},
+		{
+			Name:     "Success when previous input is unknown",
+			Previous: nil,
+			Desired: model.ApplicationCreateInput{
+				Name:   "bar",
+				Labels: map[string]interface{}{"added": "value"},
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(&model.Application{ID: id, Tenant: tnt, Name: "foo"}, nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(app *model.Application) bool {
+					return app.Name == "bar"
+				})).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertMultipleLabels", ctx, tnt, model.ApplicationLabelableObject, id, map[string]interface{}{"added": "value"}).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(nil, nil).Once()
+				return repo
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, []*labelfilter.LabelFilter(nil), 100, "").Return(currentAPIs, nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, []*labelfilter.LabelFilter(nil), 100, "").Return(emptyEventAPIs, nil).Once()
+				return repo
+			},
+			DocumentRepoFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, 100, "").Return(emptyDocuments, nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
+			NotifierFn: notifierThatSucceeds,
+		},
+		{
+			Name:     "Returns error when notifying about the change failed",
+			Previous: nil,
+			Desired: model.ApplicationCreateInput{
+				Name:   "bar",
+				Labels: map[string]interface{}{"added": "value"},
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(&model.Application{ID: id, Tenant: tnt, Name: "foo"}, nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(app *model.Application) bool {
+					return app.Name == "bar"
+				})).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertMultipleLabels", ctx, tnt, model.ApplicationLabelableObject, id, map[string]interface{}{"added": "value"}).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(nil, nil).Once()
+				return repo
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, []*labelfilter.LabelFilter(nil), 100, "").Return(currentAPIs, nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, []*labelfilter.LabelFilter(nil), 100, "").Return(emptyEventAPIs, nil).Once()
+				return repo
+			},
+			DocumentRepoFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, 100, "").Return(emptyDocuments, nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, id, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErrMessage: "while notifying about Application change",
+		},
+		{
+			Name:     "Returns error when getting Application failed",
+			Previous: previous,
+			Desired:  desired,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				return &automock.WebhookRepository{}
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				return &automock.APIRepository{}
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			DocumentRepoFn: func() *automock.DocumentRepository {
+				return &automock.DocumentRepository{}
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:     "Returns error when deleting removed label failed",
+			Previous: previous,
+			Desired:  desired,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(&model.Application{ID: id, Tenant: tnt, Name: "foo"}, nil).Once()
+				repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tnt, model.ApplicationLabelableObject, id, "removed").Return(testErr).Once()
+				return repo
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				return &automock.WebhookRepository{}
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				return &automock.APIRepository{}
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			DocumentRepoFn: func() *automock.DocumentRepository {
+				return &automock.DocumentRepository{}
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			ExpectedErrMessage: "while upgrading labels",
+		},
+		{
+			Name:     "Returns error when name of API appears more than once in the template",
+			Previous: &model.ApplicationCreateInput{Name: "foo"},
+			Desired: model.ApplicationCreateInput{
+				Name: "foo",
+				Apis: []*model.APIDefinitionInput{
+					{Name: "added", TargetURL: "http://target.url"},
+					{Name: "added", TargetURL: changedTargetURL},
+				},
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(&model.Application{ID: id, Tenant: tnt, Name: "foo"}, nil).Once()
+				repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(nil, nil).Once()
+				return repo
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, []*labelfilter.LabelFilter(nil), 100, "").Return(emptyAPIs, nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			DocumentRepoFn: func() *automock.DocumentRepository {
+				return &automock.DocumentRepository{}
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
+			ExpectedErrMessage: "key 'added' appears more than once in the current version of the template",
+		},
+		{
+			Name:     "Returns error when more than one API of the Application matches the name",
+			Previous: &model.ApplicationCreateInput{Name: "foo", Apis: previous.Apis},
+			Desired:  model.ApplicationCreateInput{Name: "foo", Apis: desired.Apis},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(&model.Application{ID: id, Tenant: tnt, Name: "foo"}, nil).Once()
+				repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(nil, nil).Once()
+				return repo
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id, []*labelfilter.LabelFilter(nil), 100, "").Return(duplicatedAPIs, nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			DocumentRepoFn: func() *automock.DocumentRepository {
+				return &automock.DocumentRepository{}
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil).Once()
+				return engine
+			},
+			ExpectedErrMessage: "more than one resource of the Application matches key 'removed'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := testCase.AppRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			labelUpsertSvc := testCase.LabelUpsertSvcFn()
+			webhookRepo := testCase.WebhookRepoFn()
+			apiRepo := testCase.APIRepoFn()
+			eventAPIRepo := testCase.EventAPIRepoFn()
+			documentRepo := testCase.DocumentRepoFn()
+			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			uidSvc := testCase.UIDServiceFn()
+			engine := testCase.ScenarioAssignmentEngineFn()
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+			svc := application.NewService(appRepo, webhookRepo, apiRepo, eventAPIRepo, documentRepo, nil, labelRepo, fetchRequestRepo, nil, labelUpsertSvc, nil, nil, uidSvc, engine, notifier)
+
+			// when
+			err := svc.UpgradeFromTemplate(ctx, id, testCase.Previous, testCase.Desired)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			appRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			labelUpsertSvc.AssertExpectations(t)
+			webhookRepo.AssertExpectations(t)
+			apiRepo.AssertExpectations(t)
+			eventAPIRepo.AssertExpectations(t)
+			documentRepo.AssertExpectations(t)
+			fetchRequestRepo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
+			notifier.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationConverter is an autogenerated mock type for the ApplicationConverter type
+type ApplicationConverter struct {
+	mock.Mock
+}
+
+// CreateInputFromGraphQL provides a mock function with given fields: in
+func (_m *ApplicationConverter) CreateInputFromGraphQL(in graphql.ApplicationCreateInput) model.ApplicationCreateInput {
+	ret := _m.Called(in)
+
+	var r0 model.ApplicationCreateInput
+	if rf, ok := ret.Get(0).(func(graphql.ApplicationCreateInput) model.ApplicationCreateInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.ApplicationCreateInput)
+	}
+
+	return r0
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *ApplicationConverter) ToGraphQL(in *model.Application) *graphql.Application {
+	ret := _m.Called(in)
+
+	var r0 *graphql.Application
+	if rf, ok := ret.Get(0).(func(*model.Application) *graphql.Application); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Application)
+		}
+	}
+
+	return r0
+}
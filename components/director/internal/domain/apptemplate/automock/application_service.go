@@ -0,0 +1,73 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationService is an autogenerated mock type for the ApplicationService type
+type ApplicationService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, in
+func (_m *ApplicationService) Create(ctx context.Context, in model.ApplicationCreateInput) (string, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, model.ApplicationCreateInput) string); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.ApplicationCreateInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ApplicationService) Get(ctx context.Context, id string) (*model.Application, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Application); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpgradeFromTemplate provides a mock function with given fields: ctx, id, previous, desired
+func (_m *ApplicationService) UpgradeFromTemplate(ctx context.Context, id string, previous *model.ApplicationCreateInput, desired model.ApplicationCreateInput) error {
+	ret := _m.Called(ctx, id, previous, desired)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.ApplicationCreateInput, model.ApplicationCreateInput) error); ok {
+		r0 = rf(ctx, id, previous, desired)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -3,10 +3,9 @@
 package automock
 
 import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	mock "github.com/stretchr/testify/mock"
-
-	model "github.com/kyma-incubator/compass/components/director/internal/model"
 )
 
 // ApplicationTemplateConverter is an autogenerated mock type for the ApplicationTemplateConverter type
@@ -14,6 +13,27 @@ type ApplicationTemplateConverter struct {
 	mock.Mock
 }
 
+// ApplicationCreateInputJSONToGQL provides a mock function with given fields: in
+func (_m *ApplicationTemplateConverter) ApplicationCreateInputJSONToGQL(in string) (graphql.ApplicationCreateInput, error) {
+	ret := _m.Called(in)
+
+	var r0 graphql.ApplicationCreateInput
+	if rf, ok := ret.Get(0).(func(string) graphql.ApplicationCreateInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(graphql.ApplicationCreateInput)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InputFromGraphQL provides a mock function with given fields: in
 func (_m *ApplicationTemplateConverter) InputFromGraphQL(in graphql.ApplicationTemplateInput) (model.ApplicationTemplateInput, error) {
 	ret := _m.Called(in)
@@ -80,3 +100,19 @@ func (_m *ApplicationTemplateConverter) ToGraphQL(in *model.ApplicationTemplate)
 
 	return r0, r1
 }
+
+// ValuesFromGraphQL provides a mock function with given fields: in
+func (_m *ApplicationTemplateConverter) ValuesFromGraphQL(in []*graphql.TemplateValueInput) []*model.ApplicationTemplateValueInput {
+	ret := _m.Called(in)
+
+	var r0 []*model.ApplicationTemplateValueInput
+	if rf, ok := ret.Get(0).(func([]*graphql.TemplateValueInput) []*model.ApplicationTemplateValueInput); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApplicationTemplateValueInput)
+		}
+	}
+
+	return r0
+}
@@ -28,6 +28,20 @@ func (_m *ApplicationTemplateRepository) Create(ctx context.Context, item model.
 	return r0
 }
 
+// CreateVersion provides a mock function with given fields: ctx, item
+func (_m *ApplicationTemplateRepository) CreateVersion(ctx context.Context, item model.ApplicationTemplate) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ApplicationTemplate) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *ApplicationTemplateRepository) Delete(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -42,20 +56,20 @@ func (_m *ApplicationTemplateRepository) Delete(ctx context.Context, id string)
 	return r0
 }
 
-// Exists provides a mock function with given fields: ctx, id
-func (_m *ApplicationTemplateRepository) Exists(ctx context.Context, id string) (bool, error) {
-	ret := _m.Called(ctx, id)
+// Exists provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationTemplateRepository) Exists(ctx context.Context, tenant string, id string) (bool, error) {
+	ret := _m.Called(ctx, tenant, id)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, tenant, id)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -63,13 +77,82 @@ func (_m *ApplicationTemplateRepository) Exists(ctx context.Context, id string)
 	return r0, r1
 }
 
-// Get provides a mock function with given fields: ctx, id
-func (_m *ApplicationTemplateRepository) Get(ctx context.Context, id string) (*model.ApplicationTemplate, error) {
-	ret := _m.Called(ctx, id)
+// Get provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationTemplateRepository) Get(ctx context.Context, tenant string, id string) (*model.ApplicationTemplate, error) {
+	ret := _m.Called(ctx, tenant, id)
 
 	var r0 *model.ApplicationTemplate
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ApplicationTemplate); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ApplicationTemplate); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByName provides a mock function with given fields: ctx, tenant, name
+func (_m *ApplicationTemplateRepository) GetByName(ctx context.Context, tenant string, name string) (*model.ApplicationTemplate, error) {
+	ret := _m.Called(ctx, tenant, name)
+
+	var r0 *model.ApplicationTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ApplicationTemplate); ok {
+		r0 = rf(ctx, tenant, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForUpdate provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationTemplateRepository) GetForUpdate(ctx context.Context, tenant string, id string) (*model.ApplicationTemplate, error) {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 *model.ApplicationTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ApplicationTemplate); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVersion provides a mock function with given fields: ctx, id, version
+func (_m *ApplicationTemplateRepository) GetVersion(ctx context.Context, id string, version int) (*model.ApplicationTemplate, error) {
+	ret := _m.Called(ctx, id, version)
+
+	var r0 *model.ApplicationTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *model.ApplicationTemplate); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationTemplate)
@@ -77,8 +160,8 @@ func (_m *ApplicationTemplateRepository) Get(ctx context.Context, id string) (*m
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, id, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -86,20 +169,20 @@ func (_m *ApplicationTemplateRepository) Get(ctx context.Context, id string) (*m
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, pageSize, cursor
-func (_m *ApplicationTemplateRepository) List(ctx context.Context, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
-	ret := _m.Called(ctx, pageSize, cursor)
+// List provides a mock function with given fields: ctx, tenant, pageSize, cursor
+func (_m *ApplicationTemplateRepository) List(ctx context.Context, tenant string, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
+	ret := _m.Called(ctx, tenant, pageSize, cursor)
 
 	var r0 model.ApplicationTemplatePage
-	if rf, ok := ret.Get(0).(func(context.Context, int, string) model.ApplicationTemplatePage); ok {
-		r0 = rf(ctx, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) model.ApplicationTemplatePage); ok {
+		r0 = rf(ctx, tenant, pageSize, cursor)
 	} else {
 		r0 = ret.Get(0).(model.ApplicationTemplatePage)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
-		r1 = rf(ctx, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, tenant, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,6 +72,52 @@ func (_m *ApplicationTemplateService) Get(ctx context.Context, id string) (*mode
 	return r0, r1
 }
 
+// GetByName provides a mock function with given fields: ctx, name
+func (_m *ApplicationTemplateService) GetByName(ctx context.Context, name string) (*model.ApplicationTemplate, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *model.ApplicationTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ApplicationTemplate); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVersion provides a mock function with given fields: ctx, id, version
+func (_m *ApplicationTemplateService) GetVersion(ctx context.Context, id string, version int) (*model.ApplicationTemplate, error) {
+	ret := _m.Called(ctx, id, version)
+
+	var r0 *model.ApplicationTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *model.ApplicationTemplate); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, id, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, pageSize, cursor
 func (_m *ApplicationTemplateService) List(ctx context.Context, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
 	ret := _m.Called(ctx, pageSize, cursor)
@@ -93,6 +139,27 @@ func (_m *ApplicationTemplateService) List(ctx context.Context, pageSize int, cu
 	return r0, r1
 }
 
+// PrepareApplicationCreateInputJSON provides a mock function with given fields: appTemplate, values
+func (_m *ApplicationTemplateService) PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values []*model.ApplicationTemplateValueInput) (string, error) {
+	ret := _m.Called(appTemplate, values)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*model.ApplicationTemplate, []*model.ApplicationTemplateValueInput) string); ok {
+		r0 = rf(appTemplate, values)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.ApplicationTemplate, []*model.ApplicationTemplateValueInput) error); ok {
+		r1 = rf(appTemplate, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *ApplicationTemplateService) Update(ctx context.Context, id string, in model.ApplicationTemplateInput) error {
 	ret := _m.Called(ctx, id, in)
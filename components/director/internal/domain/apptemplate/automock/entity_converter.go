@@ -4,9 +4,8 @@ package automock
 
 import (
 	apptemplate "github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
-	mock "github.com/stretchr/testify/mock"
-
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // EntityConverter is an autogenerated mock type for the EntityConverter type
@@ -37,6 +36,29 @@ func (_m *EntityConverter) FromEntity(entity *apptemplate.Entity) (*model.Applic
 	return r0, r1
 }
 
+// FromVersionEntity provides a mock function with given fields: entity
+func (_m *EntityConverter) FromVersionEntity(entity *apptemplate.VersionEntity) (*model.ApplicationTemplate, error) {
+	ret := _m.Called(entity)
+
+	var r0 *model.ApplicationTemplate
+	if rf, ok := ret.Get(0).(func(*apptemplate.VersionEntity) *model.ApplicationTemplate); ok {
+		r0 = rf(entity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*apptemplate.VersionEntity) error); ok {
+		r1 = rf(entity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ToEntity provides a mock function with given fields: in
 func (_m *EntityConverter) ToEntity(in *model.ApplicationTemplate) (*apptemplate.Entity, error) {
 	ret := _m.Called(in)
@@ -59,3 +81,26 @@ func (_m *EntityConverter) ToEntity(in *model.ApplicationTemplate) (*apptemplate
 
 	return r0, r1
 }
+
+// ToVersionEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToVersionEntity(in *model.ApplicationTemplate) (*apptemplate.VersionEntity, error) {
+	ret := _m.Called(in)
+
+	var r0 *apptemplate.VersionEntity
+	if rf, ok := ret.Get(0).(func(*model.ApplicationTemplate) *apptemplate.VersionEntity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apptemplate.VersionEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.ApplicationTemplate) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,36 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookNotifier is an autogenerated mock type for the WebhookNotifier type
+type WebhookNotifier struct {
+	mock.Mock
+}
+
+// NotifyApplicationLifecycle provides a mock function with given fields: ctx, applicationID, webhookType
+func (_m *WebhookNotifier) NotifyApplicationLifecycle(ctx context.Context, applicationID string, webhookType model.WebhookType) (bool, error) {
+	ret := _m.Called(ctx, applicationID, webhookType)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.WebhookType) bool); ok {
+		r0 = rf(ctx, applicationID, webhookType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.WebhookType) error); ok {
+		r1 = rf(ctx, applicationID, webhookType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -13,7 +13,10 @@ import (
 )
 
 type AppConverter interface{}
+
+//go:generate mockery -name=ApplicationConverter -output=automock -outpkg=automock -case=underscore
 type ApplicationConverter interface {
+	ToGraphQL(in *model.Application) *graphql.Application
 	CreateInputFromGraphQL(in graphql.ApplicationCreateInput) model.ApplicationCreateInput
 }
 
@@ -41,13 +44,19 @@ func (c *converter) ToGraphQL(in *model.ApplicationTemplate) (*graphql.Applicati
 		return nil, errors.Wrapf(err, "while graphqlising application create input")
 	}
 
+	placeholders, err := c.placeholdersToGraphql(in.Placeholders)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting placeholders")
+	}
+
 	return &graphql.ApplicationTemplate{
 		ID:               in.ID,
 		Name:             in.Name,
 		Description:      in.Description,
 		ApplicationInput: gqlAppInput,
-		Placeholders:     c.placeholdersToGraphql(in.Placeholders),
+		Placeholders:     placeholders,
 		AccessLevel:      graphql.ApplicationTemplateAccessLevel(in.AccessLevel),
+		Version:          in.Version,
 	}, nil
 }
 
@@ -78,15 +87,46 @@ func (c *converter) InputFromGraphQL(in graphql.ApplicationTemplateInput) (model
 		}
 	}
 
+	placeholders, err := c.placeholdersFromGraphql(in.Placeholders)
+	if err != nil {
+		return model.ApplicationTemplateInput{}, errors.Wrap(err, "while converting placeholders")
+	}
+
 	return model.ApplicationTemplateInput{
 		Name:                 in.Name,
 		Description:          in.Description,
 		ApplicationInputJSON: appCreateInput,
-		Placeholders:         c.placeholdersFromGraphql(in.Placeholders),
+		Placeholders:         placeholders,
 		AccessLevel:          model.ApplicationTemplateAccessLevel(in.AccessLevel),
 	}, nil
 }
 
+func (c *converter) ValuesFromGraphQL(in []*graphql.TemplateValueInput) []*model.ApplicationTemplateValueInput {
+	var values []*model.ApplicationTemplateValueInput
+	for _, value := range in {
+		if value == nil {
+			continue
+		}
+
+		values = append(values, &model.ApplicationTemplateValueInput{
+			Placeholder: value.Placeholder,
+			Value:       value.Value,
+		})
+	}
+
+	return values
+}
+
+func (c *converter) ApplicationCreateInputJSONToGQL(in string) (graphql.ApplicationCreateInput, error) {
+	var appInput graphql.ApplicationCreateInput
+	err := json.Unmarshal([]byte(in), &appInput)
+	if err != nil {
+		return graphql.ApplicationCreateInput{}, errors.Wrap(err, "while unmarshalling application input")
+	}
+
+	return appInput, nil
+}
+
 func (c *converter) ToEntity(in *model.ApplicationTemplate) (*Entity, error) {
 	if in == nil {
 		return nil, nil
@@ -104,6 +144,8 @@ func (c *converter) ToEntity(in *model.ApplicationTemplate) (*Entity, error) {
 		ApplicationInputJSON: in.ApplicationInputJSON,
 		PlaceholdersJSON:     placeholders,
 		AccessLevel:          string(in.AccessLevel),
+		TenantID:             repo.NewNullableString(in.Tenant),
+		Version:              in.Version,
 	}, nil
 }
 
@@ -124,6 +166,49 @@ func (c *converter) FromEntity(entity *Entity) (*model.ApplicationTemplate, erro
 		ApplicationInputJSON: entity.ApplicationInputJSON,
 		Placeholders:         placeholders,
 		AccessLevel:          model.ApplicationTemplateAccessLevel(entity.AccessLevel),
+		Tenant:               repo.StringPtrFromNullableString(entity.TenantID),
+		Version:              entity.Version,
+	}, nil
+}
+
+func (c *converter) ToVersionEntity(in *model.ApplicationTemplate) (*VersionEntity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	placeholders, err := c.placeholdersModelToJSON(in.Placeholders)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting placeholders from model to JSON")
+	}
+
+	return &VersionEntity{
+		ApplicationTemplateID: in.ID,
+		Version:               in.Version,
+		Name:                  in.Name,
+		Description:           repo.NewNullableString(in.Description),
+		ApplicationInputJSON:  in.ApplicationInputJSON,
+		PlaceholdersJSON:      placeholders,
+	}, nil
+}
+
+// FromVersionEntity returns Application Template as it was in given version, without its access level and tenant
+func (c *converter) FromVersionEntity(entity *VersionEntity) (*model.ApplicationTemplate, error) {
+	if entity == nil {
+		return nil, nil
+	}
+
+	placeholders, err := c.placeholdersJSONToModel(entity.PlaceholdersJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting placeholders from JSON to model")
+	}
+
+	return &model.ApplicationTemplate{
+		ID:                   entity.ApplicationTemplateID,
+		Name:                 entity.Name,
+		Description:          repo.StringPtrFromNullableString(entity.Description),
+		ApplicationInputJSON: entity.ApplicationInputJSON,
+		Placeholders:         placeholders,
+		Version:              entity.Version,
 	}, nil
 }
 
@@ -209,27 +294,49 @@ func (c *converter) placeholdersModelToJSON(in []model.ApplicationTemplatePlaceh
 	return repo.NewValidNullableString(string(placeholdersMarshalled)), nil
 }
 
-func (c *converter) placeholdersFromGraphql(in []*graphql.PlaceholderDefinitionInput) []model.ApplicationTemplatePlaceholder {
+func (c *converter) placeholdersFromGraphql(in []*graphql.PlaceholderDefinitionInput) ([]model.ApplicationTemplatePlaceholder, error) {
 	var placeholders []model.ApplicationTemplatePlaceholder
 	for _, p := range in {
+		schema, err := p.JSONSchema.Unmarshal()
+		if err != nil {
+			return nil, errors.Wrapf(err, "while unmarshalling JSON schema of placeholder [name=%s]", p.Name)
+		}
+
 		np := model.ApplicationTemplatePlaceholder{
 			Name:        p.Name,
 			Description: p.Description,
+			JSONSchema:  schema,
+			Regex:       p.Regex,
+			Required:    p.Required == nil || *p.Required,
+			Default:     p.Default,
+			Sensitive:   p.Sensitive != nil && *p.Sensitive,
 		}
 		placeholders = append(placeholders, np)
 	}
-	return placeholders
+	return placeholders, nil
 }
 
-func (c *converter) placeholdersToGraphql(in []model.ApplicationTemplatePlaceholder) []*graphql.PlaceholderDefinition {
+func (c *converter) placeholdersToGraphql(in []model.ApplicationTemplatePlaceholder) ([]*graphql.PlaceholderDefinition, error) {
 	var placeholders []*graphql.PlaceholderDefinition
 	for _, p := range in {
+		schema, err := graphql.MarshalSchema(p.JSONSchema)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while marshalling JSON schema of placeholder [name=%s]", p.Name)
+		}
+
 		np := graphql.PlaceholderDefinition{
 			Name:        p.Name,
 			Description: p.Description,
+			JSONSchema:  schema,
+			Regex:       p.Regex,
+			Required:    p.Required,
+			Sensitive:   p.Sensitive,
+		}
+		if !p.Sensitive {
+			np.Default = p.Default
 		}
 		placeholders = append(placeholders, &np)
 	}
 
-	return placeholders
+	return placeholders, nil
 }
@@ -18,6 +18,11 @@ func TestConverter_ToGraphQL(t *testing.T) {
 	appConv := &automock.ApplicationConverter{}
 	converter := apptemplate.NewConverter(appConv)
 
+	modelAppTemplateWithTypedPlaceholders := fixModelAppTemplate(testID, testName)
+	modelAppTemplateWithTypedPlaceholders.Placeholders = fixModelTypedPlaceholders()
+	gqlAppTemplateWithTypedPlaceholders := fixGQLAppTemplate(testID, testName)
+	gqlAppTemplateWithTypedPlaceholders.Placeholders = fixGQLTypedPlaceholders()
+
 	testCases := []struct {
 		Name          string
 		Input         *model.ApplicationTemplate
@@ -30,6 +35,12 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Expected:      fixGQLAppTemplate(testID, testName),
 			ExpectedError: false,
 		},
+		{
+			Name:          "Default value of sensitive placeholder is not returned",
+			Input:         modelAppTemplateWithTypedPlaceholders,
+			Expected:      gqlAppTemplateWithTypedPlaceholders,
+			ExpectedError: false,
+		},
 		{
 			Name: "Error when graphqlising Application Create Input",
 			Input: &model.ApplicationTemplate{
@@ -142,6 +153,12 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 	appConv := &automock.ApplicationConverter{}
 	converter := apptemplate.NewConverter(appConv)
 
+	appInputJSON := "{\"name\":\"foo\",\"description\":\"Lorem ipsum\",\"labels\":null,\"webhooks\":null,\"healthCheckURL\":null,\"healthCheckAuth\":null,\"apis\":null,\"eventAPIs\":null,\"documents\":null,\"integrationSystemID\":null}"
+	gqlAppTemplateInputWithTypedPlaceholders := fixGQLAppTemplateInput(testName)
+	gqlAppTemplateInputWithTypedPlaceholders.Placeholders = fixGQLTypedPlaceholderDefinitionInput()
+	modelAppTemplateInputWithTypedPlaceholders := fixModelAppTemplateInput(testName, appInputJSON)
+	modelAppTemplateInputWithTypedPlaceholders.Placeholders = fixModelTypedPlaceholders()
+
 	testCases := []struct {
 		Name     string
 		Input    graphql.ApplicationTemplateInput
@@ -150,7 +167,12 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 		{
 			Name:     "All properties given",
 			Input:    *fixGQLAppTemplateInput(testName),
-			Expected: *fixModelAppTemplateInput(testName, "{\"name\":\"foo\",\"description\":\"Lorem ipsum\",\"labels\":null,\"webhooks\":null,\"healthCheckURL\":null,\"apis\":null,\"eventAPIs\":null,\"documents\":null,\"integrationSystemID\":null}"),
+			Expected: *fixModelAppTemplateInput(testName, appInputJSON),
+		},
+		{
+			Name:     "Placeholders with constraints given",
+			Input:    *gqlAppTemplateInputWithTypedPlaceholders,
+			Expected: *modelAppTemplateInputWithTypedPlaceholders,
 		},
 		{
 			Name:     "Empty",
@@ -275,3 +297,59 @@ func TestConverter_FromEntity(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_ValuesFromGraphQL(t *testing.T) {
+	// GIVEN
+	converter := apptemplate.NewConverter(nil)
+
+	testCases := []struct {
+		Name     string
+		Input    []*graphql.TemplateValueInput
+		Expected []*model.ApplicationTemplateValueInput
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixGQLTemplateValues("name", "value"),
+			Expected: fixModelTemplateValues("name", "value"),
+		},
+		{
+			Name:     "Empty",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			res := converter.ValuesFromGraphQL(testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_ApplicationCreateInputJSONToGQL(t *testing.T) {
+	// GIVEN
+	converter := apptemplate.NewConverter(nil)
+	desc := testDescription
+
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		res, err := converter.ApplicationCreateInputJSONToGQL(fixApplicationCreateInputString())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, graphql.ApplicationCreateInput{Name: "foo", Description: &desc}, res)
+	})
+
+	t.Run("Error when JSON is invalid", func(t *testing.T) {
+		// WHEN
+		_, err := converter.ApplicationCreateInputJSONToGQL("{")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling application input")
+	})
+}
@@ -11,6 +11,17 @@ type Entity struct {
 	ApplicationInputJSON string         `db:"application_input"`
 	PlaceholdersJSON     sql.NullString `db:"placeholders"`
 	AccessLevel          string         `db:"access_level"`
+	TenantID             sql.NullString `db:"tenant_id"`
+	Version              int            `db:"version"`
+}
+
+type VersionEntity struct {
+	ApplicationTemplateID string         `db:"app_template_id"`
+	Version               int            `db:"version"`
+	Name                  string         `db:"name"`
+	Description           sql.NullString `db:"description"`
+	ApplicationInputJSON  string         `db:"application_input"`
+	PlaceholdersJSON      sql.NullString `db:"placeholders"`
 }
 
 type EntityCollection []Entity
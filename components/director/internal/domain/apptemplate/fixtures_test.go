@@ -13,6 +13,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/stretchr/testify/require"
@@ -23,13 +24,14 @@ const (
 	testID          = "foo"
 	testName        = "bar"
 	testDescription = "Lorem ipsum"
+	testGlobalScope = "application_template:write_global"
 	testPageSize    = 3
 	testCursor      = ""
 )
 
 var (
 	testError        = errors.New("test error")
-	testTableColumns = []string{"id", "name", "description", "application_input", "placeholders", "access_level"}
+	testTableColumns = []string{"id", "name", "description", "application_input", "placeholders", "access_level", "tenant_id", "version"}
 )
 
 func fixModelAppTemplate(id, name string) *model.ApplicationTemplate {
@@ -43,9 +45,18 @@ func fixModelAppTemplate(id, name string) *model.ApplicationTemplate {
 		ApplicationInputJSON: appInputString,
 		Placeholders:         fixModelPlaceholders(),
 		AccessLevel:          model.GlobalApplicationTemplateAccessLevel,
+		Version:              1,
 	}
 }
 
+func fixModelTenantAppTemplate(id, name string) *model.ApplicationTemplate {
+	tnt := testTenant
+	appTemplate := fixModelAppTemplate(id, name)
+	appTemplate.AccessLevel = model.TenantApplicationTemplateAccessLevel
+	appTemplate.Tenant = &tnt
+	return appTemplate
+}
+
 func fixGQLAppTemplate(id, name string) *graphql.ApplicationTemplate {
 	desc := testDescription
 
@@ -56,6 +67,7 @@ func fixGQLAppTemplate(id, name string) *graphql.ApplicationTemplate {
 		ApplicationInput: fixApplicationCreateInputGraphqlized(),
 		Placeholders:     fixGQLPlaceholders(),
 		AccessLevel:      graphql.ApplicationTemplateAccessLevelGlobal,
+		Version:          1,
 	}
 }
 
@@ -124,6 +136,20 @@ func fixEntityAppTemplate(t *testing.T, id, name string) *apptemplate.Entity {
 		ApplicationInputJSON: marshalledAppInput,
 		PlaceholdersJSON:     repo.NewValidNullableString(string(marshalledPlaceholders)),
 		AccessLevel:          string(model.GlobalApplicationTemplateAccessLevel),
+		Version:              1,
+	}
+}
+
+func fixEntityAppTemplateVersion(t *testing.T, id, name string) *apptemplate.VersionEntity {
+	entity := fixEntityAppTemplate(t, id, name)
+
+	return &apptemplate.VersionEntity{
+		ApplicationTemplateID: entity.ID,
+		Version:               entity.Version,
+		Name:                  entity.Name,
+		Description:           entity.Description,
+		ApplicationInputJSON:  entity.ApplicationInputJSON,
+		PlaceholdersJSON:      entity.PlaceholdersJSON,
 	}
 }
 
@@ -132,6 +158,10 @@ func fixApplicationCreateInputString() string {
 	return fmt.Sprintf(`{"name":"foo","description":"%s"}`, testDescription)
 }
 
+func fixApplicationCreateInputWithPlaceholderString() string {
+	return `{"name":"foo","description":"{{test}}"}`
+}
+
 func fixApplicationCreateInputGraphqlized() string {
 	return `{name: "foo",description: "Lorem ipsum",}`
 }
@@ -141,6 +171,7 @@ func fixModelPlaceholders() []model.ApplicationTemplatePlaceholder {
 		{
 			Name:        "test",
 			Description: &placeholderDesc,
+			Required:    true,
 		},
 	}
 }
@@ -161,18 +192,96 @@ func fixGQLPlaceholders() []*graphql.PlaceholderDefinition {
 		{
 			Name:        "test",
 			Description: &placeholderDesc,
+			Required:    true,
 		},
 	}
 }
 
+func fixModelTypedPlaceholders() []model.ApplicationTemplatePlaceholder {
+	var schema interface{} = map[string]interface{}{"type": "string"}
+	regex := "[a-z]+"
+	defaultValue := "secret"
+	return []model.ApplicationTemplatePlaceholder{
+		{
+			Name:       "test",
+			JSONSchema: &schema,
+			Regex:      &regex,
+			Required:   false,
+			Default:    &defaultValue,
+			Sensitive:  true,
+		},
+	}
+}
+
+func fixGQLTypedPlaceholderDefinitionInput() []*graphql.PlaceholderDefinitionInput {
+	schema := graphql.JSONSchema(`{"type":"string"}`)
+	regex := "[a-z]+"
+	required := false
+	defaultValue := "secret"
+	sensitive := true
+	return []*graphql.PlaceholderDefinitionInput{
+		{
+			Name:       "test",
+			JSONSchema: &schema,
+			Regex:      &regex,
+			Required:   &required,
+			Default:    &defaultValue,
+			Sensitive:  &sensitive,
+		},
+	}
+}
+
+func fixGQLTypedPlaceholders() []*graphql.PlaceholderDefinition {
+	schema := graphql.JSONSchema(`{"type":"string"}`)
+	regex := "[a-z]+"
+	return []*graphql.PlaceholderDefinition{
+		{
+			Name:       "test",
+			JSONSchema: &schema,
+			Regex:      &regex,
+			Required:   false,
+			Default:    nil,
+			Sensitive:  true,
+		},
+	}
+}
+
+func fixScopesGetter() *automock.ScopesGetter {
+	scopesGetter := &automock.ScopesGetter{}
+	scopesGetter.On("GetRequiredScopes", "applicationTemplateAccessLevels.global").Return([]string{testGlobalScope}, nil)
+	return scopesGetter
+}
+
 func fixAppTemplateCreateArgs(entity apptemplate.Entity) []driver.Value {
-	return []driver.Value{entity.ID, entity.Name, entity.Description, entity.ApplicationInputJSON, entity.PlaceholdersJSON, entity.AccessLevel}
+	return []driver.Value{entity.ID, entity.Name, entity.Description, entity.ApplicationInputJSON, entity.PlaceholdersJSON, entity.AccessLevel, entity.TenantID, entity.Version}
 }
 
 func fixSQLRows(entities []apptemplate.Entity) *sqlmock.Rows {
 	out := sqlmock.NewRows(testTableColumns)
 	for _, entity := range entities {
-		out.AddRow(entity.ID, entity.Name, entity.Description, entity.ApplicationInputJSON, entity.PlaceholdersJSON, entity.AccessLevel)
+		out.AddRow(entity.ID, entity.Name, entity.Description, entity.ApplicationInputJSON, entity.PlaceholdersJSON, entity.AccessLevel, entity.TenantID, entity.Version)
 	}
 	return out
 }
+
+func fixModelAppTemplateWithPlaceholders(id, name, appInputJSON string, placeholders ...string) *model.ApplicationTemplate {
+	appTemplate := fixModelAppTemplate(id, name)
+	appTemplate.ApplicationInputJSON = appInputJSON
+	appTemplate.Placeholders = nil
+	for _, placeholder := range placeholders {
+		appTemplate.Placeholders = append(appTemplate.Placeholders, model.ApplicationTemplatePlaceholder{Name: placeholder, Required: true})
+	}
+	return appTemplate
+}
+
+func fixModelTemplateValues(placeholder, value string) []*model.ApplicationTemplateValueInput {
+	return []*model.ApplicationTemplateValueInput{
+		{Placeholder: placeholder, Value: value},
+	}
+}
+
+func fixGQLTemplateValues(placeholder, value string) []*graphql.TemplateValueInput {
+	return []*graphql.TemplateValueInput{
+		{Placeholder: placeholder, Value: value},
+	}
+}
@@ -31,6 +31,9 @@ func (g *Graphqlizer) ApplicationCreateInputToGQL(in graphql.ApplicationCreateIn
 		{{- if .HealthCheckURL }}
 		healthCheckURL: "{{ .HealthCheckURL }}"
 		{{- end }}
+		{{- if .HealthCheckAuth }}
+		healthCheckAuth: {{- AuthInputToGQL .HealthCheckAuth }}
+		{{- end }}
 		{{- if .Apis }}
 		apis: [
 			{{- range $i, $e := .Apis }}
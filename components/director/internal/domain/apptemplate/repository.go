@@ -2,45 +2,63 @@ package apptemplate
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/pkg/errors"
 )
 
-const tableName string = `public.app_templates`
+const (
+	tableName         string = `public.app_templates`
+	versionsTableName string = `public.app_template_versions`
+	// visibleForTenantColumn used as a tenant column matches templates of the tenant and the global ones, which have no tenant
+	visibleForTenantColumn string = `COALESCE(tenant_id, $1)`
+)
 
 var (
-	updatableTableColumns = []string{"name", "description", "application_input", "placeholders", "access_level"}
+	updatableTableColumns = []string{"name", "description", "application_input", "placeholders", "access_level", "tenant_id", "version"}
 	idTableColumns        = []string{"id"}
 	tableColumns          = append(idTableColumns, updatableTableColumns...)
+	versionTableColumns   = []string{"app_template_id", "version", "name", "description", "application_input", "placeholders"}
 )
 
 //go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
 type EntityConverter interface {
 	ToEntity(in *model.ApplicationTemplate) (*Entity, error)
 	FromEntity(entity *Entity) (*model.ApplicationTemplate, error)
+	ToVersionEntity(in *model.ApplicationTemplate) (*VersionEntity, error)
+	FromVersionEntity(entity *VersionEntity) (*model.ApplicationTemplate, error)
 }
 
 type repository struct {
-	creator               repo.Creator
-	existQuerierGlobal    repo.ExistQuerierGlobal
-	singleGetterGlobal    repo.SingleGetterGlobal
-	pageableQuerierGlobal repo.PageableQuerierGlobal
-	updaterGlobal         repo.UpdaterGlobal
-	deleterGlobal         repo.DeleterGlobal
-	conv                  EntityConverter
+	creator         repo.Creator
+	existQuerier    repo.ExistQuerier
+	singleGetter    repo.SingleGetter
+	pageableQuerier repo.PageableQuerier
+	updaterGlobal   repo.UpdaterGlobal
+	deleterGlobal   repo.DeleterGlobal
+	versionCreator  repo.Creator
+	versionGetter   repo.SingleGetterGlobal
+	conv            EntityConverter
 }
 
 func NewRepository(conv EntityConverter) *repository {
 	return &repository{
-		creator:               repo.NewCreator(tableName, tableColumns),
-		existQuerierGlobal:    repo.NewExistQuerierGlobal(tableName),
-		singleGetterGlobal:    repo.NewSingleGetterGlobal(tableName, tableColumns),
-		pageableQuerierGlobal: repo.NewPageableQuerierGlobal(tableName, tableColumns),
-		updaterGlobal:         repo.NewUpdaterGlobal(tableName, updatableTableColumns, idTableColumns),
-		deleterGlobal:         repo.NewDeleterGlobal(tableName),
-		conv:                  conv,
+		creator:         repo.NewCreator(tableName, tableColumns),
+		existQuerier:    repo.NewExistQuerier(tableName, visibleForTenantColumn),
+		singleGetter:    repo.NewSingleGetter(tableName, visibleForTenantColumn, tableColumns),
+		pageableQuerier: repo.NewPageableQuerier(tableName, visibleForTenantColumn, tableColumns),
+		updaterGlobal:   repo.NewUpdaterGlobal(tableName, updatableTableColumns, idTableColumns),
+		deleterGlobal:   repo.NewDeleterGlobal(tableName),
+		versionCreator:  repo.NewCreator(versionsTableName, versionTableColumns),
+		versionGetter:   repo.NewSingleGetterGlobal(versionsTableName, versionTableColumns),
+		conv:            conv,
 	}
 }
 
@@ -53,12 +71,66 @@ func (r *repository) Create(ctx context.Context, item model.ApplicationTemplate)
 	return r.creator.Create(ctx, entity)
 }
 
-func (r *repository) Get(ctx context.Context, id string) (*model.ApplicationTemplate, error) {
+func (r *repository) Get(ctx context.Context, tenant, id string) (*model.ApplicationTemplate, error) {
 	var entity Entity
-	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, &entity); err != nil {
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, &entity); err != nil {
+		return nil, err
+	}
+
+	result, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting Application Template with ID %s", id)
+	}
+
+	return result, nil
+}
+
+// GetByName returns the template of the tenant with the given name or, if the tenant has no such template, the global one
+func (r *repository) GetByName(ctx context.Context, tenant, name string) (*model.ApplicationTemplate, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND name = $2 ORDER BY tenant_id NULLS LAST LIMIT 1`,
+		strings.Join(tableColumns, ", "), tableName, visibleForTenantColumn)
+
+	var entity Entity
+	err = persist.Get(&entity, stmt, tenant, name)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, apperrors.NewNotFoundError(name)
+	case err != nil:
+		return nil, errors.Wrapf(err, "while getting Application Template with name %s from DB", name)
+	}
+
+	result, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting Application Template with name %s", name)
+	}
+
+	return result, nil
+}
+
+// GetForUpdate returns the template like Get, but locks its row until the end of the transaction, so that concurrent updates do not bump it to the same version
+func (r *repository) GetForUpdate(ctx context.Context, tenant, id string) (*model.ApplicationTemplate, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND id = $2 FOR UPDATE`,
+		strings.Join(tableColumns, ", "), tableName, visibleForTenantColumn)
+
+	var entity Entity
+	err = persist.Get(&entity, stmt, tenant, id)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, apperrors.NewNotFoundError(id)
+	case err != nil:
+		return nil, errors.Wrapf(err, "while getting Application Template with ID %s from DB", id)
+	}
+
 	result, err := r.conv.FromEntity(&entity)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while converting Application Template with ID %s", id)
@@ -67,13 +139,13 @@ func (r *repository) Get(ctx context.Context, id string) (*model.ApplicationTemp
 	return result, nil
 }
 
-func (r *repository) Exists(ctx context.Context, id string) (bool, error) {
-	return r.existQuerierGlobal.ExistsGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+func (r *repository) Exists(ctx context.Context, tenant, id string) (bool, error) {
+	return r.existQuerier.Exists(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
-func (r *repository) List(ctx context.Context, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
+func (r *repository) List(ctx context.Context, tenant string, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
 	var entityCollection EntityCollection
-	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, "id", &entityCollection)
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &entityCollection)
 	if err != nil {
 		return model.ApplicationTemplatePage{}, err
 	}
@@ -107,3 +179,28 @@ func (r *repository) Update(ctx context.Context, model model.ApplicationTemplate
 func (r *repository) Delete(ctx context.Context, id string) error {
 	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
+
+// CreateVersion stores current state of the Application Template in its history
+func (r *repository) CreateVersion(ctx context.Context, item model.ApplicationTemplate) error {
+	entity, err := r.conv.ToVersionEntity(&item)
+	if err != nil {
+		return errors.Wrapf(err, "while converting version %d of Application Template with ID %s", item.Version, item.ID)
+	}
+
+	return r.versionCreator.Create(ctx, entity)
+}
+
+func (r *repository) GetVersion(ctx context.Context, id string, version int) (*model.ApplicationTemplate, error) {
+	var entity VersionEntity
+	conditions := repo.Conditions{repo.NewEqualCondition("app_template_id", id), repo.NewEqualCondition("version", strconv.Itoa(version))}
+	if err := r.versionGetter.GetGlobal(ctx, conditions, &entity); err != nil {
+		return nil, err
+	}
+
+	result, err := r.conv.FromVersionEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting version %d of Application Template with ID %s", version, id)
+	}
+
+	return result, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -28,7 +29,7 @@ func TestRepository_Create(t *testing.T) {
 		mockConverter.On("ToEntity", appTemplateModel).Return(appTemplateEntity, nil).Once()
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_templates ( id, name, description, application_input, placeholders, access_level ) VALUES ( ?, ?, ?, ?, ?, ? )`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_templates ( id, name, description, application_input, placeholders, access_level, tenant_id, version ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )`)).
 			WithArgs(fixAppTemplateCreateArgs(*appTemplateEntity)...).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
@@ -70,7 +71,7 @@ func TestRepository_Create(t *testing.T) {
 		mockConverter.On("ToEntity", appTemplateModel).Return(appTemplateEntity, nil).Once()
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_templates ( id, name, description, application_input, placeholders, access_level ) VALUES ( ?, ?, ?, ?, ?, ? )`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_templates ( id, name, description, application_input, placeholders, access_level, tenant_id, version ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )`)).
 			WithArgs(fixAppTemplateCreateArgs(*appTemplateEntity)...).
 			WillReturnError(testError)
 
@@ -99,15 +100,15 @@ func TestRepository_Get(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND id = $2`)).
+			WithArgs(testTenant, testID).
 			WillReturnRows(rowsToReturn)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		result, err := appTemplateRepo.Get(ctx, testID)
+		result, err := appTemplateRepo.Get(ctx, testTenant, testID)
 
 		// THEN
 		require.NoError(t, err)
@@ -121,15 +122,15 @@ func TestRepository_Get(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND id = $2`)).
+			WithArgs(testTenant, testID).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.Get(ctx, testID)
+		_, err := appTemplateRepo.Get(ctx, testTenant, testID)
 
 		// THEN
 		require.Error(t, err)
@@ -147,15 +148,15 @@ func TestRepository_Get(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND id = $2`)).
+			WithArgs(testTenant, testID).
 			WillReturnRows(rowsToReturn)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.Get(ctx, testID)
+		_, err := appTemplateRepo.Get(ctx, testTenant, testID)
 
 		// THEN
 		require.Error(t, err)
@@ -168,15 +169,15 @@ func TestRepository_Exists(t *testing.T) {
 		// GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND id = $2`)).
+			WithArgs(testTenant, testID).
 			WillReturnRows(testdb.RowWhenObjectExist())
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(nil)
 
 		// WHEN
-		result, err := appTemplateRepo.Exists(ctx, testID)
+		result, err := appTemplateRepo.Exists(ctx, testTenant, testID)
 
 		// THEN
 		require.NoError(t, err)
@@ -188,15 +189,15 @@ func TestRepository_Exists(t *testing.T) {
 		// GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND id = $2`)).
+			WithArgs(testTenant, testID).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(nil)
 
 		// WHEN
-		result, err := appTemplateRepo.Exists(ctx, testID)
+		result, err := appTemplateRepo.Exists(ctx, testTenant, testID)
 
 		// THEN
 		require.Error(t, err)
@@ -230,16 +231,18 @@ func TestRepository_List(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows(appTemplateEntities)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1)=$1 ORDER BY id LIMIT 3 OFFSET 0`)).
+			WithArgs(testTenant).
 			WillReturnRows(rowsToReturn)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates WHERE COALESCE(tenant_id, $1)=$1`)).
+			WithArgs(testTenant).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		result, err := appTemplateRepo.List(ctx, testPageSize, testCursor)
+		result, err := appTemplateRepo.List(ctx, testTenant, testPageSize, testCursor)
 
 		// THEN
 		require.NoError(t, err)
@@ -261,16 +264,18 @@ func TestRepository_List(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows(appTemplateEntities)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1)=$1 ORDER BY id LIMIT 3 OFFSET 0`)).
+			WithArgs(testTenant).
 			WillReturnRows(rowsToReturn)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates WHERE COALESCE(tenant_id, $1)=$1`)).
+			WithArgs(testTenant).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.List(ctx, testPageSize, testCursor)
+		_, err := appTemplateRepo.List(ctx, testTenant, testPageSize, testCursor)
 
 		// THEN
 		require.Error(t, err)
@@ -283,14 +288,15 @@ func TestRepository_List(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1)=$1 ORDER BY id LIMIT 3 OFFSET 0`)).
+			WithArgs(testTenant).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.List(ctx, testPageSize, testCursor)
+		_, err := appTemplateRepo.List(ctx, testTenant, testPageSize, testCursor)
 
 		// THEN
 		require.Error(t, err)
@@ -309,8 +315,8 @@ func TestRepository_Update(t *testing.T) {
 		mockConverter.On("ToEntity", appTemplateModel).Return(appTemplateEntity, nil).Once()
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.app_templates SET name = ?, description = ?, application_input = ?, placeholders = ?, access_level = ? WHERE id = ?`)).
-			WithArgs(appTemplateEntity.Name, appTemplateEntity.Description, appTemplateEntity.ApplicationInputJSON, appTemplateEntity.PlaceholdersJSON, appTemplateEntity.AccessLevel, appTemplateEntity.ID).
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.app_templates SET name = ?, description = ?, application_input = ?, placeholders = ?, access_level = ?, tenant_id = ?, version = ? WHERE id = ?`)).
+			WithArgs(appTemplateEntity.Name, appTemplateEntity.Description, appTemplateEntity.ApplicationInputJSON, appTemplateEntity.PlaceholdersJSON, appTemplateEntity.AccessLevel, appTemplateEntity.TenantID, appTemplateEntity.Version, appTemplateEntity.ID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -333,8 +339,8 @@ func TestRepository_Update(t *testing.T) {
 		mockConverter.On("ToEntity", appTemplateModel).Return(appTemplateEntity, nil).Once()
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.app_templates SET name = ?, description = ?, application_input = ?, placeholders = ?, access_level = ? WHERE id = ?`)).
-			WithArgs(appTemplateEntity.Name, appTemplateEntity.Description, appTemplateEntity.ApplicationInputJSON, appTemplateEntity.PlaceholdersJSON, appTemplateEntity.AccessLevel, appTemplateEntity.ID).
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.app_templates SET name = ?, description = ?, application_input = ?, placeholders = ?, access_level = ?, tenant_id = ?, version = ? WHERE id = ?`)).
+			WithArgs(appTemplateEntity.Name, appTemplateEntity.Description, appTemplateEntity.ApplicationInputJSON, appTemplateEntity.PlaceholdersJSON, appTemplateEntity.AccessLevel, appTemplateEntity.TenantID, appTemplateEntity.Version, appTemplateEntity.ID).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -405,3 +411,238 @@ func TestRepository_Delete(t *testing.T) {
 		assert.Contains(t, err.Error(), testError.Error())
 	})
 }
+
+func TestRepository_GetByName(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		appTemplateModel := fixModelAppTemplate(testID, testName)
+		appTemplateEntity := fixEntityAppTemplate(t, testID, testName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", appTemplateEntity).Return(appTemplateModel, nil).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND name = $2 ORDER BY tenant_id NULLS LAST LIMIT 1`)).
+			WithArgs(testTenant, testName).
+			WillReturnRows(rowsToReturn)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := appTemplateRepo.GetByName(ctx, testTenant, testName)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, appTemplateModel, result)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND name = $2 ORDER BY tenant_id NULLS LAST LIMIT 1`)).
+			WithArgs(testTenant, testName).
+			WillReturnRows(fixSQLRows(nil))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := appTemplateRepo.GetByName(ctx, testTenant, testName)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error when getting", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND name = $2 ORDER BY tenant_id NULLS LAST LIMIT 1`)).
+			WithArgs(testTenant, testName).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := appTemplateRepo.GetByName(ctx, testTenant, testName)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
+
+func TestRepository_GetForUpdate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		appTemplateModel := fixModelAppTemplate(testID, testName)
+		appTemplateEntity := fixEntityAppTemplate(t, testID, testName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", appTemplateEntity).Return(appTemplateModel, nil).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND id = $2 FOR UPDATE`)).
+			WithArgs(testTenant, testID).
+			WillReturnRows(rowsToReturn)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := appTemplateRepo.GetForUpdate(ctx, testTenant, testID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, appTemplateModel, result)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND id = $2 FOR UPDATE`)).
+			WithArgs(testTenant, testID).
+			WillReturnRows(fixSQLRows(nil))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := appTemplateRepo.GetForUpdate(ctx, testTenant, testID)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error when getting", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE COALESCE(tenant_id, $1) = $1 AND id = $2 FOR UPDATE`)).
+			WithArgs(testTenant, testID).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := appTemplateRepo.GetForUpdate(ctx, testTenant, testID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
+
+func TestRepository_CreateVersion(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		appTemplateModel := fixModelAppTemplate(testID, testName)
+		versionEntity := fixEntityAppTemplateVersion(t, testID, testName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToVersionEntity", appTemplateModel).Return(versionEntity, nil).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_template_versions ( app_template_id, version, name, description, application_input, placeholders ) VALUES ( ?, ?, ?, ?, ?, ? )`)).
+			WithArgs(versionEntity.ApplicationTemplateID, versionEntity.Version, versionEntity.Name, versionEntity.Description, versionEntity.ApplicationInputJSON, versionEntity.PlaceholdersJSON).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		err := appTemplateRepo.CreateVersion(ctx, *appTemplateModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when converting", func(t *testing.T) {
+		// GIVEN
+		appTemplateModel := fixModelAppTemplate(testID, testName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToVersionEntity", appTemplateModel).Return(nil, testError).Once()
+
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		err := appTemplateRepo.CreateVersion(context.TODO(), *appTemplateModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
+
+func TestRepository_GetVersion(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		appTemplateModel := fixModelAppTemplate(testID, testName)
+		versionEntity := fixEntityAppTemplateVersion(t, testID, testName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromVersionEntity", versionEntity).Return(appTemplateModel, nil).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rowsToReturn := sqlmock.NewRows([]string{"app_template_id", "version", "name", "description", "application_input", "placeholders"}).
+			AddRow(versionEntity.ApplicationTemplateID, versionEntity.Version, versionEntity.Name, versionEntity.Description, versionEntity.ApplicationInputJSON, versionEntity.PlaceholdersJSON)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_template_id, version, name, description, application_input, placeholders FROM public.app_template_versions WHERE app_template_id = $1 AND version = $2`)).
+			WithArgs(testID, "1").
+			WillReturnRows(rowsToReturn)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := appTemplateRepo.GetVersion(ctx, testID, 1)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, appTemplateModel, result)
+	})
+
+	t.Run("Error when getting", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_template_id, version, name, description, application_input, placeholders FROM public.app_template_versions WHERE app_template_id = $1 AND version = $2`)).
+			WithArgs(testID, "1").
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(nil)
+
+		// WHEN
+		_, err := appTemplateRepo.GetVersion(ctx, testID, 1)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
@@ -2,6 +2,7 @@ package apptemplate
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
@@ -16,9 +17,12 @@ import (
 type ApplicationTemplateService interface {
 	Create(ctx context.Context, in model.ApplicationTemplateInput) (string, error)
 	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
+	GetByName(ctx context.Context, name string) (*model.ApplicationTemplate, error)
+	GetVersion(ctx context.Context, id string, version int) (*model.ApplicationTemplate, error)
 	List(ctx context.Context, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
 	Update(ctx context.Context, id string, in model.ApplicationTemplateInput) error
 	Delete(ctx context.Context, id string) error
+	PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values []*model.ApplicationTemplateValueInput) (string, error)
 }
 
 //go:generate mockery -name=ApplicationTemplateConverter -output=automock -outpkg=automock -case=underscore
@@ -26,20 +30,47 @@ type ApplicationTemplateConverter interface {
 	ToGraphQL(in *model.ApplicationTemplate) (*graphql.ApplicationTemplate, error)
 	MultipleToGraphQL(in []*model.ApplicationTemplate) ([]*graphql.ApplicationTemplate, error)
 	InputFromGraphQL(in graphql.ApplicationTemplateInput) (model.ApplicationTemplateInput, error)
+	ValuesFromGraphQL(in []*graphql.TemplateValueInput) []*model.ApplicationTemplateValueInput
+	ApplicationCreateInputJSONToGQL(in string) (graphql.ApplicationCreateInput, error)
+}
+
+//go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
+type ApplicationService interface {
+	Create(ctx context.Context, in model.ApplicationCreateInput) (string, error)
+	Get(ctx context.Context, id string) (*model.Application, error)
+	UpgradeFromTemplate(ctx context.Context, id string, previous *model.ApplicationCreateInput, desired model.ApplicationCreateInput) error
+}
+
+//go:generate mockery -name=WebhookNotifier -output=automock -outpkg=automock -case=underscore
+type WebhookNotifier interface {
+	NotifyApplicationLifecycle(ctx context.Context, applicationID string, webhookType model.WebhookType) (bool, error)
+}
+
+//go:generate mockery -name=SpecPrefetcher -output=automock -outpkg=automock -case=underscore
+type SpecPrefetcher interface {
+	Prefetch(ctx context.Context, fetchRequests []*model.FetchRequest) context.Context
 }
 
 type Resolver struct {
 	transact persistence.Transactioner
 
+	appSvc               ApplicationService
+	appConverter         ApplicationConverter
 	appTemplateSvc       ApplicationTemplateService
 	appTemplateConverter ApplicationTemplateConverter
+	notifier             WebhookNotifier
+	prefetcher           SpecPrefetcher
 }
 
-func NewResolver(transact persistence.Transactioner, appTemplateSvc ApplicationTemplateService, appTemplateConverter ApplicationTemplateConverter) *Resolver {
+func NewResolver(transact persistence.Transactioner, appSvc ApplicationService, appConverter ApplicationConverter, appTemplateSvc ApplicationTemplateService, appTemplateConverter ApplicationTemplateConverter, notifier WebhookNotifier, prefetcher SpecPrefetcher) *Resolver {
 	return &Resolver{
 		transact:             transact,
+		appSvc:               appSvc,
+		appConverter:         appConverter,
 		appTemplateSvc:       appTemplateSvc,
 		appTemplateConverter: appTemplateConverter,
+		notifier:             notifier,
+		prefetcher:           prefetcher,
 	}
 }
 
@@ -222,3 +253,227 @@ func (r *Resolver) DeleteApplicationTemplate(ctx context.Context, id string) (*g
 
 	return deletedAppTemplate, nil
 }
+
+// RegisterApplicationFromTemplate renders the Application input in a separate transaction and downloads its specifications
+// before the Application is created, so that the transaction which creates it is not kept open during the downloads.
+func (r *Resolver) RegisterApplicationFromTemplate(ctx context.Context, templateName string, values []*graphql.TemplateValueInput) (*graphql.Application, error) {
+	convertedValues := r.appTemplateConverter.ValuesFromGraphQL(values)
+
+	appCreateInput, err := r.renderRegisterInput(ctx, templateName, convertedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = r.prefetcher.Prefetch(ctx, model.FetchRequestsFromInputs(appCreateInput.FetchRequestInputs()))
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.appSvc.Create(ctx, appCreateInput)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating Application from Application Template with name %s", templateName)
+	}
+
+	app, err := r.appSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.notifier.NotifyApplicationLifecycle(ctx, id, model.WebhookTypeRegisterApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.appConverter.ToGraphQL(app), nil
+}
+
+// UpgradeApplicationFromTemplate renders the previous and desired Application inputs in a separate transaction and downloads
+// the specifications of the desired input before the Application is upgraded, as the changed objects are only known during the upgrade.
+func (r *Resolver) UpgradeApplicationFromTemplate(ctx context.Context, appID string, values []*graphql.TemplateValueInput) (*graphql.Application, error) {
+	convertedValues := r.appTemplateConverter.ValuesFromGraphQL(values)
+
+	previousInput, desiredInput, err := r.renderUpgradeInputs(ctx, appID, convertedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = r.prefetcher.Prefetch(ctx, model.FetchRequestsFromInputs(desiredInput.FetchRequestInputs()))
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = r.appSvc.UpgradeFromTemplate(ctx, appID, previousInput, desiredInput)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while upgrading Application with ID %s from Application Template", appID)
+	}
+
+	app, err := r.appSvc.Get(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.appConverter.ToGraphQL(app), nil
+}
+
+func (r *Resolver) renderRegisterInput(ctx context.Context, templateName string, values []*model.ApplicationTemplateValueInput) (model.ApplicationCreateInput, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return model.ApplicationCreateInput{}, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	appTemplate, err := r.appTemplateSvc.GetByName(ctx, templateName)
+	if err != nil {
+		return model.ApplicationCreateInput{}, err
+	}
+
+	appCreateInput, err := r.prepareApplicationCreateInput(appTemplate, values)
+	if err != nil {
+		return model.ApplicationCreateInput{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return model.ApplicationCreateInput{}, err
+	}
+
+	return appCreateInput, nil
+}
+
+func (r *Resolver) renderUpgradeInputs(ctx context.Context, appID string, values []*model.ApplicationTemplateValueInput) (*model.ApplicationCreateInput, model.ApplicationCreateInput, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, model.ApplicationCreateInput{}, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	app, err := r.appSvc.Get(ctx, appID)
+	if err != nil {
+		return nil, model.ApplicationCreateInput{}, err
+	}
+
+	if app.ApplicationTemplateID == nil {
+		return nil, model.ApplicationCreateInput{}, fmt.Errorf("application with ID %s was not registered from an Application Template", appID)
+	}
+
+	appTemplate, err := r.appTemplateSvc.Get(ctx, *app.ApplicationTemplateID)
+	if err != nil {
+		return nil, model.ApplicationCreateInput{}, err
+	}
+
+	var previousInput *model.ApplicationCreateInput
+	if app.ApplicationTemplateVersion != nil {
+		previousAppTemplate, err := r.appTemplateSvc.GetVersion(ctx, appTemplate.ID, *app.ApplicationTemplateVersion)
+		if err != nil {
+			return nil, model.ApplicationCreateInput{}, err
+		}
+
+		// values of sensitive placeholders are not stored, so the provided ones are used for rendering the previous version too
+		previousValues := append(filterValues(previousAppTemplate, values, true), app.ApplicationTemplateValues...)
+		in, err := r.prepareApplicationCreateInput(previousAppTemplate, previousValues)
+		if err != nil {
+			return nil, model.ApplicationCreateInput{}, errors.Wrapf(err, "while preparing Application input from version %d", previousAppTemplate.Version)
+		}
+		previousInput = &in
+	}
+
+	desiredInput, err := r.prepareApplicationCreateInput(appTemplate, upgradeValues(appTemplate, app.ApplicationTemplateValues, values))
+	if err != nil {
+		return nil, model.ApplicationCreateInput{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, model.ApplicationCreateInput{}, err
+	}
+
+	return previousInput, desiredInput, nil
+}
+
+// prepareApplicationCreateInput renders Application input from the template, remembering the template version and provided values in it.
+// Values of sensitive placeholders are not remembered, so they have to be provided again when the Application is upgraded.
+func (r *Resolver) prepareApplicationCreateInput(appTemplate *model.ApplicationTemplate, values []*model.ApplicationTemplateValueInput) (model.ApplicationCreateInput, error) {
+	appCreateInputJSON, err := r.appTemplateSvc.PrepareApplicationCreateInputJSON(appTemplate, values)
+	if err != nil {
+		return model.ApplicationCreateInput{}, errors.Wrapf(err, "while preparing Application input from Application Template with name %s", appTemplate.Name)
+	}
+
+	appCreateInputGQL, err := r.appTemplateConverter.ApplicationCreateInputJSONToGQL(appCreateInputJSON)
+	if err != nil {
+		return model.ApplicationCreateInput{}, errors.Wrap(err, "while converting Application input")
+	}
+
+	appCreateInput := r.appConverter.CreateInputFromGraphQL(appCreateInputGQL)
+	appCreateInput.ApplicationTemplateID = &appTemplate.ID
+	appCreateInput.ApplicationTemplateVersion = &appTemplate.Version
+	appCreateInput.ApplicationTemplateValues = filterValues(appTemplate, values, false)
+
+	return appCreateInput, nil
+}
+
+// upgradeValues returns provided values completed with the ones used before for placeholders still declared in the template
+func upgradeValues(appTemplate *model.ApplicationTemplate, previous, provided []*model.ApplicationTemplateValueInput) []*model.ApplicationTemplateValueInput {
+	declared := make(map[string]struct{}, len(appTemplate.Placeholders))
+	for _, placeholder := range appTemplate.Placeholders {
+		declared[placeholder.Name] = struct{}{}
+	}
+
+	overridden := make(map[string]struct{}, len(provided))
+	for _, value := range provided {
+		overridden[value.Placeholder] = struct{}{}
+	}
+
+	values := append([]*model.ApplicationTemplateValueInput{}, provided...)
+	for _, value := range previous {
+		_, isDeclared := declared[value.Placeholder]
+		_, isOverridden := overridden[value.Placeholder]
+		if isDeclared && !isOverridden {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// filterValues returns the values of sensitive or not sensitive placeholders of the template
+func filterValues(appTemplate *model.ApplicationTemplate, values []*model.ApplicationTemplateValueInput, sensitive bool) []*model.ApplicationTemplateValueInput {
+	sensitivePlaceholders := make(map[string]struct{})
+	for _, placeholder := range appTemplate.Placeholders {
+		if placeholder.Sensitive {
+			sensitivePlaceholders[placeholder.Name] = struct{}{}
+		}
+	}
+
+	var filtered []*model.ApplicationTemplateValueInput
+	for _, value := range values {
+		if _, isSensitive := sensitivePlaceholders[value.Placeholder]; isSensitive == sensitive {
+			filtered = append(filtered, value)
+		}
+	}
+
+	return filtered
+}
@@ -2,6 +2,7 @@ package apptemplate_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -15,7 +16,9 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -129,7 +132,7 @@ func TestResolver_ApplicationTemplate(t *testing.T) {
 			appTemplateSvc := testCase.AppTemplateSvcFn()
 			appTemplateConv := testCase.AppTemplateConvFn()
 
-			resolver := apptemplate.NewResolver(transact, appTemplateSvc, appTemplateConv)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, nil, nil)
 
 			// WHEN
 			result, err := resolver.ApplicationTemplate(ctx, testID)
@@ -256,7 +259,7 @@ func TestResolver_ApplicationTemplates(t *testing.T) {
 			appTemplateSvc := testCase.AppTemplateSvcFn()
 			appTemplateConv := testCase.AppTemplateConvFn()
 
-			resolver := apptemplate.NewResolver(transact, appTemplateSvc, appTemplateConv)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, nil, nil)
 
 			// WHEN
 			result, err := resolver.ApplicationTemplates(ctx, &first, &gqlAfter)
@@ -413,7 +416,7 @@ func TestResolver_CreateApplicationTemplate(t *testing.T) {
 			appTemplateSvc := testCase.AppTemplateSvcFn()
 			appTemplateConv := testCase.AppTemplateConvFn()
 
-			resolver := apptemplate.NewResolver(transact, appTemplateSvc, appTemplateConv)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, nil, nil)
 
 			// WHEN
 			result, err := resolver.CreateApplicationTemplate(ctx, *gqlAppTemplateInput)
@@ -570,7 +573,7 @@ func TestResolver_UpdateApplicationTemplate(t *testing.T) {
 			appTemplateSvc := testCase.AppTemplateSvcFn()
 			appTemplateConv := testCase.AppTemplateConvFn()
 
-			resolver := apptemplate.NewResolver(transact, appTemplateSvc, appTemplateConv)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, nil, nil)
 
 			// WHEN
 			result, err := resolver.UpdateApplicationTemplate(ctx, testID, *gqlAppTemplateInput)
@@ -705,7 +708,7 @@ func TestResolver_DeleteApplicationTemplate(t *testing.T) {
 			persist, transact := testCase.TxFn()
 			appTemplateSvc := testCase.AppTemplateSvcFn()
 			appTemplateConv := testCase.AppTemplateConvFn()
-			resolver := apptemplate.NewResolver(transact, appTemplateSvc, appTemplateConv)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, nil, nil)
 
 			// WHEN
 			result, err := resolver.DeleteApplicationTemplate(ctx, testID)
@@ -726,3 +729,648 @@ func TestResolver_DeleteApplicationTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	appInputJSON := `{"name":"{{name}}"}`
+	appTemplate := fixModelAppTemplateWithPlaceholders(testID, testName, appInputJSON, "name")
+	gqlValues := fixGQLTemplateValues("name", "foo")
+	modelValues := fixModelTemplateValues("name", "foo")
+
+	preparedJSON := `{"name":"foo"}`
+	gqlAppCreateInput := graphql.ApplicationCreateInput{Name: "foo"}
+	modelAppCreateInput := model.ApplicationCreateInput{Name: "foo"}
+	modelAppCreateInputWithTemplate := model.ApplicationCreateInput{Name: "foo", ApplicationTemplateID: str.Ptr(testID), ApplicationTemplateVersion: &appTemplate.Version, ApplicationTemplateValues: modelValues}
+
+	appID := "app"
+	modelApp := &model.Application{ID: appID, Name: "foo", ApplicationTemplateID: str.Ptr(testID)}
+	gqlApp := &graphql.Application{ID: appID, Name: "foo", ApplicationTemplateID: str.Ptr(testID)}
+
+	prefetcherThatSucceeds := func() *automock.SpecPrefetcher {
+		prefetcher := &automock.SpecPrefetcher{}
+		prefetcher.On("Prefetch", ctx, []*model.FetchRequest(nil)).Return(ctx).Once()
+		return prefetcher
+	}
+
+	testCases := []struct {
+		Name              string
+		TxFn              func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppTemplateSvcFn  func() *automock.ApplicationTemplateService
+		AppTemplateConvFn func() *automock.ApplicationTemplateConverter
+		AppSvcFn          func() *automock.ApplicationService
+		AppConvFn         func() *automock.ApplicationConverter
+		NotifierFn        func() *automock.WebhookNotifier
+		PrefetcherFn      func() *automock.SpecPrefetcher
+		ExpectedOutput    *graphql.Application
+		ExpectedError     error
+	}{
+		{
+			Name: "Success",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			PrefetcherFn: prefetcherThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return(preparedJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", preparedJSON).Return(gqlAppCreateInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInputWithTemplate).Return(appID, nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelApp, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", gqlAppCreateInput).Return(modelAppCreateInput).Once()
+				appConv.On("ToGraphQL", modelApp).Return(gqlApp).Once()
+				return appConv
+			},
+			NotifierFn: func() *automock.WebhookNotifier {
+				notifier := &automock.WebhookNotifier{}
+				notifier.On("NotifyApplicationLifecycle", txtest.CtxWithDBMatcher(), appID, model.WebhookTypeRegisterApplication).Return(false, nil).Once()
+				return notifier
+			},
+			ExpectedOutput: gqlApp,
+		},
+		{
+			Name: "Returns error when getting application template failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(nil, testError).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn:      fixEmptyAppSvc,
+			AppConvFn:     fixEmptyAppConv,
+			NotifierFn:    fixEmptyNotifier,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when preparing application input failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return("", testError).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn:      fixEmptyAppSvc,
+			AppConvFn:     fixEmptyAppConv,
+			NotifierFn:    fixEmptyNotifier,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when converting application input failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return(preparedJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", preparedJSON).Return(graphql.ApplicationCreateInput{}, testError).Once()
+				return appTemplateConv
+			},
+			AppSvcFn:      fixEmptyAppSvc,
+			AppConvFn:     fixEmptyAppConv,
+			NotifierFn:    fixEmptyNotifier,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when creating application failed",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommitAfterSucceeding(1)
+			},
+			PrefetcherFn: prefetcherThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return(preparedJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", preparedJSON).Return(gqlAppCreateInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInputWithTemplate).Return("", testError).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", gqlAppCreateInput).Return(modelAppCreateInput).Once()
+				return appConv
+			},
+			NotifierFn:    fixEmptyNotifier,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				return &automock.ApplicationTemplateService{}
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn:      fixEmptyAppSvc,
+			AppConvFn:     fixEmptyAppConv,
+			NotifierFn:    fixEmptyNotifier,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatFailsOnCommitAfterSucceeding(1)
+			},
+			PrefetcherFn: prefetcherThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return(preparedJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", preparedJSON).Return(gqlAppCreateInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInputWithTemplate).Return(appID, nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelApp, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", gqlAppCreateInput).Return(modelAppCreateInput).Once()
+				return appConv
+			},
+			NotifierFn: func() *automock.WebhookNotifier {
+				notifier := &automock.WebhookNotifier{}
+				notifier.On("NotifyApplicationLifecycle", txtest.CtxWithDBMatcher(), appID, model.WebhookTypeRegisterApplication).Return(false, nil).Once()
+				return notifier
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when notifying Webhooks failed",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommitAfterSucceeding(1)
+			},
+			PrefetcherFn: prefetcherThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return(preparedJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", preparedJSON).Return(gqlAppCreateInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInputWithTemplate).Return(appID, nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelApp, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", gqlAppCreateInput).Return(modelAppCreateInput).Once()
+				return appConv
+			},
+			NotifierFn: func() *automock.WebhookNotifier {
+				notifier := &automock.WebhookNotifier{}
+				notifier.On("NotifyApplicationLifecycle", txtest.CtxWithDBMatcher(), appID, model.WebhookTypeRegisterApplication).Return(false, testError).Once()
+				return notifier
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			appTemplateSvc := testCase.AppTemplateSvcFn()
+			appTemplateConv := testCase.AppTemplateConvFn()
+			appSvc := testCase.AppSvcFn()
+			appConv := testCase.AppConvFn()
+			notifier := testCase.NotifierFn()
+			prefetcher := &automock.SpecPrefetcher{}
+			if testCase.PrefetcherFn != nil {
+				prefetcher = testCase.PrefetcherFn()
+			}
+
+			resolver := apptemplate.NewResolver(transact, appSvc, appConv, appTemplateSvc, appTemplateConv, notifier, prefetcher)
+
+			// WHEN
+			result, err := resolver.RegisterApplicationFromTemplate(ctx, testName, gqlValues)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			appTemplateSvc.AssertExpectations(t)
+			appTemplateConv.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			appConv.AssertExpectations(t)
+			notifier.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_UpgradeApplicationFromTemplate(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	previousVersion := 1
+	previousAppTemplate := fixModelAppTemplateWithPlaceholders(testID, testName, `{"name":"{{name}}"}`, "name")
+	appTemplate := fixModelAppTemplateWithPlaceholders(testID, testName, `{"name":"{{name}}","description":"{{description}}"}`, "name", "description")
+	appTemplate.Version = 2
+
+	gqlValues := fixGQLTemplateValues("description", "bar")
+	modelValues := fixModelTemplateValues("description", "bar")
+	storedValues := append(fixModelTemplateValues("name", "foo"), fixModelTemplateValues("removed", "baz")...)
+	upgradeValues := append(fixModelTemplateValues("description", "bar"), fixModelTemplateValues("name", "foo")...)
+
+	previousJSON := `{"name":"foo"}`
+	previousGQLInput := graphql.ApplicationCreateInput{Name: "foo"}
+	previousModelInput := model.ApplicationCreateInput{Name: "foo"}
+	previousModelInputWithTemplate := model.ApplicationCreateInput{Name: "foo", ApplicationTemplateID: str.Ptr(testID), ApplicationTemplateVersion: &previousAppTemplate.Version, ApplicationTemplateValues: storedValues}
+
+	desiredJSON := `{"name":"foo","description":"bar"}`
+	desiredGQLInput := graphql.ApplicationCreateInput{Name: "foo", Description: str.Ptr("bar")}
+	desiredAPIs := []*model.APIDefinitionInput{{Name: "api", Spec: &model.APISpecInput{FetchRequest: &model.FetchRequestInput{URL: "foo.bar"}}}}
+	desiredModelInput := model.ApplicationCreateInput{Name: "foo", Description: str.Ptr("bar"), Apis: desiredAPIs}
+	desiredModelInputWithTemplate := model.ApplicationCreateInput{Name: "foo", Description: str.Ptr("bar"), Apis: desiredAPIs, ApplicationTemplateID: str.Ptr(testID), ApplicationTemplateVersion: &appTemplate.Version, ApplicationTemplateValues: upgradeValues}
+
+	appID := "app"
+	modelApp := &model.Application{ID: appID, Name: "foo", ApplicationTemplateID: str.Ptr(testID), ApplicationTemplateVersion: &previousVersion, ApplicationTemplateValues: storedValues}
+	modelAppWithoutVersion := &model.Application{ID: appID, Name: "foo", ApplicationTemplateID: str.Ptr(testID)}
+	modelAppWithoutTemplate := &model.Application{ID: appID, Name: "foo"}
+	upgradedModelApp := &model.Application{ID: appID, Name: "foo", Description: str.Ptr("bar"), ApplicationTemplateID: str.Ptr(testID), ApplicationTemplateVersion: &appTemplate.Version}
+	gqlApp := &graphql.Application{ID: appID, Name: "foo", Description: str.Ptr("bar"), ApplicationTemplateID: str.Ptr(testID), ApplicationTemplateVersion: &appTemplate.Version}
+
+	sensitivePreviousAppTemplate := fixModelAppTemplateWithPlaceholders(testID, testName, `{"name":"{{name}}"}`, "name", "password")
+	sensitivePreviousAppTemplate.Placeholders[1].Sensitive = true
+	sensitiveAppTemplate := fixModelAppTemplateWithPlaceholders(testID, testName, `{"name":"{{name}}","description":"{{description}}"}`, "name", "description", "password")
+	sensitiveAppTemplate.Placeholders[2].Sensitive = true
+	sensitiveAppTemplate.Version = 2
+	sensitiveValues := fixModelTemplateValues("password", "secret")
+	modelValuesWithSensitive := append(fixModelTemplateValues("description", "bar"), sensitiveValues...)
+	previousValuesWithSensitive := append(fixModelTemplateValues("password", "secret"), storedValues...)
+	upgradeValuesWithSensitive := append(fixModelTemplateValues("description", "bar"), append(fixModelTemplateValues("password", "secret"), fixModelTemplateValues("name", "foo")...)...)
+	previousModelInputWithSensitiveTemplate := previousModelInputWithTemplate
+	previousModelInputWithSensitiveTemplate.ApplicationTemplateValues = storedValues
+	desiredModelInputWithSensitiveTemplate := desiredModelInputWithTemplate
+	desiredModelInputWithSensitiveTemplate.ApplicationTemplateValues = upgradeValues
+
+	prefetcherThatSucceeds := func() *automock.SpecPrefetcher {
+		prefetcher := &automock.SpecPrefetcher{}
+		prefetchedFetchRequests := []*model.FetchRequest{{URL: "foo.bar", Mode: model.FetchModeSingle, Status: &model.FetchRequestStatus{Condition: model.FetchRequestStatusConditionInitial}}}
+		prefetcher.On("Prefetch", ctx, prefetchedFetchRequests).Return(ctx).Once()
+		return prefetcher
+	}
+
+	testCases := []struct {
+		Name              string
+		TxFn              func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppTemplateSvcFn  func() *automock.ApplicationTemplateService
+		AppTemplateConvFn func() *automock.ApplicationTemplateConverter
+		AppSvcFn          func() *automock.ApplicationService
+		AppConvFn         func() *automock.ApplicationConverter
+		PrefetcherFn      func() *automock.SpecPrefetcher
+		ExpectedOutput    *graphql.Application
+		ExpectedError     error
+	}{
+		{
+			Name: "Success",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			PrefetcherFn: prefetcherThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("GetVersion", txtest.CtxWithDBMatcher(), testID, previousVersion).Return(previousAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", previousAppTemplate, storedValues).Return(previousJSON, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, upgradeValues).Return(desiredJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", previousJSON).Return(previousGQLInput, nil).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", desiredJSON).Return(desiredGQLInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelApp, nil).Once()
+				appSvc.On("UpgradeFromTemplate", txtest.CtxWithDBMatcher(), appID, &previousModelInputWithTemplate, desiredModelInputWithTemplate).Return(nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(upgradedModelApp, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", previousGQLInput).Return(previousModelInput).Once()
+				appConv.On("CreateInputFromGraphQL", desiredGQLInput).Return(desiredModelInput).Once()
+				appConv.On("ToGraphQL", upgradedModelApp).Return(gqlApp).Once()
+				return appConv
+			},
+			ExpectedOutput: gqlApp,
+		},
+		{
+			Name: "Success when values of sensitive placeholders are provided again",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			PrefetcherFn: prefetcherThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(sensitiveAppTemplate, nil).Once()
+				appTemplateSvc.On("GetVersion", txtest.CtxWithDBMatcher(), testID, previousVersion).Return(sensitivePreviousAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", sensitivePreviousAppTemplate, previousValuesWithSensitive).Return(previousJSON, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", sensitiveAppTemplate, upgradeValuesWithSensitive).Return(desiredJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValuesWithSensitive).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", previousJSON).Return(previousGQLInput, nil).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", desiredJSON).Return(desiredGQLInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelApp, nil).Once()
+				appSvc.On("UpgradeFromTemplate", txtest.CtxWithDBMatcher(), appID, &previousModelInputWithSensitiveTemplate, desiredModelInputWithSensitiveTemplate).Return(nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(upgradedModelApp, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", previousGQLInput).Return(previousModelInput).Once()
+				appConv.On("CreateInputFromGraphQL", desiredGQLInput).Return(desiredModelInput).Once()
+				appConv.On("ToGraphQL", upgradedModelApp).Return(gqlApp).Once()
+				return appConv
+			},
+			ExpectedOutput: gqlApp,
+		},
+		{
+			Name: "Success when previous version of Application Template is unknown",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			PrefetcherFn: prefetcherThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return(desiredJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", desiredJSON).Return(desiredGQLInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				desiredInput := desiredModelInputWithTemplate
+				desiredInput.ApplicationTemplateValues = modelValues
+
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelAppWithoutVersion, nil).Once()
+				appSvc.On("UpgradeFromTemplate", txtest.CtxWithDBMatcher(), appID, (*model.ApplicationCreateInput)(nil), desiredInput).Return(nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(upgradedModelApp, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", desiredGQLInput).Return(desiredModelInput).Once()
+				appConv.On("ToGraphQL", upgradedModelApp).Return(gqlApp).Once()
+				return appConv
+			},
+			ExpectedOutput: gqlApp,
+		},
+		{
+			Name:             "Returns error when getting application failed",
+			TxFn:             txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: fixEmptyAppTemplateSvc,
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(nil, testError).Once()
+				return appSvc
+			},
+			AppConvFn:     fixEmptyAppConv,
+			ExpectedError: testError,
+		},
+		{
+			Name:             "Returns error when application was not registered from template",
+			TxFn:             txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: fixEmptyAppTemplateSvc,
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelAppWithoutTemplate, nil).Once()
+				return appSvc
+			},
+			AppConvFn:     fixEmptyAppConv,
+			ExpectedError: errors.New("application with ID app was not registered from an Application Template"),
+		},
+		{
+			Name: "Returns error when getting previous version of application template failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("GetVersion", txtest.CtxWithDBMatcher(), testID, previousVersion).Return(nil, testError).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelApp, nil).Once()
+				return appSvc
+			},
+			AppConvFn:     fixEmptyAppConv,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when preparing desired application input failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return("", testError).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelAppWithoutVersion, nil).Once()
+				return appSvc
+			},
+			AppConvFn:     fixEmptyAppConv,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when upgrading application failed",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommitAfterSucceeding(1)
+			},
+			PrefetcherFn: prefetcherThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(appTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, modelValues).Return(desiredJSON, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				appTemplateConv.On("ApplicationCreateInputJSONToGQL", desiredJSON).Return(desiredGQLInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(modelAppWithoutVersion, nil).Once()
+				appSvc.On("UpgradeFromTemplate", txtest.CtxWithDBMatcher(), appID, (*model.ApplicationCreateInput)(nil), mock.Anything).Return(testError).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", desiredGQLInput).Return(desiredModelInput).Once()
+				return appConv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:             "Returns error when beginning transaction",
+			TxFn:             txGen.ThatFailsOnBegin,
+			AppTemplateSvcFn: fixEmptyAppTemplateSvc,
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ValuesFromGraphQL", gqlValues).Return(modelValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn:      fixEmptyAppSvc,
+			AppConvFn:     fixEmptyAppConv,
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			appTemplateSvc := testCase.AppTemplateSvcFn()
+			appTemplateConv := testCase.AppTemplateConvFn()
+			appSvc := testCase.AppSvcFn()
+			appConv := testCase.AppConvFn()
+			prefetcher := &automock.SpecPrefetcher{}
+			if testCase.PrefetcherFn != nil {
+				prefetcher = testCase.PrefetcherFn()
+			}
+
+			resolver := apptemplate.NewResolver(transact, appSvc, appConv, appTemplateSvc, appTemplateConv, nil, prefetcher)
+
+			// WHEN
+			result, err := resolver.UpgradeApplicationFromTemplate(ctx, appID, gqlValues)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			appTemplateSvc.AssertExpectations(t)
+			appTemplateConv.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			appConv.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
+		})
+	}
+}
+
+func fixEmptyNotifier() *automock.WebhookNotifier {
+	return &automock.WebhookNotifier{}
+}
+
+func fixEmptyAppTemplateSvc() *automock.ApplicationTemplateService {
+	return &automock.ApplicationTemplateService{}
+}
+
+func fixEmptyAppSvc() *automock.ApplicationService {
+	return &automock.ApplicationService{}
+}
+
+func fixEmptyAppConv() *automock.ApplicationConverter {
+	return &automock.ApplicationConverter{}
+}
@@ -2,21 +2,32 @@ package apptemplate
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
 
 	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 )
 
+// globalAccessLevelScopesPath points to scopes required for creating, updating and deleting global Application Templates
+const globalAccessLevelScopesPath = "applicationTemplateAccessLevels.global"
+
 //go:generate mockery -name=ApplicationTemplateRepository -output=automock -outpkg=automock -case=underscore
 type ApplicationTemplateRepository interface {
 	Create(ctx context.Context, item model.ApplicationTemplate) error
-	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
-	Exists(ctx context.Context, id string) (bool, error)
-	List(ctx context.Context, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
+	Get(ctx context.Context, tenant, id string) (*model.ApplicationTemplate, error)
+	GetByName(ctx context.Context, tenant, name string) (*model.ApplicationTemplate, error)
+	GetForUpdate(ctx context.Context, tenant, id string) (*model.ApplicationTemplate, error)
+	Exists(ctx context.Context, tenant, id string) (bool, error)
+	List(ctx context.Context, tenant string, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
 	Update(ctx context.Context, model model.ApplicationTemplate) error
 	Delete(ctx context.Context, id string) error
+	CreateVersion(ctx context.Context, item model.ApplicationTemplate) error
+	GetVersion(ctx context.Context, id string, version int) (*model.ApplicationTemplate, error)
 }
 
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
@@ -24,16 +35,25 @@ type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=ScopesGetter -output=automock -outpkg=automock -case=underscore
+type ScopesGetter interface {
+	GetRequiredScopes(scopesDefinition string) ([]string, error)
+}
+
+var placeholderTokenRegex = regexp.MustCompile(`\{\{([^{}]*)\}\}`)
+
 type service struct {
 	appTemplateRepo ApplicationTemplateRepository
 
-	uidService UIDService
+	uidService   UIDService
+	scopesGetter ScopesGetter
 }
 
-func NewService(appTemplateRepo ApplicationTemplateRepository, uidService UIDService) *service {
+func NewService(appTemplateRepo ApplicationTemplateRepository, uidService UIDService, scopesGetter ScopesGetter) *service {
 	return &service{
 		appTemplateRepo: appTemplateRepo,
 		uidService:      uidService,
+		scopesGetter:    scopesGetter,
 	}
 }
 
@@ -46,16 +66,48 @@ func (s *service) Create(ctx context.Context, in model.ApplicationTemplateInput)
 		return "", fmt.Errorf("while creating Application Template [name=%s]: placeholder [name=%s] appears more than once", in.Name, faultyPlaceholder)
 	}
 
-	err := s.appTemplateRepo.Create(ctx, appTemplate)
+	err := s.validatePlaceholders(appTemplate)
+	if err != nil {
+		return "", errors.Wrapf(err, "while creating Application Template [name=%s]", in.Name)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if appTemplate.AccessLevel == model.GlobalApplicationTemplateAccessLevel {
+		if err := s.verifyGlobalAccess(ctx); err != nil {
+			return "", errors.Wrapf(err, "while creating Application Template [name=%s]", in.Name)
+		}
+	}
+
+	appTemplate.Tenant, err = ownerTenant(tnt, appTemplate.AccessLevel)
+	if err != nil {
+		return "", errors.Wrapf(err, "while creating Application Template [name=%s]", in.Name)
+	}
+
+	appTemplate.Version = 1
+	err = s.appTemplateRepo.Create(ctx, appTemplate)
 	if err != nil {
 		return "", errors.Wrap(err, "while creating Application Template")
 	}
 
+	err = s.appTemplateRepo.CreateVersion(ctx, appTemplate)
+	if err != nil {
+		return "", errors.Wrap(err, "while creating first version of Application Template")
+	}
+
 	return id, nil
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.ApplicationTemplate, error) {
-	appTemplate, err := s.appTemplateRepo.Get(ctx, id)
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appTemplate, err := s.appTemplateRepo.Get(ctx, tnt, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting Application Template with ID %s", id)
 	}
@@ -63,8 +115,44 @@ func (s *service) Get(ctx context.Context, id string) (*model.ApplicationTemplat
 	return appTemplate, nil
 }
 
+// GetVersion returns Application Template as it was in given version
+func (s *service) GetVersion(ctx context.Context, id string, version int) (*model.ApplicationTemplate, error) {
+	current, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	appTemplate, err := s.appTemplateRepo.GetVersion(ctx, id, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting version %d of Application Template with ID %s", version, id)
+	}
+	appTemplate.AccessLevel = current.AccessLevel
+	appTemplate.Tenant = current.Tenant
+
+	return appTemplate, nil
+}
+
+func (s *service) GetByName(ctx context.Context, name string) (*model.ApplicationTemplate, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appTemplate, err := s.appTemplateRepo.GetByName(ctx, tnt, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Application Template with name %s", name)
+	}
+
+	return appTemplate, nil
+}
+
 func (s *service) Exists(ctx context.Context, id string) (bool, error) {
-	exist, err := s.appTemplateRepo.Exists(ctx, id)
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	exist, err := s.appTemplateRepo.Exists(ctx, tnt, id)
 	if err != nil {
 		return false, errors.Wrapf(err, "while getting Application Template with ID %s", id)
 	}
@@ -77,7 +165,12 @@ func (s *service) List(ctx context.Context, pageSize int, cursor string) (model.
 		return model.ApplicationTemplatePage{}, errors.New("page size must be between 1 and 100")
 	}
 
-	return s.appTemplateRepo.List(ctx, pageSize, cursor)
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return model.ApplicationTemplatePage{}, err
+	}
+
+	return s.appTemplateRepo.List(ctx, tnt, pageSize, cursor)
 }
 
 func (s *service) Update(ctx context.Context, id string, in model.ApplicationTemplateInput) error {
@@ -85,19 +178,67 @@ func (s *service) Update(ctx context.Context, id string, in model.ApplicationTem
 
 	faultyPlaceholder, ok := s.checkIfPlaceholdersAreUnique(appTemplate.Placeholders)
 	if !ok {
-		return fmt.Errorf("while creating Application Template [name=%s]: placeholder [name=%s] appears more than once", in.Name, faultyPlaceholder)
+		return fmt.Errorf("while updating Application Template [name=%s]: placeholder [name=%s] appears more than once", in.Name, faultyPlaceholder)
 	}
 
-	err := s.appTemplateRepo.Update(ctx, appTemplate)
+	err := s.validatePlaceholders(appTemplate)
+	if err != nil {
+		return errors.Wrapf(err, "while updating Application Template [name=%s]", in.Name)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.appTemplateRepo.GetForUpdate(ctx, tnt, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting Application Template with ID %s", id)
+	}
+
+	if existing.AccessLevel == model.GlobalApplicationTemplateAccessLevel || appTemplate.AccessLevel == model.GlobalApplicationTemplateAccessLevel {
+		if err := s.verifyGlobalAccess(ctx); err != nil {
+			return errors.Wrapf(err, "while updating Application Template with ID %s", id)
+		}
+	}
+
+	appTemplate.Tenant, err = ownerTenant(tnt, appTemplate.AccessLevel)
 	if err != nil {
 		return errors.Wrapf(err, "while updating Application Template with ID %s", id)
 	}
 
+	appTemplate.Version = existing.Version + 1
+	err = s.appTemplateRepo.Update(ctx, appTemplate)
+	if err != nil {
+		return errors.Wrapf(err, "while updating Application Template with ID %s", id)
+	}
+
+	err = s.appTemplateRepo.CreateVersion(ctx, appTemplate)
+	if err != nil {
+		return errors.Wrapf(err, "while creating version %d of Application Template with ID %s", appTemplate.Version, id)
+	}
+
 	return nil
 }
 
 func (s *service) Delete(ctx context.Context, id string) error {
-	err := s.appTemplateRepo.Delete(ctx, id)
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	appTemplate, err := s.appTemplateRepo.Get(ctx, tnt, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting Application Template with ID %s", id)
+	}
+
+	if appTemplate.AccessLevel == model.GlobalApplicationTemplateAccessLevel {
+		if err := s.verifyGlobalAccess(ctx); err != nil {
+			return errors.Wrapf(err, "while deleting Application Template with ID %s", id)
+		}
+	}
+
+	err = s.appTemplateRepo.Delete(ctx, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Application Template with ID %s", id)
 	}
@@ -105,6 +246,63 @@ func (s *service) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// PrepareApplicationCreateInputJSON substitutes {{placeholder}} occurrences in the Application input of the template with provided values.
+// Missing values are replaced with defaults, or with empty strings for optional placeholders.
+func (s *service) PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values []*model.ApplicationTemplateValueInput) (string, error) {
+	declaredPlaceholders := make(map[string]model.ApplicationTemplatePlaceholder, len(appTemplate.Placeholders))
+	for _, placeholder := range appTemplate.Placeholders {
+		declaredPlaceholders[placeholder.Name] = placeholder
+	}
+
+	providedValues := make(map[string]string, len(values))
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		placeholder, exists := declaredPlaceholders[value.Placeholder]
+		if !exists {
+			return "", fmt.Errorf("placeholder [name=%s] is not defined in Application Template [name=%s]", value.Placeholder, appTemplate.Name)
+		}
+		if _, exists := providedValues[value.Placeholder]; exists {
+			return "", fmt.Errorf("value for placeholder [name=%s] provided more than once", value.Placeholder)
+		}
+		if err := placeholder.ValidateValue(value.Value); err != nil {
+			return "", err
+		}
+		providedValues[value.Placeholder] = value.Value
+	}
+
+	escapedValues := make(map[string]string, len(appTemplate.Placeholders))
+	for _, placeholder := range appTemplate.Placeholders {
+		value, exists := providedValues[placeholder.Name]
+		switch {
+		case exists:
+		case placeholder.Default != nil:
+			value = *placeholder.Default
+		case placeholder.Required:
+			return "", fmt.Errorf("value for placeholder [name=%s] not provided", placeholder.Name)
+		}
+
+		escapedValue, err := escapeJSONString(value)
+		if err != nil {
+			return "", errors.Wrapf(err, "while escaping value for placeholder [name=%s]", placeholder.Name)
+		}
+
+		escapedValues[placeholder.Name] = escapedValue
+	}
+
+	// all placeholders are replaced in one pass, so a value containing a placeholder token is not replaced again
+	appInputJSON := placeholderTokenRegex.ReplaceAllStringFunc(appTemplate.ApplicationInputJSON, func(token string) string {
+		escapedValue, exists := escapedValues[placeholderTokenRegex.FindStringSubmatch(token)[1]]
+		if !exists {
+			return token
+		}
+		return escapedValue
+	})
+
+	return appInputJSON, nil
+}
+
 func (s *service) checkIfPlaceholdersAreUnique(placeholders []model.ApplicationTemplatePlaceholder) (string, bool) {
 	keys := make(map[string]interface{})
 	for _, item := range placeholders {
@@ -117,3 +315,77 @@ func (s *service) checkIfPlaceholdersAreUnique(placeholders []model.ApplicationT
 	}
 	return "", true
 }
+
+// ownerTenant returns tenant that owns Application Template with given access level, global templates have no owner
+func ownerTenant(tnt string, accessLevel model.ApplicationTemplateAccessLevel) (*string, error) {
+	switch accessLevel {
+	case model.GlobalApplicationTemplateAccessLevel:
+		return nil, nil
+	case model.TenantApplicationTemplateAccessLevel:
+		return &tnt, nil
+	}
+
+	return nil, fmt.Errorf("unknown access level %s", accessLevel)
+}
+
+func (s *service) verifyGlobalAccess(ctx context.Context) error {
+	actualScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	requiredScopes, err := s.scopesGetter.GetRequiredScopes(globalAccessLevelScopesPath)
+	if err != nil {
+		return errors.Wrap(err, "while getting required scopes")
+	}
+
+	actual := make(map[string]struct{}, len(actualScopes))
+	for _, actualScope := range actualScopes {
+		actual[actualScope] = struct{}{}
+	}
+	for _, requiredScope := range requiredScopes {
+		if _, ok := actual[requiredScope]; !ok {
+			return errors.Wrapf(scope.InsufficientScopesError(requiredScopes, actualScopes), "while verifying access to %s Application Templates", model.GlobalApplicationTemplateAccessLevel)
+		}
+	}
+
+	return nil
+}
+
+// validatePlaceholders checks definitions of the placeholders and if the Application input refers to all of them and only to them
+func (s *service) validatePlaceholders(appTemplate model.ApplicationTemplate) error {
+	declaredPlaceholders := make(map[string]struct{}, len(appTemplate.Placeholders))
+	for _, placeholder := range appTemplate.Placeholders {
+		if err := placeholder.Validate(); err != nil {
+			return err
+		}
+		declaredPlaceholders[placeholder.Name] = struct{}{}
+	}
+
+	usedPlaceholders := make(map[string]struct{})
+	for _, match := range placeholderTokenRegex.FindAllStringSubmatch(appTemplate.ApplicationInputJSON, -1) {
+		name := match[1]
+		if _, exists := declaredPlaceholders[name]; !exists {
+			return fmt.Errorf("placeholder [name=%s] is used in Application input but it is not declared", name)
+		}
+		usedPlaceholders[name] = struct{}{}
+	}
+
+	for _, placeholder := range appTemplate.Placeholders {
+		if _, exists := usedPlaceholders[placeholder.Name]; !exists {
+			return fmt.Errorf("placeholder [name=%s] is declared but it is not used in Application input", placeholder.Name)
+		}
+	}
+
+	return nil
+}
+
+// escapeJSONString returns value escaped to be placed inside of a JSON string
+func escapeJSONString(value string) (string, error) {
+	marshalled, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(marshalled[1 : len(marshalled)-1]), nil
+}
@@ -12,22 +12,33 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestService_Create(t *testing.T) {
 	// GIVEN
-	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	ctxWithoutScopes := scope.SaveToContext(tenant.SaveToContext(context.TODO(), testTenant), []string{})
+	ctx := scope.SaveToContext(ctxWithoutScopes, []string{testGlobalScope})
 	uidSvcFn := func() *automock.UIDService {
 		uidSvc := &automock.UIDService{}
 		uidSvc.On("Generate").Return(testID).Once()
 		return uidSvc
 	}
 	modelAppTemplate := fixModelAppTemplate(testID, testName)
+	modelAppTemplate.ApplicationInputJSON = fixApplicationCreateInputWithPlaceholderString()
+	invalidRegex := "[a-z"
+	defaultValue := "foo"
+
+	tenantAppTemplateInput := fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString())
+	tenantAppTemplateInput.AccessLevel = model.TenantApplicationTemplateAccessLevel
+	tenantAppTemplate := fixModelTenantAppTemplate(testID, testName)
+	tenantAppTemplate.ApplicationInputJSON = fixApplicationCreateInputWithPlaceholderString()
 
 	testCases := []struct {
 		Name              string
+		Context           context.Context
 		Input             *model.ApplicationTemplateInput
 		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
 		ExpectedError     error
@@ -35,14 +46,37 @@ func TestService_Create(t *testing.T) {
 	}{
 		{
 			Name:  "Success",
-			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputString()),
+			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
 				appTemplateRepo.On("Create", ctx, *modelAppTemplate).Return(nil).Once()
+				appTemplateRepo.On("CreateVersion", ctx, *modelAppTemplate).Return(nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedOutput: testID,
+		},
+		{
+			Name:    "Success when creating tenant application template without global scopes",
+			Context: ctxWithoutScopes,
+			Input:   tenantAppTemplateInput,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Create", ctxWithoutScopes, *tenantAppTemplate).Return(nil).Once()
+				appTemplateRepo.On("CreateVersion", ctxWithoutScopes, *tenantAppTemplate).Return(nil).Once()
 				return appTemplateRepo
 			},
 			ExpectedOutput: testID,
 		},
+		{
+			Name:    "Error when creating global application template without global scopes",
+			Context: ctxWithoutScopes,
+			Input:   fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				return appTemplateRepo
+			},
+			ExpectedError: errors.New("while verifying access to GLOBAL Application Templates: insufficient scopes provided"),
+		},
 		{
 			Name: "Error when application template placeholders are not unique",
 			Input: &model.ApplicationTemplateInput{
@@ -64,8 +98,45 @@ func TestService_Create(t *testing.T) {
 			ExpectedError: fmt.Errorf("while creating Application Template [name=]: placeholder [name=%s] appears more than once", testName),
 		},
 		{
-			Name:  "Error when creating application template",
+			Name:  "Error when application input contains undeclared placeholder",
+			Input: fixModelAppTemplateInput(testName, `{"name":"{{name}}","description":"{{test}}"}`),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				return appTemplateRepo
+			},
+			ExpectedError: fmt.Errorf("while creating Application Template [name=%s]: placeholder [name=name] is used in Application input but it is not declared", testName),
+		},
+		{
+			Name:  "Error when declared placeholder is not used in application input",
 			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputString()),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				return appTemplateRepo
+			},
+			ExpectedError: fmt.Errorf("while creating Application Template [name=%s]: placeholder [name=test] is declared but it is not used in Application input", testName),
+		},
+		{
+			Name: "Error when placeholder definition is invalid",
+			Input: &model.ApplicationTemplateInput{
+				Name:                 testName,
+				ApplicationInputJSON: fixApplicationCreateInputWithPlaceholderString(),
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{
+						Name:    "test",
+						Regex:   &invalidRegex,
+						Default: &defaultValue,
+					},
+				},
+			},
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				return appTemplateRepo
+			},
+			ExpectedError: fmt.Errorf("while creating Application Template [name=%s]: while validating regex of placeholder [name=test]", testName),
+		},
+		{
+			Name:  "Error when creating application template",
+			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
 				appTemplateRepo.On("Create", ctx, *modelAppTemplate).Return(testError).Once()
@@ -74,13 +145,29 @@ func TestService_Create(t *testing.T) {
 			ExpectedError:  testError,
 			ExpectedOutput: "",
 		},
+		{
+			Name:  "Error when creating first version of application template",
+			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Create", ctx, *modelAppTemplate).Return(nil).Once()
+				appTemplateRepo.On("CreateVersion", ctx, *modelAppTemplate).Return(testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError:  testError,
+			ExpectedOutput: "",
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
 			idSvc := uidSvcFn()
-			svc := apptemplate.NewService(appTemplateRepo, idSvc)
+			svc := apptemplate.NewService(appTemplateRepo, idSvc, fixScopesGetter())
+			ctx := ctx
+			if testCase.Context != nil {
+				ctx = testCase.Context
+			}
 
 			// WHEN
 			result, err := svc.Create(ctx, *testCase.Input)
@@ -115,7 +202,7 @@ func TestService_Get(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Get", ctx, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
 				return appTemplateRepo
 			},
 			ExpectedOutput: modelAppTemplate,
@@ -124,7 +211,7 @@ func TestService_Get(t *testing.T) {
 			Name: "Error when getting application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Get", ctx, testID).Return(nil, testError).Once()
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(nil, testError).Once()
 				return appTemplateRepo
 			},
 			ExpectedError: testError,
@@ -134,7 +221,7 @@ func TestService_Get(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.Get(ctx, testID)
@@ -167,7 +254,7 @@ func TestService_Exists(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Exists", ctx, testID).Return(true, nil).Once()
+				appTemplateRepo.On("Exists", ctx, testTenant, testID).Return(true, nil).Once()
 				return appTemplateRepo
 			},
 			ExpectedOutput: true,
@@ -176,7 +263,7 @@ func TestService_Exists(t *testing.T) {
 			Name: "Error when getting application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Exists", ctx, testID).Return(false, testError).Once()
+				appTemplateRepo.On("Exists", ctx, testTenant, testID).Return(false, testError).Once()
 				return appTemplateRepo
 			},
 			ExpectedError:  testError,
@@ -187,7 +274,7 @@ func TestService_Exists(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.Exists(ctx, testID)
@@ -225,7 +312,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("List", ctx, 50, testCursor).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("List", ctx, testTenant, 50, testCursor).Return(modelAppTemplate, nil).Once()
 				return appTemplateRepo
 			},
 			InputPageSize:  50,
@@ -235,7 +322,7 @@ func TestService_List(t *testing.T) {
 			Name: "Error when listing application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("List", ctx, 50, testCursor).Return(model.ApplicationTemplatePage{}, testError).Once()
+				appTemplateRepo.On("List", ctx, testTenant, 50, testCursor).Return(model.ApplicationTemplatePage{}, testError).Once()
 				return appTemplateRepo
 			},
 			InputPageSize:  50,
@@ -267,7 +354,7 @@ func TestService_List(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.List(ctx, testCase.InputPageSize, testCursor)
@@ -286,26 +373,145 @@ func TestService_List(t *testing.T) {
 	}
 }
 
-func TestService_Update(t *testing.T) {
+func TestService_GetVersion(t *testing.T) {
 	// GIVEN
 	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	tenantAppTemplate := fixModelTenantAppTemplate(testID, testName)
+	tenantAppTemplate.Version = 2
+	versionAppTemplate := fixModelAppTemplate(testID, testName)
+	versionAppTemplate.AccessLevel = ""
+	expectedAppTemplate := *versionAppTemplate
+	expectedAppTemplate.AccessLevel = tenantAppTemplate.AccessLevel
+	expectedAppTemplate.Tenant = tenantAppTemplate.Tenant
+
+	testCases := []struct {
+		Name              string
+		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
+		ExpectedError     error
+		ExpectedOutput    *model.ApplicationTemplate
+	}{
+		{
+			Name: "Success",
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(tenantAppTemplate, nil).Once()
+				appTemplateRepo.On("GetVersion", ctx, testID, 1).Return(versionAppTemplate, nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedOutput: &expectedAppTemplate,
+		},
+		{
+			Name: "Error when getting application template",
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(nil, testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Error when getting application template version",
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(tenantAppTemplate, nil).Once()
+				appTemplateRepo.On("GetVersion", ctx, testID, 1).Return(nil, testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appTemplateRepo := testCase.AppTemplateRepoFn()
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
+
+			// WHEN
+			result, err := svc.GetVersion(ctx, testID, 1)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			appTemplateRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	// GIVEN
+	ctxWithoutScopes := scope.SaveToContext(tenant.SaveToContext(context.TODO(), testTenant), []string{})
+	ctx := scope.SaveToContext(ctxWithoutScopes, []string{testGlobalScope})
 	modelAppTemplate := fixModelAppTemplate(testID, testName)
+	modelAppTemplate.ApplicationInputJSON = fixApplicationCreateInputWithPlaceholderString()
+	tenantAppTemplate := fixModelTenantAppTemplate(testID, testName)
+	tenantAppTemplate.ApplicationInputJSON = fixApplicationCreateInputWithPlaceholderString()
+	updatedAppTemplate := *modelAppTemplate
+	updatedAppTemplate.Version = 2
+	updatedTenantAppTemplate := *tenantAppTemplate
+	updatedTenantAppTemplate.Version = 2
+	tenantAppTemplateInput := fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString())
+	tenantAppTemplateInput.AccessLevel = model.TenantApplicationTemplateAccessLevel
+	invalidRegex := "[a-z"
+	defaultValue := "foo"
 
 	testCases := []struct {
 		Name              string
+		Context           context.Context
 		Input             *model.ApplicationTemplateInput
 		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
 		ExpectedError     error
 	}{
 		{
 			Name:  "Success",
-			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputString()),
+			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Update", ctx, *modelAppTemplate).Return(nil).Once()
+				appTemplateRepo.On("GetForUpdate", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Update", ctx, updatedAppTemplate).Return(nil).Once()
+				appTemplateRepo.On("CreateVersion", ctx, updatedAppTemplate).Return(nil).Once()
 				return appTemplateRepo
 			},
 		},
+		{
+			Name:    "Success when updating tenant application template without global scopes",
+			Context: ctxWithoutScopes,
+			Input:   tenantAppTemplateInput,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("GetForUpdate", ctxWithoutScopes, testTenant, testID).Return(tenantAppTemplate, nil).Once()
+				appTemplateRepo.On("Update", ctxWithoutScopes, updatedTenantAppTemplate).Return(nil).Once()
+				appTemplateRepo.On("CreateVersion", ctxWithoutScopes, updatedTenantAppTemplate).Return(nil).Once()
+				return appTemplateRepo
+			},
+		},
+		{
+			Name:    "Error when updating global application template without global scopes",
+			Context: ctxWithoutScopes,
+			Input:   tenantAppTemplateInput,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("GetForUpdate", ctxWithoutScopes, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: errors.New("while verifying access to GLOBAL Application Templates: insufficient scopes provided"),
+		},
+		{
+			Name:    "Error when making tenant application template global without global scopes",
+			Context: ctxWithoutScopes,
+			Input:   fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("GetForUpdate", ctxWithoutScopes, testTenant, testID).Return(tenantAppTemplate, nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: errors.New("while verifying access to GLOBAL Application Templates: insufficient scopes provided"),
+		},
 		{
 			Name: "Error when application template placeholders are not unique",
 			Input: &model.ApplicationTemplateInput{
@@ -324,14 +530,74 @@ func TestService_Update(t *testing.T) {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
 				return appTemplateRepo
 			},
-			ExpectedError: fmt.Errorf("while creating Application Template [name=]: placeholder [name=%s] appears more than once", testName),
+			ExpectedError: fmt.Errorf("while updating Application Template [name=]: placeholder [name=%s] appears more than once", testName),
 		},
 		{
-			Name:  "Error when updating application template",
+			Name:  "Error when application input contains undeclared placeholder",
+			Input: fixModelAppTemplateInput(testName, `{"name":"{{name}}","description":"{{test}}"}`),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				return appTemplateRepo
+			},
+			ExpectedError: fmt.Errorf("while updating Application Template [name=%s]: placeholder [name=name] is used in Application input but it is not declared", testName),
+		},
+		{
+			Name:  "Error when declared placeholder is not used in application input",
 			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputString()),
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Update", ctx, *modelAppTemplate).Return(testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: fmt.Errorf("while updating Application Template [name=%s]: placeholder [name=test] is declared but it is not used in Application input", testName),
+		},
+		{
+			Name: "Error when placeholder definition is invalid",
+			Input: &model.ApplicationTemplateInput{
+				Name:                 testName,
+				ApplicationInputJSON: fixApplicationCreateInputWithPlaceholderString(),
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{
+						Name:    "test",
+						Regex:   &invalidRegex,
+						Default: &defaultValue,
+					},
+				},
+			},
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				return appTemplateRepo
+			},
+			ExpectedError: fmt.Errorf("while updating Application Template [name=%s]: while validating regex of placeholder [name=test]", testName),
+		},
+		{
+			Name:  "Error when getting application template",
+			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("GetForUpdate", ctx, testTenant, testID).Return(nil, testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:  "Error when updating application template",
+			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("GetForUpdate", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Update", ctx, updatedAppTemplate).Return(testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:  "Error when creating version of application template",
+			Input: fixModelAppTemplateInput(testName, fixApplicationCreateInputWithPlaceholderString()),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("GetForUpdate", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Update", ctx, updatedAppTemplate).Return(nil).Once()
+				appTemplateRepo.On("CreateVersion", ctx, updatedAppTemplate).Return(testError).Once()
 				return appTemplateRepo
 			},
 			ExpectedError: testError,
@@ -341,7 +607,11 @@ func TestService_Update(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, fixScopesGetter())
+			ctx := ctx
+			if testCase.Context != nil {
+				ctx = testCase.Context
+			}
 
 			// WHEN
 			err := svc.Update(ctx, testID, *testCase.Input)
@@ -361,10 +631,14 @@ func TestService_Update(t *testing.T) {
 
 func TestService_Delete(t *testing.T) {
 	// GIVEN
-	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	ctxWithoutScopes := scope.SaveToContext(tenant.SaveToContext(context.TODO(), testTenant), []string{})
+	ctx := scope.SaveToContext(ctxWithoutScopes, []string{testGlobalScope})
+	modelAppTemplate := fixModelAppTemplate(testID, testName)
+	tenantAppTemplate := fixModelTenantAppTemplate(testID, testName)
 
 	testCases := []struct {
 		Name              string
+		Context           context.Context
 		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
 		ExpectedError     error
 	}{
@@ -372,14 +646,45 @@ func TestService_Delete(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
 				appTemplateRepo.On("Delete", ctx, testID).Return(nil).Once()
 				return appTemplateRepo
 			},
 		},
+		{
+			Name:    "Success when deleting tenant application template without global scopes",
+			Context: ctxWithoutScopes,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctxWithoutScopes, testTenant, testID).Return(tenantAppTemplate, nil).Once()
+				appTemplateRepo.On("Delete", ctxWithoutScopes, testID).Return(nil).Once()
+				return appTemplateRepo
+			},
+		},
+		{
+			Name:    "Error when deleting global application template without global scopes",
+			Context: ctxWithoutScopes,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctxWithoutScopes, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: errors.New("while verifying access to GLOBAL Application Templates: insufficient scopes provided"),
+		},
+		{
+			Name: "Error when getting application template",
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(nil, testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: testError,
+		},
 		{
 			Name: "Error when deleting application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
 				appTemplateRepo.On("Delete", ctx, testID).Return(testError).Once()
 				return appTemplateRepo
 			},
@@ -390,7 +695,11 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, fixScopesGetter())
+			ctx := ctx
+			if testCase.Context != nil {
+				ctx = testCase.Context
+			}
 
 			// WHEN
 			err := svc.Delete(ctx, testID)
@@ -407,3 +716,224 @@ func TestService_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestService_GetByName(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	modelAppTemplate := fixModelAppTemplate(testID, testName)
+
+	testCases := []struct {
+		Name              string
+		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
+		ExpectedError     error
+		ExpectedOutput    *model.ApplicationTemplate
+	}{
+		{
+			Name: "Success",
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("GetByName", ctx, testTenant, testName).Return(modelAppTemplate, nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedOutput: modelAppTemplate,
+		},
+		{
+			Name: "Error when getting application template",
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("GetByName", ctx, testTenant, testName).Return(nil, testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appTemplateRepo := testCase.AppTemplateRepoFn()
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
+
+			// WHEN
+			result, err := svc.GetByName(ctx, testName)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			appTemplateRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
+	// GIVEN
+	svc := apptemplate.NewService(nil, nil, nil)
+	appInputJSON := `{"name":"{{name}}","description":"App {{name}} described as {{description}}"}`
+
+	testCases := []struct {
+		Name               string
+		Placeholders       []string
+		Values             []*model.ApplicationTemplateValueInput
+		ExpectedOutput     string
+		ExpectedErrMessage string
+	}{
+		{
+			Name:         "Success",
+			Placeholders: []string{"name", "description"},
+			Values: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+				{Placeholder: "description", Value: "bar"},
+			},
+			ExpectedOutput: `{"name":"foo","description":"App foo described as bar"}`,
+		},
+		{
+			Name:         "Success when value has to be escaped",
+			Placeholders: []string{"name", "description"},
+			Values: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+				{Placeholder: "description", Value: `"quoted"`},
+			},
+			ExpectedOutput: `{"name":"foo","description":"App foo described as \"quoted\""}`,
+		},
+		{
+			Name:         "Success when value contains token of other placeholder",
+			Placeholders: []string{"name", "description"},
+			Values: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "{{description}}"},
+				{Placeholder: "description", Value: "{{name}}"},
+			},
+			ExpectedOutput: `{"name":"{{description}}","description":"App {{description}} described as {{name}}"}`,
+		},
+		{
+			Name:         "Error when value for placeholder is missing",
+			Placeholders: []string{"name", "description"},
+			Values: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+			},
+			ExpectedErrMessage: "value for placeholder [name=description] not provided",
+		},
+		{
+			Name:         "Error when placeholder is unknown",
+			Placeholders: []string{"name", "description"},
+			Values: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+				{Placeholder: "description", Value: "bar"},
+				{Placeholder: "url", Value: "baz"},
+			},
+			ExpectedErrMessage: "placeholder [name=url] is not defined in Application Template [name=bar]",
+		},
+		{
+			Name:         "Error when value is provided more than once",
+			Placeholders: []string{"name", "description"},
+			Values: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+				{Placeholder: "name", Value: "bar"},
+			},
+			ExpectedErrMessage: "value for placeholder [name=name] provided more than once",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appTemplate := fixModelAppTemplateWithPlaceholders(testID, testName, appInputJSON, testCase.Placeholders...)
+
+			// WHEN
+			result, err := svc.PrepareApplicationCreateInputJSON(appTemplate, testCase.Values)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
+
+func TestService_PrepareApplicationCreateInputJSON_PlaceholderConstraints(t *testing.T) {
+	// GIVEN
+	svc := apptemplate.NewService(nil, nil, nil)
+	appInputJSON := `{"name":"{{name}}","description":"{{description}}"}`
+	defaultDescription := "default"
+	nameRegex := "[a-z]+"
+	var descriptionSchema interface{} = map[string]interface{}{"type": "string", "maxLength": 10}
+
+	testCases := []struct {
+		Name                   string
+		DescriptionPlaceholder model.ApplicationTemplatePlaceholder
+		Values                 []*model.ApplicationTemplateValueInput
+		ExpectedOutput         string
+		ExpectedErrMessage     string
+		NotExpectedInError     string
+	}{
+		{
+			Name:                   "Success when default value is used",
+			DescriptionPlaceholder: model.ApplicationTemplatePlaceholder{Name: "description", Default: &defaultDescription, Required: true},
+			Values:                 fixModelTemplateValues("name", "foo"),
+			ExpectedOutput:         `{"name":"foo","description":"default"}`,
+		},
+		{
+			Name:                   "Success when provided value overrides default one",
+			DescriptionPlaceholder: model.ApplicationTemplatePlaceholder{Name: "description", Default: &defaultDescription, Required: true},
+			Values: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+				{Placeholder: "description", Value: "bar"},
+			},
+			ExpectedOutput: `{"name":"foo","description":"bar"}`,
+		},
+		{
+			Name:                   "Success when value for optional placeholder is missing",
+			DescriptionPlaceholder: model.ApplicationTemplatePlaceholder{Name: "description"},
+			Values:                 fixModelTemplateValues("name", "foo"),
+			ExpectedOutput:         `{"name":"foo","description":""}`,
+		},
+		{
+			Name:                   "Error when value does not match regex",
+			DescriptionPlaceholder: model.ApplicationTemplatePlaceholder{Name: "description"},
+			Values:                 fixModelTemplateValues("name", "Foo"),
+			ExpectedErrMessage:     "value for placeholder [name=name] does not match regex [a-z]+",
+		},
+		{
+			Name:                   "Error when sensitive value is not valid against JSON schema",
+			DescriptionPlaceholder: model.ApplicationTemplatePlaceholder{Name: "description", JSONSchema: &descriptionSchema, Sensitive: true},
+			Values: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+				{Placeholder: "description", Value: "very-long-secret"},
+			},
+			ExpectedErrMessage: "value for placeholder [name=description] is not valid against its JSON schema",
+			NotExpectedInError: "very-long-secret",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appTemplate := fixModelAppTemplateWithPlaceholders(testID, testName, appInputJSON)
+			appTemplate.Placeholders = []model.ApplicationTemplatePlaceholder{
+				{Name: "name", Regex: &nameRegex, Required: true},
+				testCase.DescriptionPlaceholder,
+			}
+
+			// WHEN
+			result, err := svc.PrepareApplicationCreateInputJSON(appTemplate, testCase.Values)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				if testCase.NotExpectedInError != "" {
+					assert.NotContains(t, err.Error(), testCase.NotExpectedInError)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BulkLabelService is an autogenerated mock type for the BulkLabelService type
+type BulkLabelService struct {
+	mock.Mock
+}
+
+// DeleteLabels provides a mock function with given fields: ctx, objectType, filter, key
+func (_m *BulkLabelService) DeleteLabels(ctx context.Context, objectType model.LabelableObject, filter *labelfilter.LabelFilter, key string) ([]string, error) {
+	ret := _m.Called(ctx, objectType, filter, key)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, model.LabelableObject, *labelfilter.LabelFilter, string) []string); ok {
+		r0 = rf(ctx, objectType, filter, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.LabelableObject, *labelfilter.LabelFilter, string) error); ok {
+		r1 = rf(ctx, objectType, filter, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLabels provides a mock function with given fields: ctx, objectType, filter, key, value
+func (_m *BulkLabelService) SetLabels(ctx context.Context, objectType model.LabelableObject, filter *labelfilter.LabelFilter, key string, value interface{}) ([]string, error) {
+	ret := _m.Called(ctx, objectType, filter, key, value)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, model.LabelableObject, *labelfilter.LabelFilter, string, interface{}) []string); ok {
+		r0 = rf(ctx, objectType, filter, key, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.LabelableObject, *labelfilter.LabelFilter, string, interface{}) error); ok {
+		r1 = rf(ctx, objectType, filter, key, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,43 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RuntimeService is an autogenerated mock type for the RuntimeService type
+type RuntimeService struct {
+	mock.Mock
+}
+
+// DeleteLabel provides a mock function with given fields: ctx, runtimeID, key
+func (_m *RuntimeService) DeleteLabel(ctx context.Context, runtimeID string, key string) error {
+	ret := _m.Called(ctx, runtimeID, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, runtimeID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetLabel provides a mock function with given fields: ctx, label
+func (_m *RuntimeService) SetLabel(ctx context.Context, label *model.LabelInput) error {
+	ret := _m.Called(ctx, label)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LabelInput) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
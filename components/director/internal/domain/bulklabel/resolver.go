@@ -0,0 +1,142 @@
+package bulklabel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+// objectTypeScopesPrefix points to scopes required for changing labels by filter for given object type
+const objectTypeScopesPrefix = "labelsByFilterObjectTypes"
+
+//go:generate mockery -name=BulkLabelService -output=automock -outpkg=automock -case=underscore
+type BulkLabelService interface {
+	SetLabels(ctx context.Context, objectType model.LabelableObject, filter *labelfilter.LabelFilter, key string, value interface{}) ([]string, error)
+	DeleteLabels(ctx context.Context, objectType model.LabelableObject, filter *labelfilter.LabelFilter, key string) ([]string, error)
+}
+
+//go:generate mockery -name=ScopesVerifier -output=automock -outpkg=automock -case=underscore
+type ScopesVerifier interface {
+	VerifyScopes(ctx context.Context, obj interface{}, next gqlgen.Resolver, scopesDefinition string) (interface{}, error)
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	svc    BulkLabelService
+	scopes ScopesVerifier
+}
+
+func NewResolver(transact persistence.Transactioner, svc BulkLabelService, scopes ScopesVerifier) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		scopes:   scopes,
+	}
+}
+
+// SetLabelsByFilter sets the label on all matching objects. In dry run the transaction is rolled back, so the matching objects are validated, but not changed.
+func (r *Resolver) SetLabelsByFilter(ctx context.Context, objectType graphql.LabelableObject, filter graphql.LabelFilterExpression, key string, value interface{}, dryRun *bool) ([]string, error) {
+	modelObjectType, err := labelableObjectFromGraphQL(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.verifyScopes(ctx, modelObjectType); err != nil {
+		return nil, err
+	}
+
+	labelFilter, err := labelfilter.ExpressionFromGraphQL(&filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting label filter expression")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	objectIDs, err := r.svc.SetLabels(ctx, modelObjectType, labelFilter, key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.finish(tx, objectIDs, dryRun)
+}
+
+// DeleteLabelsByFilter deletes the label from all matching objects. In dry run the transaction is rolled back, so no label is deleted.
+func (r *Resolver) DeleteLabelsByFilter(ctx context.Context, objectType graphql.LabelableObject, filter graphql.LabelFilterExpression, key string, dryRun *bool) ([]string, error) {
+	modelObjectType, err := labelableObjectFromGraphQL(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.verifyScopes(ctx, modelObjectType); err != nil {
+		return nil, err
+	}
+
+	labelFilter, err := labelfilter.ExpressionFromGraphQL(&filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting label filter expression")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	objectIDs, err := r.svc.DeleteLabels(ctx, modelObjectType, labelFilter, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.finish(tx, objectIDs, dryRun)
+}
+
+func (r *Resolver) finish(tx persistence.PersistenceTx, objectIDs []string, dryRun *bool) ([]string, error) {
+	if objectIDs == nil {
+		objectIDs = []string{}
+	}
+
+	if dryRun != nil && *dryRun {
+		return objectIDs, nil
+	}
+
+	err := tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return objectIDs, nil
+}
+
+// verifyScopes checks the scopes required for the object type, as the scopes of the mutation do not depend on it
+func (r *Resolver) verifyScopes(ctx context.Context, objectType model.LabelableObject) error {
+	path := fmt.Sprintf("%s.%s", objectTypeScopesPrefix, strings.ToLower(string(objectType)))
+	_, err := r.scopes.VerifyScopes(ctx, nil, func(ctx context.Context) (interface{}, error) { return nil, nil }, path)
+	return err
+}
+
+func labelableObjectFromGraphQL(in graphql.LabelableObject) (model.LabelableObject, error) {
+	switch in {
+	case graphql.LabelableObjectApplication:
+		return model.ApplicationLabelableObject, nil
+	case graphql.LabelableObjectRuntime:
+		return model.RuntimeLabelableObject, nil
+	}
+
+	return "", fmt.Errorf("unknown labelable object type %s", in)
+}
@@ -0,0 +1,270 @@
+package bulklabel_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/bulklabel"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/bulklabel/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_SetLabelsByFilter(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	gqlFilter := graphql.LabelFilterExpression{Label: &graphql.LabelFilter{Key: "env"}}
+	filter := &labelfilter.LabelFilter{Key: "env"}
+	objectIDs := []string{"foo", "bar"}
+	dryRun := true
+
+	testCases := []struct {
+		Name            string
+		ObjectType      graphql.LabelableObject
+		DryRun          *bool
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.BulkLabelService
+		ScopesErr       error
+		ExpectedResult  []string
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success for Applications",
+			ObjectType:      graphql.LabelableObjectApplication,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("SetLabels", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, filter, testKey, testValue).Return(objectIDs, nil).Once()
+				return svc
+			},
+			ExpectedResult: objectIDs,
+		},
+		{
+			Name:            "Success for Runtimes",
+			ObjectType:      graphql.LabelableObjectRuntime,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("SetLabels", txtest.CtxWithDBMatcher(), model.RuntimeLabelableObject, filter, testKey, testValue).Return(nil, nil).Once()
+				return svc
+			},
+			ExpectedResult: []string{},
+		},
+		{
+			Name:            "Does not commit in dry run",
+			ObjectType:      graphql.LabelableObjectApplication,
+			DryRun:          &dryRun,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("SetLabels", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, filter, testKey, testValue).Return(objectIDs, nil).Once()
+				return svc
+			},
+			ExpectedResult: objectIDs,
+		},
+		{
+			Name:            "Returns error when setting labels failed",
+			ObjectType:      graphql.LabelableObjectApplication,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("SetLabels", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, filter, testKey, testValue).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when scopes for the object type are insufficient",
+			ObjectType:      graphql.LabelableObjectRuntime,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.BulkLabelService {
+				return &automock.BulkLabelService{}
+			},
+			ScopesErr:   testErr,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when beginning transaction",
+			ObjectType:      graphql.LabelableObjectApplication,
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.BulkLabelService {
+				return &automock.BulkLabelService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when committing transaction",
+			ObjectType:      graphql.LabelableObjectRuntime,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("SetLabels", txtest.CtxWithDBMatcher(), model.RuntimeLabelableObject, filter, testKey, testValue).Return(objectIDs, nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			scopes := fixScopesVerifier(testCase.ObjectType, testCase.ScopesErr)
+			resolver := bulklabel.NewResolver(transact, svc, scopes)
+
+			// when
+			result, err := resolver.SetLabelsByFilter(context.TODO(), testCase.ObjectType, gqlFilter, testKey, testValue, testCase.DryRun)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			scopes.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error for invalid filter expression", func(t *testing.T) {
+		resolver := bulklabel.NewResolver(nil, nil, fixScopesVerifier(graphql.LabelableObjectApplication, nil))
+		// when
+		_, err := resolver.SetLabelsByFilter(context.TODO(), graphql.LabelableObjectApplication, graphql.LabelFilterExpression{}, testKey, testValue, nil)
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while converting label filter expression")
+	})
+
+	t.Run("Returns error for unknown object type", func(t *testing.T) {
+		resolver := bulklabel.NewResolver(nil, nil, nil)
+		// when
+		_, err := resolver.SetLabelsByFilter(context.TODO(), graphql.LabelableObject("UNKNOWN"), gqlFilter, testKey, testValue, nil)
+		// then
+		require.EqualError(t, err, "unknown labelable object type UNKNOWN")
+	})
+}
+
+func TestResolver_DeleteLabelsByFilter(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	gqlFilter := graphql.LabelFilterExpression{Not: &graphql.LabelFilterExpression{Label: &graphql.LabelFilter{Key: "env"}}}
+	filter := &labelfilter.LabelFilter{Not: &labelfilter.LabelFilter{Key: "env"}}
+	objectIDs := []string{"foo"}
+	dryRun := true
+
+	testCases := []struct {
+		Name            string
+		ObjectType      graphql.LabelableObject
+		DryRun          *bool
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.BulkLabelService
+		ScopesErr       error
+		ExpectedResult  []string
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success for Applications",
+			ObjectType:      graphql.LabelableObjectApplication,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("DeleteLabels", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, filter, testKey).Return(objectIDs, nil).Once()
+				return svc
+			},
+			ExpectedResult: objectIDs,
+		},
+		{
+			Name:            "Does not commit in dry run",
+			ObjectType:      graphql.LabelableObjectRuntime,
+			DryRun:          &dryRun,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("DeleteLabels", txtest.CtxWithDBMatcher(), model.RuntimeLabelableObject, filter, testKey).Return(objectIDs, nil).Once()
+				return svc
+			},
+			ExpectedResult: objectIDs,
+		},
+		{
+			Name:            "Returns error when scopes for the object type are insufficient",
+			ObjectType:      graphql.LabelableObjectApplication,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.BulkLabelService {
+				return &automock.BulkLabelService{}
+			},
+			ScopesErr:   testErr,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when deleting labels failed",
+			ObjectType:      graphql.LabelableObjectRuntime,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("DeleteLabels", txtest.CtxWithDBMatcher(), model.RuntimeLabelableObject, filter, testKey).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when committing transaction",
+			ObjectType:      graphql.LabelableObjectRuntime,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.BulkLabelService {
+				svc := &automock.BulkLabelService{}
+				svc.On("DeleteLabels", txtest.CtxWithDBMatcher(), model.RuntimeLabelableObject, filter, testKey).Return(objectIDs, nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			scopes := fixScopesVerifier(testCase.ObjectType, testCase.ScopesErr)
+			resolver := bulklabel.NewResolver(transact, svc, scopes)
+
+			// when
+			result, err := resolver.DeleteLabelsByFilter(context.TODO(), testCase.ObjectType, gqlFilter, testKey, testCase.DryRun)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			scopes.AssertExpectations(t)
+		})
+	}
+}
+
+func fixScopesVerifier(objectType graphql.LabelableObject, err error) *automock.ScopesVerifier {
+	scopes := &automock.ScopesVerifier{}
+	scopes.On("VerifyScopes", mock.Anything, nil, mock.Anything, "labelsByFilterObjectTypes."+strings.ToLower(string(objectType))).Return(nil, err).Once()
+	return scopes
+}
@@ -0,0 +1,119 @@
+package bulklabel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	ListMatchingObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, filter *labelfilter.LabelFilter) ([]string, error)
+}
+
+//go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
+type ApplicationService interface {
+	SetLabel(ctx context.Context, label *model.LabelInput) error
+	DeleteLabel(ctx context.Context, applicationID string, key string) error
+}
+
+//go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
+type RuntimeService interface {
+	SetLabel(ctx context.Context, label *model.LabelInput) error
+	DeleteLabel(ctx context.Context, runtimeID string, key string) error
+}
+
+type service struct {
+	labelRepo LabelRepository
+
+	appSvc     ApplicationService
+	runtimeSvc RuntimeService
+}
+
+func NewService(labelRepo LabelRepository, appSvc ApplicationService, runtimeSvc RuntimeService) *service {
+	return &service{
+		labelRepo:  labelRepo,
+		appSvc:     appSvc,
+		runtimeSvc: runtimeSvc,
+	}
+}
+
+// SetLabels sets the label on every object of given type matching the filter and returns IDs of the labeled objects.
+//
+// Labels are set through the Application and Runtime services, so the value is validated against the Label Definition
+// and Scenario Assignments are evaluated for every object.
+func (s *service) SetLabels(ctx context.Context, objectType model.LabelableObject, filter *labelfilter.LabelFilter, key string, value interface{}) ([]string, error) {
+	objectIDs, err := s.listMatchingObjectIDs(ctx, objectType, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, objectID := range objectIDs {
+		labelInput := &model.LabelInput{
+			Key:        key,
+			Value:      value,
+			ObjectType: objectType,
+			ObjectID:   objectID,
+		}
+
+		switch objectType {
+		case model.ApplicationLabelableObject:
+			err = s.appSvc.SetLabel(ctx, labelInput)
+		case model.RuntimeLabelableObject:
+			err = s.runtimeSvc.SetLabel(ctx, labelInput)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "while setting label '%s' for %s with ID %s", key, objectType, objectID)
+		}
+	}
+
+	return objectIDs, nil
+}
+
+// DeleteLabels deletes the label from every object of given type matching the filter and returns IDs of the objects from which it was deleted
+func (s *service) DeleteLabels(ctx context.Context, objectType model.LabelableObject, filter *labelfilter.LabelFilter, key string) ([]string, error) {
+	labeledFilter := &labelfilter.LabelFilter{
+		And: []*labelfilter.LabelFilter{filter, {Key: key}},
+	}
+
+	objectIDs, err := s.listMatchingObjectIDs(ctx, objectType, labeledFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, objectID := range objectIDs {
+		switch objectType {
+		case model.ApplicationLabelableObject:
+			err = s.appSvc.DeleteLabel(ctx, objectID, key)
+		case model.RuntimeLabelableObject:
+			err = s.runtimeSvc.DeleteLabel(ctx, objectID, key)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "while deleting label '%s' from %s with ID %s", key, objectType, objectID)
+		}
+	}
+
+	return objectIDs, nil
+}
+
+func (s *service) listMatchingObjectIDs(ctx context.Context, objectType model.LabelableObject, filter *labelfilter.LabelFilter) ([]string, error) {
+	if objectType != model.ApplicationLabelableObject && objectType != model.RuntimeLabelableObject {
+		return nil, fmt.Errorf("labels cannot be changed by filter for %s", objectType)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	objectIDs, err := s.labelRepo.ListMatchingObjectIDs(ctx, tnt, objectType, filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing %s objects matching the filter", objectType)
+	}
+
+	return objectIDs, nil
+}
@@ -0,0 +1,304 @@
+package bulklabel_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/bulklabel"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/bulklabel/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTenant = "dddddddd-dddd-dddd-dddd-dddddddddddd"
+	testKey    = "region"
+	testValue  = "eu"
+)
+
+func TestService_SetLabels(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	filter := &labelfilter.LabelFilter{Key: "env"}
+	objectIDs := []string{"foo", "bar"}
+
+	labelInput := func(objectType model.LabelableObject, objectID string) *model.LabelInput {
+		return &model.LabelInput{
+			Key:        testKey,
+			Value:      testValue,
+			ObjectType: objectType,
+			ObjectID:   objectID,
+		}
+	}
+
+	testCases := []struct {
+		Name               string
+		ObjectType         model.LabelableObject
+		LabelRepoFn        func() *automock.LabelRepository
+		AppSvcFn           func() *automock.ApplicationService
+		RuntimeSvcFn       func() *automock.RuntimeService
+		ExpectedResult     []string
+		ExpectedErrMessage string
+	}{
+		{
+			Name:       "Success for Applications",
+			ObjectType: model.ApplicationLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return(objectIDs, nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetLabel", ctx, labelInput(model.ApplicationLabelableObject, "foo")).Return(nil).Once()
+				svc.On("SetLabel", ctx, labelInput(model.ApplicationLabelableObject, "bar")).Return(nil).Once()
+				return svc
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ExpectedResult: objectIDs,
+		},
+		{
+			Name:       "Success for Runtimes",
+			ObjectType: model.RuntimeLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, filter).Return(objectIDs, nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("SetLabel", ctx, labelInput(model.RuntimeLabelableObject, "foo")).Return(nil).Once()
+				svc.On("SetLabel", ctx, labelInput(model.RuntimeLabelableObject, "bar")).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: objectIDs,
+		},
+		{
+			Name:       "Success when no object matches",
+			ObjectType: model.RuntimeLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, filter).Return(nil, nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ExpectedResult: nil,
+		},
+		{
+			Name:       "Returns error when setting label failed",
+			ObjectType: model.ApplicationLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return(objectIDs, nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetLabel", ctx, labelInput(model.ApplicationLabelableObject, "foo")).Return(testErr).Once()
+				return svc
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ExpectedErrMessage: "while setting label 'region' for Application with ID foo: Test error",
+		},
+		{
+			Name:       "Returns error when listing matching objects failed",
+			ObjectType: model.ApplicationLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return(nil, testErr).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:       "Returns error for unsupported object type",
+			ObjectType: model.IntegrationSystemLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ExpectedErrMessage: "labels cannot be changed by filter for IntegrationSystem",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepoFn()
+			appSvc := testCase.AppSvcFn()
+			runtimeSvc := testCase.RuntimeSvcFn()
+			svc := bulklabel.NewService(labelRepo, appSvc, runtimeSvc)
+
+			// when
+			result, err := svc.SetLabels(ctx, testCase.ObjectType, filter, testKey, testValue)
+
+			// then
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			labelRepo.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			runtimeSvc.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when tenant is missing in context", func(t *testing.T) {
+		svc := bulklabel.NewService(nil, nil, nil)
+		// when
+		_, err := svc.SetLabels(context.TODO(), model.ApplicationLabelableObject, filter, testKey, testValue)
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
+func TestService_DeleteLabels(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	filter := &labelfilter.LabelFilter{Key: "env"}
+	labeledFilter := &labelfilter.LabelFilter{
+		And: []*labelfilter.LabelFilter{filter, {Key: testKey}},
+	}
+	objectIDs := []string{"foo", "bar"}
+
+	testCases := []struct {
+		Name               string
+		ObjectType         model.LabelableObject
+		LabelRepoFn        func() *automock.LabelRepository
+		AppSvcFn           func() *automock.ApplicationService
+		RuntimeSvcFn       func() *automock.RuntimeService
+		ExpectedResult     []string
+		ExpectedErrMessage string
+	}{
+		{
+			Name:       "Success for Applications",
+			ObjectType: model.ApplicationLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, labeledFilter).Return(objectIDs, nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("DeleteLabel", ctx, "foo", testKey).Return(nil).Once()
+				svc.On("DeleteLabel", ctx, "bar", testKey).Return(nil).Once()
+				return svc
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ExpectedResult: objectIDs,
+		},
+		{
+			Name:       "Success for Runtimes",
+			ObjectType: model.RuntimeLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, labeledFilter).Return(objectIDs, nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("DeleteLabel", ctx, "foo", testKey).Return(nil).Once()
+				svc.On("DeleteLabel", ctx, "bar", testKey).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: objectIDs,
+		},
+		{
+			Name:       "Returns error when deleting label failed",
+			ObjectType: model.RuntimeLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, labeledFilter).Return(objectIDs, nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("DeleteLabel", ctx, "foo", testKey).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while deleting label 'region' from Runtime with ID foo: Test error",
+		},
+		{
+			Name:       "Returns error when listing matching objects failed",
+			ObjectType: model.ApplicationLabelableObject,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, labeledFilter).Return(nil, testErr).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepoFn()
+			appSvc := testCase.AppSvcFn()
+			runtimeSvc := testCase.RuntimeSvcFn()
+			svc := bulklabel.NewService(labelRepo, appSvc, runtimeSvc)
+
+			// when
+			result, err := svc.DeleteLabels(ctx, testCase.ObjectType, filter, testKey)
+
+			// then
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			labelRepo.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			runtimeSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChangeEventConverter is an autogenerated mock type for the ChangeEventConverter type
+type ChangeEventConverter struct {
+	mock.Mock
+}
+
+// ApplicationEventToGraphQL provides a mock function with given fields: in, applicationID, operation
+func (_m *ChangeEventConverter) ApplicationEventToGraphQL(in model.ChangeEvent, applicationID *string, operation model.ConfigurationChangeOperation) *graphql.ApplicationEvent {
+	ret := _m.Called(in, applicationID, operation)
+
+	var r0 *graphql.ApplicationEvent
+	if rf, ok := ret.Get(0).(func(model.ChangeEvent, *string, model.ConfigurationChangeOperation) *graphql.ApplicationEvent); ok {
+		r0 = rf(in, applicationID, operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ApplicationEvent)
+		}
+	}
+
+	return r0
+}
+
+// LabelEventToGraphQL provides a mock function with given fields: in
+func (_m *ChangeEventConverter) LabelEventToGraphQL(in model.ChangeEvent) *graphql.LabelEvent {
+	ret := _m.Called(in)
+
+	var r0 *graphql.LabelEvent
+	if rf, ok := ret.Get(0).(func(model.ChangeEvent) *graphql.LabelEvent); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.LabelEvent)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,74 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+	time "time"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChangeEventRepository is an autogenerated mock type for the ChangeEventRepository type
+type ChangeEventRepository struct {
+	mock.Mock
+}
+
+// DeleteCreatedBefore provides a mock function with given fields: ctx, createdBefore
+func (_m *ChangeEventRepository) DeleteCreatedBefore(ctx context.Context, createdBefore time.Time) error {
+	ret := _m.Called(ctx, createdBefore)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, createdBefore)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetLastID provides a mock function with given fields: ctx
+func (_m *ChangeEventRepository) GetLastID(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAfter provides a mock function with given fields: ctx, afterID, ids
+func (_m *ChangeEventRepository) ListAfter(ctx context.Context, afterID int64, ids []int64) ([]*model.ChangeEvent, error) {
+	ret := _m.Called(ctx, afterID, ids)
+
+	var r0 []*model.ChangeEvent
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []*model.ChangeEvent); ok {
+		r0 = rf(ctx, afterID, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ChangeEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, afterID, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
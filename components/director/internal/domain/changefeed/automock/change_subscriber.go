@@ -0,0 +1,31 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChangeSubscriber is an autogenerated mock type for the ChangeSubscriber type
+type ChangeSubscriber struct {
+	mock.Mock
+}
+
+// Subscribe provides a mock function with given fields: ctx, tenant
+func (_m *ChangeSubscriber) Subscribe(ctx context.Context, tenant string) <-chan model.ChangeEvent {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 <-chan model.ChangeEvent
+	if rf, ok := ret.Get(0).(func(context.Context, string) <-chan model.ChangeEvent); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan model.ChangeEvent)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,28 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	changefeed "github.com/kyma-incubator/compass/components/director/internal/domain/changefeed"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) FromEntity(in changefeed.Entity) model.ChangeEvent {
+	ret := _m.Called(in)
+
+	var r0 model.ChangeEvent
+	if rf, ok := ret.Get(0).(func(changefeed.Entity) model.ChangeEvent); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.ChangeEvent)
+	}
+
+	return r0
+}
@@ -0,0 +1,38 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	graphql "github.com/99designs/gqlgen/graphql"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScopesVerifier is an autogenerated mock type for the ScopesVerifier type
+type ScopesVerifier struct {
+	mock.Mock
+}
+
+// VerifyScopes provides a mock function with given fields: ctx, obj, next, scopesDefinition
+func (_m *ScopesVerifier) VerifyScopes(ctx context.Context, obj interface{}, next graphql.Resolver, scopesDefinition string) (interface{}, error) {
+	ret := _m.Called(ctx, obj, next, scopesDefinition)
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, graphql.Resolver, string) interface{}); ok {
+		r0 = rf(ctx, obj, next, scopesDefinition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, graphql.Resolver, string) error); ok {
+		r1 = rf(ctx, obj, next, scopesDefinition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
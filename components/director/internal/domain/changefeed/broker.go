@@ -0,0 +1,195 @@
+package changefeed
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=ChangeEventRepository -output=automock -outpkg=automock -case=underscore
+type ChangeEventRepository interface {
+	GetLastID(ctx context.Context) (int64, error)
+	ListAfter(ctx context.Context, afterID int64, ids []int64) ([]*model.ChangeEvent, error)
+	DeleteCreatedBefore(ctx context.Context, createdBefore time.Time) error
+}
+
+type subscription struct {
+	tenant string
+	events chan model.ChangeEvent
+}
+
+// broker reads the Change Events recorded by all Director replicas and dispatches them to the subscriptions of this replica.
+type broker struct {
+	transact     persistence.Transactioner
+	repo         ChangeEventRepository
+	cfg          Config
+	timestampGen func() time.Time
+
+	initialized bool
+	lastID      int64
+	// IDs are assigned before the transaction commits, so an event with a lower ID can become visible after an event with a higher one.
+	// Such IDs are checked again until they appear or GapTimeout passes, for example when the transaction was rolled back.
+	// An event committed after its ID was skipped is never dispatched, so GapTimeout must exceed the longest transaction recording Change Events.
+	// At most MaxGaps IDs are checked, the IDs missing above the limit are skipped right away.
+	missing map[int64]time.Time
+
+	mutex         sync.Mutex
+	subscriptions map[*subscription]struct{}
+}
+
+func NewBroker(transact persistence.Transactioner, repo ChangeEventRepository, cfg Config) *broker {
+	return &broker{
+		transact:      transact,
+		repo:          repo,
+		cfg:           cfg,
+		timestampGen:  func() time.Time { return time.Now().UTC() },
+		missing:       make(map[int64]time.Time),
+		subscriptions: make(map[*subscription]struct{}),
+	}
+}
+
+// Subscribe returns the Change Events of the given tenant recorded after the call, until the context is done.
+// If the subscriber does not keep up with the events, the channel is closed.
+func (b *broker) Subscribe(ctx context.Context, tenant string) <-chan model.ChangeEvent {
+	sub := &subscription{
+		tenant: tenant,
+		events: make(chan model.ChangeEvent, b.cfg.BufferSize),
+	}
+
+	b.mutex.Lock()
+	b.subscriptions[sub] = struct{}{}
+	b.mutex.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(sub)
+	}()
+
+	return sub.events
+}
+
+func (b *broker) Poll(ctx context.Context) error {
+	tx, err := b.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer b.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if !b.initialized {
+		lastID, err := b.repo.GetLastID(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+
+		b.lastID = lastID
+		b.initialized = true
+		return nil
+	}
+
+	// IDs missing for GapTimeout are read once more before they are skipped
+	now := b.timestampGen()
+	missingIDs := make([]int64, 0, len(b.missing))
+	var expiredIDs []int64
+	for id, since := range b.missing {
+		missingIDs = append(missingIDs, id)
+		if now.Sub(since) >= b.cfg.GapTimeout {
+			expiredIDs = append(expiredIDs, id)
+		}
+	}
+
+	events, err := b.repo.ListAfter(ctx, b.lastID, missingIDs)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if _, ok := b.missing[event.ID]; ok {
+			delete(b.missing, event.ID)
+		} else {
+			for id := b.lastID + 1; id < event.ID; id++ {
+				if len(b.missing) >= b.cfg.MaxGaps {
+					log.Warnf("Skipping ChangeEvents with IDs from %d to %d, as %d missing IDs are already checked", id, event.ID-1, b.cfg.MaxGaps)
+					break
+				}
+				b.missing[id] = now
+			}
+			b.lastID = event.ID
+		}
+
+		b.dispatch(*event)
+	}
+
+	for _, id := range expiredIDs {
+		if _, ok := b.missing[id]; !ok {
+			continue
+		}
+
+		log.Warnf("Skipping ChangeEvent with ID %d, as it has not been committed within %s", id, b.cfg.GapTimeout)
+		delete(b.missing, id)
+	}
+
+	return nil
+}
+
+func (b *broker) DeleteExpired(ctx context.Context) error {
+	tx, err := b.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer b.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = b.repo.DeleteCreatedBefore(ctx, b.timestampGen().Add(-b.cfg.Retention))
+	if err != nil {
+		return errors.Wrap(err, "while deleting expired ChangeEvents")
+	}
+
+	return tx.Commit()
+}
+
+func (b *broker) dispatch(event model.ChangeEvent) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for sub := range b.subscriptions {
+		if sub.tenant != event.Tenant {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			log.Warnf("Closing subscription for tenant %s as it does not keep up with the ChangeEvents", sub.tenant)
+			delete(b.subscriptions, sub)
+			close(sub.events)
+		}
+	}
+}
+
+func (b *broker) unsubscribe(sub *subscription) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, ok := b.subscriptions[sub]; !ok {
+		return
+	}
+
+	delete(b.subscriptions, sub)
+	close(sub.events)
+}
@@ -0,0 +1,227 @@
+package changefeed_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker_Poll(t *testing.T) {
+	cfg := changefeed.Config{GapTimeout: time.Minute, MaxGaps: 2, BufferSize: 10}
+
+	t.Run("Dispatches events to subscriptions of the tenant and checks gaps again", func(t *testing.T) {
+		// given
+		first := fixAppEvent(6, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated)
+		delayed := fixAppEvent(7, testAppID, model.ChangeEventResourceTypeDocument, testResourceID, model.ConfigurationChangeOperationCreated)
+		last := fixAppEvent(8, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationDeleted)
+		otherTenant := fixAppEvent(9, testOtherAppID, model.ChangeEventResourceTypeApplication, testOtherAppID, model.ConfigurationChangeOperationCreated)
+		otherTenant.Tenant = testOtherTenant
+
+		persistTx, transact := fixTransactioner()
+		repo := &automock.ChangeEventRepository{}
+		repo.On("GetLastID", txtest.CtxWithDBMatcher()).Return(int64(5), nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(5), []int64{}).Return([]*model.ChangeEvent{&first, &last, &otherTenant}, nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(9), []int64{7}).Return([]*model.ChangeEvent{&delayed}, nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(9), []int64{}).Return(nil, nil).Once()
+
+		broker := changefeed.NewBroker(transact, repo, cfg)
+		broker.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		events := broker.Subscribe(ctx, testTenant)
+
+		// when
+		for i := 0; i < 4; i++ {
+			err := broker.Poll(context.TODO())
+			require.NoError(t, err)
+		}
+
+		// then
+		assert.Equal(t, first, <-events)
+		assert.Equal(t, last, <-events)
+		assert.Equal(t, delayed, <-events)
+		assert.Len(t, events, 0)
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Stops checking gaps after timeout", func(t *testing.T) {
+		// given
+		event := fixAppEvent(2, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated)
+
+		_, transact := fixTransactioner()
+		repo := &automock.ChangeEventRepository{}
+		repo.On("GetLastID", txtest.CtxWithDBMatcher()).Return(int64(0), nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(0), []int64{}).Return([]*model.ChangeEvent{&event}, nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(2), []int64{1}).Return(nil, nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(2), []int64{}).Return(nil, nil).Once()
+
+		now := testTimestamp
+		broker := changefeed.NewBroker(transact, repo, cfg)
+		broker.SetTimestampGen(func() time.Time { return now })
+
+		// when
+		require.NoError(t, broker.Poll(context.TODO()))
+		require.NoError(t, broker.Poll(context.TODO()))
+		now = now.Add(time.Minute)
+		require.NoError(t, broker.Poll(context.TODO()))
+		require.NoError(t, broker.Poll(context.TODO()))
+
+		// then
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Checks gaps once more when timeout passes", func(t *testing.T) {
+		// given
+		event := fixAppEvent(2, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated)
+		delayed := fixAppEvent(1, testAppID, model.ChangeEventResourceTypeDocument, testResourceID, model.ConfigurationChangeOperationCreated)
+
+		_, transact := fixTransactioner()
+		repo := &automock.ChangeEventRepository{}
+		repo.On("GetLastID", txtest.CtxWithDBMatcher()).Return(int64(0), nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(0), []int64{}).Return([]*model.ChangeEvent{&event}, nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(2), []int64{1}).Return([]*model.ChangeEvent{&delayed}, nil).Once()
+
+		now := testTimestamp
+		broker := changefeed.NewBroker(transact, repo, cfg)
+		broker.SetTimestampGen(func() time.Time { return now })
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		events := broker.Subscribe(ctx, testTenant)
+
+		// when
+		require.NoError(t, broker.Poll(context.TODO()))
+		require.NoError(t, broker.Poll(context.TODO()))
+		now = now.Add(time.Minute)
+		require.NoError(t, broker.Poll(context.TODO()))
+
+		// then
+		assert.Equal(t, event, <-events)
+		assert.Equal(t, delayed, <-events)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Skips gaps above the limit", func(t *testing.T) {
+		// given
+		event := fixAppEvent(4, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated)
+		next := fixAppEvent(6, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationUpdated)
+
+		// missing IDs are listed in random order
+		missingIDs := mock.MatchedBy(func(ids []int64) bool {
+			return len(ids) == 2 && (ids[0] == 1 && ids[1] == 2 || ids[0] == 2 && ids[1] == 1)
+		})
+
+		_, transact := fixTransactioner()
+		repo := &automock.ChangeEventRepository{}
+		repo.On("GetLastID", txtest.CtxWithDBMatcher()).Return(int64(0), nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(0), []int64{}).Return([]*model.ChangeEvent{&event}, nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(4), missingIDs).Return([]*model.ChangeEvent{&next}, nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(6), missingIDs).Return(nil, nil).Once()
+
+		broker := changefeed.NewBroker(transact, repo, cfg)
+		broker.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		// when
+		for i := 0; i < 4; i++ {
+			require.NoError(t, broker.Poll(context.TODO()))
+		}
+
+		// then
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Closes subscription which does not keep up with events", func(t *testing.T) {
+		// given
+		first := fixAppEvent(1, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated)
+		second := fixAppEvent(2, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationUpdated)
+
+		_, transact := fixTransactioner()
+		repo := &automock.ChangeEventRepository{}
+		repo.On("GetLastID", txtest.CtxWithDBMatcher()).Return(int64(0), nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(0), []int64{}).Return([]*model.ChangeEvent{&first, &second}, nil).Once()
+
+		broker := changefeed.NewBroker(transact, repo, changefeed.Config{BufferSize: 1})
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		events := broker.Subscribe(ctx, testTenant)
+
+		// when
+		require.NoError(t, broker.Poll(context.TODO()))
+		require.NoError(t, broker.Poll(context.TODO()))
+
+		// then
+		assert.Equal(t, first, <-events)
+		_, ok := <-events
+		assert.False(t, ok)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when listing events failed", func(t *testing.T) {
+		// given
+		persistTx, transact := fixTransactioner()
+		repo := &automock.ChangeEventRepository{}
+		repo.On("GetLastID", txtest.CtxWithDBMatcher()).Return(int64(0), nil).Once()
+		repo.On("ListAfter", txtest.CtxWithDBMatcher(), int64(0), mock.Anything).Return(nil, testErr).Once()
+
+		broker := changefeed.NewBroker(transact, repo, cfg)
+		require.NoError(t, broker.Poll(context.TODO()))
+
+		// when
+		err := broker.Poll(context.TODO())
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+		persistTx.AssertNumberOfCalls(t, "Commit", 1)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestBroker_Subscribe(t *testing.T) {
+	// given
+	broker := changefeed.NewBroker(nil, nil, changefeed.Config{BufferSize: 1})
+	ctx, cancel := context.WithCancel(context.TODO())
+	events := broker.Subscribe(ctx, testTenant)
+
+	// when
+	cancel()
+
+	// then
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed")
+	}
+}
+
+func TestBroker_DeleteExpired(t *testing.T) {
+	// given
+	persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+	repo := &automock.ChangeEventRepository{}
+	repo.On("DeleteCreatedBefore", txtest.CtxWithDBMatcher(), testTimestamp.Add(-time.Hour)).Return(nil).Once()
+
+	broker := changefeed.NewBroker(transact, repo, changefeed.Config{Retention: time.Hour})
+	broker.SetTimestampGen(func() time.Time { return testTimestamp })
+
+	// when
+	err := broker.DeleteExpired(context.TODO())
+
+	// then
+	require.NoError(t, err)
+	persistTx.AssertExpectations(t)
+	transact.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,12 @@
+package changefeed
+
+import "time"
+
+type Config struct {
+	Period        time.Duration `envconfig:"default=1s"`
+	GapTimeout    time.Duration `envconfig:"default=10m"`
+	MaxGaps       int           `envconfig:"default=1000"`
+	Retention     time.Duration `envconfig:"default=1h"`
+	CleanupPeriod time.Duration `envconfig:"default=10m"`
+	BufferSize    int           `envconfig:"default=100"`
+}
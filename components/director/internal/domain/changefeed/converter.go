@@ -0,0 +1,45 @@
+package changefeed
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) FromEntity(in Entity) model.ChangeEvent {
+	return model.ChangeEvent{
+		ID:           in.ID,
+		Tenant:       in.TenantID,
+		ObjectType:   model.ChangeEventObjectType(in.ObjectType),
+		ObjectID:     in.ObjectID,
+		ResourceType: model.ChangeEventResourceType(in.ResourceType),
+		ResourceID:   in.ResourceID,
+		Operation:    model.ConfigurationChangeOperation(in.Operation),
+		CreatedAt:    in.CreatedAt,
+	}
+}
+
+// ApplicationEventToGraphQL converts the event to an ApplicationEvent with the given Application ID and operation,
+// as changes of the resources of the Application are reported as updates of the Application itself.
+func (c *converter) ApplicationEventToGraphQL(in model.ChangeEvent, applicationID *string, operation model.ConfigurationChangeOperation) *graphql.ApplicationEvent {
+	return &graphql.ApplicationEvent{
+		ApplicationID: applicationID,
+		Operation:     graphql.ChangeOperation(operation),
+		Timestamp:     graphql.Timestamp(in.CreatedAt),
+	}
+}
+
+func (c *converter) LabelEventToGraphQL(in model.ChangeEvent) *graphql.LabelEvent {
+	return &graphql.LabelEvent{
+		ObjectType: graphql.LabelableObject(in.ObjectType),
+		ObjectID:   in.ObjectID,
+		Key:        in.ResourceID,
+		Operation:  graphql.ChangeOperation(in.Operation),
+		Timestamp:  graphql.Timestamp(in.CreatedAt),
+	}
+}
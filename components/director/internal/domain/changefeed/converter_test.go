@@ -0,0 +1,53 @@
+package changefeed_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_FromEntity(t *testing.T) {
+	// given
+	entity := fixEntityChangeEvent(5)
+
+	// when
+	result := changefeed.NewConverter().FromEntity(entity)
+
+	// then
+	assert.Equal(t, fixAppEvent(5, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated), result)
+}
+
+func TestConverter_ApplicationEventToGraphQL(t *testing.T) {
+	// given
+	event := fixAppEvent(5, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated)
+
+	// when
+	result := changefeed.NewConverter().ApplicationEventToGraphQL(event, strPtr(testAppID), model.ConfigurationChangeOperationUpdated)
+
+	// then
+	assert.Equal(t, &graphql.ApplicationEvent{
+		ApplicationID: strPtr(testAppID),
+		Operation:     graphql.ChangeOperationUpdated,
+		Timestamp:     graphql.Timestamp(testTimestamp),
+	}, result)
+}
+
+func TestConverter_LabelEventToGraphQL(t *testing.T) {
+	// given
+	event := fixRuntimeEvent(5, model.ChangeEventResourceTypeLabel, "foo", model.ConfigurationChangeOperationDeleted)
+
+	// when
+	result := changefeed.NewConverter().LabelEventToGraphQL(event)
+
+	// then
+	assert.Equal(t, &graphql.LabelEvent{
+		ObjectType: graphql.LabelableObjectRuntime,
+		ObjectID:   testRuntimeID,
+		Key:        "foo",
+		Operation:  graphql.ChangeOperationDeleted,
+		Timestamp:  graphql.Timestamp(testTimestamp),
+	}, result)
+}
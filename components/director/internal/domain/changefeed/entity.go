@@ -0,0 +1,20 @@
+package changefeed
+
+import "time"
+
+type Entity struct {
+	ID           int64     `db:"id"`
+	TenantID     string    `db:"tenant_id"`
+	ObjectType   string    `db:"object_type"`
+	ObjectID     string    `db:"object_id"`
+	ResourceType string    `db:"resource_type"`
+	ResourceID   string    `db:"resource_id"`
+	Operation    string    `db:"operation"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+type Collection []Entity
+
+func (c Collection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,7 @@
+package changefeed
+
+import "time"
+
+func (b *broker) SetTimestampGen(timestampGen func() time.Time) {
+	b.timestampGen = timestampGen
+}
@@ -0,0 +1,106 @@
+package changefeed_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+)
+
+const (
+	testTenant      = "tttttttt-tttt-tttt-tttt-tttttttttttt"
+	testOtherTenant = "oooooooo-oooo-oooo-oooo-oooooooooooo"
+	testAppID       = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	testOtherAppID  = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	testRuntimeID   = "5b1b8e0c-2a8f-4b4a-9e36-4c1c4a7f6d2e"
+	testResourceID  = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+)
+
+var (
+	testErr       = errors.New("test error")
+	testTimestamp = time.Date(2019, 11, 26, 12, 0, 0, 0, time.UTC)
+)
+
+func fixModelChangeEvent(id int64, objectType model.ChangeEventObjectType, objectID string, resourceType model.ChangeEventResourceType, resourceID string, operation model.ConfigurationChangeOperation) model.ChangeEvent {
+	return model.ChangeEvent{
+		ID:           id,
+		Tenant:       testTenant,
+		ObjectType:   objectType,
+		ObjectID:     objectID,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Operation:    operation,
+		CreatedAt:    testTimestamp,
+	}
+}
+
+func fixAppEvent(id int64, appID string, resourceType model.ChangeEventResourceType, resourceID string, operation model.ConfigurationChangeOperation) model.ChangeEvent {
+	return fixModelChangeEvent(id, model.ChangeEventObjectTypeApplication, appID, resourceType, resourceID, operation)
+}
+
+func fixRuntimeEvent(id int64, resourceType model.ChangeEventResourceType, resourceID string, operation model.ConfigurationChangeOperation) model.ChangeEvent {
+	return fixModelChangeEvent(id, model.ChangeEventObjectTypeRuntime, testRuntimeID, resourceType, resourceID, operation)
+}
+
+func fixEntityChangeEvent(id int64) changefeed.Entity {
+	return changefeed.Entity{
+		ID:           id,
+		TenantID:     testTenant,
+		ObjectType:   "APPLICATION",
+		ObjectID:     testAppID,
+		ResourceType: "API",
+		ResourceID:   testResourceID,
+		Operation:    "CREATED",
+		CreatedAt:    testTimestamp,
+	}
+}
+
+func fixChangeEventRows(entities ...changefeed.Entity) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "object_type", "object_id", "resource_type", "resource_id", "operation", "created_at"})
+	for _, e := range entities {
+		rows.AddRow(e.ID, e.TenantID, e.ObjectType, e.ObjectID, e.ResourceType, e.ResourceID, e.Operation, e.CreatedAt)
+	}
+	return rows
+}
+
+func fixScenariosLabel(scenarios ...string) *model.Label {
+	var value []interface{}
+	for _, scenario := range scenarios {
+		value = append(value, scenario)
+	}
+	return &model.Label{Key: model.ScenariosKey, Value: value}
+}
+
+// fixTransactioner returns a Transactioner which can be used for any number of successful transactions.
+func fixTransactioner() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	persistTx := &persistenceautomock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil)
+
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil)
+	transact.On("RollbackUnlessCommited", persistTx).Return()
+
+	return persistTx, transact
+}
+
+func fixCtxWithTenant() context.Context {
+	return tenant.SaveToContext(context.TODO(), testTenant)
+}
+
+func fixEventChannel(events ...model.ChangeEvent) <-chan model.ChangeEvent {
+	ch := make(chan model.ChangeEvent, len(events))
+	for _, event := range events {
+		ch <- event
+	}
+	close(ch)
+	return ch
+}
+
+func strPtr(s string) *string {
+	return &s
+}
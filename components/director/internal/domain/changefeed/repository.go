@@ -0,0 +1,84 @@
+package changefeed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const changeEventTable string = `public.change_events`
+
+var changeEventColumns = []string{"id", "tenant_id", "object_type", "object_id", "resource_type", "resource_id", "operation", "created_at"}
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	FromEntity(in Entity) model.ChangeEvent
+}
+
+// Change Events are written by database triggers, so the repository only reads and removes them.
+type repository struct {
+	conv EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{conv: conv}
+}
+
+func (r *repository) GetLastID(ctx context.Context) (int64, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var lastID int64
+	err = persist.Get(&lastID, fmt.Sprintf(`SELECT COALESCE(MAX(id), 0) FROM %s`, changeEventTable))
+	if err != nil {
+		return 0, errors.Wrap(err, "while getting last ChangeEvent ID from DB")
+	}
+
+	return lastID, nil
+}
+
+// ListAfter returns the Change Events with ID greater than afterID, together with the Change Events with the given IDs, ordered by ID.
+func (r *repository) ListAfter(ctx context.Context, afterID int64, ids []int64) ([]*model.ChangeEvent, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE id > $1 OR id = ANY($2) ORDER BY id`, strings.Join(changeEventColumns, ", "), changeEventTable)
+
+	var entities Collection
+	err = persist.Select(&entities, stmt, afterID, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing ChangeEvents from DB")
+	}
+
+	var items []*model.ChangeEvent
+	for _, entity := range entities {
+		event := r.conv.FromEntity(entity)
+		items = append(items, &event)
+	}
+
+	return items, nil
+}
+
+func (r *repository) DeleteCreatedBefore(ctx context.Context, createdBefore time.Time) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = persist.Exec(fmt.Sprintf(`DELETE FROM %s WHERE created_at < $1`, changeEventTable), createdBefore)
+	if err != nil {
+		return errors.Wrap(err, "while deleting ChangeEvents from DB")
+	}
+
+	return nil
+}
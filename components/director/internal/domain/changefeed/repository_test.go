@@ -0,0 +1,124 @@
+package changefeed_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetLastID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(MAX(id), 0) FROM public.change_events`)).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(42))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changefeed.NewRepository(nil)
+
+		// when
+		result, err := repo.GetLastID(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), result)
+	})
+
+	t.Run("Error when getting", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery("SELECT .*").WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changefeed.NewRepository(nil)
+
+		// when
+		_, err := repo.GetLastID(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestRepository_ListAfter(t *testing.T) {
+	stmt := regexp.QuoteMeta(`SELECT id, tenant_id, object_type, object_id, resource_type, resource_id, operation, created_at FROM public.change_events WHERE id > $1 OR id = ANY($2) ORDER BY id`)
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		firstEntity := fixEntityChangeEvent(3)
+		secondEntity := fixEntityChangeEvent(6)
+		firstEvent := fixAppEvent(3, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated)
+		secondEvent := fixAppEvent(6, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", firstEntity).Return(firstEvent).Once()
+		mockConverter.On("FromEntity", secondEntity).Return(secondEvent).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(stmt).
+			WithArgs(int64(5), pq.Array([]int64{3})).
+			WillReturnRows(fixChangeEventRows(firstEntity, secondEntity))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changefeed.NewRepository(mockConverter)
+
+		// when
+		result, err := repo.ListAfter(ctx, 5, []int64{3})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ChangeEvent{&firstEvent, &secondEvent}, result)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(stmt).WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changefeed.NewRepository(nil)
+
+		// when
+		_, err := repo.ListAfter(ctx, 5, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestRepository_DeleteCreatedBefore(t *testing.T) {
+	// given
+	createdBefore := testTimestamp.Add(-time.Hour)
+
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.change_events WHERE created_at < $1`)).
+		WithArgs(createdBefore).
+		WillReturnResult(sqlmock.NewResult(-1, 3))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := changefeed.NewRepository(nil)
+
+	// when
+	err := repo.DeleteCreatedBefore(ctx, createdBefore)
+
+	// then
+	require.NoError(t, err)
+}
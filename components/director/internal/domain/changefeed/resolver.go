@@ -0,0 +1,414 @@
+package changefeed
+
+import (
+	"context"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const appsPageSize = 100
+
+//go:generate mockery -name=ChangeSubscriber -output=automock -outpkg=automock -case=underscore
+type ChangeSubscriber interface {
+	Subscribe(ctx context.Context, tenant string) <-chan model.ChangeEvent
+}
+
+//go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
+type ApplicationService interface {
+	Exist(ctx context.Context, id string) (bool, error)
+	ListByRuntimeID(ctx context.Context, runtimeUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error)
+}
+
+//go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
+type RuntimeService interface {
+	Exist(ctx context.Context, id string) (bool, error)
+}
+
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+}
+
+//go:generate mockery -name=ChangeEventConverter -output=automock -outpkg=automock -case=underscore
+type ChangeEventConverter interface {
+	ApplicationEventToGraphQL(in model.ChangeEvent, applicationID *string, operation model.ConfigurationChangeOperation) *graphql.ApplicationEvent
+	LabelEventToGraphQL(in model.ChangeEvent) *graphql.LabelEvent
+}
+
+//go:generate mockery -name=ScopesVerifier -output=automock -outpkg=automock -case=underscore
+type ScopesVerifier interface {
+	// VerifyScopes is called explicitly, because gqlgen does not call field directives for subscriptions.
+	VerifyScopes(ctx context.Context, obj interface{}, next gqlgen.Resolver, scopesDefinition string) (interface{}, error)
+}
+
+type Resolver struct {
+	transact   persistence.Transactioner
+	subscriber ChangeSubscriber
+	appSvc     ApplicationService
+	runtimeSvc RuntimeService
+	labelRepo  LabelRepository
+	converter  ChangeEventConverter
+	scopes     ScopesVerifier
+}
+
+func NewResolver(transact persistence.Transactioner, subscriber ChangeSubscriber, appSvc ApplicationService, runtimeSvc RuntimeService, labelRepo LabelRepository, converter ChangeEventConverter, scopes ScopesVerifier) *Resolver {
+	return &Resolver{
+		transact:   transact,
+		subscriber: subscriber,
+		appSvc:     appSvc,
+		runtimeSvc: runtimeSvc,
+		labelRepo:  labelRepo,
+		converter:  converter,
+		scopes:     scopes,
+	}
+}
+
+func (r *Resolver) ApplicationChanged(ctx context.Context, id string) (<-chan *graphql.ApplicationEvent, error) {
+	tnt, err := r.prepare(ctx, "graphql.subscription.applicationChanged")
+	if err != nil {
+		return nil, err
+	}
+
+	events, unsubscribe := r.subscribe(ctx, tnt)
+
+	err = r.withTx(ctx, func(ctx context.Context) error {
+		return r.ensureExists(ctx, r.appSvc, "application", id)
+	})
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	out := make(chan *graphql.ApplicationEvent, 1)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		for event := range events {
+			if event.ObjectType != model.ChangeEventObjectTypeApplication || event.ObjectID != id {
+				continue
+			}
+
+			operation := model.ConfigurationChangeOperationUpdated
+			if event.ResourceType == model.ChangeEventResourceTypeApplication {
+				operation = event.Operation
+			}
+
+			if !sendApplicationEvent(ctx, out, r.converter.ApplicationEventToGraphQL(event, &id, operation)) || operation == model.ConfigurationChangeOperationDeleted {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// LabelChanged requires the scopes defined for the type of the watched object.
+func (r *Resolver) LabelChanged(ctx context.Context, objectType graphql.LabelableObject, objectID string) (<-chan *graphql.LabelEvent, error) {
+	var svc existenceChecker
+	var scopesDefinition string
+	switch objectType {
+	case graphql.LabelableObjectApplication:
+		svc = r.appSvc
+		scopesDefinition = "graphql.subscription.labelChanged.application"
+	case graphql.LabelableObjectRuntime:
+		svc = r.runtimeSvc
+		scopesDefinition = "graphql.subscription.labelChanged.runtime"
+	default:
+		return nil, errors.Errorf("invalid object type %s", objectType)
+	}
+
+	tnt, err := r.prepare(ctx, scopesDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	events, unsubscribe := r.subscribe(ctx, tnt)
+
+	err = r.withTx(ctx, func(ctx context.Context) error {
+		return r.ensureExists(ctx, svc, string(objectType), objectID)
+	})
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	out := make(chan *graphql.LabelEvent, 1)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		for event := range events {
+			if string(event.ObjectType) != string(objectType) || event.ObjectID != objectID {
+				continue
+			}
+
+			if event.ResourceType == model.ChangeEventResourceTypeLabel {
+				select {
+				case out <- r.converter.LabelEventToGraphQL(event):
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if string(event.ResourceType) == string(objectType) && event.Operation == model.ConfigurationChangeOperationDeleted {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// ApplicationsForRuntimeChanged notifies about every change that affects the result of the applicationsForRuntime query,
+// that is, changes of Applications in the scenarios of the Runtime, Applications entering or leaving these scenarios,
+// and changes of the scenarios of the Runtime itself.
+func (r *Resolver) ApplicationsForRuntimeChanged(ctx context.Context, runtimeID string) (<-chan *graphql.ApplicationEvent, error) {
+	tnt, err := r.prepare(ctx, "graphql.subscription.applicationsForRuntimeChanged")
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeUUID, err := uuid.Parse(runtimeID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting runtimeID to UUID")
+	}
+
+	events, unsubscribe := r.subscribe(ctx, tnt)
+
+	view := &runtimeView{resolver: r, tenant: tnt, runtimeID: runtimeID, runtimeUUID: runtimeUUID}
+	err = r.withTx(ctx, func(ctx context.Context) error {
+		if err := r.ensureExists(ctx, r.runtimeSvc, "runtime", runtimeID); err != nil {
+			return err
+		}
+		return view.reload(ctx)
+	})
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	out := make(chan *graphql.ApplicationEvent, 1)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		for event := range events {
+			result, done, err := view.handle(ctx, event)
+			if err != nil {
+				log.Error(errors.Wrapf(err, "while handling ChangeEvent with ID %d for Runtime with ID %s", event.ID, runtimeID))
+				return
+			}
+			if result != nil && !sendApplicationEvent(ctx, out, result) {
+				return
+			}
+			if done {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+type existenceChecker interface {
+	Exist(ctx context.Context, id string) (bool, error)
+}
+
+func (r *Resolver) prepare(ctx context.Context, scopesDefinition string) (string, error) {
+	_, err := r.scopes.VerifyScopes(ctx, nil, func(ctx context.Context) (interface{}, error) { return nil, nil }, scopesDefinition)
+	if err != nil {
+		return "", err
+	}
+
+	return tenant.LoadFromContext(ctx)
+}
+
+// subscribe starts listening before the watched object is checked, so no event is missed between the check and the subscription.
+// The returned function ends the subscription, when the check fails or the subscriber stops reading events before the context is done.
+func (r *Resolver) subscribe(ctx context.Context, tnt string) (<-chan model.ChangeEvent, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	return r.subscriber.Subscribe(ctx, tnt), cancel
+}
+
+func (r *Resolver) ensureExists(ctx context.Context, svc existenceChecker, objectType, id string) error {
+	exists, err := svc.Exist(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking if %s exists", objectType)
+	}
+	if !exists {
+		return errors.Errorf("%s with ID %s does not exist", objectType, id)
+	}
+
+	return nil
+}
+
+func (r *Resolver) withTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	if err := fn(persistence.SaveToContext(ctx, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Resolver) getScenarios(ctx context.Context, tnt string, objectType model.LabelableObject, objectID string) (map[string]struct{}, error) {
+	label, err := r.labelRepo.GetByKey(ctx, tnt, objectType, objectID, model.ScenariosKey)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return map[string]struct{}{}, nil
+		}
+		return nil, errors.Wrapf(err, "while getting scenarios of %s with ID %s", objectType, objectID)
+	}
+
+	scenarios := make(map[string]struct{})
+	values, ok := label.Value.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("invalid scenarios of %s with ID %s", objectType, objectID)
+	}
+	for _, value := range values {
+		scenario, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid scenarios of %s with ID %s", objectType, objectID)
+		}
+		scenarios[scenario] = struct{}{}
+	}
+
+	return scenarios, nil
+}
+
+// runtimeView tracks the Applications visible for a Runtime, so that Applications leaving its scenarios are reported as deleted.
+type runtimeView struct {
+	resolver    *Resolver
+	tenant      string
+	runtimeID   string
+	runtimeUUID uuid.UUID
+
+	scenarios map[string]struct{}
+	visible   map[string]struct{}
+}
+
+func (v *runtimeView) reload(ctx context.Context) error {
+	scenarios, err := v.resolver.getScenarios(ctx, v.tenant, model.RuntimeLabelableObject, v.runtimeID)
+	if err != nil {
+		return err
+	}
+
+	visible := make(map[string]struct{})
+	cursor := ""
+	for {
+		page, err := v.resolver.appSvc.ListByRuntimeID(ctx, v.runtimeUUID, appsPageSize, cursor)
+		if err != nil {
+			return errors.Wrap(err, "while listing Applications for Runtime")
+		}
+		for _, app := range page.Data {
+			visible[app.ID] = struct{}{}
+		}
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			break
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+
+	v.scenarios = scenarios
+	v.visible = visible
+	return nil
+}
+
+func (v *runtimeView) handle(ctx context.Context, event model.ChangeEvent) (*graphql.ApplicationEvent, bool, error) {
+	conv := v.resolver.converter
+
+	switch event.ObjectType {
+	case model.ChangeEventObjectTypeRuntime:
+		if event.ObjectID != v.runtimeID {
+			return nil, false, nil
+		}
+
+		switch {
+		case event.ResourceType == model.ChangeEventResourceTypeRuntime && event.Operation == model.ConfigurationChangeOperationDeleted:
+			return conv.ApplicationEventToGraphQL(event, nil, model.ConfigurationChangeOperationDeleted), true, nil
+		case isScenariosEvent(event):
+			err := v.resolver.withTx(ctx, v.reload)
+			if err != nil {
+				return nil, false, err
+			}
+			return conv.ApplicationEventToGraphQL(event, nil, model.ConfigurationChangeOperationUpdated), false, nil
+		}
+	case model.ChangeEventObjectTypeApplication:
+		appID := event.ObjectID
+		_, wasVisible := v.visible[appID]
+
+		switch {
+		case event.ResourceType == model.ChangeEventResourceTypeApplication && event.Operation == model.ConfigurationChangeOperationDeleted:
+			delete(v.visible, appID)
+			if wasVisible {
+				return conv.ApplicationEventToGraphQL(event, &appID, model.ConfigurationChangeOperationDeleted), false, nil
+			}
+		case event.ResourceType == model.ChangeEventResourceTypeApplication && event.Operation == model.ConfigurationChangeOperationCreated, isScenariosEvent(event):
+			var isVisible bool
+			err := v.resolver.withTx(ctx, func(ctx context.Context) error {
+				var err error
+				isVisible, err = v.isVisible(ctx, appID)
+				return err
+			})
+			if err != nil {
+				return nil, false, err
+			}
+
+			operation := model.ConfigurationChangeOperationUpdated
+			switch {
+			case isVisible && !wasVisible:
+				v.visible[appID] = struct{}{}
+				operation = model.ConfigurationChangeOperationCreated
+			case !isVisible && wasVisible:
+				delete(v.visible, appID)
+				operation = model.ConfigurationChangeOperationDeleted
+			case !isVisible:
+				return nil, false, nil
+			}
+			return conv.ApplicationEventToGraphQL(event, &appID, operation), false, nil
+		case wasVisible:
+			return conv.ApplicationEventToGraphQL(event, &appID, model.ConfigurationChangeOperationUpdated), false, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (v *runtimeView) isVisible(ctx context.Context, appID string) (bool, error) {
+	scenarios, err := v.resolver.getScenarios(ctx, v.tenant, model.ApplicationLabelableObject, appID)
+	if err != nil {
+		return false, err
+	}
+
+	for scenario := range scenarios {
+		if _, ok := v.scenarios[scenario]; ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func isScenariosEvent(event model.ChangeEvent) bool {
+	return event.ResourceType == model.ChangeEventResourceTypeLabel && event.ResourceID == model.ScenariosKey
+}
+
+func sendApplicationEvent(ctx context.Context, out chan<- *graphql.ApplicationEvent, event *graphql.ApplicationEvent) bool {
+	select {
+	case out <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,313 @@
+package changefeed_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ApplicationChanged(t *testing.T) {
+	// given
+	scopesPath := "graphql.subscription.applicationChanged"
+
+	testCases := []struct {
+		Name           string
+		ScopesFn       func() *automock.ScopesVerifier
+		AppSvcFn       func() *automock.ApplicationService
+		Events         []model.ChangeEvent
+		ExpectedOutput []*graphql.ApplicationEvent
+		ExpectedErr    error
+	}{
+		{
+			Name:     "Success",
+			ScopesFn: fixScopesVerifier(scopesPath, nil),
+			AppSvcFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Exist", txtest.CtxWithDBMatcher(), testAppID).Return(true, nil).Once()
+				return svc
+			},
+			Events: []model.ChangeEvent{
+				fixAppEvent(1, testOtherAppID, model.ChangeEventResourceTypeApplication, testOtherAppID, model.ConfigurationChangeOperationUpdated),
+				fixAppEvent(2, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationCreated),
+				fixRuntimeEvent(3, model.ChangeEventResourceTypeRuntime, testRuntimeID, model.ConfigurationChangeOperationUpdated),
+				fixAppEvent(4, testAppID, model.ChangeEventResourceTypeApplication, testAppID, model.ConfigurationChangeOperationDeleted),
+				fixAppEvent(5, testAppID, model.ChangeEventResourceTypeApplication, testAppID, model.ConfigurationChangeOperationCreated),
+			},
+			ExpectedOutput: []*graphql.ApplicationEvent{
+				fixGQLApplicationEvent(strPtr(testAppID), graphql.ChangeOperationUpdated),
+				fixGQLApplicationEvent(strPtr(testAppID), graphql.ChangeOperationDeleted),
+			},
+		},
+		{
+			Name:     "Returns error when scopes are insufficient",
+			ScopesFn: fixScopesVerifier(scopesPath, testErr),
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:     "Returns error when Application does not exist",
+			ScopesFn: fixScopesVerifier(scopesPath, nil),
+			AppSvcFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Exist", txtest.CtxWithDBMatcher(), testAppID).Return(false, nil).Once()
+				return svc
+			},
+			ExpectedErr: errors.New("application with ID aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, transact := fixTransactioner()
+			scopes := testCase.ScopesFn()
+			appSvc := testCase.AppSvcFn()
+			subscriber := &automock.ChangeSubscriber{}
+			subscriber.On("Subscribe", mock.Anything, testTenant).Return(fixEventChannel(testCase.Events...)).Maybe()
+
+			resolver := changefeed.NewResolver(transact, subscriber, appSvc, nil, nil, changefeed.NewConverter(), scopes)
+
+			// when
+			result, err := resolver.ApplicationChanged(fixCtxWithTenant(), testAppID)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOutput, collectApplicationEvents(t, result))
+			}
+
+			scopes.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_LabelChanged(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// given
+		_, transact := fixTransactioner()
+		scopes := fixScopesVerifier("graphql.subscription.labelChanged.runtime", nil)()
+		runtimeSvc := &automock.RuntimeService{}
+		runtimeSvc.On("Exist", txtest.CtxWithDBMatcher(), testRuntimeID).Return(true, nil).Once()
+		subscriber := &automock.ChangeSubscriber{}
+		subscriber.On("Subscribe", mock.Anything, testTenant).Return(fixEventChannel(
+			fixRuntimeEvent(1, model.ChangeEventResourceTypeLabel, "foo", model.ConfigurationChangeOperationCreated),
+			fixAppEvent(2, testRuntimeID, model.ChangeEventResourceTypeLabel, "foo", model.ConfigurationChangeOperationCreated),
+			fixRuntimeEvent(3, model.ChangeEventResourceTypeRuntime, testRuntimeID, model.ConfigurationChangeOperationUpdated),
+			fixRuntimeEvent(4, model.ChangeEventResourceTypeRuntime, testRuntimeID, model.ConfigurationChangeOperationDeleted),
+			fixRuntimeEvent(5, model.ChangeEventResourceTypeLabel, "bar", model.ConfigurationChangeOperationCreated),
+		)).Once()
+
+		resolver := changefeed.NewResolver(transact, subscriber, nil, runtimeSvc, nil, changefeed.NewConverter(), scopes)
+
+		// when
+		result, err := resolver.LabelChanged(fixCtxWithTenant(), graphql.LabelableObjectRuntime, testRuntimeID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*graphql.LabelEvent{{
+			ObjectType: graphql.LabelableObjectRuntime,
+			ObjectID:   testRuntimeID,
+			Key:        "foo",
+			Operation:  graphql.ChangeOperationCreated,
+			Timestamp:  graphql.Timestamp(testTimestamp),
+		}}, collectLabelEvents(t, result))
+
+		scopes.AssertExpectations(t)
+		runtimeSvc.AssertExpectations(t)
+		subscriber.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when scopes for Application are insufficient", func(t *testing.T) {
+		// given
+		scopes := fixScopesVerifier("graphql.subscription.labelChanged.application", testErr)()
+		resolver := changefeed.NewResolver(nil, nil, nil, nil, nil, nil, scopes)
+
+		// when
+		_, err := resolver.LabelChanged(fixCtxWithTenant(), graphql.LabelableObjectApplication, testAppID)
+
+		// then
+		require.EqualError(t, err, testErr.Error())
+		scopes.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when object type is invalid", func(t *testing.T) {
+		// given
+		resolver := changefeed.NewResolver(nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.LabelChanged(fixCtxWithTenant(), graphql.LabelableObject("FOO"), testRuntimeID)
+
+		// then
+		require.EqualError(t, err, "invalid object type FOO")
+	})
+}
+
+func TestResolver_ApplicationsForRuntimeChanged(t *testing.T) {
+	runtimeUUID, err := uuid.Parse(testRuntimeID)
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		_, transact := fixTransactioner()
+		scopes := fixScopesVerifier("graphql.subscription.applicationsForRuntimeChanged", nil)()
+
+		runtimeSvc := &automock.RuntimeService{}
+		runtimeSvc.On("Exist", txtest.CtxWithDBMatcher(), testRuntimeID).Return(true, nil).Once()
+
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("ListByRuntimeID", txtest.CtxWithDBMatcher(), runtimeUUID, 100, "").Return(&model.ApplicationPage{
+			Data:     []*model.Application{{ID: testAppID}},
+			PageInfo: &pagination.Page{HasNextPage: true, EndCursor: "next"},
+		}, nil).Once()
+		appSvc.On("ListByRuntimeID", txtest.CtxWithDBMatcher(), runtimeUUID, 100, "next").Return(&model.ApplicationPage{
+			Data:     []*model.Application{},
+			PageInfo: &pagination.Page{},
+		}, nil).Once()
+		appSvc.On("ListByRuntimeID", txtest.CtxWithDBMatcher(), runtimeUUID, 100, "").Return(&model.ApplicationPage{
+			Data:     []*model.Application{{ID: testOtherAppID}},
+			PageInfo: &pagination.Page{},
+		}, nil).Once()
+
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), testTenant, model.RuntimeLabelableObject, testRuntimeID, model.ScenariosKey).Return(fixScenariosLabel("foo"), nil).Once()
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), testTenant, model.ApplicationLabelableObject, testOtherAppID, model.ScenariosKey).Return(fixScenariosLabel("foo", "bar"), nil).Once()
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), testTenant, model.ApplicationLabelableObject, testAppID, model.ScenariosKey).Return(nil, apperrors.NewNotFoundError("")).Once()
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), testTenant, model.RuntimeLabelableObject, testRuntimeID, model.ScenariosKey).Return(fixScenariosLabel("bar"), nil).Once()
+
+		subscriber := &automock.ChangeSubscriber{}
+		subscriber.On("Subscribe", mock.Anything, testTenant).Return(fixEventChannel(
+			fixAppEvent(1, testAppID, model.ChangeEventResourceTypeAPI, testResourceID, model.ConfigurationChangeOperationUpdated),
+			fixAppEvent(2, testOtherAppID, model.ChangeEventResourceTypeDocument, testResourceID, model.ConfigurationChangeOperationCreated),
+			fixAppEvent(3, testOtherAppID, model.ChangeEventResourceTypeLabel, model.ScenariosKey, model.ConfigurationChangeOperationCreated),
+			fixAppEvent(4, testAppID, model.ChangeEventResourceTypeLabel, model.ScenariosKey, model.ConfigurationChangeOperationDeleted),
+			fixAppEvent(5, testAppID, model.ChangeEventResourceTypeApplication, testAppID, model.ConfigurationChangeOperationDeleted),
+			fixRuntimeEvent(6, model.ChangeEventResourceTypeLabel, model.ScenariosKey, model.ConfigurationChangeOperationUpdated),
+			fixAppEvent(7, testOtherAppID, model.ChangeEventResourceTypeWebhook, testResourceID, model.ConfigurationChangeOperationDeleted),
+			fixRuntimeEvent(8, model.ChangeEventResourceTypeRuntime, testRuntimeID, model.ConfigurationChangeOperationDeleted),
+			fixRuntimeEvent(9, model.ChangeEventResourceTypeLabel, "foo", model.ConfigurationChangeOperationCreated),
+		)).Once()
+
+		resolver := changefeed.NewResolver(transact, subscriber, appSvc, runtimeSvc, labelRepo, changefeed.NewConverter(), scopes)
+
+		// when
+		result, err := resolver.ApplicationsForRuntimeChanged(fixCtxWithTenant(), testRuntimeID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*graphql.ApplicationEvent{
+			fixGQLApplicationEvent(strPtr(testAppID), graphql.ChangeOperationUpdated),
+			fixGQLApplicationEvent(strPtr(testOtherAppID), graphql.ChangeOperationCreated),
+			fixGQLApplicationEvent(strPtr(testAppID), graphql.ChangeOperationDeleted),
+			fixGQLApplicationEvent(nil, graphql.ChangeOperationUpdated),
+			fixGQLApplicationEvent(strPtr(testOtherAppID), graphql.ChangeOperationUpdated),
+			fixGQLApplicationEvent(nil, graphql.ChangeOperationDeleted),
+		}, collectApplicationEvents(t, result))
+
+		scopes.AssertExpectations(t)
+		runtimeSvc.AssertExpectations(t)
+		appSvc.AssertExpectations(t)
+		labelRepo.AssertExpectations(t)
+		subscriber.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when Runtime does not exist", func(t *testing.T) {
+		// given
+		_, transact := fixTransactioner()
+		scopes := fixScopesVerifier("graphql.subscription.applicationsForRuntimeChanged", nil)()
+		runtimeSvc := &automock.RuntimeService{}
+		runtimeSvc.On("Exist", txtest.CtxWithDBMatcher(), testRuntimeID).Return(false, nil).Once()
+		var subscriptionCtx context.Context
+		subscriber := &automock.ChangeSubscriber{}
+		subscriber.On("Subscribe", mock.Anything, testTenant).Return(fixEventChannel()).Run(func(args mock.Arguments) {
+			subscriptionCtx = args.Get(0).(context.Context)
+		}).Once()
+
+		resolver := changefeed.NewResolver(transact, subscriber, nil, runtimeSvc, nil, nil, scopes)
+
+		// when
+		_, err := resolver.ApplicationsForRuntimeChanged(fixCtxWithTenant(), testRuntimeID)
+
+		// then
+		require.EqualError(t, err, "runtime with ID 5b1b8e0c-2a8f-4b4a-9e36-4c1c4a7f6d2e does not exist")
+		require.NotNil(t, subscriptionCtx)
+		assert.Error(t, subscriptionCtx.Err(), "subscription should be ended")
+		runtimeSvc.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when Runtime ID is not UUID", func(t *testing.T) {
+		// given
+		scopes := fixScopesVerifier("graphql.subscription.applicationsForRuntimeChanged", nil)()
+		resolver := changefeed.NewResolver(nil, nil, nil, nil, nil, nil, scopes)
+
+		// when
+		_, err := resolver.ApplicationsForRuntimeChanged(fixCtxWithTenant(), "foo")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while converting runtimeID to UUID")
+	})
+}
+
+func fixScopesVerifier(scopesPath string, err error) func() *automock.ScopesVerifier {
+	return func() *automock.ScopesVerifier {
+		scopes := &automock.ScopesVerifier{}
+		scopes.On("VerifyScopes", mock.Anything, nil, mock.Anything, scopesPath).Return(nil, err).Once()
+		return scopes
+	}
+}
+
+func fixGQLApplicationEvent(applicationID *string, operation graphql.ChangeOperation) *graphql.ApplicationEvent {
+	return &graphql.ApplicationEvent{
+		ApplicationID: applicationID,
+		Operation:     operation,
+		Timestamp:     graphql.Timestamp(testTimestamp),
+	}
+}
+
+func collectApplicationEvents(t *testing.T, ch <-chan *graphql.ApplicationEvent) []*graphql.ApplicationEvent {
+	var events []*graphql.ApplicationEvent
+	for {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not closed")
+		}
+	}
+}
+
+func collectLabelEvents(t *testing.T, ch <-chan *graphql.LabelEvent) []*graphql.LabelEvent {
+	var events []*graphql.LabelEvent
+	for {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not closed")
+		}
+	}
+}
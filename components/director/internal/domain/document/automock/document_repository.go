@@ -2,10 +2,12 @@
 
 package automock
 
-import context "context"
+import (
+	context "context"
 
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // DocumentRepository is an autogenerated mock type for the DocumentRepository type
 type DocumentRepository struct {
@@ -106,3 +108,17 @@ func (_m *DocumentRepository) ListByApplicationID(ctx context.Context, tenant st
 
 	return r0, r1
 }
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *DocumentRepository) Update(ctx context.Context, item *model.Document) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Document) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
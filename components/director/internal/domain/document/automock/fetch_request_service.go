@@ -0,0 +1,38 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FetchRequestService is an autogenerated mock type for the FetchRequestService type
+type FetchRequestService struct {
+	mock.Mock
+}
+
+// HandleSpec provides a mock function with given fields: ctx, fr, validate
+func (_m *FetchRequestService) HandleSpec(ctx context.Context, fr *model.FetchRequest, validate func(string) error) (*string, error) {
+	ret := _m.Called(ctx, fr, validate)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FetchRequest, func(string) error) *string); ok {
+		r0 = rf(ctx, fr, validate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.FetchRequest, func(string) error) error); ok {
+		r1 = rf(ctx, fr, validate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
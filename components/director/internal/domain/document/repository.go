@@ -16,8 +16,9 @@ import (
 const documentTable = "public.documents"
 
 var (
-	documentColumns = []string{"id", "tenant_id", "app_id", "title", "display_name", "description", "format", "kind", "data"}
-	tenantColumn    = "tenant_id"
+	documentColumns  = []string{"id", "tenant_id", "app_id", "title", "display_name", "description", "format", "kind", "data"}
+	updatableColumns = []string{"title", "display_name", "description", "format", "kind", "data"}
+	tenantColumn     = "tenant_id"
 )
 
 //go:generate mockery -name=Converter -output=automock -outpkg=automock -case=underscore
@@ -32,6 +33,7 @@ type repository struct {
 	deleter         repo.Deleter
 	pageableQuerier repo.PageableQuerier
 	creator         repo.Creator
+	updater         repo.Updater
 
 	conv Converter
 }
@@ -43,6 +45,7 @@ func NewRepository(conv Converter) *repository {
 		deleter:         repo.NewDeleter(documentTable, tenantColumn),
 		pageableQuerier: repo.NewPageableQuerier(documentTable, tenantColumn, documentColumns),
 		creator:         repo.NewCreator(documentTable, documentColumns),
+		updater:         repo.NewUpdater(documentTable, updatableColumns, tenantColumn, []string{"id"}),
 
 		conv: conv,
 	}
@@ -93,6 +96,19 @@ func (r *repository) CreateMany(ctx context.Context, items []*model.Document) er
 	return nil
 }
 
+func (r *repository) Update(ctx context.Context, item *model.Document) error {
+	if item == nil {
+		return errors.New("Document cannot be empty")
+	}
+
+	entity, err := r.conv.ToEntity(*item)
+	if err != nil {
+		return errors.Wrap(err, "while creating Document entity from model")
+	}
+
+	return r.updater.UpdateSingle(ctx, entity)
+}
+
 func (r *repository) Delete(ctx context.Context, tenant, id string) error {
 	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
@@ -383,6 +383,79 @@ func TestRepository_GetByID(t *testing.T) {
 	})
 }
 
+func TestRepository_Update(t *testing.T) {
+	refID := appID()
+	updateQuery := regexp.QuoteMeta("UPDATE public.documents SET title = ?, display_name = ?, description = ?, format = ?, kind = ?, data = ? WHERE tenant_id = ? AND id = ?")
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		docModel := fixModelDocument(givenID(), refID)
+		docEntity := fixEntityDocument(givenID(), refID)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("ToEntity", *docModel).Return(*docEntity, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(updateQuery).
+			WithArgs(docEntity.Title, docEntity.DisplayName, docEntity.Description, docEntity.Format, docEntity.Kind, docEntity.Data, givenTenant(), givenID()).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := document.NewRepository(mockConverter)
+		// WHEN
+		err := repo.Update(ctx, docModel)
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// GIVEN
+		docModel := fixModelDocument(givenID(), refID)
+		docEntity := fixEntityDocument(givenID(), refID)
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", *docModel).Return(*docEntity, nil)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec("UPDATE .*").WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := document.NewRepository(mockConverter)
+		// WHEN
+		err := repo.Update(ctx, docModel)
+		// THEN
+		require.EqualError(t, err, "while updating single entity: some error")
+	})
+
+	t.Run("Converter Error", func(t *testing.T) {
+		// GIVEN
+		docModel := fixModelDocument(givenID(), refID)
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", *docModel).Return(document.Entity{}, givenError())
+
+		repo := document.NewRepository(mockConverter)
+		// WHEN
+		err := repo.Update(context.TODO(), docModel)
+		// THEN
+		require.EqualError(t, err, "while creating Document entity from model: some error")
+	})
+
+	t.Run("Nil Error", func(t *testing.T) {
+		// GIVEN
+		repo := document.NewRepository(nil)
+		// WHEN
+		err := repo.Update(context.TODO(), nil)
+		// THEN
+		require.EqualError(t, err, "Document cannot be empty")
+	})
+}
+
 func TestRepository_Delete(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// GIVEN
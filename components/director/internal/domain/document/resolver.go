@@ -37,25 +37,38 @@ type FetchRequestConverter interface {
 type ApplicationService interface {
 	Exist(ctx context.Context, id string) (bool, error)
 }
+
+//go:generate mockery -name=SpecPrefetcher -output=automock -outpkg=automock -case=underscore
+type SpecPrefetcher interface {
+	Prefetch(ctx context.Context, fetchRequests []*model.FetchRequest) context.Context
+}
+
 type Resolver struct {
 	transact    persistence.Transactioner
 	svc         DocumentService
 	appSvc      ApplicationService
 	converter   DocumentConverter
 	frConverter FetchRequestConverter
+	prefetcher  SpecPrefetcher
 }
 
-func NewResolver(transact persistence.Transactioner, svc DocumentService, appSvc ApplicationService, frConverter FetchRequestConverter) *Resolver {
+func NewResolver(transact persistence.Transactioner, svc DocumentService, appSvc ApplicationService, frConverter FetchRequestConverter, prefetcher SpecPrefetcher) *Resolver {
 	return &Resolver{
 		transact:    transact,
 		svc:         svc,
 		appSvc:      appSvc,
 		frConverter: frConverter,
 		converter:   &converter{frConverter: frConverter},
+		prefetcher:  prefetcher,
 	}
 }
 
 func (r *Resolver) AddDocument(ctx context.Context, applicationID string, in graphql.DocumentInput) (*graphql.Document, error) {
+	convertedIn := r.converter.InputFromGraphQL(&in)
+
+	// the data is downloaded before the transaction is started, so that the transaction is not kept open during the download
+	ctx = r.prefetcher.Prefetch(ctx, model.FetchRequestsFromInputs([]*model.FetchRequestInput{convertedIn.FetchRequest}))
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -64,8 +77,6 @@ func (r *Resolver) AddDocument(ctx context.Context, applicationID string, in gra
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	convertedIn := r.converter.InputFromGraphQL(&in)
-
 	found, err := r.appSvc.Exist(ctx, applicationID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while checking existence of Application")
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
@@ -212,8 +213,10 @@ func TestResolver_AddDocument(t *testing.T) {
 			svc := testCase.ServiceFn()
 			appSvc := testCase.AppServiceFn()
 			converter := testCase.ConverterFn()
+			prefetcher := &automock.SpecPrefetcher{}
+			prefetcher.On("Prefetch", context.TODO(), model.FetchRequestsFromInputs([]*model.FetchRequestInput{modelInput.FetchRequest})).Return(context.TODO()).Once()
 
-			resolver := document.NewResolver(transact, svc, appSvc, nil)
+			resolver := document.NewResolver(transact, svc, appSvc, nil, prefetcher)
 			resolver.SetConverter(converter)
 
 			// when
@@ -233,6 +236,7 @@ func TestResolver_AddDocument(t *testing.T) {
 			svc.AssertExpectations(t)
 			appSvc.AssertExpectations(t)
 			converter.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
 		})
 	}
 }
@@ -344,7 +348,7 @@ func TestResolver_DeleteDocument(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := document.NewResolver(transact, svc, nil, nil)
+			resolver := document.NewResolver(transact, svc, nil, nil, nil)
 			resolver.SetConverter(converter)
 
 			// when
@@ -464,7 +468,7 @@ func TestResolver_FetchRequest(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := document.NewResolver(transact, svc, nil, converter)
+			resolver := document.NewResolver(transact, svc, nil, converter, nil)
 
 			// when
 			result, err := resolver.FetchRequest(context.TODO(), &graphql.Document{ID: id})
@@ -19,6 +19,7 @@ type DocumentRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Document, error)
 	ListByApplicationID(ctx context.Context, tenant string, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error)
 	Create(ctx context.Context, item *model.Document) error
+	Update(ctx context.Context, item *model.Document) error
 	Delete(ctx context.Context, tenant, id string) error
 }
 
@@ -29,24 +30,38 @@ type FetchRequestRepository interface {
 	Delete(ctx context.Context, tenant, id string) error
 }
 
+//go:generate mockery -name=FetchRequestService -output=automock -outpkg=automock -case=underscore
+type FetchRequestService interface {
+	HandleSpec(ctx context.Context, fr *model.FetchRequest, validate func(data string) error) (*string, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=ConfigurationChangeNotifier -output=automock -outpkg=automock -case=underscore
+type ConfigurationChangeNotifier interface {
+	NotifyConfigurationChanged(ctx context.Context, applicationID string, change model.ConfigurationChange) error
+}
+
 type service struct {
-	repo             DocumentRepository
-	fetchRequestRepo FetchRequestRepository
-	uidService       UIDService
-	timestampGen     timestamp.Generator
+	repo                DocumentRepository
+	fetchRequestRepo    FetchRequestRepository
+	fetchRequestService FetchRequestService
+	uidService          UIDService
+	notifier            ConfigurationChangeNotifier
+	timestampGen        timestamp.Generator
 }
 
-func NewService(repo DocumentRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService) *service {
+func NewService(repo DocumentRepository, fetchRequestRepo FetchRequestRepository, fetchRequestService FetchRequestService, uidService UIDService, notifier ConfigurationChangeNotifier) *service {
 	return &service{
-		repo:             repo,
-		fetchRequestRepo: fetchRequestRepo,
-		uidService:       uidService,
-		timestampGen:     timestamp.DefaultGenerator(),
+		repo:                repo,
+		fetchRequestRepo:    fetchRequestRepo,
+		fetchRequestService: fetchRequestService,
+		uidService:          uidService,
+		notifier:            notifier,
+		timestampGen:        timestamp.DefaultGenerator(),
 	}
 }
 
@@ -95,6 +110,25 @@ func (s *service) Create(ctx context.Context, applicationID string, in model.Doc
 		if err != nil {
 			return "", errors.Wrapf(err, "while creating FetchRequest for Document %s", id)
 		}
+
+		data, err := s.fetchRequestService.HandleSpec(ctx, fetchRequestModel, nil)
+		if err != nil {
+			return "", errors.Wrapf(err, "while fetching data for Document %s", id)
+		}
+		// the data provided in the input is kept when the fetch fails
+		if data != nil {
+			document.Data = data
+		}
+
+		err = s.repo.Update(ctx, document)
+		if err != nil {
+			return "", errors.Wrapf(err, "while updating Document %s with fetched data", id)
+		}
+	}
+
+	err = s.notifier.NotifyConfigurationChanged(ctx, applicationID, documentChange(id, model.ConfigurationChangeOperationCreated))
+	if err != nil {
+		return "", errors.Wrapf(err, "while notifying about creation of Document %s", id)
 	}
 
 	return document.ID, nil
@@ -106,11 +140,21 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	document, err := s.repo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting Document with ID %s", id)
+	}
+
 	err = s.repo.Delete(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Document with ID %s", id)
 	}
 
+	err = s.notifier.NotifyConfigurationChanged(ctx, document.ApplicationID, documentChange(id, model.ConfigurationChangeOperationDeleted))
+	if err != nil {
+		return errors.Wrapf(err, "while notifying about deletion of Document %s", id)
+	}
+
 	return nil
 }
 
@@ -138,3 +182,11 @@ func (s *service) GetFetchRequest(ctx context.Context, documentID string) (*mode
 
 	return fetchRequest, nil
 }
+
+func documentChange(id string, operation model.ConfigurationChangeOperation) model.ConfigurationChange {
+	return model.ConfigurationChange{
+		ResourceType: model.ConfigurationChangeResourceTypeDocument,
+		ResourceID:   id,
+		Operation:    operation,
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,7 +66,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := document.NewService(repo, nil, nil)
+			svc := document.NewService(repo, nil, nil, nil, nil)
 
 			// when
 			document, err := svc.Get(ctx, testCase.InputID)
@@ -144,7 +145,7 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := document.NewService(repo, nil, nil)
+			svc := document.NewService(repo, nil, nil, nil, nil)
 
 			// when
 			docs, err := svc.List(ctx, applicationID, first, after)
@@ -177,20 +178,27 @@ func TestService_Create(t *testing.T) {
 	timestamp := time.Now()
 	modelInput := fixModelDocumentInputWithFetchRequest(frURL)
 	modelDoc := modelInput.ToDocument(id, tnt, applicationID)
+	fetchedData := "fetched"
+	modelDocWithFetchedData := modelInput.ToDocument(id, tnt, applicationID)
+	modelDocWithFetchedData.Data = &fetchedData
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeDocument, ResourceID: id, Operation: model.ConfigurationChangeOperationCreated}
 
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.DocumentRepository
-		FetchRequestRepoFn func() *automock.FetchRequestRepository
-		UIDServiceFn       func() *automock.UIDService
-		Input              model.DocumentInput
-		ExpectedErr        error
+		Name                  string
+		RepositoryFn          func() *automock.DocumentRepository
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		FetchRequestServiceFn func() *automock.FetchRequestService
+		UIDServiceFn          func() *automock.UIDService
+		NotifierFn            func() *automock.ConfigurationChangeNotifier
+		Input                 model.DocumentInput
+		ExpectedErr           error
 	}{
 		{
 			Name: "Success",
 			RepositoryFn: func() *automock.DocumentRepository {
 				repo := &automock.DocumentRepository{}
 				repo.On("Create", ctx, modelDoc).Return(nil).Once()
+				repo.On("Update", ctx, modelDocWithFetchedData).Return(nil).Once()
 				return repo
 			},
 			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
@@ -198,12 +206,22 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&fetchedData, nil).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
 				svc.On("Generate").Return(frID).Once()
 				return svc
 			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
 			Input:       *modelInput,
 			ExpectedErr: nil,
 		},
@@ -218,6 +236,9 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
@@ -238,6 +259,62 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(testErr).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input:       *modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - Fetching data",
+			RepositoryFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("Create", ctx, modelDoc).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(nil, testErr).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input:       *modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - Document Update with fetched data",
+			RepositoryFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("Create", ctx, modelDoc).Return(nil).Once()
+				repo.On("Update", ctx, modelDocWithFetchedData).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&fetchedData, nil).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
@@ -247,6 +324,38 @@ func TestService_Create(t *testing.T) {
 			Input:       *modelInput,
 			ExpectedErr: testErr,
 		},
+		{
+			Name: "Returns error when notifying about creation failed",
+			RepositoryFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("Create", ctx, modelDoc).Return(nil).Once()
+				repo.On("Update", ctx, modelDocWithFetchedData).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&fetchedData, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			Input:       *modelInput,
+			ExpectedErr: testErr,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -254,7 +363,12 @@ func TestService_Create(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			idSvc := testCase.UIDServiceFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := document.NewService(repo, fetchRequestRepo, idSvc)
+			fetchRequestSvc := testCase.FetchRequestServiceFn()
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+			svc := document.NewService(repo, fetchRequestRepo, fetchRequestSvc, idSvc, notifier)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -272,11 +386,13 @@ func TestService_Create(t *testing.T) {
 			repo.AssertExpectations(t)
 			idSvc.AssertExpectations(t)
 			fetchRequestRepo.AssertExpectations(t)
+			fetchRequestSvc.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := document.NewService(nil, nil, nil)
+		svc := document.NewService(nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), "Dd", model.DocumentInput{})
 		assert.Equal(t, tenant.NoTenantError, err)
@@ -289,16 +405,18 @@ func TestService_Delete(t *testing.T) {
 
 	applicationID := "foo"
 	id := "bar"
-	documentModel := fixModelDocument(applicationID, id)
+	documentModel := fixModelDocument(id, applicationID)
 
 	tnt := documentModel.Tenant
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, documentModel.Tenant)
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeDocument, ResourceID: id, Operation: model.ConfigurationChangeOperationDeleted}
 
 	testCases := []struct {
 		Name               string
 		RepositoryFn       func() *automock.DocumentRepository
+		NotifierFn         func() *automock.ConfigurationChangeNotifier
 		Input              model.DocumentInput
 		InputID            string
 		ExpectedErrMessage string
@@ -307,29 +425,66 @@ func TestService_Delete(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.DocumentRepository {
 				repo := &automock.DocumentRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(documentModel, nil).Once()
 				repo.On("Delete", ctx, tnt, id).Return(nil).Once()
 				return repo
 			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
 			InputID:            id,
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Returns error when getting document failed",
+			RepositoryFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:            id,
+			ExpectedErrMessage: testErr.Error(),
+		},
 		{
 			Name: "Returns error when document deletion failed",
 			RepositoryFn: func() *automock.DocumentRepository {
 				repo := &automock.DocumentRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(documentModel, nil).Once()
 				repo.On("Delete", ctx, tnt, id).Return(testErr).Once()
 				return repo
 			},
 			InputID:            id,
 			ExpectedErrMessage: testErr.Error(),
 		},
+		{
+			Name: "Returns error when notifying about deletion failed",
+			RepositoryFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(documentModel, nil).Once()
+				repo.On("Delete", ctx, tnt, id).Return(nil).Once()
+				return repo
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			InputID:            id,
+			ExpectedErrMessage: testErr.Error(),
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
 
-			svc := document.NewService(repo, nil, nil)
+			svc := document.NewService(repo, nil, nil, nil, notifier)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -342,6 +497,7 @@ func TestService_Delete(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 }
@@ -434,7 +590,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := document.NewService(repo, fetchRequestRepo, nil)
+			svc := document.NewService(repo, fetchRequestRepo, nil, nil, nil)
 
 			// when
 			l, err := svc.GetFetchRequest(ctx, refID)
@@ -0,0 +1,80 @@
+package event
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// RuntimeEventURLLabelKey is the label set by the Runtime Agent with the URL of the Events Gateway of the Runtime.
+	RuntimeEventURLLabelKey = "runtime/event_service_url"
+	// ApplicationEventURLLabelKey is the label which overrides the Event URL of the Application for all Runtimes.
+	ApplicationEventURLLabelKey = "application/event_service_url"
+)
+
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+}
+
+type service struct {
+	labelRepo       LabelRepository
+	defaultEventURL string
+}
+
+func NewService(labelRepo LabelRepository, defaultEventURL string) *service {
+	return &service{
+		labelRepo:       labelRepo,
+		defaultEventURL: defaultEventURL,
+	}
+}
+
+// GetEventURL returns the URL to which the Application sends events, as seen by the caller.
+// The URL set on the Application takes precedence over the URL of the calling Runtime, which takes precedence over the default one.
+// A label with a value which is not a non-empty string is ignored, so that it does not break queries of the Application.
+// It returns an empty string if no URL is configured.
+func (s *service) GetEventURL(ctx context.Context, applicationID string) (string, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	url, found, err := s.getURLFromLabel(ctx, tnt, model.ApplicationLabelableObject, applicationID, ApplicationEventURLLabelKey)
+	if err != nil || found {
+		return url, err
+	}
+
+	c, err := consumer.LoadFromContext(ctx)
+	if err == nil && c.ConsumerType == consumer.Runtime {
+		url, found, err := s.getURLFromLabel(ctx, tnt, model.RuntimeLabelableObject, c.ConsumerID, RuntimeEventURLLabelKey)
+		if err != nil || found {
+			return url, err
+		}
+	}
+
+	return s.defaultEventURL, nil
+}
+
+func (s *service) getURLFromLabel(ctx context.Context, tnt string, objectType model.LabelableObject, objectID, key string) (string, bool, error) {
+	label, err := s.labelRepo.GetByKey(ctx, tnt, objectType, objectID, key)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return "", false, nil
+		}
+		return "", false, errors.Wrapf(err, "while getting label %s for %s with ID %s", key, objectType, objectID)
+	}
+
+	url, ok := label.Value.(string)
+	if !ok || url == "" {
+		log.Warnf("Ignoring label %s for %s with ID %s, as it is not a non-empty string", key, objectType, objectID)
+		return "", false, nil
+	}
+
+	return url, true, nil
+}
@@ -0,0 +1,163 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/event"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/event/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetEventURL(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+	tnt := "tenant"
+	appID := "foo"
+	runtimeID := "bar"
+	defaultURL := "http://default.url"
+	appURL := "http://app.url"
+	runtimeURL := "http://runtime.url"
+
+	ctxWithConsumer := func(consumerType consumer.ConsumerType) context.Context {
+		ctx := tenant.SaveToContext(context.TODO(), tnt)
+		return consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumerType})
+	}
+	notFound := apperrors.NewNotFoundError("")
+
+	testCases := []struct {
+		Name           string
+		Context        context.Context
+		LabelRepoFn    func() *automock.LabelRepository
+		DefaultURL     string
+		ExpectedOutput string
+		ExpectedErr    error
+	}{
+		{
+			Name:    "Returns URL of the Application when it is set",
+			Context: ctxWithConsumer(consumer.Runtime),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", mock.Anything, tnt, model.ApplicationLabelableObject, appID, event.ApplicationEventURLLabelKey).Return(&model.Label{Value: appURL}, nil).Once()
+				return repo
+			},
+			DefaultURL:     defaultURL,
+			ExpectedOutput: appURL,
+		},
+		{
+			Name:    "Returns URL of the calling Runtime",
+			Context: ctxWithConsumer(consumer.Runtime),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", mock.Anything, tnt, model.ApplicationLabelableObject, appID, event.ApplicationEventURLLabelKey).Return(nil, notFound).Once()
+				repo.On("GetByKey", mock.Anything, tnt, model.RuntimeLabelableObject, runtimeID, event.RuntimeEventURLLabelKey).Return(&model.Label{Value: runtimeURL}, nil).Once()
+				return repo
+			},
+			DefaultURL:     defaultURL,
+			ExpectedOutput: runtimeURL,
+		},
+		{
+			Name:    "Returns default URL when calling Runtime has no URL",
+			Context: ctxWithConsumer(consumer.Runtime),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", mock.Anything, tnt, model.ApplicationLabelableObject, appID, event.ApplicationEventURLLabelKey).Return(nil, notFound).Once()
+				repo.On("GetByKey", mock.Anything, tnt, model.RuntimeLabelableObject, runtimeID, event.RuntimeEventURLLabelKey).Return(nil, notFound).Once()
+				return repo
+			},
+			DefaultURL:     defaultURL,
+			ExpectedOutput: defaultURL,
+		},
+		{
+			Name:    "Returns default URL when caller is not a Runtime",
+			Context: ctxWithConsumer(consumer.Application),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", mock.Anything, tnt, model.ApplicationLabelableObject, appID, event.ApplicationEventURLLabelKey).Return(nil, notFound).Once()
+				return repo
+			},
+			DefaultURL:     defaultURL,
+			ExpectedOutput: defaultURL,
+		},
+		{
+			Name:    "Returns empty URL when nothing is configured",
+			Context: tenant.SaveToContext(context.TODO(), tnt),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", mock.Anything, tnt, model.ApplicationLabelableObject, appID, event.ApplicationEventURLLabelKey).Return(nil, notFound).Once()
+				return repo
+			},
+			ExpectedOutput: "",
+		},
+		{
+			Name:    "Returns default URL when label value of the Runtime is not a string",
+			Context: ctxWithConsumer(consumer.Runtime),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", mock.Anything, tnt, model.ApplicationLabelableObject, appID, event.ApplicationEventURLLabelKey).Return(nil, notFound).Once()
+				repo.On("GetByKey", mock.Anything, tnt, model.RuntimeLabelableObject, runtimeID, event.RuntimeEventURLLabelKey).Return(&model.Label{Value: []interface{}{"foo"}}, nil).Once()
+				return repo
+			},
+			DefaultURL:     defaultURL,
+			ExpectedOutput: defaultURL,
+		},
+		{
+			Name:    "Returns URL of the calling Runtime when label value of the Application is empty",
+			Context: ctxWithConsumer(consumer.Runtime),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", mock.Anything, tnt, model.ApplicationLabelableObject, appID, event.ApplicationEventURLLabelKey).Return(&model.Label{Value: ""}, nil).Once()
+				repo.On("GetByKey", mock.Anything, tnt, model.RuntimeLabelableObject, runtimeID, event.RuntimeEventURLLabelKey).Return(&model.Label{Value: runtimeURL}, nil).Once()
+				return repo
+			},
+			DefaultURL:     defaultURL,
+			ExpectedOutput: runtimeURL,
+		},
+		{
+			Name:    "Returns error when getting label failed",
+			Context: ctxWithConsumer(consumer.Runtime),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", mock.Anything, tnt, model.ApplicationLabelableObject, appID, event.ApplicationEventURLLabelKey).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:    "Returns error when tenant is not in context",
+			Context: context.TODO(),
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			ExpectedErr: tenant.NoTenantError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepoFn()
+			svc := event.NewService(labelRepo, testCase.DefaultURL)
+
+			// when
+			result, err := svc.GetEventURL(testCase.Context, appID)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOutput, result)
+			}
+
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -5,9 +5,9 @@ package automock
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // EventAPIRepository is an autogenerated mock type for the EventAPIRepository type
@@ -138,13 +138,13 @@ func (_m *EventAPIRepository) GetForApplication(ctx context.Context, tenant stri
 	return r0, r1
 }
 
-// ListByApplicationID provides a mock function with given fields: ctx, tenantID, applicationID, pageSize, cursor
-func (_m *EventAPIRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error) {
-	ret := _m.Called(ctx, tenantID, applicationID, pageSize, cursor)
+// ListByApplicationID provides a mock function with given fields: ctx, tenantID, applicationID, filter, pageSize, cursor
+func (_m *EventAPIRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, applicationID, filter, pageSize, cursor)
 
 	var r0 *model.EventAPIDefinitionPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.EventAPIDefinitionPage); ok {
-		r0 = rf(ctx, tenantID, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []*labelfilter.LabelFilter, int, string) *model.EventAPIDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, applicationID, filter, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.EventAPIDefinitionPage)
@@ -152,8 +152,8 @@ func (_m *EventAPIRepository) ListByApplicationID(ctx context.Context, tenantID
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
-		r1 = rf(ctx, tenantID, applicationID, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, tenantID, applicationID, filter, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2,10 +2,12 @@
 
 package automock
 
-import context "context"
+import (
+	context "context"
 
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // EventAPIService is an autogenerated mock type for the EventAPIService type
 type EventAPIService struct {
@@ -47,6 +49,20 @@ func (_m *EventAPIService) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
+// DeleteLabel provides a mock function with given fields: ctx, eventAPIDefID, key
+func (_m *EventAPIService) DeleteLabel(ctx context.Context, eventAPIDefID string, key string) error {
+	ret := _m.Called(ctx, eventAPIDefID, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, eventAPIDefID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *EventAPIService) Get(ctx context.Context, id string) (*model.EventAPIDefinition, error) {
 	ret := _m.Called(ctx, id)
@@ -93,6 +109,52 @@ func (_m *EventAPIService) GetFetchRequest(ctx context.Context, eventAPIDefID st
 	return r0, r1
 }
 
+// GetLabel provides a mock function with given fields: ctx, eventAPIDefID, key
+func (_m *EventAPIService) GetLabel(ctx context.Context, eventAPIDefID string, key string) (*model.Label, error) {
+	ret := _m.Called(ctx, eventAPIDefID, key)
+
+	var r0 *model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Label); ok {
+		r0 = rf(ctx, eventAPIDefID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, eventAPIDefID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLabels provides a mock function with given fields: ctx, eventAPIDefID
+func (_m *EventAPIService) ListLabels(ctx context.Context, eventAPIDefID string) (map[string]*model.Label, error) {
+	ret := _m.Called(ctx, eventAPIDefID)
+
+	var r0 map[string]*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]*model.Label); ok {
+		r0 = rf(ctx, eventAPIDefID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventAPIDefID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RefetchAPISpec provides a mock function with given fields: ctx, id
 func (_m *EventAPIService) RefetchAPISpec(ctx context.Context, id string) (*model.EventAPISpec, error) {
 	ret := _m.Called(ctx, id)
@@ -116,6 +178,20 @@ func (_m *EventAPIService) RefetchAPISpec(ctx context.Context, id string) (*mode
 	return r0, r1
 }
 
+// SetLabel provides a mock function with given fields: ctx, labelInput
+func (_m *EventAPIService) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
+	ret := _m.Called(ctx, labelInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LabelInput) error); ok {
+		r0 = rf(ctx, labelInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *EventAPIService) Update(ctx context.Context, id string, in model.EventAPIDefinitionInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -11,12 +11,16 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
 	eventAPIID = "eeeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
 	appID      = "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
 	tenantID   = "ttttttttt-tttt-tttt-tttt-tttttttttttt"
+
+	asyncAPISpec        = "asyncapi: '2.0.0'\ninfo:\n  title: Foo\n  version: v1\nchannels: {}\n"
+	invalidAsyncAPISpec = "asyncapi: '2.0.0'\ninfo:\n  title: Foo\n  version: v1\n"
 )
 
 func fixMinModelEventAPIDefinition(id, placeholder string) *model.EventAPIDefinition {
@@ -218,6 +222,13 @@ func fixModelFetchRequest(id, url string, timestamp time.Time) *model.FetchReque
 	}
 }
 
+// fixSpecValidator matches the function validating fetched Spec, which accepts the valid and rejects the invalid data
+func fixSpecValidator(valid, invalid string) interface{} {
+	return mock.MatchedBy(func(validate func(data string) error) bool {
+		return validate(valid) == nil && validate(invalid) != nil
+	})
+}
+
 func fixGQLFetchRequest(url string, timestamp time.Time) *graphql.FetchRequest {
 	return &graphql.FetchRequest{
 		Filter: nil,
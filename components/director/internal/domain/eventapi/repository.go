@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/pkg/errors"
@@ -91,10 +94,24 @@ func (r *pgRepository) GetForApplication(ctx context.Context, tenant string, id
 	return &eventAPIModel, nil
 }
 
-func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error) {
-	appCond := fmt.Sprintf("app_id = %s ", pq.QuoteLiteral(applicationID))
+func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error) {
+	conditions := []string{fmt.Sprintf("app_id = %s ", pq.QuoteLiteral(applicationID))}
+	var args []interface{}
+	if len(filter) > 0 {
+		tenantUUID, err := uuid.Parse(tenantID)
+		if err != nil {
+			return nil, errors.Wrap(err, "while parsing tenant as UUID")
+		}
+		var filterSubquery string
+		filterSubquery, args, err = label.FilterQuery(model.EventAPIDefinitionLabelableObject, label.IntersectSet, tenantUUID, filter, 2)
+		if err != nil {
+			return nil, errors.Wrap(err, "while building filter query")
+		}
+		conditions = append(conditions, fmt.Sprintf(`"id" IN (%s)`, filterSubquery))
+	}
+
 	var eventAPIDefCollection EventAPIDefCollection
-	page, totalCount, err := r.pageableQuerier.List(ctx, tenantID, pageSize, cursor, "id", &eventAPIDefCollection, appCond)
+	page, totalCount, err := r.pageableQuerier.ListWithArgs(ctx, tenantID, pageSize, cursor, "id", &eventAPIDefCollection, conditions, args)
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +218,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock.On("FromEntity", secondEventAPIDefEntity).Return(model.EventAPIDefinition{ID: secondEventAPIDefID}, nil)
 		pgRepository := eventapi.NewRepository(convMock)
 		// WHEN
-		modelEventAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		modelEventAPIDef, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, nil, inputPageSize, inputCursor)
 		//THEN
 		require.NoError(t, err)
 		require.Len(t, modelEventAPIDef.Data, 2)
@@ -248,7 +248,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		convMock.On("FromEntity", firstEventAPIDefEntity).Return(model.EventAPIDefinition{}, testErr).Once()
 		pgRepository := eventapi.NewRepository(convMock)
 		//WHEN
-		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, nil, inputPageSize, inputCursor)
 		//THEN
 		require.Error(t, err)
 		require.Contains(t, err.Error(), testErr.Error())
@@ -265,7 +265,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		pgRepository := eventapi.NewRepository(nil)
 		// WHEN
-		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, inputPageSize, inputCursor)
+		_, err := pgRepository.ListByApplicationID(ctx, tenantID, appID, nil, inputPageSize, inputCursor)
 		//THEN
 		require.Error(t, err)
 		assert.Error(t, err, testErr)
@@ -4,11 +4,14 @@ import (
 	"context"
 
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/specformat"
 
 	"github.com/pkg/errors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
@@ -20,6 +23,10 @@ type EventAPIService interface {
 	Delete(ctx context.Context, id string) error
 	RefetchAPISpec(ctx context.Context, id string) (*model.EventAPISpec, error)
 	GetFetchRequest(ctx context.Context, eventAPIDefID string) (*model.FetchRequest, error)
+	SetLabel(ctx context.Context, labelInput *model.LabelInput) error
+	GetLabel(ctx context.Context, eventAPIDefID string, key string) (*model.Label, error)
+	ListLabels(ctx context.Context, eventAPIDefID string) (map[string]*model.Label, error)
+	DeleteLabel(ctx context.Context, eventAPIDefID string, key string) error
 }
 
 //go:generate mockery -name=EventAPIConverter -output=automock -outpkg=automock -case=underscore
@@ -41,25 +48,39 @@ type ApplicationService interface {
 	Exist(ctx context.Context, id string) (bool, error)
 }
 
+//go:generate mockery -name=SpecPrefetcher -output=automock -outpkg=automock -case=underscore
+type SpecPrefetcher interface {
+	Prefetch(ctx context.Context, fetchRequests []*model.FetchRequest) context.Context
+}
+
 type Resolver struct {
 	transact    persistence.Transactioner
 	svc         EventAPIService
 	appSvc      ApplicationService
 	converter   EventAPIConverter
 	frConverter FetchRequestConverter
+	prefetcher  SpecPrefetcher
+	labels      *label.ObjectResolver
 }
 
-func NewResolver(transact persistence.Transactioner, svc EventAPIService, appSvc ApplicationService, converter EventAPIConverter, frConverter FetchRequestConverter) *Resolver {
+func NewResolver(transact persistence.Transactioner, svc EventAPIService, appSvc ApplicationService, converter EventAPIConverter, frConverter FetchRequestConverter, prefetcher SpecPrefetcher) *Resolver {
 	return &Resolver{
 		transact:    transact,
 		svc:         svc,
 		appSvc:      appSvc,
 		converter:   converter,
 		frConverter: frConverter,
+		prefetcher:  prefetcher,
+		labels:      label.NewObjectResolver(transact, svc, model.EventAPIDefinitionLabelableObject),
 	}
 }
 
 func (r *Resolver) AddEventAPI(ctx context.Context, applicationID string, in graphql.EventAPIDefinitionInput) (*graphql.EventAPIDefinition, error) {
+	convertedIn := r.converter.InputFromGraphQL(&in)
+
+	// the specification is downloaded before the transaction is started, so that the transaction is not kept open during the download
+	ctx = r.prefetcher.Prefetch(ctx, model.FetchRequestsFromInputs([]*model.FetchRequestInput{convertedIn.FetchRequestInput()}))
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -68,8 +89,6 @@ func (r *Resolver) AddEventAPI(ctx context.Context, applicationID string, in gra
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	convertedIn := r.converter.InputFromGraphQL(&in)
-
 	found, err := r.appSvc.Exist(ctx, applicationID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while checking existence of Application")
@@ -100,6 +119,10 @@ func (r *Resolver) AddEventAPI(ctx context.Context, applicationID string, in gra
 }
 
 func (r *Resolver) UpdateEventAPI(ctx context.Context, id string, in graphql.EventAPIDefinitionInput) (*graphql.EventAPIDefinition, error) {
+	convertedIn := r.converter.InputFromGraphQL(&in)
+
+	ctx = r.prefetcher.Prefetch(ctx, model.FetchRequestsFromInputs([]*model.FetchRequestInput{convertedIn.FetchRequestInput()}))
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -108,8 +131,6 @@ func (r *Resolver) UpdateEventAPI(ctx context.Context, id string, in graphql.Eve
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	convertedIn := r.converter.InputFromGraphQL(&in)
-
 	err = r.svc.Update(ctx, id, *convertedIn)
 	if err != nil {
 		return nil, err
@@ -160,6 +181,11 @@ func (r *Resolver) DeleteEventAPI(ctx context.Context, id string) (*graphql.Even
 }
 
 func (r *Resolver) RefetchEventAPISpec(ctx context.Context, eventID string) (*graphql.EventAPISpec, error) {
+	ctx, err := r.prefetchSpec(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -170,6 +196,12 @@ func (r *Resolver) RefetchEventAPISpec(ctx context.Context, eventID string) (*gr
 
 	spec, err := r.svc.RefetchAPISpec(ctx, eventID)
 	if err != nil {
+		if apperrors.IsFetchFailed(err) {
+			// the failed FetchRequest status is persisted while the stored spec stays untouched
+			if commitErr := tx.Commit(); commitErr != nil {
+				return nil, commitErr
+			}
+		}
 		return nil, err
 	}
 
@@ -183,6 +215,31 @@ func (r *Resolver) RefetchEventAPISpec(ctx context.Context, eventID string) (*gr
 	return convertedOut.Spec, nil
 }
 
+// prefetchSpec downloads the specification of the Event API in a separate transaction, so that the transaction which stores it is not kept open during the download
+func (r *Resolver) prefetchSpec(ctx context.Context, eventAPIID string) (context.Context, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	fr, err := r.svc.GetFetchRequest(persistence.SaveToContext(ctx, tx), eventAPIID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if fr == nil {
+		return ctx, nil
+	}
+
+	return r.prefetcher.Prefetch(ctx, []*model.FetchRequest{fr}), nil
+}
+
 func (r *Resolver) FetchRequest(ctx context.Context, obj *graphql.EventAPISpec) (*graphql.FetchRequest, error) {
 	if obj == nil {
 		return nil, errors.New("Event API Spec cannot be empty")
@@ -217,3 +274,37 @@ func (r *Resolver) FetchRequest(ctx context.Context, obj *graphql.EventAPISpec)
 	frGQL := r.frConverter.ToGraphQL(fr)
 	return frGQL, nil
 }
+
+func (r *Resolver) Data(ctx context.Context, obj *graphql.EventAPISpec, format *graphql.SpecFormat) (*graphql.CLOB, error) {
+	if obj == nil {
+		return nil, errors.New("Event API Spec cannot be empty")
+	}
+
+	if obj.Data == nil || format == nil {
+		return obj.Data, nil
+	}
+
+	data, err := specformat.Convert(string(*obj.Data), model.SpecFormat(obj.Format), model.SpecFormat(*format))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting Event API Spec to %s", *format)
+	}
+
+	clob := graphql.CLOB(data)
+	return &clob, nil
+}
+
+func (r *Resolver) SetEventAPILabel(ctx context.Context, eventAPIID string, key string, value interface{}) (*graphql.Label, error) {
+	return r.labels.SetLabel(ctx, eventAPIID, key, value)
+}
+
+func (r *Resolver) DeleteEventAPILabel(ctx context.Context, eventAPIID string, key string) (*graphql.Label, error) {
+	return r.labels.DeleteLabel(ctx, eventAPIID, key)
+}
+
+func (r *Resolver) Labels(ctx context.Context, obj *graphql.EventAPIDefinition, key *string) (graphql.Labels, error) {
+	if obj == nil {
+		return nil, errors.New("Event API Definition cannot be empty")
+	}
+
+	return r.labels.Labels(ctx, obj.ID)
+}
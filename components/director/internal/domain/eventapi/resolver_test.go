@@ -16,6 +16,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventapi"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventapi/automock"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 )
@@ -81,6 +82,7 @@ func TestResolver_AddEventAPI(t *testing.T) {
 			},
 			ConverterFn: func() *automock.EventAPIConverter {
 				conv := &automock.EventAPIConverter{}
+				conv.On("InputFromGraphQL", gqlAPIInput).Return(modelAPIInput).Once()
 				return conv
 			},
 			ExpectedAPI: nil,
@@ -200,8 +202,10 @@ func TestResolver_AddEventAPI(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 			appSvc := testCase.AppServiceFn()
+			prefetcher := &automock.SpecPrefetcher{}
+			prefetcher.On("Prefetch", context.TODO(), model.FetchRequestsFromInputs([]*model.FetchRequestInput{modelAPIInput.FetchRequestInput()})).Return(context.TODO()).Once()
 
-			resolver := eventapi.NewResolver(tx, svc, appSvc, converter, nil)
+			resolver := eventapi.NewResolver(tx, svc, appSvc, converter, nil, prefetcher)
 
 			// when
 			result, err := resolver.AddEventAPI(context.TODO(), appId, *gqlAPIInput)
@@ -219,6 +223,7 @@ func TestResolver_AddEventAPI(t *testing.T) {
 			svc.AssertExpectations(t)
 			appSvc.AssertExpectations(t)
 			converter.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
 		})
 	}
 }
@@ -330,7 +335,7 @@ func TestResolver_DeleteEventAPI(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := eventapi.NewResolver(tx, svc, nil, converter, nil)
+			resolver := eventapi.NewResolver(tx, svc, nil, converter, nil, nil)
 
 			// when
 			result, err := resolver.DeleteEventAPI(context.TODO(), id)
@@ -398,6 +403,7 @@ func TestResolver_UpdateEventAPI(t *testing.T) {
 			},
 			ConverterFn: func() *automock.EventAPIConverter {
 				conv := &automock.EventAPIConverter{}
+				conv.On("InputFromGraphQL", gqlAPIDefinitionInput).Return(modelAPIDefinitionInput).Once()
 				return conv
 			},
 			ExpectedAPIDefinition: nil,
@@ -466,8 +472,10 @@ func TestResolver_UpdateEventAPI(t *testing.T) {
 			persistance, tx := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
+			prefetcher := &automock.SpecPrefetcher{}
+			prefetcher.On("Prefetch", context.TODO(), model.FetchRequestsFromInputs([]*model.FetchRequestInput{modelAPIDefinitionInput.FetchRequestInput()})).Return(context.TODO()).Once()
 
-			resolver := eventapi.NewResolver(tx, svc, nil, converter, nil)
+			resolver := eventapi.NewResolver(tx, svc, nil, converter, nil, prefetcher)
 
 			// when
 			result, err := resolver.UpdateEventAPI(context.TODO(), id, *gqlAPIDefinitionInput)
@@ -480,6 +488,7 @@ func TestResolver_UpdateEventAPI(t *testing.T) {
 			tx.AssertExpectations(t)
 			svc.AssertExpectations(t)
 			converter.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
 		})
 	}
 }
@@ -508,20 +517,34 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 		Spec: gqlEventAPISpec,
 	}
 
+	fetchFailedErr := apperrors.NewFetchFailedError("while fetching Spec: unexpected status code 404")
+
+	storedFetchRequest := &model.FetchRequest{ID: "frID", URL: "foo.bar", Mode: model.FetchModeSingle}
+	prefetcherThatSucceeds := func() *automock.SpecPrefetcher {
+		prefetcher := &automock.SpecPrefetcher{}
+		prefetcher.On("Prefetch", context.TODO(), []*model.FetchRequest{storedFetchRequest}).Return(context.TODO()).Once()
+		return prefetcher
+	}
+
 	txGen := txtest.NewTransactionContextGenerator(testErr)
 	testCases := []struct {
 		Name            string
 		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn       func() *automock.EventAPIService
 		ConvFn          func() *automock.EventAPIConverter
+		PrefetcherFn    func() *automock.SpecPrefetcher
 		ExpectedAPISpec *graphql.EventAPISpec
 		ExpectedErr     error
 	}{
 		{
-			Name:            "Success",
-			TransactionerFn: txGen.ThatSucceeds,
+			Name:         "Success",
+			PrefetcherFn: prefetcherThatSucceeds,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
 			ServiceFn: func() *automock.EventAPIService {
 				svc := &automock.EventAPIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(storedFetchRequest, nil).Once()
 				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(modelEventAPISpec, nil).Once()
 				return svc
 			},
@@ -534,10 +557,14 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 			ExpectedErr:     nil,
 		},
 		{
-			Name:            "Retuns error when transaction commit faied",
-			TransactionerFn: txGen.ThatFailsOnCommit,
+			Name:         "Retuns error when transaction commit faied",
+			PrefetcherFn: prefetcherThatSucceeds,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatFailsOnCommitAfterSucceeding(1)
+			},
 			ServiceFn: func() *automock.EventAPIService {
 				svc := &automock.EventAPIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(storedFetchRequest, nil).Once()
 				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(modelEventAPISpec, nil).Once()
 				return svc
 			},
@@ -549,10 +576,33 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 			ExpectedErr:     testErr,
 		},
 		{
-			Name:            "Returns error when refetching EventAPI spec failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			Name:         "Returns error and persists FetchRequest status when fetching spec failed",
+			PrefetcherFn: prefetcherThatSucceeds,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			ServiceFn: func() *automock.EventAPIService {
+				svc := &automock.EventAPIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(storedFetchRequest, nil).Once()
+				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(nil, fetchFailedErr).Once()
+				return svc
+			},
+			ConvFn: func() *automock.EventAPIConverter {
+				conv := &automock.EventAPIConverter{}
+				return conv
+			},
+			ExpectedAPISpec: nil,
+			ExpectedErr:     fetchFailedErr,
+		},
+		{
+			Name:         "Returns error when refetching EventAPI spec failed",
+			PrefetcherFn: prefetcherThatSucceeds,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommitAfterSucceeding(1)
+			},
 			ServiceFn: func() *automock.EventAPIService {
 				svc := &automock.EventAPIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(storedFetchRequest, nil).Once()
 				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(nil, testErr).Once()
 				return svc
 			},
@@ -577,6 +627,39 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 			ExpectedAPISpec: nil,
 			ExpectedErr:     testErr,
 		},
+		{
+			Name: "Success when Event API has no FetchRequest",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			ServiceFn: func() *automock.EventAPIService {
+				svc := &automock.EventAPIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(nil, nil).Once()
+				svc.On("RefetchAPISpec", txtest.CtxWithDBMatcher(), apiID).Return(modelEventAPISpec, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.EventAPIConverter {
+				conv := &automock.EventAPIConverter{}
+				conv.On("ToGraphQL", modelEventAPIDefinition).Return(gqlEventAPIDefinition).Once()
+				return conv
+			},
+			ExpectedAPISpec: gqlEventAPISpec,
+			ExpectedErr:     nil,
+		},
+		{
+			Name:            "Returns error when getting FetchRequest failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.EventAPIService {
+				svc := &automock.EventAPIService{}
+				svc.On("GetFetchRequest", txtest.CtxWithDBMatcher(), apiID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConvFn: func() *automock.EventAPIConverter {
+				return &automock.EventAPIConverter{}
+			},
+			ExpectedAPISpec: nil,
+			ExpectedErr:     testErr,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -585,7 +668,11 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 			persist, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			conv := testCase.ConvFn()
-			resolver := eventapi.NewResolver(transact, svc, nil, conv, nil)
+			prefetcher := &automock.SpecPrefetcher{}
+			if testCase.PrefetcherFn != nil {
+				prefetcher = testCase.PrefetcherFn()
+			}
+			resolver := eventapi.NewResolver(transact, svc, nil, conv, nil, prefetcher)
 
 			// when
 			result, err := resolver.RefetchEventAPISpec(context.TODO(), apiID)
@@ -596,6 +683,7 @@ func TestResolver_RefetchAPISpec(t *testing.T) {
 
 			svc.AssertExpectations(t)
 			conv.AssertExpectations(t)
+			prefetcher.AssertExpectations(t)
 			persist.AssertExpectations(t)
 			transact.AssertExpectations(t)
 		})
@@ -727,7 +815,7 @@ func TestResolver_FetchRequest(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := eventapi.NewResolver(transact, svc, nil, nil, converter)
+			resolver := eventapi.NewResolver(transact, svc, nil, nil, converter, nil)
 
 			// when
 			result, err := resolver.FetchRequest(context.TODO(), testCase.EventApiSpec)
@@ -748,3 +836,254 @@ func TestResolver_FetchRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_Data(t *testing.T) {
+	// given
+	jsonData := graphql.CLOB(`{"asyncapi": "2.0.0"}`)
+	yamlData := graphql.CLOB("asyncapi: 2.0.0\n")
+	yamlFormat := graphql.SpecFormatYaml
+	xmlFormat := graphql.SpecFormatXML
+
+	testCases := []struct {
+		Name           string
+		Spec           *graphql.EventAPISpec
+		Format         *graphql.SpecFormat
+		ExpectedResult *graphql.CLOB
+		ExpectedErr    string
+	}{
+		{
+			Name:           "Returns data as it is when format not specified",
+			Spec:           &graphql.EventAPISpec{Data: &jsonData, Format: graphql.SpecFormatJSON},
+			ExpectedResult: &jsonData,
+		},
+		{
+			Name:           "Returns data in requested format",
+			Spec:           &graphql.EventAPISpec{Data: &jsonData, Format: graphql.SpecFormatJSON},
+			Format:         &yamlFormat,
+			ExpectedResult: &yamlData,
+		},
+		{
+			Name:        "Returns error when conversion is not supported",
+			Spec:        &graphql.EventAPISpec{Data: &jsonData, Format: graphql.SpecFormatJSON},
+			Format:      &xmlFormat,
+			ExpectedErr: "while converting Event API Spec to XML",
+		},
+		{
+			Name:        "Returns error when parent object is nil",
+			Format:      &yamlFormat,
+			ExpectedErr: "Event API Spec cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			resolver := eventapi.NewResolver(nil, nil, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.Data(context.TODO(), testCase.Spec, testCase.Format)
+
+			// then
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+		})
+	}
+}
+
+func TestResolver_SetEventAPILabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	labelKey := "domain"
+	labelValue := "payments"
+	labelInput := &model.LabelInput{
+		Key:        labelKey,
+		Value:      labelValue,
+		ObjectType: model.EventAPIDefinitionLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.EventAPIService
+		ExpectedResult  *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EventAPIService {
+				svc := &automock.EventAPIService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.Label{
+				Key:   labelKey,
+				Value: labelValue,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := eventapi.NewResolver(transact, svc, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.SetEventAPILabel(context.TODO(), objID, labelKey, labelValue)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_DeleteEventAPILabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	labelKey := "domain"
+	labelValue := "payments"
+	modelLabel := &model.Label{
+		ID:         "1",
+		Key:        labelKey,
+		Value:      labelValue,
+		ObjectType: model.EventAPIDefinitionLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.EventAPIService
+		ExpectedResult  *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EventAPIService {
+				svc := &automock.EventAPIService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), objID, labelKey).Return(modelLabel, nil).Once()
+				svc.On("DeleteLabel", txtest.CtxWithDBMatcher(), objID, labelKey).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.Label{
+				Key:   labelKey,
+				Value: labelValue,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := eventapi.NewResolver(transact, svc, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.DeleteEventAPILabel(context.TODO(), objID, labelKey)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_Labels(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	modelLabels := map[string]*model.Label{
+		"domain": {
+			ID:         "1",
+			Key:        "domain",
+			Value:      "payments",
+			ObjectType: model.EventAPIDefinitionLabelableObject,
+			ObjectID:   objID,
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.EventAPIService
+		ExpectedResult  graphql.Labels
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EventAPIService {
+				svc := &automock.EventAPIService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), objID).Return(modelLabels, nil).Once()
+				return svc
+			},
+			ExpectedResult: graphql.Labels{"domain": "payments"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := eventapi.NewResolver(transact, svc, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.Labels(context.TODO(), &graphql.EventAPIDefinition{ID: objID}, nil)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when Event API Definition is nil", func(t *testing.T) {
+		resolver := eventapi.NewResolver(nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.Labels(context.TODO(), nil, nil)
+		// then
+		require.EqualError(t, err, "Event API Definition cannot be empty")
+	})
+}
@@ -6,9 +6,12 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/pkg/errors"
 )
@@ -18,7 +21,7 @@ type EventAPIRepository interface {
 	GetByID(ctx context.Context, tenantID string, id string) (*model.EventAPIDefinition, error)
 	GetForApplication(ctx context.Context, tenant string, id string, applicationID string) (*model.EventAPIDefinition, error)
 	Exists(ctx context.Context, tenantID, id string) (bool, error)
-	ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error)
+	ListByApplicationID(ctx context.Context, tenantID string, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error)
 	Create(ctx context.Context, item *model.EventAPIDefinition) error
 	CreateMany(ctx context.Context, items []*model.EventAPIDefinition) error
 	Update(ctx context.Context, item *model.EventAPIDefinition) error
@@ -33,27 +36,61 @@ type FetchRequestRepository interface {
 	DeleteByReferenceObjectID(ctx context.Context, tenant string, objectType model.FetchRequestReferenceObjectType, objectID string) error
 }
 
+//go:generate mockery -name=FetchRequestService -output=automock -outpkg=automock -case=underscore
+type FetchRequestService interface {
+	HandleSpec(ctx context.Context, fr *model.FetchRequest, validate func(data string) error) (*string, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
+}
+
+//go:generate mockery -name=LabelUpsertService -output=automock -outpkg=automock -case=underscore
+type LabelUpsertService interface {
+	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
+}
+
+//go:generate mockery -name=ConfigurationChangeNotifier -output=automock -outpkg=automock -case=underscore
+type ConfigurationChangeNotifier interface {
+	NotifyConfigurationChanged(ctx context.Context, applicationID string, change model.ConfigurationChange) error
+}
+
 type service struct {
-	eventAPIRepo     EventAPIRepository
-	fetchRequestRepo FetchRequestRepository
-	uidService       UIDService
-	timestampGen     timestamp.Generator
+	*label.ObjectService
+
+	eventAPIRepo        EventAPIRepository
+	fetchRequestRepo    FetchRequestRepository
+	fetchRequestService FetchRequestService
+	uidService          UIDService
+	notifier            ConfigurationChangeNotifier
+	timestampGen        timestamp.Generator
 }
 
-func NewService(eventAPIRepo EventAPIRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService) *service {
-	return &service{eventAPIRepo: eventAPIRepo,
-		fetchRequestRepo: fetchRequestRepo,
-		uidService:       uidService,
-		timestampGen:     timestamp.DefaultGenerator(),
+func NewService(eventAPIRepo EventAPIRepository, fetchRequestRepo FetchRequestRepository, fetchRequestService FetchRequestService, uidService UIDService, labelRepo LabelRepository, labelUpsertService LabelUpsertService, notifier ConfigurationChangeNotifier) *service {
+	exists := func(ctx context.Context, tnt, id string) (bool, error) {
+		return eventAPIRepo.Exists(ctx, tnt, id)
+	}
+
+	return &service{
+		ObjectService:       label.NewObjectService(model.EventAPIDefinitionLabelableObject, "Event API Definition", exists, labelRepo, labelUpsertService),
+		eventAPIRepo:        eventAPIRepo,
+		fetchRequestRepo:    fetchRequestRepo,
+		fetchRequestService: fetchRequestService,
+		uidService:          uidService,
+		notifier:            notifier,
+		timestampGen:        timestamp.DefaultGenerator(),
 	}
 }
 
-func (s *service) List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error) {
+func (s *service) List(ctx context.Context, applicationID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.EventAPIDefinitionPage, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "while loading tenant from context")
@@ -63,7 +100,7 @@ func (s *service) List(ctx context.Context, applicationID string, pageSize int,
 		return nil, errors.New("page size must be between 1 and 100")
 	}
 
-	return s.eventAPIRepo.ListByApplicationID(ctx, tnt, applicationID, pageSize, cursor)
+	return s.eventAPIRepo.ListByApplicationID(ctx, tnt, applicationID, filter, pageSize, cursor)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.EventAPIDefinition, error) {
@@ -104,16 +141,40 @@ func (s *service) Create(ctx context.Context, applicationID string, in model.Eve
 
 	eventAPI := in.ToEventAPIDefinition(id, applicationID, tnt)
 
+	err = specvalidation.ValidateEventAPISpec(eventAPI.Spec)
+	if err != nil {
+		return "", errors.Wrapf(err, "while validating Spec for EventAPIDefinition %s", id)
+	}
+
 	err = s.eventAPIRepo.Create(ctx, eventAPI)
 	if err != nil {
 		return "", err
 	}
 
 	if in.Spec != nil && in.Spec.FetchRequest != nil {
-		_, err = s.createFetchRequest(ctx, tnt, in.Spec.FetchRequest, id)
+		fr, err := s.createFetchRequest(ctx, tnt, in.Spec.FetchRequest, id)
 		if err != nil {
 			return "", errors.Wrapf(err, "while creating FetchRequest for EventAPIDefinition %s", id)
 		}
+
+		data, err := s.fetchRequestService.HandleSpec(ctx, fr, specvalidation.EventAPISpecValidator(eventAPI.Spec))
+		if err != nil {
+			return "", errors.Wrapf(err, "while fetching Spec for EventAPIDefinition %s", id)
+		}
+		// the Spec provided in the input is kept when the fetch fails or the fetched Spec is invalid
+		if data != nil {
+			eventAPI.Spec.Data = data
+		}
+
+		err = s.eventAPIRepo.Update(ctx, eventAPI)
+		if err != nil {
+			return "", errors.Wrapf(err, "while updating EventAPIDefinition %s with fetched Spec", id)
+		}
+	}
+
+	err = s.notifier.NotifyConfigurationChanged(ctx, applicationID, eventAPIChange(id, model.ConfigurationChangeOperationCreated))
+	if err != nil {
+		return "", errors.Wrapf(err, "while notifying about creation of EventAPIDefinition %s", id)
 	}
 
 	return id, nil
@@ -135,20 +196,37 @@ func (s *service) Update(ctx context.Context, id string, in model.EventAPIDefini
 		return errors.Wrapf(err, "while deleting FetchRequest for EventAPIDefinition %s", id)
 	}
 
+	eventAPI = in.ToEventAPIDefinition(id, eventAPI.ApplicationID, tnt)
+	err = specvalidation.ValidateEventAPISpec(eventAPI.Spec)
+	if err != nil {
+		return errors.Wrapf(err, "while validating Spec for EventAPIDefinition %s", id)
+	}
+
 	if in.Spec != nil && in.Spec.FetchRequest != nil {
-		_, err = s.createFetchRequest(ctx, tnt, in.Spec.FetchRequest, id)
+		fr, err := s.createFetchRequest(ctx, tnt, in.Spec.FetchRequest, id)
 		if err != nil {
 			return errors.Wrapf(err, "while creating FetchRequest for EventAPIDefinition %s", id)
 		}
-	}
 
-	eventAPI = in.ToEventAPIDefinition(id, eventAPI.ApplicationID, tnt)
+		data, err := s.fetchRequestService.HandleSpec(ctx, fr, specvalidation.EventAPISpecValidator(eventAPI.Spec))
+		if err != nil {
+			return errors.Wrapf(err, "while fetching Spec for EventAPIDefinition %s", id)
+		}
+		if data != nil {
+			eventAPI.Spec.Data = data
+		}
+	}
 
 	err = s.eventAPIRepo.Update(ctx, eventAPI)
 	if err != nil {
 		return errors.Wrapf(err, "while updating EventAPIDefinition with ID %s", id)
 	}
 
+	err = s.notifier.NotifyConfigurationChanged(ctx, eventAPI.ApplicationID, eventAPIChange(id, model.ConfigurationChangeOperationUpdated))
+	if err != nil {
+		return errors.Wrapf(err, "while notifying about update of EventAPIDefinition %s", id)
+	}
+
 	return nil
 }
 
@@ -158,11 +236,21 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	eventAPI, err := s.eventAPIRepo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return err
+	}
+
 	err = s.eventAPIRepo.Delete(ctx, tnt, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting EventAPIDefinition with ID %s", id)
 	}
 
+	err = s.notifier.NotifyConfigurationChanged(ctx, eventAPI.ApplicationID, eventAPIChange(id, model.ConfigurationChangeOperationDeleted))
+	if err != nil {
+		return errors.Wrapf(err, "while notifying about deletion of EventAPIDefinition %s", id)
+	}
+
 	return nil
 }
 
@@ -177,6 +265,43 @@ func (s *service) RefetchAPISpec(ctx context.Context, id string) (*model.EventAP
 		return nil, err
 	}
 
+	fetchRequest, err := s.fetchRequestRepo.GetByReferenceObjectID(ctx, tnt, model.EventAPIFetchRequestReference, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return eventAPI.Spec, nil
+		}
+		return nil, errors.Wrapf(err, "while getting FetchRequest by Event API Definition ID %s", id)
+	}
+
+	if eventAPI.Spec == nil {
+		eventAPI.Spec = &model.EventAPISpec{}
+	}
+
+	data, err := s.fetchRequestService.HandleSpec(ctx, fetchRequest, specvalidation.EventAPISpecValidator(eventAPI.Spec))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while refetching Spec for Event API Definition with ID %s", id)
+	}
+
+	if fetchRequest.Status != nil && fetchRequest.Status.Condition == model.FetchRequestStatusConditionFailed {
+		reason := "unknown reason"
+		if fetchRequest.Status.Message != nil {
+			reason = *fetchRequest.Status.Message
+		}
+		return nil, apperrors.NewFetchFailedError(reason)
+	}
+
+	eventAPI.Spec.Data = data
+
+	err = s.eventAPIRepo.Update(ctx, eventAPI)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while updating Event API Definition with ID %s", id)
+	}
+
+	err = s.notifier.NotifyConfigurationChanged(ctx, eventAPI.ApplicationID, eventAPIChange(id, model.ConfigurationChangeOperationUpdated))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while notifying about update of Event API Definition %s", id)
+	}
+
 	return eventAPI.Spec, nil
 }
 
@@ -205,7 +330,7 @@ func (s *service) GetFetchRequest(ctx context.Context, eventAPIDefID string) (*m
 	return fetchRequest, nil
 }
 
-func (s *service) createFetchRequest(ctx context.Context, tenant string, in *model.FetchRequestInput, parentObjectID string) (*string, error) {
+func (s *service) createFetchRequest(ctx context.Context, tenant string, in *model.FetchRequestInput, parentObjectID string) (*model.FetchRequest, error) {
 	if in == nil {
 		return nil, nil
 	}
@@ -217,5 +342,13 @@ func (s *service) createFetchRequest(ctx context.Context, tenant string, in *mod
 		return nil, errors.Wrapf(err, "while creating FetchRequest for %s with ID %s", model.EventAPIFetchRequestReference, parentObjectID)
 	}
 
-	return &id, nil
+	return fr, nil
+}
+
+func eventAPIChange(id string, operation model.ConfigurationChangeOperation) model.ConfigurationChange {
+	return model.ConfigurationChange{
+		ResourceType: model.ConfigurationChangeResourceTypeEventAPI,
+		ResourceID:   id,
+		Operation:    operation,
+	}
 }
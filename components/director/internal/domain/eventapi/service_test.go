@@ -16,6 +16,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventapi"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventapi/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/stretchr/testify/assert"
@@ -66,7 +67,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := eventapi.NewService(repo, nil, nil)
+			svc := eventapi.NewService(repo, nil, nil, nil, nil, nil, nil)
 
 			// when
 			eventAPIDefinition, err := svc.Get(ctx, testCase.InputID)
@@ -83,7 +84,7 @@ func TestService_Get(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventapi.NewService(nil, nil, nil)
+		svc := eventapi.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Get(context.TODO(), "")
 		// THEN
@@ -141,7 +142,7 @@ func TestService_GetForApplication(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := eventapi.NewService(repo, nil, nil)
+			svc := eventapi.NewService(repo, nil, nil, nil, nil, nil, nil)
 
 			// when
 			eventAPIDefinition, err := svc.GetForApplication(ctx, testCase.InputID, testCase.ApplicationID)
@@ -158,7 +159,7 @@ func TestService_GetForApplication(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventapi.NewService(nil, nil, nil)
+		svc := eventapi.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForApplication(context.TODO(), "", "")
 		// THEN
@@ -191,6 +192,7 @@ func TestService_List(t *testing.T) {
 
 	first := 2
 	after := "test"
+	filter := []*labelfilter.LabelFilter{{Key: "domain"}}
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
@@ -207,7 +209,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
-				repo.On("ListByApplicationID", ctx, tenantID, applicationID, first, after).Return(eventAPIDefinitionPage, nil).Once()
+				repo.On("ListByApplicationID", ctx, tenantID, applicationID, filter, first, after).Return(eventAPIDefinitionPage, nil).Once()
 				return repo
 			},
 			InputPageSize:      first,
@@ -241,7 +243,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when EventAPI listing failed",
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
-				repo.On("ListByApplicationID", ctx, tenantID, applicationID, first, after).Return(nil, testErr).Once()
+				repo.On("ListByApplicationID", ctx, tenantID, applicationID, filter, first, after).Return(nil, testErr).Once()
 				return repo
 			},
 			InputPageSize:      first,
@@ -255,10 +257,10 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := eventapi.NewService(repo, nil, nil)
+			svc := eventapi.NewService(repo, nil, nil, nil, nil, nil, nil)
 
 			// when
-			docs, err := svc.List(ctx, applicationID, testCase.InputPageSize, testCase.InputCursor)
+			docs, err := svc.List(ctx, applicationID, filter, testCase.InputPageSize, testCase.InputCursor)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -272,9 +274,9 @@ func TestService_List(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventapi.NewService(nil, nil, nil)
+		svc := eventapi.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
-		_, err := svc.List(context.TODO(), "", 5, "")
+		_, err := svc.List(context.TODO(), "", nil, 5, "")
 		// THEN
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot read tenant from context")
@@ -296,6 +298,8 @@ func TestService_Create(t *testing.T) {
 	modelInput := model.EventAPIDefinitionInput{
 		Name: name,
 		Spec: &model.EventAPISpecInput{
+			EventSpecType: model.EventAPISpecTypeAsyncAPI,
+			Format:        model.SpecFormatYaml,
 			FetchRequest: &model.FetchRequestInput{
 				URL: frURL,
 			},
@@ -308,26 +312,42 @@ func TestService_Create(t *testing.T) {
 		Tenant:        tenantID,
 		ApplicationID: applicationID,
 		Name:          name,
-		Spec:          &model.EventAPISpec{},
+		Spec:          &model.EventAPISpec{Type: model.EventAPISpecTypeAsyncAPI, Format: model.SpecFormatYaml},
+		Version:       &model.Version{},
+	}
+
+	spec := asyncAPISpec
+	invalidSpec := invalidAsyncAPISpec
+	modelEventAPIDefinitionWithSpec := &model.EventAPIDefinition{
+		ID:            id,
+		Tenant:        tenantID,
+		ApplicationID: applicationID,
+		Name:          name,
+		Spec:          &model.EventAPISpec{Data: &spec, Type: model.EventAPISpecTypeAsyncAPI, Format: model.SpecFormatYaml},
 		Version:       &model.Version{},
 	}
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
 
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeEventAPI, ResourceID: id, Operation: model.ConfigurationChangeOperationCreated}
+
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.EventAPIRepository
-		FetchRequestRepoFn func() *automock.FetchRequestRepository
-		UIDServiceFn       func() *automock.UIDService
-		Input              model.EventAPIDefinitionInput
-		ExpectedErr        error
+		Name                  string
+		RepositoryFn          func() *automock.EventAPIRepository
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		FetchRequestServiceFn func() *automock.FetchRequestService
+		UIDServiceFn          func() *automock.UIDService
+		NotifierFn            func() *automock.ConfigurationChangeNotifier
+		Input                 model.EventAPIDefinitionInput
+		ExpectedErr           error
 	}{
 		{
 			Name: "Success",
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
 				repo.On("Create", ctx, modelEventAPIDefinition).Return(nil).Once()
+				repo.On("Update", ctx, modelEventAPIDefinitionWithSpec).Return(nil).Once()
 				return repo
 			},
 			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
@@ -335,6 +355,11 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
@@ -343,6 +368,43 @@ func TestService_Create(t *testing.T) {
 			},
 			Input:       modelInput,
 			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Error - Notifying about creation",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("Create", ctx, modelEventAPIDefinition).Return(nil).Once()
+				repo.On("Update", ctx, modelEventAPIDefinitionWithSpec).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input: modelInput,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErr: testErr,
 		},
 		{
 			Name: "Error - EventAPI Creation",
@@ -355,6 +417,9 @@ func TestService_Create(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
@@ -375,6 +440,9 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(testErr).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(id).Once()
@@ -384,6 +452,117 @@ func TestService_Create(t *testing.T) {
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
+		{
+			Name: "Error - Fetching Spec",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("Create", ctx, modelEventAPIDefinition).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(nil, testErr).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - EventAPI Update with fetched Spec",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("Create", ctx, modelEventAPIDefinition).Return(nil).Once()
+				repo.On("Update", ctx, modelEventAPIDefinitionWithSpec).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Success when fetched Spec is invalid keeps the provided Spec",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("Create", ctx, modelEventAPIDefinition).Return(nil).Once()
+				repo.On("Update", ctx, modelEventAPIDefinition).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), fixSpecValidator(asyncAPISpec, invalidAsyncAPISpec)).Return(nil, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			Input:       modelInput,
+			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Error - invalid Spec",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				return &automock.EventAPIRepository{}
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id).Once()
+				return svc
+			},
+			Input: model.EventAPIDefinitionInput{
+				Name: name,
+				Spec: &model.EventAPISpecInput{
+					Data:          &invalidSpec,
+					EventSpecType: model.EventAPISpecTypeAsyncAPI,
+					Format:        model.SpecFormatXML,
+				},
+			},
+			ExpectedErr: errors.New("while validating Spec for EventAPIDefinition foo: spec has to be in JSON or YAML format, got XML"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -391,9 +570,15 @@ func TestService_Create(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			fetchRequestService := testCase.FetchRequestServiceFn()
 			uidSvc := testCase.UIDServiceFn()
 
-			svc := eventapi.NewService(repo, fetchRequestRepo, uidSvc)
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+
+			svc := eventapi.NewService(repo, fetchRequestRepo, fetchRequestService, uidSvc, nil, nil, notifier)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -409,11 +594,13 @@ func TestService_Create(t *testing.T) {
 
 			repo.AssertExpectations(t)
 			fetchRequestRepo.AssertExpectations(t)
+			fetchRequestService.AssertExpectations(t)
 			uidSvc.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventapi.NewService(nil, nil, nil)
+		svc := eventapi.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Create(context.TODO(), "", model.EventAPIDefinitionInput{})
 		// THEN
@@ -434,6 +621,8 @@ func TestService_Update(t *testing.T) {
 	modelInput := model.EventAPIDefinitionInput{
 		Name: "Foo",
 		Spec: &model.EventAPISpecInput{
+			EventSpecType: model.EventAPISpecTypeAsyncAPI,
+			Format:        model.SpecFormatYaml,
 			FetchRequest: &model.FetchRequestInput{
 				URL: frURL,
 			},
@@ -441,29 +630,51 @@ func TestService_Update(t *testing.T) {
 		Version: &model.VersionInput{},
 	}
 
-	inputEventAPIDefinitionModel := mock.MatchedBy(func(api *model.EventAPIDefinition) bool {
-		return api.Name == modelInput.Name
-	})
-
 	eventAPIDefinitionModel := &model.EventAPIDefinition{
 		Name:          "Bar",
 		Tenant:        tenantID,
 		ApplicationID: "id",
-		Spec:          &model.EventAPISpec{},
+		Spec:          &model.EventAPISpec{Type: model.EventAPISpecTypeAsyncAPI, Format: model.SpecFormatYaml},
 		Version:       &model.Version{},
 	}
 
+	spec := asyncAPISpec
+	invalidSpec := invalidAsyncAPISpec
+	inputEventAPIDefinitionModel := mock.MatchedBy(func(api *model.EventAPIDefinition) bool {
+		return api.Name == modelInput.Name && api.Spec.Data != nil && *api.Spec.Data == spec
+	})
+
+	modelInputWithSpec := modelInput
+	modelInputWithSpec.Spec = &model.EventAPISpecInput{
+		Data:          &spec,
+		EventSpecType: model.EventAPISpecTypeAsyncAPI,
+		Format:        model.SpecFormatYaml,
+		FetchRequest:  &model.FetchRequestInput{URL: frURL},
+	}
+
+	modelInputWithInvalidSpec := modelInput
+	modelInputWithInvalidSpec.Spec = &model.EventAPISpecInput{
+		Data:          &invalidSpec,
+		EventSpecType: model.EventAPISpecTypeAsyncAPI,
+		Format:        model.SpecFormatYaml,
+		FetchRequest:  &model.FetchRequestInput{URL: frURL},
+	}
+
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
 
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeEventAPI, ResourceID: id, Operation: model.ConfigurationChangeOperationUpdated}
+
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.EventAPIRepository
-		FetchRequestRepoFn func() *automock.FetchRequestRepository
-		UIDServiceFn       func() *automock.UIDService
-		Input              model.EventAPIDefinitionInput
-		InputID            string
-		ExpectedErr        error
+		Name                  string
+		RepositoryFn          func() *automock.EventAPIRepository
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		FetchRequestServiceFn func() *automock.FetchRequestService
+		UIDServiceFn          func() *automock.UIDService
+		NotifierFn            func() *automock.ConfigurationChangeNotifier
+		Input                 model.EventAPIDefinitionInput
+		InputID               string
+		ExpectedErr           error
 	}{
 		{
 			Name: "Success",
@@ -479,6 +690,11 @@ func TestService_Update(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(frID).Once()
@@ -487,6 +703,77 @@ func TestService_Update(t *testing.T) {
 			InputID:     "foo",
 			Input:       modelInput,
 			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, eventAPIDefinitionModel.ApplicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Notifying Error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(eventAPIDefinitionModel, nil).Once()
+				repo.On("Update", ctx, inputEventAPIDefinitionModel).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, id).Return(nil).Once()
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			InputID: "foo",
+			Input:   modelInput,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, eventAPIDefinitionModel.ApplicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Success when fetching Spec failed keeps the provided Spec",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(eventAPIDefinitionModel, nil).Once()
+				repo.On("Update", ctx, inputEventAPIDefinitionModel).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, id).Return(nil).Once()
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), fixSpecValidator(asyncAPISpec, invalidAsyncAPISpec)).Return(nil, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			InputID:     "foo",
+			Input:       modelInputWithSpec,
+			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, eventAPIDefinitionModel.ApplicationID, change).Return(nil).Once()
+				return notifier
+			},
 		},
 		{
 			Name: "Update Error",
@@ -502,6 +789,38 @@ func TestService_Update(t *testing.T) {
 				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(&spec, nil).Once()
+				return svc
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(frID).Once()
+				return svc
+			},
+			InputID:     "foo",
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Fetching Spec Error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(eventAPIDefinitionModel, nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, id).Return(nil).Once()
+				repo.On("Create", ctx, fixModelFetchRequest(frID, frURL, timestamp)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fixModelFetchRequest(frID, frURL, timestamp), mock.Anything).Return(nil, testErr).Once()
+				return svc
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				svc.On("Generate").Return(frID).Once()
@@ -511,12 +830,37 @@ func TestService_Update(t *testing.T) {
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
+		{
+			Name: "Invalid Spec Error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(eventAPIDefinitionModel, nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("DeleteByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, id).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			InputID:     "foo",
+			Input:       modelInputWithInvalidSpec,
+			ExpectedErr: errors.New("while validating Spec for EventAPIDefinition foo: invalid AsyncAPI spec: root (line 1, column 1): channels is required"),
+		},
 		{
 			Name: "Get Error",
 			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
 				repo := &automock.FetchRequestRepository{}
 				return repo
 			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
 			UIDServiceFn: func() *automock.UIDService {
 				svc := &automock.UIDService{}
 				return svc
@@ -537,9 +881,15 @@ func TestService_Update(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			fetchRequestService := testCase.FetchRequestServiceFn()
 			uidSvc := testCase.UIDServiceFn()
 
-			svc := eventapi.NewService(repo, fetchRequestRepo, uidSvc)
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+
+			svc := eventapi.NewService(repo, fetchRequestRepo, fetchRequestService, uidSvc, nil, nil, notifier)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -555,11 +905,13 @@ func TestService_Update(t *testing.T) {
 
 			repo.AssertExpectations(t)
 			fetchRequestRepo.AssertExpectations(t)
+			fetchRequestService.AssertExpectations(t)
 			uidSvc.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventapi.NewService(nil, nil, nil)
+		svc := eventapi.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Update(context.TODO(), "", model.EventAPIDefinitionInput{})
 		// THEN
@@ -573,13 +925,18 @@ func TestService_Delete(t *testing.T) {
 	testErr := errors.New("Test error")
 
 	id := "foo"
+	applicationID := "bar"
+	eventAPIDefinitionModel := &model.EventAPIDefinition{ID: id, ApplicationID: applicationID, Tenant: tenantID}
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
 
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeEventAPI, ResourceID: id, Operation: model.ConfigurationChangeOperationDeleted}
+
 	testCases := []struct {
 		Name         string
 		RepositoryFn func() *automock.EventAPIRepository
+		NotifierFn   func() *automock.ConfigurationChangeNotifier
 		Input        model.EventAPIDefinitionInput
 		InputID      string
 		ExpectedErr  error
@@ -588,16 +945,49 @@ func TestService_Delete(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(eventAPIDefinitionModel, nil).Once()
 				repo.On("Delete", ctx, tenantID, id).Return(nil).Once()
 				return repo
 			},
 			InputID:     id,
 			ExpectedErr: nil,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Notifying Error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(eventAPIDefinitionModel, nil).Once()
+				repo.On("Delete", ctx, tenantID, id).Return(nil).Once()
+				return repo
+			},
+			InputID: id,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, applicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Get Error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:     id,
+			ExpectedErr: testErr,
 		},
 		{
 			Name: "Delete Error",
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, id).Return(eventAPIDefinitionModel, nil).Once()
 				repo.On("Delete", ctx, tenantID, id).Return(testErr).Once()
 				return repo
 			},
@@ -611,7 +1001,12 @@ func TestService_Delete(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := eventapi.NewService(repo, nil, nil)
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+
+			svc := eventapi.NewService(repo, nil, nil, nil, nil, nil, notifier)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -624,10 +1019,11 @@ func TestService_Delete(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventapi.NewService(nil, nil, nil)
+		svc := eventapi.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Delete(context.TODO(), "")
 		// THEN
@@ -641,34 +1037,154 @@ func TestService_RefetchAPISpec(t *testing.T) {
 	testErr := errors.New("Test error")
 
 	apiID := "foo"
+	frID := "fr-id"
+	frURL := "foo.bar"
+	timestamp := time.Now()
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID)
 
-	dataBytes := "data"
-	modelAPISpec := &model.EventAPISpec{
-		Data: &dataBytes,
+	dataBytes := asyncAPISpec
+	refetchedDataBytes := "asyncapi: '2.0.0'\ninfo:\n  title: Foo\n  version: v2\nchannels: {}\n"
+
+	modelAPIDefinitionFn := func() *model.EventAPIDefinition {
+		return &model.EventAPIDefinition{
+			ID:     apiID,
+			Tenant: tenantID,
+			Spec: &model.EventAPISpec{
+				Data:   &dataBytes,
+				Type:   model.EventAPISpecTypeAsyncAPI,
+				Format: model.SpecFormatYaml,
+			},
+		}
 	}
 
-	modelAPIDefinition := &model.EventAPIDefinition{
-		Spec: modelAPISpec,
+	modelAPIDefinitionWithRefetchedSpec := &model.EventAPIDefinition{
+		ID:     apiID,
+		Tenant: tenantID,
+		Spec: &model.EventAPISpec{
+			Data:   &refetchedDataBytes,
+			Type:   model.EventAPISpecTypeAsyncAPI,
+			Format: model.SpecFormatYaml,
+		},
 	}
 
+	fetchRequest := fixModelFetchRequest(frID, frURL, timestamp)
+
+	failedMessage := "while fetching Spec: unexpected status code 404"
+	failedFetchRequest := fixModelFetchRequest(frID, frURL, timestamp)
+	failedFetchRequest.Status = &model.FetchRequestStatus{
+		Condition: model.FetchRequestStatusConditionFailed,
+		Message:   &failedMessage,
+		Timestamp: timestamp,
+	}
+
+	invalidMessage := "invalid AsyncAPI spec: root (line 1, column 1): channels is required"
+	invalidFetchRequest := fixModelFetchRequest(frID, frURL, timestamp)
+	invalidFetchRequest.Status = &model.FetchRequestStatus{
+		Condition: model.FetchRequestStatusConditionFailed,
+		Message:   &invalidMessage,
+		Timestamp: timestamp,
+	}
+
+	change := model.ConfigurationChange{ResourceType: model.ConfigurationChangeResourceTypeEventAPI, ResourceID: apiID, Operation: model.ConfigurationChangeOperationUpdated}
+
 	testCases := []struct {
-		Name            string
-		RepositoryFn    func() *automock.EventAPIRepository
-		ExpectedAPISpec *model.EventAPISpec
-		ExpectedErr     error
+		Name                  string
+		RepositoryFn          func() *automock.EventAPIRepository
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		FetchRequestServiceFn func() *automock.FetchRequestService
+		NotifierFn            func() *automock.ConfigurationChangeNotifier
+		ExpectedAPISpec       *model.EventAPISpec
+		ExpectedErrMessage    string
+		ExpectedFetchFailed   bool
 	}{
 		{
 			Name: "Success",
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
-				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinition, nil).Once()
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithRefetchedSpec).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, apiID).Return(fetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fetchRequest, mock.Anything).Return(&refetchedDataBytes, nil).Once()
+				return svc
+			},
+			ExpectedAPISpec: modelAPIDefinitionWithRefetchedSpec.Spec,
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, modelAPIDefinitionFn().ApplicationID, change).Return(nil).Once()
+				return notifier
+			},
+		},
+		{
+			Name: "Notifying error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithRefetchedSpec).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, apiID).Return(fetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fetchRequest, mock.Anything).Return(&refetchedDataBytes, nil).Once()
+				return svc
+			},
+			NotifierFn: func() *automock.ConfigurationChangeNotifier {
+				notifier := &automock.ConfigurationChangeNotifier{}
+				notifier.On("NotifyConfigurationChanged", ctx, modelAPIDefinitionFn().ApplicationID, change).Return(testErr).Once()
+				return notifier
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Success when there is no FetchRequest",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, apiID).Return(nil, apperrors.NewNotFoundError("")).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedAPISpec: modelAPIDefinitionFn().Spec,
+		},
+		{
+			Name: "Error when fetching Spec failed",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, apiID).Return(failedFetchRequest, nil).Once()
 				return repo
 			},
-			ExpectedAPISpec: modelAPISpec,
-			ExpectedErr:     nil,
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, failedFetchRequest, mock.Anything).Return(nil, nil).Once()
+				return svc
+			},
+			ExpectedErrMessage:  "fetching Spec failed: while fetching Spec: unexpected status code 404",
+			ExpectedFetchFailed: true,
 		},
 		{
 			Name: "Get from repository error",
@@ -677,8 +1193,89 @@ func TestService_RefetchAPISpec(t *testing.T) {
 				repo.On("GetByID", ctx, tenantID, apiID).Return(nil, testErr).Once()
 				return repo
 			},
-			ExpectedAPISpec: nil,
-			ExpectedErr:     testErr,
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Get FetchRequest error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, apiID).Return(nil, testErr).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Handle Spec error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, apiID).Return(fetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fetchRequest, mock.Anything).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Update error",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				repo.On("Update", ctx, modelAPIDefinitionWithRefetchedSpec).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, apiID).Return(fetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fetchRequest, mock.Anything).Return(&refetchedDataBytes, nil).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Error when refetched Spec is invalid",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, apiID).Return(modelAPIDefinitionFn(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenantID, model.EventAPIFetchRequestReference, apiID).Return(invalidFetchRequest, nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, invalidFetchRequest, fixSpecValidator(asyncAPISpec, invalidAsyncAPISpec)).Return(nil, nil).Once()
+				return svc
+			},
+			ExpectedErrMessage:  "fetching Spec failed: " + invalidMessage,
+			ExpectedFetchFailed: true,
 		},
 	}
 
@@ -686,21 +1283,37 @@ func TestService_RefetchAPISpec(t *testing.T) {
 		t.Run(fmt.Sprintf("%s", testCase.Name), func(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
+			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			fetchRequestService := testCase.FetchRequestServiceFn()
+
+			notifier := &automock.ConfigurationChangeNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
 
-			svc := eventapi.NewService(repo, nil, nil)
+			svc := eventapi.NewService(repo, fetchRequestRepo, fetchRequestService, nil, nil, nil, notifier)
 
 			// when
 			result, err := svc.RefetchAPISpec(ctx, apiID)
 
 			// then
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Equal(t, testCase.ExpectedFetchFailed, apperrors.IsFetchFailed(err))
+			} else {
+				require.NoError(t, err)
+			}
 			assert.Equal(t, testCase.ExpectedAPISpec, result)
-			assert.Equal(t, testCase.ExpectedErr, err)
 
 			repo.AssertExpectations(t)
+			fetchRequestRepo.AssertExpectations(t)
+			fetchRequestService.AssertExpectations(t)
+			notifier.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := eventapi.NewService(nil, nil, nil)
+		svc := eventapi.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.RefetchAPISpec(context.TODO(), "")
 		// THEN
@@ -797,7 +1410,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := eventapi.NewService(repo, fetchRequestRepo, nil)
+			svc := eventapi.NewService(repo, fetchRequestRepo, nil, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.GetFetchRequest(ctx, refID)
@@ -817,9 +1430,78 @@ func TestService_GetFetchRequest(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := eventapi.NewService(nil, nil, nil)
+		svc := eventapi.NewService(nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.GetFetchRequest(context.TODO(), "dd")
 		assert.Equal(t, tenant.NoTenantError, err)
 	})
 }
+
+func TestService_SetLabel(t *testing.T) {
+	// given
+	tnt := "tenant"
+	ctx := tenant.SaveToContext(context.TODO(), tnt)
+
+	objID := "foo"
+	labelInput := &model.LabelInput{
+		Key:        "domain",
+		Value:      "payments",
+		ObjectType: model.EventAPIDefinitionLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name                 string
+		RepositoryFn         func() *automock.EventAPIRepository
+		LabelUpsertServiceFn func() *automock.LabelUpsertService
+		ExpectedErrMessage   string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("Exists", ctx, tnt, objID).Return(true, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, labelInput).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Returns error when Event API Definition does not exist",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("Exists", ctx, tnt, objID).Return(false, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			ExpectedErrMessage: "Event API Definition with ID foo doesn't exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelUpsertSvc := testCase.LabelUpsertServiceFn()
+			svc := eventapi.NewService(repo, nil, nil, nil, nil, labelUpsertSvc, nil)
+
+			// when
+			err := svc.SetLabel(ctx, labelInput)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelUpsertSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package fetchrequest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"sort"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+// extractFile returns content of the only regular file of zip or tar.gz archive matching the filter.
+// Only the selected file is read into memory and reading it fails when it is larger than maxSize.
+func extractFile(archive []byte, filter *string, maxSize int64) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(archive, zipMagic):
+		return extractZipFile(archive, filter, maxSize)
+	case bytes.HasPrefix(archive, gzipMagic):
+		return extractTarGzFile(archive, filter, maxSize)
+	}
+
+	return nil, errors.New("unsupported package format, expected zip or tar.gz archive")
+}
+
+func extractZipFile(archive []byte, filter *string, maxSize int64) ([]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening zip package")
+	}
+
+	files := make(map[string]*zip.File)
+	names := make([]string, 0, len(reader.File))
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		files[f.Name] = f
+		names = append(names, f.Name)
+	}
+
+	name, err := selectSingle(names, filter, "package file")
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := readZipFile(files[name], maxSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading file %s from zip package", name)
+	}
+
+	return content, nil
+}
+
+func readZipFile(f *zip.File, maxSize int64) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer closeReader(rc)
+
+	return readLimited(rc, maxSize)
+}
+
+func extractTarGzFile(archive []byte, filter *string, maxSize int64) ([]byte, error) {
+	var names []string
+	err := walkTarGz(archive, func(header *tar.Header, _ io.Reader) (bool, error) {
+		names = append(names, header.Name)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := selectSingle(names, filter, "package file")
+	if err != nil {
+		return nil, err
+	}
+
+	var content []byte
+	err = walkTarGz(archive, func(header *tar.Header, reader io.Reader) (bool, error) {
+		if header.Name != name {
+			return false, nil
+		}
+
+		content, err = readLimited(reader, maxSize)
+		if err != nil {
+			return false, errors.Wrapf(err, "while reading file %s from tar.gz package", name)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// walkTarGz calls fn for every regular file of the tar.gz archive until fn returns true or an error
+func walkTarGz(archive []byte, fn func(header *tar.Header, reader io.Reader) (bool, error)) error {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return errors.Wrap(err, "while opening tar.gz package")
+	}
+	defer closeReader(gz)
+
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "while reading tar.gz package")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		done, err := fn(header, reader)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// readLimited reads the whole content of the reader, unless it is larger than maxSize
+func readLimited(reader io.Reader, maxSize int64) ([]byte, error) {
+	content, err := ioutil.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("size exceeds the limit of %d bytes", maxSize)
+	}
+
+	return content, nil
+}
+
+// closeReader closes the reader without draining it, so that the rest of a decompressed archive is not read
+func closeReader(reader io.Closer) {
+	if err := reader.Close(); err != nil {
+		log.Error(err)
+	}
+}
+
+// parseIndex reads index document being a JSON array of entry URLs. Relative URLs are resolved against the index URL.
+func parseIndex(indexURL string, index []byte) ([]string, error) {
+	base, err := url.Parse(indexURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing index URL")
+	}
+
+	var entries []string
+	if err := json.Unmarshal(index, &entries); err != nil {
+		return nil, errors.Wrap(err, "while parsing index document, expected JSON array of URLs")
+	}
+
+	resolved := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		entryURL, err := url.Parse(entry)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing index entry %s", entry)
+		}
+		resolved = append(resolved, base.ResolveReference(entryURL).String())
+	}
+
+	return resolved, nil
+}
+
+// checkOrigin makes sure that the index entry is served by the same scheme and host as the index
+func checkOrigin(indexURL, entryURL string) error {
+	index, err := url.Parse(indexURL)
+	if err != nil {
+		return errors.Wrap(err, "while parsing index URL")
+	}
+
+	entry, err := url.Parse(entryURL)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing index entry %s", entryURL)
+	}
+
+	if entry.Scheme != index.Scheme || entry.Host != index.Host {
+		return fmt.Errorf("index entry %s must have the same origin as the index", entryURL)
+	}
+
+	return nil
+}
+
+// selectSingle picks the only candidate matching the filter. Filter is a glob pattern matched against the whole candidate or its last path element.
+// A filter matching more than one candidate is rejected, as only a single specification is stored.
+func selectSingle(candidates []string, filter *string, kind string) (string, error) {
+	var matched []string
+	for _, candidate := range candidates {
+		ok, err := matchFilter(candidate, filter)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			matched = append(matched, candidate)
+		}
+	}
+	sort.Strings(matched)
+
+	switch len(matched) {
+	case 0:
+		if filter == nil {
+			return "", fmt.Errorf("no %s found", kind)
+		}
+		return "", fmt.Errorf("no %s matches filter %s", kind, *filter)
+	case 1:
+		return matched[0], nil
+	}
+
+	if filter == nil {
+		return "", fmt.Errorf("filter is required to choose %s out of %d candidates: %v", kind, len(matched), matched)
+	}
+	return "", fmt.Errorf("filter %s matches %d candidates for %s, expected exactly one: %v", *filter, len(matched), kind, matched)
+}
+
+func matchFilter(candidate string, filter *string) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+
+	ok, err := path.Match(*filter, candidate)
+	if err != nil {
+		return false, errors.Wrapf(err, "while matching filter %s", *filter)
+	}
+	if ok {
+		return true, nil
+	}
+
+	return path.Match(*filter, path.Base(candidate))
+}
@@ -0,0 +1,38 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	http "net/http"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HTTPClient is an autogenerated mock type for the HTTPClient type
+type HTTPClient struct {
+	mock.Mock
+}
+
+// Do provides a mock function with given fields: req, auth
+func (_m *HTTPClient) Do(req *http.Request, auth *model.Auth) (*http.Response, error) {
+	ret := _m.Called(req, auth)
+
+	var r0 *http.Response
+	if rf, ok := ret.Get(0).(func(*http.Request, *model.Auth) *http.Response); ok {
+		r0 = rf(req, auth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*http.Request, *model.Auth) error); ok {
+		r1 = rf(req, auth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,5 @@
+package fetchrequest
+
+type Config struct {
+	MaxSpecSize int64 `envconfig:"default=10485760"`
+}
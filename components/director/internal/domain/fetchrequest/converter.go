@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
@@ -94,7 +95,10 @@ func (c *converter) ToEntity(in model.FetchRequest) (Entity, error) {
 		Mode:            string(in.Mode),
 		Filter:          filter,
 		StatusCondition: string(in.Status.Condition),
+		StatusMessage:   repo.NewNullableString(in.Status.Message),
 		StatusTimestamp: in.Status.Timestamp,
+		LastChecked:     in.Status.LastChecked,
+		LastChanged:     in.Status.LastChanged,
 	}, nil
 }
 
@@ -115,8 +119,11 @@ func (c *converter) FromEntity(in Entity) (model.FetchRequest, error) {
 		ObjectID:   objectID,
 		ObjectType: objectType,
 		Status: &model.FetchRequestStatus{
-			Timestamp: in.StatusTimestamp,
-			Condition: model.FetchRequestStatusCondition(in.StatusCondition),
+			Timestamp:   in.StatusTimestamp,
+			Condition:   model.FetchRequestStatusCondition(in.StatusCondition),
+			Message:     repo.StringPtrFromNullableString(in.StatusMessage),
+			LastChecked: in.LastChecked,
+			LastChanged: in.LastChanged,
 		},
 		URL:    in.URL,
 		Mode:   model.FetchMode(in.Mode),
@@ -145,11 +152,23 @@ func (c *converter) statusToGraphQL(in *model.FetchRequestStatus) *graphql.Fetch
 	}
 
 	return &graphql.FetchRequestStatus{
-		Condition: condition,
-		Timestamp: graphql.Timestamp(in.Timestamp),
+		Condition:   condition,
+		Message:     in.Message,
+		Timestamp:   graphql.Timestamp(in.Timestamp),
+		LastChecked: c.timestampPtrToGraphQL(in.LastChecked),
+		LastChanged: c.timestampPtrToGraphQL(in.LastChanged),
 	}
 }
 
+func (c *converter) timestampPtrToGraphQL(in *time.Time) *graphql.Timestamp {
+	if in == nil {
+		return nil
+	}
+
+	timestamp := graphql.Timestamp(*in)
+	return &timestamp
+}
+
 func (c *converter) authToEntity(in *model.Auth) (sql.NullString, error) {
 	var auth sql.NullString
 	if in == nil {
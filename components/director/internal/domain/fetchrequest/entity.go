@@ -16,5 +16,8 @@ type Entity struct {
 	Auth            sql.NullString `db:"auth"`
 	Filter          sql.NullString `db:"filter"`
 	StatusCondition string         `db:"status_condition"`
+	StatusMessage   sql.NullString `db:"status_message"`
 	StatusTimestamp time.Time      `db:"status_timestamp"`
+	LastChecked     *time.Time     `db:"status_last_checked"`
+	LastChanged     *time.Time     `db:"status_last_changed"`
 }
@@ -16,6 +16,7 @@ import (
 func fixModelFetchRequest(t *testing.T, url, filter string) *model.FetchRequest {
 	time, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
 	require.NoError(t, err)
+	message := "message"
 
 	return &model.FetchRequest{
 		URL:    url,
@@ -23,8 +24,10 @@ func fixModelFetchRequest(t *testing.T, url, filter string) *model.FetchRequest
 		Mode:   model.FetchModeSingle,
 		Filter: &filter,
 		Status: &model.FetchRequestStatus{
-			Condition: model.FetchRequestStatusConditionInitial,
-			Timestamp: time,
+			Condition:   model.FetchRequestStatusConditionInitial,
+			Message:     &message,
+			Timestamp:   time,
+			LastChecked: &time,
 		},
 	}
 }
@@ -32,6 +35,7 @@ func fixModelFetchRequest(t *testing.T, url, filter string) *model.FetchRequest
 func fixGQLFetchRequest(t *testing.T, url, filter string) *graphql.FetchRequest {
 	time, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
 	require.NoError(t, err)
+	message := "message"
 
 	return &graphql.FetchRequest{
 		URL:    url,
@@ -39,8 +43,10 @@ func fixGQLFetchRequest(t *testing.T, url, filter string) *graphql.FetchRequest
 		Mode:   graphql.FetchModeSingle,
 		Filter: &filter,
 		Status: &graphql.FetchRequestStatus{
-			Condition: graphql.FetchRequestStatusConditionInitial,
-			Timestamp: graphql.Timestamp(time),
+			Condition:   graphql.FetchRequestStatusConditionInitial,
+			Message:     &message,
+			Timestamp:   graphql.Timestamp(time),
+			LastChecked: (*graphql.Timestamp)(&time),
 		},
 	}
 }
@@ -69,6 +75,7 @@ func fixGQLFetchRequestInput(url, filter string) *graphql.FetchRequestInput {
 
 func fixFullFetchRequestModel(id string, timestamp time.Time) model.FetchRequest {
 	filter := "filter"
+	message := "message"
 	return model.FetchRequest{
 		ID:     id,
 		Tenant: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
@@ -76,8 +83,11 @@ func fixFullFetchRequestModel(id string, timestamp time.Time) model.FetchRequest
 		Mode:   model.FetchModeIndex,
 		Filter: &filter,
 		Status: &model.FetchRequestStatus{
-			Condition: model.FetchRequestStatusConditionSucceeded,
-			Timestamp: timestamp,
+			Condition:   model.FetchRequestStatusConditionSucceeded,
+			Message:     &message,
+			Timestamp:   timestamp,
+			LastChecked: &timestamp,
+			LastChanged: &timestamp,
 		},
 		Auth: &model.Auth{
 			Credential: model.CredentialData{
@@ -116,7 +126,13 @@ func fixFullFetchRequestEntity(t *testing.T, id string, timestamp time.Time) fet
 			Valid:  true,
 		},
 		StatusCondition: string(model.FetchRequestStatusConditionSucceeded),
+		StatusMessage: sql.NullString{
+			String: "message",
+			Valid:  true,
+		},
 		StatusTimestamp: timestamp,
+		LastChecked:     &timestamp,
+		LastChanged:     &timestamp,
 		Auth: sql.NullString{
 			Valid:  true,
 			String: string(bytes),
@@ -166,3 +182,24 @@ func fixFetchRequestEntityWithReferences(id string, timestamp time.Time, apiDefI
 		DocumentID:      documentID,
 	}
 }
+
+func fixFetchRequest(url string, mode model.FetchMode, filter *string) model.FetchRequest {
+	return model.FetchRequest{
+		ID:     givenID(),
+		Tenant: givenTenant(),
+		URL:    url,
+		Mode:   mode,
+		Filter: filter,
+		Status: &model.FetchRequestStatus{
+			Condition: model.FetchRequestStatusConditionInitial,
+		},
+		ObjectType: model.APIFetchRequestReference,
+		ObjectID:   "apiID",
+	}
+}
+
+func fixFetchRequestWithAuth(url string, auth *model.Auth) model.FetchRequest {
+	fr := fixFetchRequest(url, model.FetchModeSingle, nil)
+	fr.Auth = auth
+	return fr
+}
@@ -0,0 +1,95 @@
+package fetchrequest
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	log "github.com/sirupsen/logrus"
+)
+
+type key int
+
+const prefetchedSpecsContextKey key = iota
+
+type prefetchedSpec struct {
+	data   *string
+	status model.FetchRequestStatus
+}
+
+type prefetchedSpecs map[string]prefetchedSpec
+
+// Prefetch downloads the specifications pointed by the Fetch Requests and stores them in the returned context.
+// HandleSpec uses a stored result for a Fetch Request with the same URL, authentication, mode and filter instead of downloading
+// the specification again, so the specifications can be downloaded before a database transaction is started.
+func (s *service) Prefetch(ctx context.Context, fetchRequests []*model.FetchRequest) context.Context {
+	specs := prefetchedSpecs{}
+	for k, v := range loadPrefetchedSpecs(ctx) {
+		specs[k] = v
+	}
+
+	for _, fr := range fetchRequests {
+		if fr == nil {
+			continue
+		}
+
+		specKey, ok := prefetchKey(fr)
+		if !ok {
+			continue
+		}
+		if _, exists := specs[specKey]; exists {
+			continue
+		}
+
+		data, status := s.FetchSpec(ctx, fr)
+		specs[specKey] = prefetchedSpec{data: data, status: *status}
+	}
+
+	return context.WithValue(ctx, prefetchedSpecsContextKey, specs)
+}
+
+func loadPrefetched(ctx context.Context, fr *model.FetchRequest) (*string, *model.FetchRequestStatus, bool) {
+	specKey, ok := prefetchKey(fr)
+	if !ok {
+		return nil, nil, false
+	}
+
+	spec, ok := loadPrefetchedSpecs(ctx)[specKey]
+	if !ok {
+		return nil, nil, false
+	}
+
+	status := spec.status
+	return spec.data, &status, true
+}
+
+func loadPrefetchedSpecs(ctx context.Context) prefetchedSpecs {
+	specs, ok := ctx.Value(prefetchedSpecsContextKey).(prefetchedSpecs)
+	if !ok {
+		return nil
+	}
+
+	return specs
+}
+
+func prefetchKey(fr *model.FetchRequest) (string, bool) {
+	source := struct {
+		URL    string
+		Auth   *model.Auth
+		Mode   model.FetchMode
+		Filter *string
+	}{
+		URL:    fr.URL,
+		Auth:   fr.Auth,
+		Mode:   fr.Mode,
+		Filter: fr.Filter,
+	}
+
+	marshalled, err := json.Marshal(source)
+	if err != nil {
+		log.Errorf("while marshalling source of FetchRequest with ID %s: %s", fr.ID, err)
+		return "", false
+	}
+
+	return string(marshalled), true
+}
@@ -2,9 +2,15 @@ package fetchrequest
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/pkg/errors"
 )
 
@@ -15,7 +21,8 @@ const apiDefIDColumn = "api_def_id"
 const eventAPIDefIDColumn = "event_api_def_id"
 
 var (
-	fetchRequestColumns = []string{"id", "tenant_id", apiDefIDColumn, eventAPIDefIDColumn, documentIDColumn, "url", "auth", "mode", "filter", "status_condition", "status_timestamp"}
+	fetchRequestColumns = []string{"id", "tenant_id", apiDefIDColumn, eventAPIDefIDColumn, documentIDColumn, "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "status_last_checked", "status_last_changed"}
+	updatableColumns    = []string{"status_condition", "status_message", "status_timestamp", "status_last_checked", "status_last_changed"}
 	tenantColumn        = "tenant_id"
 )
 
@@ -29,6 +36,7 @@ type repository struct {
 	creator      repo.Creator
 	singleGetter repo.SingleGetter
 	deleter      repo.Deleter
+	updater      repo.Updater
 	conv         Converter
 }
 
@@ -37,6 +45,7 @@ func NewRepository(conv Converter) *repository {
 		creator:      repo.NewCreator(fetchRequestTable, fetchRequestColumns),
 		singleGetter: repo.NewSingleGetter(fetchRequestTable, tenantColumn, fetchRequestColumns),
 		deleter:      repo.NewDeleter(fetchRequestTable, tenantColumn),
+		updater:      repo.NewUpdater(fetchRequestTable, updatableColumns, tenantColumn, []string{"id"}),
 		conv:         conv,
 	}
 }
@@ -73,6 +82,92 @@ func (r *repository) GetByReferenceObjectID(ctx context.Context, tenant string,
 	return &frModel, nil
 }
 
+// LockNextForSync returns the Fetch Request which was not checked since the given time and locks it until the end of the transaction.
+// Fetch Requests locked by other transactions and Fetch Requests which started to synchronize after the given time are skipped,
+// so that concurrent callers never get the same Fetch Request.
+func (r *repository) LockNextForSync(ctx context.Context, checkedBefore, startedBefore time.Time) (*model.FetchRequest, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE (status_last_checked IS NULL OR status_last_checked < $1) AND (sync_started_at IS NULL OR sync_started_at < $2)
+		ORDER BY status_last_checked NULLS FIRST LIMIT 1 FOR UPDATE SKIP LOCKED`, strings.Join(fetchRequestColumns, ", "), fetchRequestTable)
+
+	var entity Entity
+	err = persist.Get(&entity, stmt, checkedBefore, startedBefore)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, apperrors.NewNotFoundError("")
+	case err != nil:
+		return nil, errors.Wrap(err, "while getting FetchRequest to synchronize from DB")
+	}
+
+	frModel, err := r.conv.FromEntity(entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting FetchRequest model from entity")
+	}
+
+	return &frModel, nil
+}
+
+// MarkSyncStarted stores the time when the synchronization of the Fetch Request started
+func (r *repository) MarkSyncStarted(ctx context.Context, tenant, id string, startedAt time.Time) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf(`UPDATE %s SET sync_started_at = $1 WHERE tenant_id = $2 AND id = $3`, fetchRequestTable)
+
+	_, err = persist.Exec(stmt, startedAt, tenant, id)
+	if err != nil {
+		return errors.Wrap(err, "while marking synchronization of FetchRequest as started")
+	}
+
+	return nil
+}
+
+// LockIfSyncStartedAt returns the Fetch Request and locks it until the end of the transaction, but only if its synchronization started at the given time.
+// Otherwise, the Fetch Request was deleted or another caller started to synchronize it, and the not found error is returned.
+func (r *repository) LockIfSyncStartedAt(ctx context.Context, tenant, id string, startedAt time.Time) (*model.FetchRequest, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE tenant_id = $1 AND id = $2 AND sync_started_at = $3 FOR UPDATE`, strings.Join(fetchRequestColumns, ", "), fetchRequestTable)
+
+	var entity Entity
+	err = persist.Get(&entity, stmt, tenant, id, startedAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, apperrors.NewNotFoundError(id)
+	case err != nil:
+		return nil, errors.Wrap(err, "while getting FetchRequest from DB")
+	}
+
+	frModel, err := r.conv.FromEntity(entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting FetchRequest model from entity")
+	}
+
+	return &frModel, nil
+}
+
+func (r *repository) Update(ctx context.Context, item *model.FetchRequest) error {
+	if item == nil {
+		return errors.New("item cannot be nil")
+	}
+
+	entity, err := r.conv.ToEntity(*item)
+	if err != nil {
+		return errors.Wrap(err, "while converting FetchRequest model to entity")
+	}
+
+	return r.updater.UpdateSingle(ctx, entity)
+}
+
 func (r *repository) Delete(ctx context.Context, tenant, id string) error {
 	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
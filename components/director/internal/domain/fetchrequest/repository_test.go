@@ -15,6 +15,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -33,8 +34,8 @@ func TestRepository_Create(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, tenant_id, api_def_id, event_api_def_id, document_id, url, auth, mode, filter, status_condition, status_timestamp ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")).
-			WithArgs(givenID(), givenTenant(), sql.NullString{}, sql.NullString{}, "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusTimestamp).
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, tenant_id, api_def_id, event_api_def_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, status_last_checked, status_last_changed ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")).
+			WithArgs(givenID(), givenTenant(), sql.NullString{}, sql.NullString{}, "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, frEntity.LastChecked, frEntity.LastChanged).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -111,10 +112,10 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 			repo := fetchrequest.NewRepository(mockConverter)
 			db, dbMock := testdb.MockDatabase(t)
 
-			rows := sqlmock.NewRows([]string{"id", "tenant_id", "api_def_id", "event_api_def_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_timestamp"}).
-				AddRow(givenID(), givenTenant(), testCase.APIDefID, testCase.EventAPIDefID, testCase.DocumentID, "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusTimestamp)
+			rows := sqlmock.NewRows([]string{"id", "tenant_id", "api_def_id", "event_api_def_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "status_last_checked", "status_last_changed"}).
+				AddRow(givenID(), givenTenant(), testCase.APIDefID, testCase.EventAPIDefID, testCase.DocumentID, "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, frEntity.LastChecked, frEntity.LastChanged)
 
-			query := fmt.Sprintf("SELECT id, tenant_id, api_def_id, event_api_def_id, document_id, url, auth, mode, filter, status_condition, status_timestamp FROM public.fetch_requests WHERE tenant_id = $1 AND %s = $2", testCase.FieldName)
+			query := fmt.Sprintf("SELECT id, tenant_id, api_def_id, event_api_def_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, status_last_checked, status_last_changed FROM public.fetch_requests WHERE tenant_id = $1 AND %s = $2", testCase.FieldName)
 			dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 				WithArgs(givenTenant(), givenID()).WillReturnRows(rows)
 
@@ -144,8 +145,8 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "api_def_id", "event_api_def_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_timestamp"}).
-			AddRow(givenID(), givenTenant(), sql.NullString{}, sql.NullString{}, "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusTimestamp)
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "api_def_id", "event_api_def_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "status_last_checked", "status_last_changed"}).
+			AddRow(givenID(), givenTenant(), sql.NullString{}, sql.NullString{}, "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, frEntity.LastChecked, frEntity.LastChanged)
 
 		dbMock.ExpectQuery("SELECT .*").
 			WithArgs(givenTenant(), givenID()).WillReturnRows(rows)
@@ -188,6 +189,260 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 
 }
 
+func TestRepository_LockNextForSync(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, tenant_id, api_def_id, event_api_def_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, status_last_checked, status_last_changed FROM public.fetch_requests WHERE (status_last_checked IS NULL OR status_last_checked < $1) AND (sync_started_at IS NULL OR sync_started_at < $2)
+		ORDER BY status_last_checked NULLS FIRST LIMIT 1 FOR UPDATE SKIP LOCKED`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		frModel := fixFullFetchRequestModel(givenID(), timestamp)
+		frEntity := fixFullFetchRequestEntity(t, givenID(), timestamp)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromEntity", frEntity).Return(frModel, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "api_def_id", "event_api_def_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "status_last_checked", "status_last_changed"}).
+			AddRow(givenID(), givenTenant(), sql.NullString{}, sql.NullString{}, "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, frEntity.LastChecked, frEntity.LastChanged)
+		dbMock.ExpectQuery(query).WithArgs(timestamp, timestamp).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(mockConverter)
+
+		// WHEN
+		actual, err := repo.LockNextForSync(ctx, timestamp, timestamp)
+
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, actual)
+		assert.Equal(t, frModel, *actual)
+	})
+
+	t.Run("Error - Not found", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(timestamp, timestamp).WillReturnError(sql.ErrNoRows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.LockNextForSync(ctx, timestamp, timestamp)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error - DB", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(timestamp, timestamp).WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.LockNextForSync(ctx, timestamp, timestamp)
+
+		// THEN
+		require.EqualError(t, err, "while getting FetchRequest to synchronize from DB: some error")
+	})
+}
+
+func TestRepository_MarkSyncStarted(t *testing.T) {
+	stmt := regexp.QuoteMeta(`UPDATE public.fetch_requests SET sync_started_at = $1 WHERE tenant_id = $2 AND id = $3`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(stmt).WithArgs(timestamp, givenTenant(), givenID()).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+
+		// WHEN
+		err := repo.MarkSyncStarted(ctx, givenTenant(), givenID(), timestamp)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error - DB", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(stmt).WithArgs(timestamp, givenTenant(), givenID()).WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+
+		// WHEN
+		err := repo.MarkSyncStarted(ctx, givenTenant(), givenID(), timestamp)
+
+		// THEN
+		require.EqualError(t, err, "while marking synchronization of FetchRequest as started: some error")
+	})
+}
+
+func TestRepository_LockIfSyncStartedAt(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, tenant_id, api_def_id, event_api_def_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, status_last_checked, status_last_changed FROM public.fetch_requests WHERE tenant_id = $1 AND id = $2 AND sync_started_at = $3 FOR UPDATE`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		frModel := fixFullFetchRequestModel(givenID(), timestamp)
+		frEntity := fixFullFetchRequestEntity(t, givenID(), timestamp)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromEntity", frEntity).Return(frModel, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "api_def_id", "event_api_def_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "status_last_checked", "status_last_changed"}).
+			AddRow(givenID(), givenTenant(), sql.NullString{}, sql.NullString{}, "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, frEntity.LastChecked, frEntity.LastChanged)
+		dbMock.ExpectQuery(query).WithArgs(givenTenant(), givenID(), timestamp).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(mockConverter)
+
+		// WHEN
+		actual, err := repo.LockIfSyncStartedAt(ctx, givenTenant(), givenID(), timestamp)
+
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, actual)
+		assert.Equal(t, frModel, *actual)
+	})
+
+	t.Run("Error - Not found", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(givenTenant(), givenID(), timestamp).WillReturnError(sql.ErrNoRows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.LockIfSyncStartedAt(ctx, givenTenant(), givenID(), timestamp)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error - DB", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(givenTenant(), givenID(), timestamp).WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.LockIfSyncStartedAt(ctx, givenTenant(), givenID(), timestamp)
+
+		// THEN
+		require.EqualError(t, err, "while getting FetchRequest from DB: some error")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		frModel := fixFullFetchRequestModel(givenID(), timestamp)
+		frEntity := fixFullFetchRequestEntity(t, givenID(), timestamp)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("ToEntity", frModel).Return(frEntity, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ?, status_last_checked = ?, status_last_changed = ? WHERE tenant_id = ? AND id = ?")).
+			WithArgs(frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, frEntity.LastChecked, frEntity.LastChanged, givenTenant(), givenID()).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(mockConverter)
+		// WHEN
+		err := repo.Update(ctx, &frModel)
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error - DB", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		frModel := fixFullFetchRequestModel(givenID(), timestamp)
+		frEntity := fixFullFetchRequestEntity(t, givenID(), timestamp)
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", frModel).Return(frEntity, nil)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec("UPDATE .*").WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(mockConverter)
+		// WHEN
+		err := repo.Update(ctx, &frModel)
+		// THEN
+		require.EqualError(t, err, "while updating single entity: some error")
+	})
+
+	t.Run("Error - Converter", func(t *testing.T) {
+		// GIVEN
+		timestamp := time.Now()
+		frModel := fixFullFetchRequestModel(givenID(), timestamp)
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", frModel).Return(fetchrequest.Entity{}, givenError())
+
+		repo := fetchrequest.NewRepository(mockConverter)
+		// WHEN
+		err := repo.Update(context.TODO(), &frModel)
+		// THEN
+		require.EqualError(t, err, "while converting FetchRequest model to entity: some error")
+	})
+
+	t.Run("Error - Nil", func(t *testing.T) {
+		// GIVEN
+		repo := fetchrequest.NewRepository(nil)
+		// WHEN
+		err := repo.Update(context.TODO(), nil)
+		// THEN
+		require.EqualError(t, err, "item cannot be nil")
+	})
+}
+
 func TestRepository_Delete(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// GIVEN
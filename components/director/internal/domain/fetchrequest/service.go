@@ -0,0 +1,179 @@
+package fetchrequest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=FetchRequestRepository -output=automock -outpkg=automock -case=underscore
+type FetchRequestRepository interface {
+	Update(ctx context.Context, item *model.FetchRequest) error
+}
+
+//go:generate mockery -name=HTTPClient -output=automock -outpkg=automock -case=underscore
+type HTTPClient interface {
+	Do(req *http.Request, auth *model.Auth) (*http.Response, error)
+}
+
+type service struct {
+	repo         FetchRequestRepository
+	client       HTTPClient
+	cfg          Config
+	timestampGen timestamp.Generator
+}
+
+func NewService(repo FetchRequestRepository, client HTTPClient, cfg Config) *service {
+	return &service{
+		repo:         repo,
+		client:       client,
+		cfg:          cfg,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// HandleSpec downloads the specification pointed by the Fetch Request and persists the result in its status.
+// Fetching failures are not returned as errors - they are stored in the Fetch Request status instead.
+// The downloaded specification rejected by the optional validate function is treated as a fetching failure.
+// A specification prefetched into the context is used instead of downloading it again.
+func (s *service) HandleSpec(ctx context.Context, fr *model.FetchRequest, validate func(data string) error) (*string, error) {
+	if fr == nil {
+		return nil, errors.New("fetch request cannot be nil")
+	}
+
+	data, status, ok := loadPrefetched(ctx, fr)
+	if !ok {
+		data, status = s.FetchSpec(ctx, fr)
+	}
+	if status.Condition == model.FetchRequestStatusConditionSucceeded && validate != nil && data != nil {
+		if err := validate(*data); err != nil {
+			log.Errorf("while validating Spec fetched for FetchRequest with ID %s: %s", fr.ID, err)
+			data, status = nil, s.failedStatus(err.Error())
+		}
+	}
+	if status.Condition == model.FetchRequestStatusConditionSucceeded {
+		status.LastChanged = status.LastChecked
+	}
+	fr.Status = status
+
+	err := s.repo.Update(ctx, fr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while updating status of FetchRequest with ID %s", fr.ID)
+	}
+
+	return data, nil
+}
+
+// FetchSpec downloads the specification pointed by the Fetch Request and returns it together with the resulting status.
+// Neither the status nor the specification is persisted.
+func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest) (*string, *model.FetchRequestStatus) {
+	var spec []byte
+	var err error
+	switch fr.Mode {
+	case model.FetchModeSingle:
+		spec, err = s.fetchSingle(ctx, fr)
+	case model.FetchModePackage:
+		spec, err = s.fetchPackage(ctx, fr)
+	case model.FetchModeIndex:
+		spec, err = s.fetchIndex(ctx, fr)
+	default:
+		err = fmt.Errorf("unsupported fetch mode: %s", fr.Mode)
+	}
+	if err != nil {
+		log.Errorf("while fetching Spec for FetchRequest with ID %s: %s", fr.ID, err)
+		return nil, s.failedStatus(err.Error())
+	}
+
+	data := string(spec)
+	return &data, s.status(model.FetchRequestStatusConditionSucceeded, nil)
+}
+
+func (s *service) fetchSingle(ctx context.Context, fr *model.FetchRequest) ([]byte, error) {
+	if fr.Filter != nil {
+		return nil, errors.New("filter for Single fetch mode is not supported")
+	}
+
+	return s.download(ctx, fr.URL, fr.Auth)
+}
+
+func (s *service) fetchPackage(ctx context.Context, fr *model.FetchRequest) ([]byte, error) {
+	archive, err := s.download(ctx, fr.URL, fr.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractFile(archive, fr.Filter, s.cfg.MaxSpecSize)
+}
+
+func (s *service) fetchIndex(ctx context.Context, fr *model.FetchRequest) ([]byte, error) {
+	body, err := s.download(ctx, fr.URL, fr.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := parseIndex(fr.URL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	entryURL, err := selectSingle(entries, fr.Filter, "index entry")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkOrigin(fr.URL, entryURL); err != nil {
+		return nil, err
+	}
+
+	return s.download(ctx, entryURL, fr.Auth)
+}
+
+func (s *service) download(ctx context.Context, url string, auth *model.Auth) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating request")
+	}
+
+	resp, err := s.client.Do(req.WithContext(ctx), auth)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Spec")
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("while fetching Spec: unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := readLimited(resp.Body, s.cfg.MaxSpecSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading Spec")
+	}
+
+	return body, nil
+}
+
+func (s *service) failedStatus(message string) *model.FetchRequestStatus {
+	return s.status(model.FetchRequestStatusConditionFailed, &message)
+}
+
+func (s *service) status(condition model.FetchRequestStatusCondition, message *string) *model.FetchRequestStatus {
+	timestamp := s.timestampGen()
+	return &model.FetchRequestStatus{
+		Condition:   condition,
+		Message:     message,
+		Timestamp:   timestamp,
+		LastChecked: &timestamp,
+	}
+}
+
+func closeBody(body io.ReadCloser) {
+	if err := body.Close(); err != nil {
+		log.Error(err)
+	}
+}
@@ -0,0 +1,512 @@
+package fetchrequest_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/httpclient"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_HandleSpec(t *testing.T) {
+	timestamp := time.Now()
+	spec := "spec"
+	cfg := fetchrequest.Config{MaxSpecSize: 1024}
+	bigSpec := strings.Repeat("x", 2048)
+
+	files := map[string]string{
+		"specs/api.yaml":   spec,
+		"specs/other.json": "other",
+	}
+	responses := map[string][]byte{
+		"/spec":             []byte(spec),
+		"/specs/api.yaml":   []byte(spec),
+		"/specs/other.json": []byte("other"),
+		"/package.zip":      fixZip(t, files),
+		"/package.tar.gz":   fixTarGz(t, files),
+		"/index.json":       []byte(`["specs/api.yaml", "/specs/other.json"]`),
+		"/foreign.json":     []byte(`["http://example.com/specs/api.yaml"]`),
+		"/big.json":         []byte(bigSpec),
+		"/big.zip":          fixZip(t, map[string]string{"big.json": bigSpec}),
+		"/big.tar.gz":       fixTarGz(t, map[string]string{"big.json": bigSpec}),
+		"/invalid.json":     []byte(`{"not": "array"}`),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		switch {
+		case r.URL.Path == "/protected":
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "user" || password != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, err := w.Write([]byte(spec))
+			require.NoError(t, err)
+		case ok:
+			_, err := w.Write(body)
+			require.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	filter := "filter"
+	basicAuth := &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "user", Password: "pass"}}}
+	yamlFilter := "*.yaml"
+	allFilter := "specs/*"
+
+	testCases := []struct {
+		Name              string
+		InputFr           model.FetchRequest
+		RepositoryFn      func() *automock.FetchRequestRepository
+		ExpectedData      *string
+		ExpectedCondition model.FetchRequestStatusCondition
+		ExpectedMessage   *string
+		ExpectedErr       error
+	}{
+		{
+			Name:    "Success",
+			InputFr: fixFetchRequest(server.URL+"/spec", model.FetchModeSingle, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedData:      &spec,
+			ExpectedCondition: model.FetchRequestStatusConditionSucceeded,
+		},
+		{
+			Name:    "Success - with auth",
+			InputFr: fixFetchRequestWithAuth(server.URL+"/protected", basicAuth),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedData:      &spec,
+			ExpectedCondition: model.FetchRequestStatusConditionSucceeded,
+		},
+		{
+			Name:    "Failed - unauthorized",
+			InputFr: fixFetchRequest(server.URL+"/protected", model.FetchModeSingle, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("while fetching Spec: unexpected status code 401"),
+		},
+		{
+			Name:    "Failed - status code",
+			InputFr: fixFetchRequest(server.URL+"/missing", model.FetchModeSingle, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("while fetching Spec: unexpected status code 404"),
+		},
+		{
+			Name:    "Failed - unsupported mode",
+			InputFr: fixFetchRequest(server.URL+"/spec", model.FetchMode("UNKNOWN"), nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("unsupported fetch mode: UNKNOWN"),
+		},
+		{
+			Name:    "Success - zip package",
+			InputFr: fixFetchRequest(server.URL+"/package.zip", model.FetchModePackage, &yamlFilter),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedData:      &spec,
+			ExpectedCondition: model.FetchRequestStatusConditionSucceeded,
+		},
+		{
+			Name:    "Success - tar.gz package",
+			InputFr: fixFetchRequest(server.URL+"/package.tar.gz", model.FetchModePackage, &yamlFilter),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedData:      &spec,
+			ExpectedCondition: model.FetchRequestStatusConditionSucceeded,
+		},
+		{
+			Name:    "Failed - package without filter",
+			InputFr: fixFetchRequest(server.URL+"/package.zip", model.FetchModePackage, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("filter is required to choose package file out of 2 candidates: [specs/api.yaml specs/other.json]"),
+		},
+		{
+			Name:    "Failed - package filter matches nothing",
+			InputFr: fixFetchRequest(server.URL+"/package.zip", model.FetchModePackage, &filter),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("no package file matches filter filter"),
+		},
+		{
+			Name:    "Failed - package filter matches many",
+			InputFr: fixFetchRequest(server.URL+"/package.tar.gz", model.FetchModePackage, &allFilter),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("filter specs/* matches 2 candidates for package file, expected exactly one: [specs/api.yaml specs/other.json]"),
+		},
+		{
+			Name:    "Failed - unsupported package format",
+			InputFr: fixFetchRequest(server.URL+"/spec", model.FetchModePackage, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("unsupported package format, expected zip or tar.gz archive"),
+		},
+		{
+			Name:    "Success - index",
+			InputFr: fixFetchRequest(server.URL+"/index.json", model.FetchModeIndex, &yamlFilter),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedData:      &spec,
+			ExpectedCondition: model.FetchRequestStatusConditionSucceeded,
+		},
+		{
+			Name:    "Failed - index is not JSON array",
+			InputFr: fixFetchRequest(server.URL+"/invalid.json", model.FetchModeIndex, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("while parsing index document, expected JSON array of URLs: json: cannot unmarshal object into Go value of type []string"),
+		},
+		{
+			Name:    "Failed - index entry not found",
+			InputFr: fixFetchRequest(server.URL+"/index.json", model.FetchModeIndex, &filter),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("no index entry matches filter filter"),
+		},
+		{
+			Name:    "Failed - index entry on other origin",
+			InputFr: fixFetchRequest(server.URL+"/foreign.json", model.FetchModeIndex, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("index entry http://example.com/specs/api.yaml must have the same origin as the index"),
+		},
+		{
+			Name:    "Failed - zip package file exceeds size limit",
+			InputFr: fixFetchRequest(server.URL+"/big.zip", model.FetchModePackage, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("while reading file big.json from zip package: size exceeds the limit of 1024 bytes"),
+		},
+		{
+			Name:    "Failed - spec exceeds size limit",
+			InputFr: fixFetchRequest(server.URL+"/big.json", model.FetchModeSingle, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("while reading Spec: size exceeds the limit of 1024 bytes"),
+		},
+		{
+			Name:    "Failed - tar.gz package file exceeds size limit",
+			InputFr: fixFetchRequest(server.URL+"/big.tar.gz", model.FetchModePackage, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("while reading file big.json from tar.gz package: size exceeds the limit of 1024 bytes"),
+		},
+		{
+			Name:    "Failed - filter for single mode",
+			InputFr: fixFetchRequest(server.URL+"/spec", model.FetchModeSingle, &filter),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionFailed,
+			ExpectedMessage:   str("filter for Single fetch mode is not supported"),
+		},
+		{
+			Name:    "Error - update status",
+			InputFr: fixFetchRequest(server.URL+"/spec", model.FetchModeSingle, nil),
+			RepositoryFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Update", context.TODO(), mock.Anything).Return(givenError()).Once()
+				return repo
+			},
+			ExpectedCondition: model.FetchRequestStatusConditionSucceeded,
+			ExpectedErr:       givenError(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := fetchrequest.NewService(repo, httpclient.NewClient(http.DefaultClient), cfg)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+			fr := testCase.InputFr
+
+			// WHEN
+			data, err := svc.HandleSpec(context.TODO(), &fr, nil)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedData, data)
+			require.NotNil(t, fr.Status)
+			assert.Equal(t, testCase.ExpectedCondition, fr.Status.Condition)
+			assert.Equal(t, testCase.ExpectedMessage, fr.Status.Message)
+			assert.Equal(t, timestamp, fr.Status.Timestamp)
+			assert.Equal(t, &timestamp, fr.Status.LastChecked)
+			if testCase.ExpectedCondition == model.FetchRequestStatusConditionSucceeded {
+				assert.Equal(t, &timestamp, fr.Status.LastChanged)
+			} else {
+				assert.Nil(t, fr.Status.LastChanged)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Success - spec rejected by validation is reported as failure", func(t *testing.T) {
+		repo := &automock.FetchRequestRepository{}
+		repo.On("Update", context.TODO(), mock.Anything).Return(nil).Once()
+		svc := fetchrequest.NewService(repo, httpclient.NewClient(http.DefaultClient), cfg)
+		svc.SetTimestampGen(func() time.Time { return timestamp })
+		fr := fixFetchRequest(server.URL+"/spec", model.FetchModeSingle, nil)
+		validate := func(data string) error {
+			assert.Equal(t, spec, data)
+			return errors.New("invalid spec")
+		}
+
+		// WHEN
+		data, err := svc.HandleSpec(context.TODO(), &fr, validate)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, data)
+		assert.Equal(t, model.FetchRequestStatusConditionFailed, fr.Status.Condition)
+		assert.Equal(t, str("invalid spec"), fr.Status.Message)
+		assert.Nil(t, fr.Status.LastChanged)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Error - nil Fetch Request", func(t *testing.T) {
+		svc := fetchrequest.NewService(nil, httpclient.NewClient(http.DefaultClient), cfg)
+
+		// WHEN
+		_, err := svc.HandleSpec(context.TODO(), nil, nil)
+
+		// THEN
+		require.EqualError(t, err, "fetch request cannot be nil")
+	})
+}
+
+func TestService_FetchSpec(t *testing.T) {
+	// GIVEN
+	timestamp := time.Now()
+	spec := "spec"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(spec))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	repo := &automock.FetchRequestRepository{}
+	defer repo.AssertExpectations(t)
+	svc := fetchrequest.NewService(repo, httpclient.NewClient(http.DefaultClient), fetchrequest.Config{MaxSpecSize: 1024})
+	svc.SetTimestampGen(func() time.Time { return timestamp })
+	fr := fixFetchRequest(server.URL, model.FetchModeSingle, nil)
+
+	// WHEN
+	data, status := svc.FetchSpec(context.TODO(), &fr)
+
+	// THEN
+	assert.Equal(t, &spec, data)
+	require.NotNil(t, status)
+	assert.Equal(t, model.FetchRequestStatusConditionSucceeded, status.Condition)
+	assert.Equal(t, &timestamp, status.LastChecked)
+	assert.Nil(t, status.LastChanged)
+	assert.Equal(t, model.FetchRequestStatusConditionInitial, fr.Status.Condition)
+}
+
+func TestService_Prefetch(t *testing.T) {
+	// GIVEN
+	timestamp := time.Now()
+	spec := "spec"
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if r.URL.Path != "/spec" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(spec))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	svc := fetchrequest.NewService(nil, httpclient.NewClient(http.DefaultClient), fetchrequest.Config{MaxSpecSize: 1024})
+	svc.SetTimestampGen(func() time.Time { return timestamp })
+
+	prefetchedFr := fixFetchRequest(server.URL+"/spec", model.FetchModeSingle, nil)
+	failedFr := fixFetchRequest(server.URL+"/missing", model.FetchModeSingle, nil)
+	duplicatedFr := fixFetchRequest(server.URL+"/spec", model.FetchModeSingle, nil)
+
+	// WHEN
+	ctx := svc.Prefetch(context.TODO(), []*model.FetchRequest{&prefetchedFr, &failedFr, &duplicatedFr, nil})
+
+	// THEN
+	assert.Equal(t, 2, requestCount)
+
+	t.Run("Success - prefetched spec is used", func(t *testing.T) {
+		repo := &automock.FetchRequestRepository{}
+		repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+		svc := fetchrequest.NewService(repo, httpclient.NewClient(http.DefaultClient), fetchrequest.Config{MaxSpecSize: 1024})
+		fr := fixFetchRequest(server.URL+"/spec", model.FetchModeSingle, nil)
+
+		// WHEN
+		data, err := svc.HandleSpec(ctx, &fr, nil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &spec, data)
+		assert.Equal(t, model.FetchRequestStatusConditionSucceeded, fr.Status.Condition)
+		assert.Equal(t, &timestamp, fr.Status.LastChanged)
+		assert.Equal(t, 2, requestCount)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success - prefetched failure is used", func(t *testing.T) {
+		repo := &automock.FetchRequestRepository{}
+		repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+		svc := fetchrequest.NewService(repo, httpclient.NewClient(http.DefaultClient), fetchrequest.Config{MaxSpecSize: 1024})
+		fr := fixFetchRequest(server.URL+"/missing", model.FetchModeSingle, nil)
+
+		// WHEN
+		data, err := svc.HandleSpec(ctx, &fr, nil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, data)
+		assert.Equal(t, model.FetchRequestStatusConditionFailed, fr.Status.Condition)
+		assert.Equal(t, str("while fetching Spec: unexpected status code 404"), fr.Status.Message)
+		assert.Equal(t, 2, requestCount)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success - spec which was not prefetched is downloaded", func(t *testing.T) {
+		repo := &automock.FetchRequestRepository{}
+		repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+		svc := fetchrequest.NewService(repo, httpclient.NewClient(http.DefaultClient), fetchrequest.Config{MaxSpecSize: 1024})
+		filter := "*.yaml"
+		fr := fixFetchRequest(server.URL+"/spec", model.FetchModePackage, &filter)
+
+		// WHEN
+		_, err := svc.HandleSpec(ctx, &fr, nil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, 3, requestCount)
+		repo.AssertExpectations(t)
+	})
+}
+
+func fixZip(t *testing.T, files map[string]string) []byte {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func fixTarGz(t *testing.T, files map[string]string) []byte {
+	buf := new(bytes.Buffer)
+	gz := gzip.NewWriter(buf)
+	w := tar.NewWriter(gz)
+	for name, content := range files {
+		err := w.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func str(s string) *string {
+	return &s
+}
@@ -0,0 +1,54 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	time "time"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationRepository is an autogenerated mock type for the ApplicationRepository type
+type ApplicationRepository struct {
+	mock.Mock
+}
+
+// GetNextForHealthCheck provides a mock function with given fields: ctx, checkedBefore
+func (_m *ApplicationRepository) GetNextForHealthCheck(ctx context.Context, checkedBefore time.Time) (*model.Application, error) {
+	ret := _m.Called(ctx, checkedBefore)
+
+	var r0 *model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *model.Application); ok {
+		r0 = rf(ctx, checkedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, checkedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStatusIfCondition provides a mock function with given fields: ctx, tenant, id, status, currentConditions
+func (_m *ApplicationRepository) UpdateStatusIfCondition(ctx context.Context, tenant string, id string, status model.ApplicationStatus, currentConditions []model.ApplicationStatusCondition) error {
+	ret := _m.Called(ctx, tenant, id, status, currentConditions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ApplicationStatus, []model.ApplicationStatusCondition) error); ok {
+		r0 = rf(ctx, tenant, id, status, currentConditions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,42 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	healthcheck "github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) FromEntity(in healthcheck.Entity) model.HealthCheck {
+	ret := _m.Called(in)
+
+	var r0 model.HealthCheck
+	if rf, ok := ret.Get(0).(func(healthcheck.Entity) model.HealthCheck); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.HealthCheck)
+	}
+
+	return r0
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in model.HealthCheck) healthcheck.Entity {
+	ret := _m.Called(in)
+
+	var r0 healthcheck.Entity
+	if rf, ok := ret.Get(0).(func(model.HealthCheck) healthcheck.Entity); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(healthcheck.Entity)
+	}
+
+	return r0
+}
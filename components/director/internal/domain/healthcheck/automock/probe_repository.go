@@ -0,0 +1,45 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	time "time"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProbeRepository is an autogenerated mock type for the ProbeRepository type
+type ProbeRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, item
+func (_m *ProbeRepository) Create(ctx context.Context, item *model.HealthCheck) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.HealthCheck) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOlderThan provides a mock function with given fields: ctx, timestamp
+func (_m *ProbeRepository) DeleteOlderThan(ctx context.Context, timestamp time.Time) error {
+	ret := _m.Called(ctx, timestamp)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, timestamp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,10 @@
+package healthcheck
+
+import "time"
+
+type Config struct {
+	Period        time.Duration `envconfig:"default=1m"`
+	Timeout       time.Duration `envconfig:"default=10s"`
+	Retention     time.Duration `envconfig:"default=24h"`
+	CleanupPeriod time.Duration `envconfig:"default=1h"`
+}
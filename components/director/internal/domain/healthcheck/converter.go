@@ -1,4 +1,72 @@
 package healthcheck
 
-type converter struct {
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.HealthCheck) *graphql.HealthCheck {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.HealthCheck{
+		Type:      graphql.HealthCheckType(in.Type),
+		Condition: graphql.HealthCheckStatusCondition(in.Condition),
+		Origin:    in.Origin,
+		Message:   in.Message,
+		Timestamp: graphql.Timestamp(in.Timestamp),
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.HealthCheck) []*graphql.HealthCheck {
+	healthChecks := []*graphql.HealthCheck{}
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+		healthChecks = append(healthChecks, c.ToGraphQL(r))
+	}
+
+	return healthChecks
+}
+
+func (c *converter) TypesFromGraphQL(in []graphql.HealthCheckType) []model.HealthCheckType {
+	var types []model.HealthCheckType
+	for _, t := range in {
+		types = append(types, model.HealthCheckType(t))
+	}
+
+	return types
+}
+
+func (c *converter) ToEntity(in model.HealthCheck) Entity {
+	return Entity{
+		ID:        in.ID,
+		TenantID:  in.Tenant,
+		Type:      string(in.Type),
+		Condition: string(in.Condition),
+		Origin:    repo.NewNullableString(in.Origin),
+		Message:   repo.NewNullableString(in.Message),
+		Timestamp: in.Timestamp,
+	}
+}
+
+func (c *converter) FromEntity(in Entity) model.HealthCheck {
+	return model.HealthCheck{
+		ID:        in.ID,
+		Tenant:    in.TenantID,
+		Type:      model.HealthCheckType(in.Type),
+		Condition: model.HealthCheckStatusCondition(in.Condition),
+		Origin:    repo.StringPtrFromNullableString(in.Origin),
+		Message:   repo.StringPtrFromNullableString(in.Message),
+		Timestamp: in.Timestamp,
+	}
 }
@@ -0,0 +1,93 @@
+package healthcheck_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    *model.HealthCheck
+		Expected *graphql.HealthCheck
+	}{
+		{
+			Name:     "Succeeded",
+			Input:    fixModelHealthCheck(testID, model.HealthCheckStatusConditionSucceeded, nil),
+			Expected: fixGQLHealthCheck(graphql.HealthCheckStatusConditionSucceeded, nil),
+		},
+		{
+			Name:     "Failed with message",
+			Input:    fixModelHealthCheck(testID, model.HealthCheckStatusConditionFailed, strPtr("unexpected response status: Not Found")),
+			Expected: fixGQLHealthCheck(graphql.HealthCheckStatusConditionFailed, strPtr("unexpected response status: Not Found")),
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			converter := healthcheck.NewConverter()
+			res := converter.ToGraphQL(testCase.Input)
+
+			// then
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// given
+	input := []*model.HealthCheck{
+		fixModelHealthCheck("foo", model.HealthCheckStatusConditionSucceeded, nil),
+		nil,
+		fixModelHealthCheck("bar", model.HealthCheckStatusConditionSucceeded, nil),
+	}
+	expected := []*graphql.HealthCheck{
+		fixGQLHealthCheck(graphql.HealthCheckStatusConditionSucceeded, nil),
+		fixGQLHealthCheck(graphql.HealthCheckStatusConditionSucceeded, nil),
+	}
+
+	// when
+	converter := healthcheck.NewConverter()
+	res := converter.MultipleToGraphQL(input)
+
+	// then
+	assert.Equal(t, expected, res)
+}
+
+func TestConverter_TypesFromGraphQL(t *testing.T) {
+	// given
+	input := []graphql.HealthCheckType{graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck}
+
+	// when
+	converter := healthcheck.NewConverter()
+	res := converter.TypesFromGraphQL(input)
+
+	// then
+	assert.Equal(t, []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplicationHealthcheck}, res)
+}
+
+func TestConverter_EntityConversion(t *testing.T) {
+	// given
+	healthCheck := fixModelHealthCheck(testID, model.HealthCheckStatusConditionFailed, strPtr("foo"))
+	entity := fixEntityHealthCheck(testID, model.HealthCheckStatusConditionFailed, strPtr("foo"))
+	converter := healthcheck.NewConverter()
+
+	// when
+	resEntity := converter.ToEntity(*healthCheck)
+	resModel := converter.FromEntity(entity)
+
+	// then
+	assert.Equal(t, entity, resEntity)
+	assert.Equal(t, *healthCheck, resModel)
+}
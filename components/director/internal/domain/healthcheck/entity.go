@@ -0,0 +1,22 @@
+package healthcheck
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID        string         `db:"id"`
+	TenantID  string         `db:"tenant_id"`
+	Type      string         `db:"type"`
+	Condition string         `db:"condition"`
+	Origin    sql.NullString `db:"origin"`
+	Message   sql.NullString `db:"message"`
+	Timestamp time.Time      `db:"timestamp"`
+}
+
+type Collection []Entity
+
+func (c Collection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,7 @@
+package healthcheck
+
+import "time"
+
+func (p *prober) SetTimestampGen(timestampGen func() time.Time) {
+	p.timestampGen = timestampGen
+}
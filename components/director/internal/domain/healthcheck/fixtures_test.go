@@ -0,0 +1,93 @@
+package healthcheck_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	testTenant = "tnt"
+	testID     = "hhhhhhhh-hhhh-hhhh-hhhh-hhhhhhhhhhhh"
+	testAppID  = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+	testURL    = "http://foo.bar/healthz"
+)
+
+var (
+	testErr       = errors.New("test error")
+	testTimestamp = time.Date(2019, time.November, 28, 12, 0, 0, 0, time.UTC)
+)
+
+func fixModelHealthCheck(id string, condition model.HealthCheckStatusCondition, message *string) *model.HealthCheck {
+	origin := testAppID
+	return &model.HealthCheck{
+		ID:        id,
+		Tenant:    testTenant,
+		Type:      model.HealthCheckTypeManagementPlaneApplicationHealthcheck,
+		Condition: condition,
+		Origin:    &origin,
+		Message:   message,
+		Timestamp: testTimestamp,
+	}
+}
+
+func fixGQLHealthCheck(condition graphql.HealthCheckStatusCondition, message *string) *graphql.HealthCheck {
+	origin := testAppID
+	return &graphql.HealthCheck{
+		Type:      graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck,
+		Condition: condition,
+		Origin:    &origin,
+		Message:   message,
+		Timestamp: graphql.Timestamp(testTimestamp),
+	}
+}
+
+func fixEntityHealthCheck(id string, condition model.HealthCheckStatusCondition, message *string) healthcheck.Entity {
+	origin := testAppID
+	return healthcheck.Entity{
+		ID:        id,
+		TenantID:  testTenant,
+		Type:      string(model.HealthCheckTypeManagementPlaneApplicationHealthcheck),
+		Condition: string(condition),
+		Origin:    repo.NewNullableString(&origin),
+		Message:   repo.NewNullableString(message),
+		Timestamp: testTimestamp,
+	}
+}
+
+func fixModelApplication(condition model.ApplicationStatusCondition) *model.Application {
+	url := testURL
+	return &model.Application{
+		ID:     testAppID,
+		Tenant: testTenant,
+		Name:   "foo",
+		Status: &model.ApplicationStatus{
+			Condition: condition,
+			Timestamp: testTimestamp,
+		},
+		HealthCheckURL:  &url,
+		HealthCheckAuth: &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "user", Password: "pass"}}},
+	}
+}
+
+func fixHealthCheckRows(entities ...healthcheck.Entity) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "type", "condition", "origin", "message", "timestamp"})
+	for _, e := range entities {
+		rows.AddRow(e.ID, e.TenantID, e.Type, e.Condition, e.Origin, e.Message, e.Timestamp)
+	}
+	return rows
+}
+
+func fixHealthCheckCreateArgs(e healthcheck.Entity) []driver.Value {
+	return []driver.Value{e.ID, e.TenantID, e.Type, e.Condition, e.Origin, e.Message, e.Timestamp}
+}
+
+func strPtr(in string) *string {
+	return &in
+}
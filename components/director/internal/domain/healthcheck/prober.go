@@ -0,0 +1,227 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/httpclient"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=ProbeRepository -output=automock -outpkg=automock -case=underscore
+type ProbeRepository interface {
+	Create(ctx context.Context, item *model.HealthCheck) error
+	DeleteOlderThan(ctx context.Context, timestamp time.Time) error
+}
+
+//go:generate mockery -name=ApplicationRepository -output=automock -outpkg=automock -case=underscore
+type ApplicationRepository interface {
+	GetNextForHealthCheck(ctx context.Context, checkedBefore time.Time) (*model.Application, error)
+	UpdateStatusIfCondition(ctx context.Context, tenant, id string, status model.ApplicationStatus, currentConditions []model.ApplicationStatusCondition) error
+}
+
+//go:generate mockery -name=HTTPClient -output=automock -outpkg=automock -case=underscore
+type HTTPClient interface {
+	Do(req *http.Request, auth *model.Auth) (*http.Response, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type prober struct {
+	transact     persistence.Transactioner
+	repo         ProbeRepository
+	appRepo      ApplicationRepository
+	httpClient   HTTPClient
+	uidService   UIDService
+	cfg          Config
+	timestampGen timestamp.Generator
+}
+
+func NewProber(transact persistence.Transactioner, repo ProbeRepository, appRepo ApplicationRepository, httpClient HTTPClient, uidService UIDService, cfg Config) *prober {
+	return &prober{
+		transact:     transact,
+		repo:         repo,
+		appRepo:      appRepo,
+		httpClient:   httpClient,
+		uidService:   uidService,
+		cfg:          cfg,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// ProbeAll calls the health check URL of every Application not checked within the last half of the period. No transaction is open during the call.
+// A failed check makes a READY Application UNKNOWN, and a successful one makes an UNKNOWN Application READY again.
+func (p *prober) ProbeAll(ctx context.Context) error {
+	checkedBefore := p.timestampGen().Add(-p.cfg.Period / 2)
+
+	for {
+		processed, err := p.probeNext(ctx, checkedBefore)
+		if err != nil {
+			return err
+		}
+		if !processed {
+			return nil
+		}
+	}
+}
+
+func (p *prober) DeleteExpired(ctx context.Context) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer p.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = p.repo.DeleteOlderThan(ctx, p.timestampGen().Add(-p.cfg.Retention))
+	if err != nil {
+		return errors.Wrap(err, "while deleting expired HealthChecks")
+	}
+
+	return tx.Commit()
+}
+
+func (p *prober) probeNext(ctx context.Context, checkedBefore time.Time) (bool, error) {
+	app, err := p.getNext(ctx, checkedBefore)
+	if err != nil {
+		return false, err
+	}
+	if app == nil {
+		return false, nil
+	}
+
+	healthCheck := p.probe(ctx, app)
+
+	err = p.saveResult(ctx, app, healthCheck)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (p *prober) getNext(ctx context.Context, checkedBefore time.Time) (*model.Application, error) {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening transaction")
+	}
+	defer p.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	app, err := p.appRepo.GetNextForHealthCheck(ctx, checkedBefore)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "while getting Application to check")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return app, nil
+}
+
+func (p *prober) saveResult(ctx context.Context, app *model.Application, healthCheck *model.HealthCheck) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer p.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = p.repo.Create(ctx, healthCheck)
+	if err != nil {
+		return errors.Wrapf(err, "while creating HealthCheck of Application with ID %s", app.ID)
+	}
+
+	err = p.updateStatus(ctx, app, healthCheck)
+	if err != nil {
+		return errors.Wrapf(err, "while updating status of Application with ID %s", app.ID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "while committing transaction")
+	}
+
+	return nil
+}
+
+func (p *prober) probe(ctx context.Context, app *model.Application) *model.HealthCheck {
+	healthCheck := &model.HealthCheck{
+		ID:        p.uidService.Generate(),
+		Tenant:    app.Tenant,
+		Type:      model.HealthCheckTypeManagementPlaneApplicationHealthcheck,
+		Condition: model.HealthCheckStatusConditionFailed,
+		Origin:    &app.ID,
+	}
+
+	err := p.call(ctx, *app.HealthCheckURL, app.HealthCheckAuth)
+	healthCheck.Timestamp = p.timestampGen()
+	if err != nil {
+		message := err.Error()
+		healthCheck.Message = &message
+		return healthCheck
+	}
+
+	healthCheck.Condition = model.HealthCheckStatusConditionSucceeded
+	return healthCheck
+}
+
+func (p *prober) call(ctx context.Context, url string, auth *model.Auth) error {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "while creating request")
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := p.httpClient.Do(req, auth)
+	if err != nil {
+		return errors.Wrap(err, "while calling health check URL")
+	}
+	defer func() {
+		if err := httpclient.CloseBody(resp.Body); err != nil {
+			log.Warnf("Failed to close response body of health check: %s", err)
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (p *prober) updateStatus(ctx context.Context, app *model.Application, healthCheck *model.HealthCheck) error {
+	status := model.ApplicationStatus{
+		Condition: model.ApplicationStatusConditionUnknown,
+		Timestamp: healthCheck.Timestamp,
+	}
+	currentConditions := []model.ApplicationStatusCondition{model.ApplicationStatusConditionReady}
+
+	if healthCheck.Condition == model.HealthCheckStatusConditionSucceeded {
+		status.Condition = model.ApplicationStatusConditionReady
+		currentConditions = []model.ApplicationStatusCondition{model.ApplicationStatusConditionUnknown}
+	}
+
+	return p.appRepo.UpdateStatusIfCondition(ctx, app.Tenant, app.ID, status, currentConditions)
+}
@@ -0,0 +1,235 @@
+package healthcheck_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProber_ProbeAll(t *testing.T) {
+	// given
+	cfg := healthcheck.Config{
+		Period:  time.Minute,
+		Timeout: time.Second,
+	}
+	checkedBefore := testTimestamp.Add(-30 * time.Second)
+
+	getRequest := mock.MatchedBy(func(req *http.Request) bool {
+		_, hasDeadline := req.Context().Deadline()
+		return req.Method == http.MethodGet && req.URL.String() == testURL && hasDeadline
+	})
+	response := func(statusCode int) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Status:     http.StatusText(statusCode),
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}
+	}
+
+	testCases := []struct {
+		Name                  string
+		AppCondition          model.ApplicationStatusCondition
+		HTTPClientFn          func(auth *model.Auth) *automock.HTTPClient
+		ExpectedHealthCheck   *model.HealthCheck
+		ExpectedStatus        model.ApplicationStatus
+		ExpectedCurrentStatus []model.ApplicationStatusCondition
+	}{
+		{
+			Name:         "Success makes UNKNOWN Application READY",
+			AppCondition: model.ApplicationStatusConditionUnknown,
+			HTTPClientFn: func(auth *model.Auth) *automock.HTTPClient {
+				client := &automock.HTTPClient{}
+				client.On("Do", getRequest, auth).Return(response(http.StatusOK), nil).Once()
+				return client
+			},
+			ExpectedHealthCheck:   fixModelHealthCheck(testID, model.HealthCheckStatusConditionSucceeded, nil),
+			ExpectedStatus:        model.ApplicationStatus{Condition: model.ApplicationStatusConditionReady, Timestamp: testTimestamp},
+			ExpectedCurrentStatus: []model.ApplicationStatusCondition{model.ApplicationStatusConditionUnknown},
+		},
+		{
+			Name:         "Error response makes READY Application UNKNOWN",
+			AppCondition: model.ApplicationStatusConditionReady,
+			HTTPClientFn: func(auth *model.Auth) *automock.HTTPClient {
+				client := &automock.HTTPClient{}
+				client.On("Do", getRequest, auth).Return(response(http.StatusServiceUnavailable), nil).Once()
+				return client
+			},
+			ExpectedHealthCheck:   fixModelHealthCheck(testID, model.HealthCheckStatusConditionFailed, strPtr("unexpected response status: Service Unavailable")),
+			ExpectedStatus:        model.ApplicationStatus{Condition: model.ApplicationStatusConditionUnknown, Timestamp: testTimestamp},
+			ExpectedCurrentStatus: []model.ApplicationStatusCondition{model.ApplicationStatusConditionReady},
+		},
+		{
+			Name:         "Call error makes READY Application UNKNOWN",
+			AppCondition: model.ApplicationStatusConditionReady,
+			HTTPClientFn: func(auth *model.Auth) *automock.HTTPClient {
+				client := &automock.HTTPClient{}
+				client.On("Do", getRequest, auth).Return(nil, testErr).Once()
+				return client
+			},
+			ExpectedHealthCheck:   fixModelHealthCheck(testID, model.HealthCheckStatusConditionFailed, strPtr("while calling health check URL: test error")),
+			ExpectedStatus:        model.ApplicationStatus{Condition: model.ApplicationStatusConditionUnknown, Timestamp: testTimestamp},
+			ExpectedCurrentStatus: []model.ApplicationStatusCondition{model.ApplicationStatusConditionReady},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			app := fixModelApplication(testCase.AppCondition)
+
+			getTx := txtest.PersistenceContextThatExpectsCommit()
+			saveTx := txtest.PersistenceContextThatExpectsCommit()
+			emptyTx := txtest.PersistenceContextThatDoesntExpectCommit()
+			transact := &persistenceautomock.Transactioner{}
+			transact.On("Begin").Return(getTx, nil).Once()
+			transact.On("Begin").Return(saveTx, nil).Once()
+			transact.On("Begin").Return(emptyTx, nil).Once()
+			transact.On("RollbackUnlessCommited", getTx).Return().Once()
+			transact.On("RollbackUnlessCommited", saveTx).Return().Once()
+			transact.On("RollbackUnlessCommited", emptyTx).Return().Once()
+
+			appRepo := &automock.ApplicationRepository{}
+			appRepo.On("GetNextForHealthCheck", txtest.CtxWithDBMatcher(), checkedBefore).Return(app, nil).Once()
+			appRepo.On("UpdateStatusIfCondition", txtest.CtxWithDBMatcher(), testTenant, testAppID, testCase.ExpectedStatus, testCase.ExpectedCurrentStatus).Return(nil).Once()
+			appRepo.On("GetNextForHealthCheck", txtest.CtxWithDBMatcher(), checkedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+
+			repo := &automock.ProbeRepository{}
+			repo.On("Create", txtest.CtxWithDBMatcher(), testCase.ExpectedHealthCheck).Return(nil).Once()
+
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(testID).Once()
+
+			httpClient := testCase.HTTPClientFn(app.HealthCheckAuth)
+
+			prober := healthcheck.NewProber(transact, repo, appRepo, httpClient, uidSvc, cfg)
+			prober.SetTimestampGen(func() time.Time { return testTimestamp })
+
+			// when
+			err := prober.ProbeAll(context.TODO())
+
+			// then
+			require.NoError(t, err)
+
+			getTx.AssertExpectations(t)
+			saveTx.AssertExpectations(t)
+			emptyTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			appRepo.AssertExpectations(t)
+			repo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+			httpClient.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when getting Application failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetNextForHealthCheck", txtest.CtxWithDBMatcher(), checkedBefore).Return(nil, testErr).Once()
+
+		prober := healthcheck.NewProber(transact, nil, appRepo, nil, nil, cfg)
+		prober.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		// when
+		err := prober.ProbeAll(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		appRepo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when creating HealthCheck failed", func(t *testing.T) {
+		app := fixModelApplication(model.ApplicationStatusConditionReady)
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommitAfterSucceeding(1)
+
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetNextForHealthCheck", txtest.CtxWithDBMatcher(), checkedBefore).Return(app, nil).Once()
+
+		repo := &automock.ProbeRepository{}
+		repo.On("Create", txtest.CtxWithDBMatcher(), fixModelHealthCheck(testID, model.HealthCheckStatusConditionSucceeded, nil)).Return(testErr).Once()
+
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(testID).Once()
+
+		httpClient := &automock.HTTPClient{}
+		httpClient.On("Do", getRequest, app.HealthCheckAuth).Return(response(http.StatusOK), nil).Once()
+
+		prober := healthcheck.NewProber(transact, repo, appRepo, httpClient, uidSvc, cfg)
+		prober.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		// when
+		err := prober.ProbeAll(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while creating HealthCheck of Application with ID cccccccc-cccc-cccc-cccc-cccccccccccc")
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		appRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+		httpClient.AssertExpectations(t)
+	})
+}
+
+func TestProber_DeleteExpired(t *testing.T) {
+	// given
+	cfg := healthcheck.Config{Retention: 24 * time.Hour}
+	deletedBefore := testTimestamp.Add(-24 * time.Hour)
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+
+		repo := &automock.ProbeRepository{}
+		repo.On("DeleteOlderThan", txtest.CtxWithDBMatcher(), deletedBefore).Return(nil).Once()
+
+		prober := healthcheck.NewProber(transact, repo, nil, nil, nil, cfg)
+		prober.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		// when
+		err := prober.DeleteExpired(context.TODO())
+
+		// then
+		require.NoError(t, err)
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when deleting failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+
+		repo := &automock.ProbeRepository{}
+		repo.On("DeleteOlderThan", txtest.CtxWithDBMatcher(), deletedBefore).Return(testErr).Once()
+
+		prober := healthcheck.NewProber(transact, repo, nil, nil, nil, cfg)
+		prober.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		// when
+		err := prober.DeleteExpired(context.TODO())
+
+		// then
+		require.EqualError(t, err, "while deleting expired HealthChecks: test error")
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+}
@@ -1,13 +1,97 @@
 package healthcheck
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	healthCheckTable string = `public.health_checks`
+	tenantColumn     string = `tenant_id`
 )
 
-type inMemoryRepository struct {
-	store map[string]*model.Runtime
+var healthCheckColumns = []string{"id", "tenant_id", "type", "condition", "origin", "message", "timestamp"}
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in model.HealthCheck) Entity
+	FromEntity(in Entity) model.HealthCheck
+}
+
+type repository struct {
+	creator         repo.Creator
+	pageableQuerier repo.PageableQuerier
+	conv            EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		creator:         repo.NewCreator(healthCheckTable, healthCheckColumns),
+		pageableQuerier: repo.NewPageableQuerier(healthCheckTable, tenantColumn, healthCheckColumns),
+		conv:            conv,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, item *model.HealthCheck) error {
+	if item == nil {
+		return errors.New("item cannot be nil")
+	}
+
+	return r.creator.Create(ctx, r.conv.ToEntity(*item))
 }
 
-func NewRepository() *inMemoryRepository {
-	return &inMemoryRepository{store: make(map[string]*model.Runtime)}
+// List returns the Health Checks of the given types and origin, starting from the latest one.
+// Empty types and nil origin match all Health Checks.
+func (r *repository) List(ctx context.Context, tenant string, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	var conditions []string
+	if len(types) > 0 {
+		var quotedTypes []string
+		for _, t := range types {
+			quotedTypes = append(quotedTypes, pq.QuoteLiteral(string(t)))
+		}
+		conditions = append(conditions, fmt.Sprintf("type IN (%s)", strings.Join(quotedTypes, ", ")))
+	}
+	if origin != nil {
+		conditions = append(conditions, fmt.Sprintf("origin = %s", pq.QuoteLiteral(*origin)))
+	}
+
+	var entities Collection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "timestamp DESC", &entities, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*model.HealthCheck
+	for _, entity := range entities {
+		healthCheck := r.conv.FromEntity(entity)
+		items = append(items, &healthCheck)
+	}
+
+	return &model.HealthCheckPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
+func (r *repository) DeleteOlderThan(ctx context.Context, timestamp time.Time) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = persist.Exec(fmt.Sprintf(`DELETE FROM %s WHERE timestamp < $1`, healthCheckTable), timestamp)
+	if err != nil {
+		return errors.Wrap(err, "while deleting HealthChecks from DB")
+	}
+
+	return nil
 }
@@ -0,0 +1,170 @@
+package healthcheck_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// given
+		healthCheck := fixModelHealthCheck(testID, model.HealthCheckStatusConditionSucceeded, nil)
+		entity := fixEntityHealthCheck(testID, model.HealthCheckStatusConditionSucceeded, nil)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", *healthCheck).Return(entity).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.health_checks ( id, tenant_id, type, condition, origin, message, timestamp ) VALUES ( ?, ?, ?, ?, ?, ?, ? )`)).
+			WithArgs(fixHealthCheckCreateArgs(entity)...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(mockConverter)
+
+		// when
+		err := repo.Create(ctx, healthCheck)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when item is nil", func(t *testing.T) {
+		// given
+		repo := healthcheck.NewRepository(nil)
+
+		// when
+		err := repo.Create(context.TODO(), nil)
+
+		// then
+		require.EqualError(t, err, "item cannot be nil")
+	})
+}
+
+func TestRepository_List(t *testing.T) {
+	t.Run("Success with types and origin", func(t *testing.T) {
+		// given
+		firstEntity := fixEntityHealthCheck("foo", model.HealthCheckStatusConditionSucceeded, nil)
+		secondEntity := fixEntityHealthCheck("bar", model.HealthCheckStatusConditionFailed, strPtr("baz"))
+		firstHealthCheck := fixModelHealthCheck("foo", model.HealthCheckStatusConditionSucceeded, nil)
+		secondHealthCheck := fixModelHealthCheck("bar", model.HealthCheckStatusConditionFailed, strPtr("baz"))
+		origin := testAppID
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", firstEntity).Return(*firstHealthCheck).Once()
+		mockConverter.On("FromEntity", secondEntity).Return(*secondHealthCheck).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, tenant_id, type, condition, origin, message, timestamp FROM public.health_checks WHERE tenant_id=$1 AND type IN ('MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK') AND origin = 'cccccccc-cccc-cccc-cccc-cccccccccccc' ORDER BY timestamp DESC LIMIT 2 OFFSET 0`)).
+			WithArgs(testTenant).
+			WillReturnRows(fixHealthCheckRows(firstEntity, secondEntity))
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.health_checks WHERE tenant_id=$1 AND type IN ('MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK') AND origin = 'cccccccc-cccc-cccc-cccc-cccccccccccc'`)).
+			WithArgs(testTenant).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(mockConverter)
+
+		// when
+		page, err := repo.List(ctx, testTenant, []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplicationHealthcheck}, &origin, 2, "")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.HealthCheck{firstHealthCheck, secondHealthCheck}, page.Data)
+		assert.Equal(t, 3, page.TotalCount)
+		assert.True(t, page.PageInfo.HasNextPage)
+	})
+
+	t.Run("Success without filters", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, tenant_id, type, condition, origin, message, timestamp FROM public.health_checks WHERE tenant_id=$1 ORDER BY timestamp DESC LIMIT 2 OFFSET 0`)).
+			WithArgs(testTenant).
+			WillReturnRows(fixHealthCheckRows())
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.health_checks WHERE tenant_id=$1`)).
+			WithArgs(testTenant).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// when
+		page, err := repo.List(ctx, testTenant, nil, nil, 2, "")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, page.Data)
+		assert.Equal(t, 0, page.TotalCount)
+		assert.False(t, page.PageInfo.HasNextPage)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery("SELECT .*").WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// when
+		_, err := repo.List(ctx, testTenant, nil, nil, 2, "")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestRepository_DeleteOlderThan(t *testing.T) {
+	stmt := regexp.QuoteMeta(`DELETE FROM public.health_checks WHERE timestamp < $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(stmt).
+			WithArgs(testTimestamp).
+			WillReturnResult(sqlmock.NewResult(-1, 5))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// when
+		err := repo.DeleteOlderThan(ctx, testTimestamp)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(stmt).
+			WithArgs(testTimestamp).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// when
+		err := repo.DeleteOlderThan(ctx, testTimestamp)
+
+		// then
+		require.EqualError(t, err, "while deleting HealthChecks from DB: test error")
+	})
+}
@@ -0,0 +1,147 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_HealthChecks(t *testing.T) {
+	// given
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	gqlTypes := []graphql.HealthCheckType{graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck}
+	modelTypes := []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplicationHealthcheck}
+	origin := testAppID
+	modelHealthChecks := []*model.HealthCheck{
+		fixModelHealthCheck("foo", model.HealthCheckStatusConditionSucceeded, nil),
+		fixModelHealthCheck("bar", model.HealthCheckStatusConditionFailed, strPtr("baz")),
+	}
+	gqlHealthChecks := []*graphql.HealthCheck{
+		fixGQLHealthCheck(graphql.HealthCheckStatusConditionSucceeded, nil),
+		fixGQLHealthCheck(graphql.HealthCheckStatusConditionFailed, strPtr("baz")),
+	}
+	modelPage := &model.HealthCheckPage{
+		Data:       modelHealthChecks,
+		TotalCount: 3,
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: true,
+		},
+	}
+	first := 2
+	after := "start"
+	gqlAfter := graphql.PageCursor(after)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.HealthCheckService
+		ConverterFn    func() *automock.HealthCheckConverter
+		ExpectedOutput *graphql.HealthCheckPage
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), modelTypes, &origin, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.HealthCheckConverter {
+				conv := &automock.HealthCheckConverter{}
+				conv.On("TypesFromGraphQL", gqlTypes).Return(modelTypes).Once()
+				conv.On("MultipleToGraphQL", modelHealthChecks).Return(gqlHealthChecks).Once()
+				return conv
+			},
+			ExpectedOutput: &graphql.HealthCheckPage{
+				Data:       gqlHealthChecks,
+				TotalCount: 3,
+				PageInfo: &graphql.PageInfo{
+					StartCursor: "start",
+					EndCursor:   "end",
+					HasNextPage: true,
+				},
+			},
+		},
+		{
+			Name: "Returns error when listing health checks failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), modelTypes, &origin, first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.HealthCheckConverter {
+				conv := &automock.HealthCheckConverter{}
+				conv.On("TypesFromGraphQL", gqlTypes).Return(modelTypes).Once()
+				return conv
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.HealthCheckService {
+				return &automock.HealthCheckService{}
+			},
+			ConverterFn: func() *automock.HealthCheckConverter {
+				return &automock.HealthCheckConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), modelTypes, &origin, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.HealthCheckConverter {
+				conv := &automock.HealthCheckConverter{}
+				conv.On("TypesFromGraphQL", gqlTypes).Return(modelTypes).Once()
+				return conv
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := healthcheck.NewResolver(transact, svc, converter)
+
+			// when
+			result, err := resolver.HealthChecks(context.TODO(), gqlTypes, &origin, &first, &gqlAfter)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+}
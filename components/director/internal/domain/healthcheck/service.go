@@ -1,7 +1,16 @@
 package healthcheck
 
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+)
+
 //go:generate mockery -name=HealthCheckRepository -output=automock -outpkg=automock -case=underscore
 type HealthCheckRepository interface {
+	List(ctx context.Context, tenant string, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error)
 }
 
 type service struct {
@@ -11,3 +20,16 @@ type service struct {
 func NewService(repo HealthCheckRepository) *service {
 	return &service{repo: repo}
 }
+
+func (s *service) List(ctx context.Context, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 100 {
+		return nil, errors.New("page size must be between 1 and 100")
+	}
+
+	return s.repo.List(ctx, tnt, types, origin, pageSize, cursor)
+}
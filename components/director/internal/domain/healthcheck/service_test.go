@@ -0,0 +1,97 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_List(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	types := []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplicationHealthcheck}
+	origin := testAppID
+	page := &model.HealthCheckPage{
+		Data:       []*model.HealthCheck{fixModelHealthCheck(testID, model.HealthCheckStatusConditionSucceeded, nil)},
+		TotalCount: 1,
+	}
+
+	testCases := []struct {
+		Name         string
+		Context      context.Context
+		PageSize     int
+		RepositoryFn func() *automock.HealthCheckRepository
+		ExpectedPage *model.HealthCheckPage
+		ExpectedErr  string
+	}{
+		{
+			Name:     "Success",
+			Context:  ctx,
+			PageSize: 2,
+			RepositoryFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("List", ctx, testTenant, types, &origin, 2, "").Return(page, nil).Once()
+				return repo
+			},
+			ExpectedPage: page,
+		},
+		{
+			Name:     "Returns error when page size is too big",
+			Context:  ctx,
+			PageSize: 101,
+			RepositoryFn: func() *automock.HealthCheckRepository {
+				return &automock.HealthCheckRepository{}
+			},
+			ExpectedErr: "page size must be between 1 and 100",
+		},
+		{
+			Name:     "Returns error when listing failed",
+			Context:  ctx,
+			PageSize: 2,
+			RepositoryFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("List", ctx, testTenant, types, &origin, 2, "").Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:     "Returns error when tenant is missing",
+			Context:  context.TODO(),
+			PageSize: 2,
+			RepositoryFn: func() *automock.HealthCheckRepository {
+				return &automock.HealthCheckRepository{}
+			},
+			ExpectedErr: "cannot read tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := healthcheck.NewService(repo)
+
+			// when
+			result, err := svc.List(testCase.Context, types, &origin, testCase.PageSize, "")
+
+			// then
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedPage, result)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
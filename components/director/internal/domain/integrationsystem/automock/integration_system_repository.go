@@ -2,10 +2,13 @@
 
 package automock
 
-import context "context"
+import (
+	context "context"
 
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // IntegrationSystemRepository is an autogenerated mock type for the IntegrationSystemRepository type
 type IntegrationSystemRepository struct {
@@ -84,20 +87,20 @@ func (_m *IntegrationSystemRepository) Get(ctx context.Context, id string) (*mod
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, pageSize, cursor
-func (_m *IntegrationSystemRepository) List(ctx context.Context, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
-	ret := _m.Called(ctx, pageSize, cursor)
+// List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
+func (_m *IntegrationSystemRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
+	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
 
 	var r0 model.IntegrationSystemPage
-	if rf, ok := ret.Get(0).(func(context.Context, int, string) model.IntegrationSystemPage); ok {
-		r0 = rf(ctx, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) model.IntegrationSystemPage); ok {
+		r0 = rf(ctx, tenant, filter, pageSize, cursor)
 	} else {
 		r0 = ret.Get(0).(model.IntegrationSystemPage)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
-		r1 = rf(ctx, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, tenant, filter, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
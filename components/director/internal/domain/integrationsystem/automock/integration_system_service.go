@@ -2,10 +2,13 @@
 
 package automock
 
-import context "context"
+import (
+	context "context"
 
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // IntegrationSystemService is an autogenerated mock type for the IntegrationSystemService type
 type IntegrationSystemService struct {
@@ -47,6 +50,20 @@ func (_m *IntegrationSystemService) Delete(ctx context.Context, id string) error
 	return r0
 }
 
+// DeleteLabel provides a mock function with given fields: ctx, intSysID, key
+func (_m *IntegrationSystemService) DeleteLabel(ctx context.Context, intSysID string, key string) error {
+	ret := _m.Called(ctx, intSysID, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, intSysID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *IntegrationSystemService) Get(ctx context.Context, id string) (*model.IntegrationSystem, error) {
 	ret := _m.Called(ctx, id)
@@ -70,20 +87,43 @@ func (_m *IntegrationSystemService) Get(ctx context.Context, id string) (*model.
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, pageSize, cursor
-func (_m *IntegrationSystemService) List(ctx context.Context, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
-	ret := _m.Called(ctx, pageSize, cursor)
+// GetLabel provides a mock function with given fields: ctx, intSysID, key
+func (_m *IntegrationSystemService) GetLabel(ctx context.Context, intSysID string, key string) (*model.Label, error) {
+	ret := _m.Called(ctx, intSysID, key)
+
+	var r0 *model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Label); ok {
+		r0 = rf(ctx, intSysID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, intSysID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter, pageSize, cursor
+func (_m *IntegrationSystemService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
+	ret := _m.Called(ctx, filter, pageSize, cursor)
 
 	var r0 model.IntegrationSystemPage
-	if rf, ok := ret.Get(0).(func(context.Context, int, string) model.IntegrationSystemPage); ok {
-		r0 = rf(ctx, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) model.IntegrationSystemPage); ok {
+		r0 = rf(ctx, filter, pageSize, cursor)
 	} else {
 		r0 = ret.Get(0).(model.IntegrationSystemPage)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
-		r1 = rf(ctx, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, filter, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -91,6 +131,43 @@ func (_m *IntegrationSystemService) List(ctx context.Context, pageSize int, curs
 	return r0, r1
 }
 
+// ListLabels provides a mock function with given fields: ctx, intSysID
+func (_m *IntegrationSystemService) ListLabels(ctx context.Context, intSysID string) (map[string]*model.Label, error) {
+	ret := _m.Called(ctx, intSysID)
+
+	var r0 map[string]*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]*model.Label); ok {
+		r0 = rf(ctx, intSysID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, intSysID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLabel provides a mock function with given fields: ctx, labelInput
+func (_m *IntegrationSystemService) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
+	ret := _m.Called(ctx, labelInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LabelInput) error); ok {
+		r0 = rf(ctx, labelInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *IntegrationSystemService) Update(ctx context.Context, id string, in model.IntegrationSystemInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -2,9 +2,14 @@ package integrationsystem
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/pkg/errors"
 )
 
 const tableName string = `public.integration_systems`
@@ -56,9 +61,26 @@ func (r *pgRepository) Exists(ctx context.Context, id string) (bool, error) {
 	return r.existQuerierGlobal.ExistsGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
-func (r *pgRepository) List(ctx context.Context, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
+// List returns the Integration Systems matching the label filter. As Integration Systems are global,
+// the tenant is used only to select the labels of the filter.
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
+	var conditions []string
+	var args []interface{}
+	if len(filter) > 0 {
+		tenantID, err := uuid.Parse(tenant)
+		if err != nil {
+			return model.IntegrationSystemPage{}, errors.Wrap(err, "while parsing tenant as UUID")
+		}
+		var filterSubquery string
+		filterSubquery, args, err = label.FilterQuery(model.IntegrationSystemLabelableObject, label.IntersectSet, tenantID, filter, 1)
+		if err != nil {
+			return model.IntegrationSystemPage{}, errors.Wrap(err, "while building filter query")
+		}
+		conditions = append(conditions, fmt.Sprintf(`"id" IN (%s)`, filterSubquery))
+	}
+
 	var entityCollection Collection
-	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, "id", &entityCollection)
+	page, totalCount, err := r.pageableQuerierGlobal.ListGlobalWithArgs(ctx, pageSize, cursor, "id", &entityCollection, conditions, args)
 	if err != nil {
 		return model.IntegrationSystemPage{}, err
 	}
@@ -198,7 +198,7 @@ func TestPgRepository_List(t *testing.T) {
 		intSysRepo := integrationsystem.NewRepository(mockConverter)
 
 		// WHEN
-		result, err := intSysRepo.List(ctx, testPageSize, testCursor)
+		result, err := intSysRepo.List(ctx, testTenant, nil, testPageSize, testCursor)
 
 		// THEN
 		require.NoError(t, err)
@@ -219,7 +219,7 @@ func TestPgRepository_List(t *testing.T) {
 		intSysRepo := integrationsystem.NewRepository(mockConverter)
 
 		// WHEN
-		result, err := intSysRepo.List(ctx, testPageSize, testCursor)
+		result, err := intSysRepo.List(ctx, testTenant, nil, testPageSize, testCursor)
 
 		// THEN
 		require.Error(t, err)
@@ -5,6 +5,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -16,9 +18,13 @@ import (
 type IntegrationSystemService interface {
 	Create(ctx context.Context, in model.IntegrationSystemInput) (string, error)
 	Get(ctx context.Context, id string) (*model.IntegrationSystem, error)
-	List(ctx context.Context, pageSize int, cursor string) (model.IntegrationSystemPage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.IntegrationSystemPage, error)
 	Update(ctx context.Context, id string, in model.IntegrationSystemInput) error
 	Delete(ctx context.Context, id string) error
+	SetLabel(ctx context.Context, labelInput *model.LabelInput) error
+	GetLabel(ctx context.Context, intSysID string, key string) (*model.Label, error)
+	ListLabels(ctx context.Context, intSysID string) (map[string]*model.Label, error)
+	DeleteLabel(ctx context.Context, intSysID string, key string) error
 }
 
 //go:generate mockery -name=IntegrationSystemConverter -output=automock -outpkg=automock -case=underscore
@@ -50,6 +56,7 @@ type Resolver struct {
 	oAuth20Svc       OAuth20Service
 	intSysConverter  IntegrationSystemConverter
 	sysAuthConverter SystemAuthConverter
+	labels           *label.ObjectResolver
 }
 
 func NewResolver(transact persistence.Transactioner, intSysSvc IntegrationSystemService, sysAuthSvc SystemAuthService, oAuth20Svc OAuth20Service, intSysConverter IntegrationSystemConverter, sysAuthConverter SystemAuthConverter) *Resolver {
@@ -60,6 +67,7 @@ func NewResolver(transact persistence.Transactioner, intSysSvc IntegrationSystem
 		oAuth20Svc:       oAuth20Svc,
 		intSysConverter:  intSysConverter,
 		sysAuthConverter: sysAuthConverter,
+		labels:           label.NewObjectResolver(transact, intSysSvc, model.IntegrationSystemLabelableObject),
 	}
 }
 
@@ -88,7 +96,7 @@ func (r *Resolver) IntegrationSystem(ctx context.Context, id string) (*graphql.I
 	return r.intSysConverter.ToGraphQL(is), nil
 }
 
-func (r *Resolver) IntegrationSystems(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.IntegrationSystemPage, error) {
+func (r *Resolver) IntegrationSystems(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.IntegrationSystemPage, error) {
 	var cursor string
 	if after != nil {
 		cursor = string(*after)
@@ -105,7 +113,8 @@ func (r *Resolver) IntegrationSystems(ctx context.Context, first *int, after *gr
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	intSysPage, err := r.intSysSvc.List(ctx, *first, cursor)
+	labelFilter := labelfilter.MultipleFromGraphQL(filter)
+	intSysPage, err := r.intSysSvc.List(ctx, labelFilter, *first, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -260,3 +269,19 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.IntegrationSystem) ([
 
 	return out, nil
 }
+
+func (r *Resolver) SetIntegrationSystemLabel(ctx context.Context, integrationSystemID string, key string, value interface{}) (*graphql.Label, error) {
+	return r.labels.SetLabel(ctx, integrationSystemID, key, value)
+}
+
+func (r *Resolver) DeleteIntegrationSystemLabel(ctx context.Context, integrationSystemID string, key string) (*graphql.Label, error) {
+	return r.labels.DeleteLabel(ctx, integrationSystemID, key)
+}
+
+func (r *Resolver) Labels(ctx context.Context, obj *graphql.IntegrationSystem, key *string) (graphql.Labels, error) {
+	if obj == nil {
+		return nil, errors.New("Integration System cannot be empty")
+	}
+
+	return r.labels.Labels(ctx, obj.ID)
+}
@@ -2,6 +2,7 @@ package integrationsystem_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
@@ -153,6 +155,8 @@ func TestResolver_IntegrationSystems(t *testing.T) {
 	first := 2
 	after := "test"
 	gqlAfter := graphql.PageCursor(after)
+	filter := []*labelfilter.LabelFilter{{Key: "domain"}}
+	gqlFilter := []*graphql.LabelFilter{{Key: "domain"}}
 
 	testCases := []struct {
 		Name           string
@@ -167,7 +171,7 @@ func TestResolver_IntegrationSystems(t *testing.T) {
 			TxFn: txGen.ThatSucceeds,
 			IntSysSvcFn: func() *automock.IntegrationSystemService {
 				intSysSvc := &automock.IntegrationSystemService{}
-				intSysSvc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(modelPage, nil).Once()
+				intSysSvc.On("List", txtest.CtxWithDBMatcher(), filter, first, after).Return(modelPage, nil).Once()
 				return intSysSvc
 			},
 			IntSysConvFn: func() *automock.IntegrationSystemConverter {
@@ -182,7 +186,7 @@ func TestResolver_IntegrationSystems(t *testing.T) {
 			TxFn: txGen.ThatDoesntExpectCommit,
 			IntSysSvcFn: func() *automock.IntegrationSystemService {
 				intSysSvc := &automock.IntegrationSystemService{}
-				intSysSvc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(model.IntegrationSystemPage{}, testError).Once()
+				intSysSvc.On("List", txtest.CtxWithDBMatcher(), filter, first, after).Return(model.IntegrationSystemPage{}, testError).Once()
 				return intSysSvc
 			},
 			IntSysConvFn: func() *automock.IntegrationSystemConverter {
@@ -209,7 +213,7 @@ func TestResolver_IntegrationSystems(t *testing.T) {
 			TxFn: txGen.ThatFailsOnCommit,
 			IntSysSvcFn: func() *automock.IntegrationSystemService {
 				intSysSvc := &automock.IntegrationSystemService{}
-				intSysSvc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(modelPage, nil).Once()
+				intSysSvc.On("List", txtest.CtxWithDBMatcher(), filter, first, after).Return(modelPage, nil).Once()
 				return intSysSvc
 			},
 			IntSysConvFn: func() *automock.IntegrationSystemConverter {
@@ -229,7 +233,7 @@ func TestResolver_IntegrationSystems(t *testing.T) {
 			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil)
 
 			// WHEN
-			result, err := resolver.IntegrationSystems(ctx, &first, &gqlAfter)
+			result, err := resolver.IntegrationSystems(ctx, gqlFilter, &first, &gqlAfter)
 
 			// THEN
 			if testCase.ExpectedError != nil {
@@ -887,3 +891,197 @@ func fixOAuths() []model.SystemAuth {
 		},
 	}
 }
+
+func TestResolver_SetIntegrationSystemLabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	labelKey := "domain"
+	labelValue := "payments"
+	labelInput := &model.LabelInput{
+		Key:        labelKey,
+		Value:      labelValue,
+		ObjectType: model.IntegrationSystemLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.IntegrationSystemService
+		ExpectedResult  *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.IntegrationSystemService {
+				svc := &automock.IntegrationSystemService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.Label{
+				Key:   labelKey,
+				Value: labelValue,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := integrationsystem.NewResolver(transact, svc, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.SetIntegrationSystemLabel(context.TODO(), objID, labelKey, labelValue)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_DeleteIntegrationSystemLabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	labelKey := "domain"
+	labelValue := "payments"
+	modelLabel := &model.Label{
+		ID:         "1",
+		Key:        labelKey,
+		Value:      labelValue,
+		ObjectType: model.IntegrationSystemLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.IntegrationSystemService
+		ExpectedResult  *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.IntegrationSystemService {
+				svc := &automock.IntegrationSystemService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), objID, labelKey).Return(modelLabel, nil).Once()
+				svc.On("DeleteLabel", txtest.CtxWithDBMatcher(), objID, labelKey).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.Label{
+				Key:   labelKey,
+				Value: labelValue,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := integrationsystem.NewResolver(transact, svc, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.DeleteIntegrationSystemLabel(context.TODO(), objID, labelKey)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_Labels(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objID := "foo"
+	modelLabels := map[string]*model.Label{
+		"domain": {
+			ID:         "1",
+			Key:        "domain",
+			Value:      "payments",
+			ObjectType: model.IntegrationSystemLabelableObject,
+			ObjectID:   objID,
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.IntegrationSystemService
+		ExpectedResult  graphql.Labels
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.IntegrationSystemService {
+				svc := &automock.IntegrationSystemService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), objID).Return(modelLabels, nil).Once()
+				return svc
+			},
+			ExpectedResult: graphql.Labels{"domain": "payments"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := integrationsystem.NewResolver(transact, svc, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.Labels(context.TODO(), &graphql.IntegrationSystem{ID: objID}, nil)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when Integration System is nil", func(t *testing.T) {
+		resolver := integrationsystem.NewResolver(nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.Labels(context.TODO(), nil, nil)
+		// then
+		require.EqualError(t, err, "Integration System cannot be empty")
+	})
+}
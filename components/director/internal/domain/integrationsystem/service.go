@@ -5,7 +5,10 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 )
 
 //go:generate mockery -name=IntegrationSystemRepository -output=automock -outpkg=automock -case=underscore
@@ -13,7 +16,7 @@ type IntegrationSystemRepository interface {
 	Create(ctx context.Context, item model.IntegrationSystem) error
 	Get(ctx context.Context, id string) (*model.IntegrationSystem, error)
 	Exists(ctx context.Context, id string) (bool, error)
-	List(ctx context.Context, pageSize int, cursor string) (model.IntegrationSystemPage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.IntegrationSystemPage, error)
 	Update(ctx context.Context, model model.IntegrationSystem) error
 	Delete(ctx context.Context, id string) error
 }
@@ -23,16 +26,35 @@ type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
+}
+
+//go:generate mockery -name=LabelUpsertService -output=automock -outpkg=automock -case=underscore
+type LabelUpsertService interface {
+	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
+}
+
 type service struct {
-	intSysRepo IntegrationSystemRepository
+	*label.ObjectService
 
+	intSysRepo IntegrationSystemRepository
 	uidService UIDService
 }
 
-func NewService(intSysRepo IntegrationSystemRepository, uidService UIDService) *service {
+func NewService(intSysRepo IntegrationSystemRepository, labelRepo LabelRepository, labelUpsertService LabelUpsertService, uidService UIDService) *service {
+	// labels of Integration Systems are private to the tenant, although Integration Systems are global
+	exists := func(ctx context.Context, _, id string) (bool, error) {
+		return intSysRepo.Exists(ctx, id)
+	}
+
 	return &service{
-		intSysRepo: intSysRepo,
-		uidService: uidService,
+		ObjectService: label.NewObjectService(model.IntegrationSystemLabelableObject, "Integration System", exists, labelRepo, labelUpsertService),
+		intSysRepo:    intSysRepo,
+		uidService:    uidService,
 	}
 }
 
@@ -66,12 +88,22 @@ func (s *service) Exists(ctx context.Context, id string) (bool, error) {
 	return exist, nil
 }
 
-func (s *service) List(ctx context.Context, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
+func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
 	if pageSize < 1 || pageSize > 100 {
 		return model.IntegrationSystemPage{}, errors.New("page size must be between 1 and 100")
 	}
 
-	return s.intSysRepo.List(ctx, pageSize, cursor)
+	// Integration Systems are global, only their labels belong to a tenant
+	var tnt string
+	if len(filter) > 0 {
+		var err error
+		tnt, err = tenant.LoadFromContext(ctx)
+		if err != nil {
+			return model.IntegrationSystemPage{}, err
+		}
+	}
+
+	return s.intSysRepo.List(ctx, tnt, filter, pageSize, cursor)
 }
 
 func (s *service) Update(ctx context.Context, id string, in model.IntegrationSystemInput) error {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
@@ -58,7 +59,7 @@ func TestService_Create(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			intSysRepo := testCase.IntSysRepoFn()
 			idSvc := uidSvcFn()
-			svc := integrationsystem.NewService(intSysRepo, idSvc)
+			svc := integrationsystem.NewService(intSysRepo, nil, nil, idSvc)
 
 			// WHEN
 			result, err := svc.Create(ctx, modelIntSysInput)
@@ -112,7 +113,7 @@ func TestService_Get(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := integrationsystem.NewService(intSysRepo, nil)
+			svc := integrationsystem.NewService(intSysRepo, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.Get(ctx, testID)
@@ -165,7 +166,7 @@ func TestService_Exists(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := integrationsystem.NewService(intSysRepo, nil)
+			svc := integrationsystem.NewService(intSysRepo, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.Exists(ctx, testID)
@@ -191,10 +192,12 @@ func TestService_List(t *testing.T) {
 		fixModelIntegrationSystem("foo1", "bar1"),
 		fixModelIntegrationSystem("foo2", "bar2"),
 	})
+	filter := []*labelfilter.LabelFilter{{Key: "domain"}}
 
 	testCases := []struct {
 		Name           string
 		IntSysRepoFn   func() *automock.IntegrationSystemRepository
+		InputFilter    []*labelfilter.LabelFilter
 		InputPageSize  int
 		ExpectedError  error
 		ExpectedOutput model.IntegrationSystemPage
@@ -203,17 +206,28 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				intSysRepo := &automock.IntegrationSystemRepository{}
-				intSysRepo.On("List", ctx, 50, testCursor).Return(modelIntSys, nil).Once()
+				intSysRepo.On("List", ctx, "", []*labelfilter.LabelFilter(nil), 50, testCursor).Return(modelIntSys, nil).Once()
 				return intSysRepo
 			},
 			InputPageSize:  50,
 			ExpectedOutput: modelIntSys,
 		},
+		{
+			Name: "Success with label filter",
+			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
+				intSysRepo := &automock.IntegrationSystemRepository{}
+				intSysRepo.On("List", ctx, testTenant, filter, 50, testCursor).Return(modelIntSys, nil).Once()
+				return intSysRepo
+			},
+			InputFilter:    filter,
+			InputPageSize:  50,
+			ExpectedOutput: modelIntSys,
+		},
 		{
 			Name: "Error when listing integration system",
 			IntSysRepoFn: func() *automock.IntegrationSystemRepository {
 				intSysRepo := &automock.IntegrationSystemRepository{}
-				intSysRepo.On("List", ctx, 50, testCursor).Return(model.IntegrationSystemPage{}, testError).Once()
+				intSysRepo.On("List", ctx, "", []*labelfilter.LabelFilter(nil), 50, testCursor).Return(model.IntegrationSystemPage{}, testError).Once()
 				return intSysRepo
 			},
 			InputPageSize:  50,
@@ -245,10 +259,10 @@ func TestService_List(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := integrationsystem.NewService(intSysRepo, nil)
+			svc := integrationsystem.NewService(intSysRepo, nil, nil, nil)
 
 			// WHEN
-			result, err := svc.List(ctx, testCase.InputPageSize, testCursor)
+			result, err := svc.List(ctx, testCase.InputFilter, testCase.InputPageSize, testCursor)
 
 			// THEN
 			if testCase.ExpectedError != nil {
@@ -297,7 +311,7 @@ func TestService_Update(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := integrationsystem.NewService(intSysRepo, nil)
+			svc := integrationsystem.NewService(intSysRepo, nil, nil, nil)
 
 			// WHEN
 			err := svc.Update(ctx, testID, modelIntSysInput)
@@ -346,7 +360,7 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := integrationsystem.NewService(intSysRepo, nil)
+			svc := integrationsystem.NewService(intSysRepo, nil, nil, nil)
 
 			// WHEN
 			err := svc.Delete(ctx, testID)
@@ -363,3 +377,72 @@ func TestService_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestService_SetLabel(t *testing.T) {
+	// given
+	tnt := "tenant"
+	ctx := tenant.SaveToContext(context.TODO(), tnt)
+
+	objID := "foo"
+	labelInput := &model.LabelInput{
+		Key:        "domain",
+		Value:      "payments",
+		ObjectType: model.IntegrationSystemLabelableObject,
+		ObjectID:   objID,
+	}
+
+	testCases := []struct {
+		Name                 string
+		RepositoryFn         func() *automock.IntegrationSystemRepository
+		LabelUpsertServiceFn func() *automock.LabelUpsertService
+		ExpectedErrMessage   string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.IntegrationSystemRepository {
+				repo := &automock.IntegrationSystemRepository{}
+				repo.On("Exists", ctx, objID).Return(true, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, labelInput).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Returns error when Integration System does not exist",
+			RepositoryFn: func() *automock.IntegrationSystemRepository {
+				repo := &automock.IntegrationSystemRepository{}
+				repo.On("Exists", ctx, objID).Return(false, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			ExpectedErrMessage: "Integration System with ID foo doesn't exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelUpsertSvc := testCase.LabelUpsertServiceFn()
+			svc := integrationsystem.NewService(repo, nil, labelUpsertSvc, nil)
+
+			// when
+			err := svc.SetLabel(ctx, labelInput)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelUpsertSvc.AssertExpectations(t)
+		})
+	}
+}
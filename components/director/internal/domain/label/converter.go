@@ -25,29 +25,31 @@ func (c *converter) ToEntity(in model.Label) (Entity, error) {
 		}
 	}
 
-	var appID sql.NullString
-	var rtmID sql.NullString
+	entity := Entity{
+		ID:       in.ID,
+		TenantID: in.Tenant,
+		Key:      in.Key,
+		Value:    string(valueMarshalled),
+	}
+
+	objectID := sql.NullString{
+		Valid:  true,
+		String: in.ObjectID,
+	}
 	switch in.ObjectType {
 	case model.ApplicationLabelableObject:
-		appID = sql.NullString{
-			Valid:  true,
-			String: in.ObjectID,
-		}
+		entity.AppID = objectID
 	case model.RuntimeLabelableObject:
-		rtmID = sql.NullString{
-			Valid:  true,
-			String: in.ObjectID,
-		}
+		entity.RuntimeID = objectID
+	case model.APIDefinitionLabelableObject:
+		entity.APIDefID = objectID
+	case model.EventAPIDefinitionLabelableObject:
+		entity.EventAPIDefID = objectID
+	case model.IntegrationSystemLabelableObject:
+		entity.IntSysID = objectID
 	}
 
-	return Entity{
-		ID:        in.ID,
-		TenantID:  in.Tenant,
-		AppID:     appID,
-		RuntimeID: rtmID,
-		Key:       in.Key,
-		Value:     string(valueMarshalled),
-	}, nil
+	return entity, nil
 }
 
 func (c *converter) FromEntity(in Entity) (model.Label, error) {
@@ -62,12 +64,22 @@ func (c *converter) FromEntity(in Entity) (model.Label, error) {
 	var objectType model.LabelableObject
 	var objectID string
 
-	if in.AppID.Valid {
+	switch {
+	case in.AppID.Valid:
 		objectID = in.AppID.String
 		objectType = model.ApplicationLabelableObject
-	} else if in.RuntimeID.Valid {
+	case in.RuntimeID.Valid:
 		objectID = in.RuntimeID.String
 		objectType = model.RuntimeLabelableObject
+	case in.APIDefID.Valid:
+		objectID = in.APIDefID.String
+		objectType = model.APIDefinitionLabelableObject
+	case in.EventAPIDefID.Valid:
+		objectID = in.EventAPIDefID.String
+		objectType = model.EventAPIDefinitionLabelableObject
+	case in.IntSysID.Valid:
+		objectID = in.IntSysID.String
+		objectType = model.IntegrationSystemLabelableObject
 	}
 
 	return model.Label{
@@ -39,6 +39,12 @@ func TestConverter_ToEntity(t *testing.T) {
 			Expected:           fixLabelEntity("1", marshalledStringValue),
 			ExpectedErrMessage: "",
 		},
+		{
+			Name:               "API Definition label",
+			Input:              fixAPIDefinitionLabelModel("1", stringValue),
+			Expected:           fixAPIDefinitionLabelEntity("1", marshalledStringValue),
+			ExpectedErrMessage: "",
+		},
 		{
 			Name: "Empty value",
 			Input: model.Label{
@@ -109,6 +115,12 @@ func TestConverter_FromEntity(t *testing.T) {
 			Expected:           fixLabelModel("1", stringValue),
 			ExpectedErrMessage: "",
 		},
+		{
+			Name:               "API Definition label",
+			Input:              fixAPIDefinitionLabelEntity("1", marshalledStringValue),
+			Expected:           fixAPIDefinitionLabelModel("1", stringValue),
+			ExpectedErrMessage: "",
+		},
 		{
 			Name: "Empty value",
 			Input: label.Entity{
@@ -173,3 +185,27 @@ func fixLabelModel(id string, value interface{}) model.Label {
 		Value:      value,
 	}
 }
+
+func fixAPIDefinitionLabelEntity(id string, value []byte) label.Entity {
+	return label.Entity{
+		ID:       id,
+		TenantID: "tenant",
+		APIDefID: sql.NullString{
+			String: "654",
+			Valid:  true,
+		},
+		Key:   "test",
+		Value: string(value),
+	}
+}
+
+func fixAPIDefinitionLabelModel(id string, value interface{}) model.Label {
+	return model.Label{
+		ID:         id,
+		Tenant:     "tenant",
+		Key:        "test",
+		ObjectType: model.APIDefinitionLabelableObject,
+		ObjectID:   "654",
+		Value:      value,
+	}
+}
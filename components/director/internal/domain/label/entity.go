@@ -5,10 +5,13 @@ import (
 )
 
 type Entity struct {
-	ID        string         `db:"id"`
-	TenantID  string         `db:"tenant_id"`
-	Key       string         `db:"key"`
-	AppID     sql.NullString `db:"app_id"`
-	RuntimeID sql.NullString `db:"runtime_id"`
-	Value     string         `db:"value"`
+	ID            string         `db:"id"`
+	TenantID      string         `db:"tenant_id"`
+	Key           string         `db:"key"`
+	AppID         sql.NullString `db:"app_id"`
+	RuntimeID     sql.NullString `db:"runtime_id"`
+	APIDefID      sql.NullString `db:"api_def_id"`
+	EventAPIDefID sql.NullString `db:"event_api_def_id"`
+	IntSysID      sql.NullString `db:"int_sys_id"`
+	Value         string         `db:"value"`
 }
@@ -0,0 +1,126 @@
+package label
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+//go:generate mockery -name=ObjectLabelService -output=automock -outpkg=automock -case=underscore
+type ObjectLabelService interface {
+	SetLabel(ctx context.Context, labelInput *model.LabelInput) error
+	GetLabel(ctx context.Context, objectID string, key string) (*model.Label, error)
+	ListLabels(ctx context.Context, objectID string) (map[string]*model.Label, error)
+	DeleteLabel(ctx context.Context, objectID string, key string) error
+}
+
+// ObjectResolver resolves the label mutations and the labels field of objects of a single type.
+type ObjectResolver struct {
+	transact   persistence.Transactioner
+	svc        ObjectLabelService
+	objectType model.LabelableObject
+}
+
+func NewObjectResolver(transact persistence.Transactioner, svc ObjectLabelService, objectType model.LabelableObject) *ObjectResolver {
+	return &ObjectResolver{
+		transact:   transact,
+		svc:        svc,
+		objectType: objectType,
+	}
+}
+
+func (r *ObjectResolver) SetLabel(ctx context.Context, objectID string, key string, value interface{}) (*graphql.Label, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = r.svc.SetLabel(ctx, &model.LabelInput{
+		Key:        key,
+		Value:      value,
+		ObjectType: r.objectType,
+		ObjectID:   objectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.Label{
+		Key:   key,
+		Value: value,
+	}, nil
+}
+
+func (r *ObjectResolver) DeleteLabel(ctx context.Context, objectID string, key string) (*graphql.Label, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	label, err := r.svc.GetLabel(ctx, objectID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.svc.DeleteLabel(ctx, objectID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.Label{
+		Key:   key,
+		Value: label.Value,
+	}, nil
+}
+
+// Labels returns no labels for an object which does not exist anymore
+func (r *ObjectResolver) Labels(ctx context.Context, objectID string) (graphql.Labels, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	itemMap, err := r.svc.ListLabels(ctx, objectID)
+	if err != nil {
+		if strings.Contains(err.Error(), "doesn't exist") {
+			return graphql.Labels{}, nil
+		}
+
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	resultLabels := make(map[string]interface{})
+
+	for _, label := range itemMap {
+		resultLabels[label.Key] = label.Value
+	}
+
+	return resultLabels, nil
+}
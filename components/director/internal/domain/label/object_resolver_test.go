@@ -0,0 +1,289 @@
+package label_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectResolver_SetLabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	labelKey := "domain"
+	labelValue := "payments"
+	labelInput := &model.LabelInput{
+		Key:        labelKey,
+		Value:      labelValue,
+		ObjectType: testObjectType,
+		ObjectID:   testObjectID,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.ObjectLabelService
+		ExpectedResult  *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.Label{
+				Key:   labelKey,
+				Value: labelValue,
+			},
+		},
+		{
+			Name:            "Returns error when setting label failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when beginning transaction",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.ObjectLabelService {
+				return &automock.ObjectLabelService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := label.NewObjectResolver(transact, svc, testObjectType)
+
+			// when
+			result, err := resolver.SetLabel(context.TODO(), testObjectID, labelKey, labelValue)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestObjectResolver_DeleteLabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	labelKey := "domain"
+	labelValue := "payments"
+	modelLabel := &model.Label{
+		ID:         "1",
+		Key:        labelKey,
+		Value:      labelValue,
+		ObjectType: testObjectType,
+		ObjectID:   testObjectID,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.ObjectLabelService
+		ExpectedResult  *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), testObjectID, labelKey).Return(modelLabel, nil).Once()
+				svc.On("DeleteLabel", txtest.CtxWithDBMatcher(), testObjectID, labelKey).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.Label{
+				Key:   labelKey,
+				Value: labelValue,
+			},
+		},
+		{
+			Name:            "Returns error when getting label failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), testObjectID, labelKey).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when deleting label failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), testObjectID, labelKey).Return(modelLabel, nil).Once()
+				svc.On("DeleteLabel", txtest.CtxWithDBMatcher(), testObjectID, labelKey).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), testObjectID, labelKey).Return(modelLabel, nil).Once()
+				svc.On("DeleteLabel", txtest.CtxWithDBMatcher(), testObjectID, labelKey).Return(nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := label.NewObjectResolver(transact, svc, testObjectType)
+
+			// when
+			result, err := resolver.DeleteLabel(context.TODO(), testObjectID, labelKey)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestObjectResolver_Labels(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelLabels := map[string]*model.Label{
+		"domain": {
+			ID:         "1",
+			Key:        "domain",
+			Value:      "payments",
+			ObjectType: testObjectType,
+			ObjectID:   testObjectID,
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.ObjectLabelService
+		ExpectedResult  graphql.Labels
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), testObjectID).Return(modelLabels, nil).Once()
+				return svc
+			},
+			ExpectedResult: graphql.Labels{"domain": "payments"},
+		},
+		{
+			Name:            "Returns empty labels when object does not exist",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), testObjectID).Return(nil, errors.New("API Definition with ID foo doesn't exist")).Once()
+				return svc
+			},
+			ExpectedResult: graphql.Labels{},
+		},
+		{
+			Name:            "Returns error when listing labels failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), testObjectID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ObjectLabelService {
+				svc := &automock.ObjectLabelService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), testObjectID).Return(modelLabels, nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			resolver := label.NewObjectResolver(transact, svc, testObjectType)
+
+			// when
+			result, err := resolver.Labels(context.TODO(), testObjectID)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package label
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+)
+
+// ExistsFunc checks if the object with given ID exists for the tenant
+type ExistsFunc func(ctx context.Context, tenant, id string) (bool, error)
+
+//go:generate mockery -name=ObjectLabelRepository -output=automock -outpkg=automock -case=underscore
+type ObjectLabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
+}
+
+//go:generate mockery -name=ObjectLabelUpsertService -output=automock -outpkg=automock -case=underscore
+type ObjectLabelUpsertService interface {
+	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
+}
+
+// ObjectService manages labels of objects of a single type, which are always stored for the tenant from the context.
+// Services embed it to label their objects without repeating the existence checks and error handling.
+type ObjectService struct {
+	objectType         model.LabelableObject
+	objectName         string
+	exists             ExistsFunc
+	labelRepo          ObjectLabelRepository
+	labelUpsertService ObjectLabelUpsertService
+}
+
+func NewObjectService(objectType model.LabelableObject, objectName string, exists ExistsFunc, labelRepo ObjectLabelRepository, labelUpsertService ObjectLabelUpsertService) *ObjectService {
+	return &ObjectService{
+		objectType:         objectType,
+		objectName:         objectName,
+		exists:             exists,
+		labelRepo:          labelRepo,
+		labelUpsertService: labelUpsertService,
+	}
+}
+
+func (s *ObjectService) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.ensureExists(ctx, tnt, labelInput.ObjectID)
+	if err != nil {
+		return err
+	}
+
+	err = s.labelUpsertService.UpsertLabel(ctx, tnt, labelInput)
+	if err != nil {
+		return errors.Wrapf(err, "while creating label for %s", s.objectName)
+	}
+
+	return nil
+}
+
+func (s *ObjectService) GetLabel(ctx context.Context, objectID string, key string) (*model.Label, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ensureExists(ctx, tnt, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	label, err := s.labelRepo.GetByKey(ctx, tnt, s.objectType, objectID, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting label for %s", s.objectName)
+	}
+
+	return label, nil
+}
+
+func (s *ObjectService) ListLabels(ctx context.Context, objectID string) (map[string]*model.Label, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ensureExists(ctx, tnt, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.labelRepo.ListForObject(ctx, tnt, s.objectType, objectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting labels for %s", s.objectName)
+	}
+
+	return labels, nil
+}
+
+func (s *ObjectService) DeleteLabel(ctx context.Context, objectID string, key string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.ensureExists(ctx, tnt, objectID)
+	if err != nil {
+		return err
+	}
+
+	err = s.labelRepo.Delete(ctx, tnt, s.objectType, objectID, key)
+	if err != nil {
+		return errors.Wrapf(err, "while deleting label for %s", s.objectName)
+	}
+
+	return nil
+}
+
+func (s *ObjectService) ensureExists(ctx context.Context, tnt, id string) error {
+	exists, err := s.exists(ctx, tnt, id)
+	if err != nil {
+		return errors.Wrapf(err, "while checking if %s exists", s.objectName)
+	}
+	if !exists {
+		return fmt.Errorf("%s with ID %s doesn't exist", s.objectName, id)
+	}
+
+	return nil
+}
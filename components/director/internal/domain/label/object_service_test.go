@@ -0,0 +1,327 @@
+package label_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testObjectTenant = "tenant"
+	testObjectID     = "foo"
+	testObjectType   = model.APIDefinitionLabelableObject
+	testObjectName   = "API Definition"
+)
+
+func TestObjectService_SetLabel(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testObjectTenant)
+	testErr := errors.New("Test error")
+
+	labelInput := &model.LabelInput{
+		Key:        "domain",
+		Value:      "payments",
+		ObjectType: testObjectType,
+		ObjectID:   testObjectID,
+	}
+
+	testCases := []struct {
+		Name                 string
+		Exists               bool
+		ExistsErr            error
+		LabelUpsertServiceFn func() *automock.ObjectLabelUpsertService
+		ExpectedErrMessage   string
+	}{
+		{
+			Name:   "Success",
+			Exists: true,
+			LabelUpsertServiceFn: func() *automock.ObjectLabelUpsertService {
+				svc := &automock.ObjectLabelUpsertService{}
+				svc.On("UpsertLabel", ctx, testObjectTenant, labelInput).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:   "Returns error when object does not exist",
+			Exists: false,
+			LabelUpsertServiceFn: func() *automock.ObjectLabelUpsertService {
+				return &automock.ObjectLabelUpsertService{}
+			},
+			ExpectedErrMessage: "API Definition with ID foo doesn't exist",
+		},
+		{
+			Name:      "Returns error when checking object existence failed",
+			ExistsErr: testErr,
+			LabelUpsertServiceFn: func() *automock.ObjectLabelUpsertService {
+				return &automock.ObjectLabelUpsertService{}
+			},
+			ExpectedErrMessage: "while checking if API Definition exists: Test error",
+		},
+		{
+			Name:   "Returns error when label upsert failed",
+			Exists: true,
+			LabelUpsertServiceFn: func() *automock.ObjectLabelUpsertService {
+				svc := &automock.ObjectLabelUpsertService{}
+				svc.On("UpsertLabel", ctx, testObjectTenant, labelInput).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while creating label for API Definition: Test error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelUpsertSvc := testCase.LabelUpsertServiceFn()
+			svc := label.NewObjectService(testObjectType, testObjectName, fixExistsFunc(t, testCase.Exists, testCase.ExistsErr), nil, labelUpsertSvc)
+
+			// when
+			err := svc.SetLabel(ctx, labelInput)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelUpsertSvc.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error on loading tenant", func(t *testing.T) {
+		svc := label.NewObjectService(testObjectType, testObjectName, nil, nil, nil)
+		// when
+		err := svc.SetLabel(context.TODO(), labelInput)
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestObjectService_GetLabel(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testObjectTenant)
+	testErr := errors.New("Test error")
+
+	labelKey := "domain"
+	modelLabel := &model.Label{
+		ID:         "1",
+		Tenant:     testObjectTenant,
+		Key:        labelKey,
+		Value:      "payments",
+		ObjectType: testObjectType,
+		ObjectID:   testObjectID,
+	}
+
+	testCases := []struct {
+		Name               string
+		Exists             bool
+		LabelRepositoryFn  func() *automock.ObjectLabelRepository
+		ExpectedLabel      *model.Label
+		ExpectedErrMessage string
+	}{
+		{
+			Name:   "Success",
+			Exists: true,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				repo := &automock.ObjectLabelRepository{}
+				repo.On("GetByKey", ctx, testObjectTenant, testObjectType, testObjectID, labelKey).Return(modelLabel, nil).Once()
+				return repo
+			},
+			ExpectedLabel: modelLabel,
+		},
+		{
+			Name:   "Returns error when object does not exist",
+			Exists: false,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				return &automock.ObjectLabelRepository{}
+			},
+			ExpectedErrMessage: "API Definition with ID foo doesn't exist",
+		},
+		{
+			Name:   "Returns error when getting label failed",
+			Exists: true,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				repo := &automock.ObjectLabelRepository{}
+				repo.On("GetByKey", ctx, testObjectTenant, testObjectType, testObjectID, labelKey).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while getting label for API Definition: Test error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepositoryFn()
+			svc := label.NewObjectService(testObjectType, testObjectName, fixExistsFunc(t, testCase.Exists, nil), labelRepo, nil)
+
+			// when
+			result, err := svc.GetLabel(ctx, testObjectID, labelKey)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedLabel, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestObjectService_ListLabels(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testObjectTenant)
+	testErr := errors.New("Test error")
+
+	modelLabels := map[string]*model.Label{
+		"domain": {
+			ID:         "1",
+			Tenant:     testObjectTenant,
+			Key:        "domain",
+			Value:      "payments",
+			ObjectType: testObjectType,
+			ObjectID:   testObjectID,
+		},
+	}
+
+	testCases := []struct {
+		Name               string
+		Exists             bool
+		LabelRepositoryFn  func() *automock.ObjectLabelRepository
+		ExpectedLabels     map[string]*model.Label
+		ExpectedErrMessage string
+	}{
+		{
+			Name:   "Success",
+			Exists: true,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				repo := &automock.ObjectLabelRepository{}
+				repo.On("ListForObject", ctx, testObjectTenant, testObjectType, testObjectID).Return(modelLabels, nil).Once()
+				return repo
+			},
+			ExpectedLabels: modelLabels,
+		},
+		{
+			Name:   "Returns error when object does not exist",
+			Exists: false,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				return &automock.ObjectLabelRepository{}
+			},
+			ExpectedErrMessage: "API Definition with ID foo doesn't exist",
+		},
+		{
+			Name:   "Returns error when listing labels failed",
+			Exists: true,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				repo := &automock.ObjectLabelRepository{}
+				repo.On("ListForObject", ctx, testObjectTenant, testObjectType, testObjectID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while getting labels for API Definition: Test error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepositoryFn()
+			svc := label.NewObjectService(testObjectType, testObjectName, fixExistsFunc(t, testCase.Exists, nil), labelRepo, nil)
+
+			// when
+			result, err := svc.ListLabels(ctx, testObjectID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedLabels, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestObjectService_DeleteLabel(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testObjectTenant)
+	testErr := errors.New("Test error")
+
+	labelKey := "domain"
+
+	testCases := []struct {
+		Name               string
+		Exists             bool
+		LabelRepositoryFn  func() *automock.ObjectLabelRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name:   "Success",
+			Exists: true,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				repo := &automock.ObjectLabelRepository{}
+				repo.On("Delete", ctx, testObjectTenant, testObjectType, testObjectID, labelKey).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:   "Returns error when object does not exist",
+			Exists: false,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				return &automock.ObjectLabelRepository{}
+			},
+			ExpectedErrMessage: "API Definition with ID foo doesn't exist",
+		},
+		{
+			Name:   "Returns error when deleting label failed",
+			Exists: true,
+			LabelRepositoryFn: func() *automock.ObjectLabelRepository {
+				repo := &automock.ObjectLabelRepository{}
+				repo.On("Delete", ctx, testObjectTenant, testObjectType, testObjectID, labelKey).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while deleting label for API Definition: Test error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepositoryFn()
+			svc := label.NewObjectService(testObjectType, testObjectName, fixExistsFunc(t, testCase.Exists, nil), labelRepo, nil)
+
+			// when
+			err := svc.DeleteLabel(ctx, testObjectID, labelKey)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func fixExistsFunc(t *testing.T, exists bool, err error) label.ExistsFunc {
+	return func(ctx context.Context, tnt, id string) (bool, error) {
+		assert.Equal(t, testObjectTenant, tnt)
+		assert.Equal(t, testObjectID, id)
+		return exists, err
+	}
+}
@@ -4,10 +4,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/jsonpath"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -16,24 +16,57 @@ import (
 type SetCombination string
 
 const (
-	IntersectSet      SetCombination = "INTERSECT"
-	UnionSet          SetCombination = "UNION"
-	scenariosLabelKey string         = "SCENARIOS"
-	stmtPrefixFormat  string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "tenant_id" = '%s'`
+	IntersectSet         SetCombination = "INTERSECT"
+	UnionSet             SetCombination = "UNION"
+	ExceptSet            SetCombination = "EXCEPT"
+	stmtPrefixFormat     string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "tenant_id" = '%s'`
+	allObjectsStmtFormat string         = `SELECT "id" FROM %s WHERE "tenant_id" = '%s'`
+	// integration systems are not tenant-scoped, so all of them are candidates for negation
+	allGlobalObjectsStmtFormat string = `SELECT "id" FROM %s`
 )
 
 // FilterQuery builds select query for given filters
 //
 // It supports quering defined by `queryFor` parameter. All queries are created
-// in the context of given tenant
-func FilterQuery(queryFor model.LabelableObject, setCombination SetCombination, tenant uuid.UUID, filter []*labelfilter.LabelFilter) (string, error) {
-	if filter == nil {
-		return "", nil
+// in the context of given tenant. Label keys and values from the filter queries
+// are passed as query arguments, which are numbered starting from `argsStartIdx`.
+//
+// Group filters are combined with INTERSECT, UNION and EXCEPT, and placed in parentheses.
+func FilterQuery(queryFor model.LabelableObject, setCombination SetCombination, tenant uuid.UUID, filter []*labelfilter.LabelFilter, argsStartIdx int) (string, []interface{}, error) {
+	if len(filter) == 0 {
+		return "", nil, nil
+	}
+
+	builder := &filterQueryBuilder{
+		queryFor:     queryFor,
+		tenant:       tenant,
+		argsStartIdx: argsStartIdx,
 	}
 
-	objectField := labelableObjectField(queryFor)
+	query, err := builder.combine(setCombination, filter)
+	if err != nil {
+		return "", nil, err
+	}
 
-	stmtPrefix := fmt.Sprintf(stmtPrefixFormat, objectField, tableName, objectField, tenant)
+	return query, builder.args, nil
+}
+
+type filterQueryBuilder struct {
+	queryFor     model.LabelableObject
+	tenant       uuid.UUID
+	argsStartIdx int
+	args         []interface{}
+}
+
+func (b *filterQueryBuilder) bind(value interface{}) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", b.argsStartIdx+len(b.args)-1)
+}
+
+func (b *filterQueryBuilder) combine(setCombination SetCombination, filter []*labelfilter.LabelFilter) (string, error) {
+	if len(filter) == 0 {
+		return "", errors.New("label filter group cannot be empty")
+	}
 
 	var queryBuilder strings.Builder
 	for idx, lblFilter := range filter {
@@ -41,30 +74,62 @@ func FilterQuery(queryFor model.LabelableObject, setCombination SetCombination,
 			queryBuilder.WriteString(fmt.Sprintf(` %s `, setCombination))
 		}
 
-		queryBuilder.WriteString(fmt.Sprintf(stmtPrefix))
+		query, err := b.filter(lblFilter)
+		if err != nil {
+			return "", err
+		}
+		queryBuilder.WriteString(query)
+	}
+
+	return queryBuilder.String(), nil
+}
 
-		// TODO: for optimization it can be detected if the given Key was already added to the query
-		// if so, it can be ommited
-		queryBuilder.WriteString(fmt.Sprintf(` AND "key" = %s`, pq.QuoteLiteral(lblFilter.Key)))
+func (b *filterQueryBuilder) filter(lblFilter *labelfilter.LabelFilter) (string, error) {
+	switch {
+	case lblFilter.And != nil:
+		return b.group(IntersectSet, lblFilter.And)
+	case lblFilter.Or != nil:
+		return b.group(UnionSet, lblFilter.Or)
+	case lblFilter.Not != nil:
+		query, err := b.filter(lblFilter.Not)
+		if err != nil {
+			return "", err
+		}
+		allObjects := fmt.Sprintf(allObjectsStmtFormat, labelableObjectTable(b.queryFor), b.tenant)
+		if b.queryFor == model.IntegrationSystemLabelableObject {
+			allObjects = fmt.Sprintf(allGlobalObjectsStmtFormat, labelableObjectTable(b.queryFor))
+		}
+		return fmt.Sprintf(`(%s %s (%s))`, allObjects, ExceptSet, query), nil
+	}
 
-		if lblFilter.Query != nil {
-			queryValue := *lblFilter.Query
-			// Handling the Scenarios label case - we assume that Query is
-			// in SQL/JSON path format supported by PostgreSQL 12. Till it
-			// is not production ready, we need to transform the Query from
-			// SQL/JSON path to old JSON queries.
-			if strings.ToUpper(lblFilter.Key) == scenariosLabelKey {
-				extractedValue, err := ExtractValueFromJSONPath(queryValue)
-				if err != nil {
-					return "", errors.Wrap(err, "while extracting value from JSON path")
-				}
+	objectField := labelableObjectField(b.queryFor)
 
-				queryValue = `["` + *extractedValue + `"]`
-			}
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(fmt.Sprintf(stmtPrefixFormat, objectField, tableName, objectField, b.tenant))
 
-			queryBuilder.WriteString(fmt.Sprintf(` AND "value" @> %s`, pq.QuoteLiteral(queryValue)))
+	// TODO: for optimization it can be detected if the given Key was already added to the query
+	// if so, it can be ommited
+	queryBuilder.WriteString(fmt.Sprintf(` AND "key" = %s`, b.bind(lblFilter.Key)))
+
+	if lblFilter.Query != nil {
+		path, err := jsonpath.Parse(*lblFilter.Query)
+		if err != nil {
+			return "", errors.Wrapf(err, "while parsing query of label filter with key %s", lblFilter.Key)
 		}
+
+		condition, conditionArgs := path.ToSQL(`"value"`, b.argsStartIdx+len(b.args))
+		b.args = append(b.args, conditionArgs...)
+		queryBuilder.WriteString(" AND " + condition)
 	}
 
 	return queryBuilder.String(), nil
 }
+
+func (b *filterQueryBuilder) group(setCombination SetCombination, filter []*labelfilter.LabelFilter) (string, error) {
+	query, err := b.combine(setCombination, filter)
+	if err != nil {
+		return "", err
+	}
+
+	return "(" + query + ")", nil
+}
@@ -6,18 +6,18 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
 
-func Test_FilterQuery_Intersection(t *testing.T) {
+func Test_FilterQuery(t *testing.T) {
 	tenantID := uuid.New()
 
-	fooQuery := `["foo-value"]`
-	barQuery := `["bar-value"]`
 	scenariosFooQuery := `$[*] ? (@ == "foo")`
 	scenariosBarPongQuery := `$[*] ? (@ == "bar pong")`
+	sizeQuery := `$ ? (@ > 10)`
 
 	filterAllFoos := labelfilter.LabelFilter{
 		Key:   "Foo",
@@ -27,129 +27,160 @@ func Test_FilterQuery_Intersection(t *testing.T) {
 		Key:   "Bar",
 		Query: nil,
 	}
-	filterFoosWithValues := labelfilter.LabelFilter{
-		Key:   "Foo",
-		Query: &fooQuery,
-	}
-	filterBarsWithValues := labelfilter.LabelFilter{
-		Key:   "Bar",
-		Query: &barQuery,
-	}
-	filterAllScenarios := labelfilter.LabelFilter{
-		Key:   "Scenarios",
-		Query: nil,
-	}
 	filterScenariosWithFooValues := labelfilter.LabelFilter{
 		Key:   "Scenarios",
 		Query: &scenariosFooQuery,
 	}
-	filterScenariosWithbarPongValues := labelfilter.LabelFilter{
+	filterScenariosWithBarPongValues := labelfilter.LabelFilter{
 		Key:   "Scenarios",
 		Query: &scenariosBarPongQuery,
 	}
+	filterSizeWithValues := labelfilter.LabelFilter{
+		Key:   "Size",
+		Query: &sizeQuery,
+	}
 
 	stmtPrefix := `SELECT "runtime_id" FROM public.labels ` +
 		`WHERE "runtime_id" IS NOT NULL AND "tenant_id" = '` + tenantID.String() + `'`
 
+	unwrap := func(item, alias string) string {
+		return `jsonb_array_elements(CASE WHEN jsonb_typeof(` + item + `) = 'array' THEN ` + item + ` ELSE jsonb_build_array(` + item + `) END) AS ` + alias + `(item)`
+	}
+	scenarioCondition := func(idx string) string {
+		return `EXISTS (SELECT 1 FROM ` + unwrap(`"value"`, "jp0") +
+			` WHERE EXISTS (SELECT 1 FROM ` + unwrap("jp0.item", "jp1") + ` WHERE jp1.item = $` + idx + `::jsonb))`
+	}
+	sizeCondition := func(idx string) string {
+		return `EXISTS (SELECT 1 FROM ` + unwrap(`"value"`, "jp0") +
+			` WHERE EXISTS (SELECT 1 FROM ` + unwrap("jp0.item", "jp1") +
+			` WHERE (CASE WHEN jsonb_typeof(jp1.item) = 'number' THEN (jp1.item #>> '{}')::numeric > $` + idx + `::numeric ELSE false END)))`
+	}
+
 	testCases := []struct {
 		Name                 string
 		ReturnSetCombination SetCombination
 		FilterInput          []*labelfilter.LabelFilter
 		ExpectedQueryFilter  string
-		ExpectedError        error
+		ExpectedArgs         []interface{}
 	}{
 		{
 			Name:                 "Returns empty query filter when no label filters defined - intersect set",
 			ReturnSetCombination: IntersectSet,
 			FilterInput:          nil,
 			ExpectedQueryFilter:  "",
-			ExpectedError:        nil,
 		}, {
 			Name:                 "Returns empty query filter when no label filters defined - union set",
 			ReturnSetCombination: UnionSet,
 			FilterInput:          nil,
 			ExpectedQueryFilter:  "",
-			ExpectedError:        nil,
 		}, {
 			Name:                 "Query only for label assigned if label filter defined only with key - intersect set",
 			ReturnSetCombination: IntersectSet,
 			FilterInput:          []*labelfilter.LabelFilter{&filterAllFoos},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterAllFoos.Key + `'`,
-			ExpectedError:        nil,
-		}, {
-			Name:                 "Query only for label assigned if label filter defined only with key - union set",
-			ReturnSetCombination: UnionSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterAllFoos},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterAllFoos.Key + `'`,
-			ExpectedError:        nil,
+			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = $2`,
+			ExpectedArgs:         []interface{}{"Foo"},
 		}, {
 			Name:                 "Query only for labels assigned if label filter defined only with keys (multiple) - intersect set",
 			ReturnSetCombination: IntersectSet,
 			FilterInput:          []*labelfilter.LabelFilter{&filterAllFoos, &filterAllBars},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterAllFoos.Key + `'` +
-				` INTERSECT ` + stmtPrefix + ` AND "key" = '` + filterAllBars.Key + `'`,
-			ExpectedError: nil,
+			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = $2` + ` INTERSECT ` + stmtPrefix + ` AND "key" = $3`,
+			ExpectedArgs:         []interface{}{"Foo", "Bar"},
 		}, {
 			Name:                 "Query only for labels assigned if label filter defined only with keys (multiple) - union set",
 			ReturnSetCombination: UnionSet,
 			FilterInput:          []*labelfilter.LabelFilter{&filterAllFoos, &filterAllBars},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterAllFoos.Key + `'` +
-				` UNION ` + stmtPrefix + ` AND "key" = '` + filterAllBars.Key + `'`,
-			ExpectedError: nil,
+			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = $2` + ` UNION ` + stmtPrefix + ` AND "key" = $3`,
+			ExpectedArgs:         []interface{}{"Foo", "Bar"},
 		}, {
-			Name:                 "Query for label assigned with value - intersect set",
+			Name:                 "Query for label assigned with value",
 			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterFoosWithValues},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterFoosWithValues.Key + `' AND "value" @> '` + *filterFoosWithValues.Query + `'`,
-			ExpectedError:        nil,
-		}, {
-			Name:                 "Query for label assigned with value - union set",
-			ReturnSetCombination: UnionSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterFoosWithValues},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterFoosWithValues.Key + `' AND "value" @> '` + *filterFoosWithValues.Query + `'`,
-			ExpectedError:        nil,
+			FilterInput:          []*labelfilter.LabelFilter{&filterScenariosWithFooValues},
+			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = $2 AND ` + scenarioCondition("3"),
+			ExpectedArgs:         []interface{}{"Scenarios", `"foo"`},
 		}, {
-			Name:                 "Query for labels assigned with values (multiple) - intersect set",
+			Name:                 "Query for label assigned with number comparison",
 			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterFoosWithValues, &filterBarsWithValues},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterFoosWithValues.Key + `' AND "value" @> '` + *filterFoosWithValues.Query + `'` +
-				` INTERSECT ` + stmtPrefix + ` AND "key" = '` + filterBarsWithValues.Key + `' AND "value" @> '` + *filterBarsWithValues.Query + `'`,
-			ExpectedError: nil,
+			FilterInput:          []*labelfilter.LabelFilter{&filterSizeWithValues},
+			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = $2 AND ` + sizeCondition("3"),
+			ExpectedArgs:         []interface{}{"Size", "10"},
 		}, {
 			Name:                 "Query for labels assigned with values (multiple) - union set",
 			ReturnSetCombination: UnionSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterFoosWithValues, &filterBarsWithValues},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterFoosWithValues.Key + `' AND "value" @> '` + *filterFoosWithValues.Query + `'` +
-				` UNION ` + stmtPrefix + ` AND "key" = '` + filterBarsWithValues.Key + `' AND "value" @> '` + *filterBarsWithValues.Query + `'`,
-			ExpectedError: nil,
+			FilterInput:          []*labelfilter.LabelFilter{&filterScenariosWithFooValues, &filterScenariosWithBarPongValues},
+			ExpectedQueryFilter: stmtPrefix + ` AND "key" = $2 AND ` + scenarioCondition("3") +
+				` UNION ` + stmtPrefix + ` AND "key" = $4 AND ` + scenarioCondition("5"),
+			ExpectedArgs: []interface{}{"Scenarios", `"foo"`, "Scenarios", `"bar pong"`},
 		}, {
-			Name:                 "[Scenarios] Query for label assigned",
+			Name:                 "Query for nested groups",
 			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterAllScenarios},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterAllScenarios.Key + `'`,
-			ExpectedError:        nil,
+			FilterInput: []*labelfilter.LabelFilter{
+				&filterAllFoos,
+				{Or: []*labelfilter.LabelFilter{
+					&filterAllBars,
+					{And: []*labelfilter.LabelFilter{&filterSizeWithValues, &filterScenariosWithFooValues}},
+				}},
+			},
+			ExpectedQueryFilter: stmtPrefix + ` AND "key" = $2` + ` INTERSECT (` +
+				stmtPrefix + ` AND "key" = $3` + ` UNION (` +
+				stmtPrefix + ` AND "key" = $4 AND ` + sizeCondition("5") + ` INTERSECT ` +
+				stmtPrefix + ` AND "key" = $6 AND ` + scenarioCondition("7") + `))`,
+			ExpectedArgs: []interface{}{"Foo", "Bar", "Size", "10", "Scenarios", `"foo"`},
 		}, {
-			Name:                 "[Scenarios] Query for label assigned with value",
+			Name:                 "Query for negated filter",
 			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterScenariosWithFooValues},
-			ExpectedQueryFilter:  stmtPrefix + ` AND "key" = '` + filterScenariosWithFooValues.Key + `' AND "value" @> '["foo"]'`,
-			ExpectedError:        nil,
-		}, {
-			Name:                 "[Scenarios] Query for label assigned with values",
-			ReturnSetCombination: IntersectSet,
-			FilterInput:          []*labelfilter.LabelFilter{&filterScenariosWithFooValues, &filterScenariosWithbarPongValues},
-			ExpectedQueryFilter: stmtPrefix + ` AND "key" = '` + filterScenariosWithFooValues.Key + `' AND "value" @> '["foo"]'` +
-				` INTERSECT ` + stmtPrefix + ` AND "key" = '` + filterScenariosWithbarPongValues.Key + `' AND "value" @> '["bar pong"]'`,
-			ExpectedError: nil,
+			FilterInput:          []*labelfilter.LabelFilter{{Not: &filterAllFoos}},
+			ExpectedQueryFilter: `(SELECT "id" FROM public.runtimes WHERE "tenant_id" = '` + tenantID.String() + `'` +
+				` EXCEPT (` + stmtPrefix + ` AND "key" = $2))`,
+			ExpectedArgs: []interface{}{"Foo"},
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			queryFilter, err := FilterQuery(model.RuntimeLabelableObject, testCase.ReturnSetCombination, tenantID, testCase.FilterInput)
+			queryFilter, args, err := FilterQuery(model.RuntimeLabelableObject, testCase.ReturnSetCombination, tenantID, testCase.FilterInput, 2)
 
+			require.NoError(t, err)
 			assert.Equal(t, testCase.ExpectedQueryFilter, queryFilter)
-			assert.Equal(t, testCase.ExpectedError, err)
+			assert.Equal(t, testCase.ExpectedArgs, args)
 		})
 	}
 }
+
+func Test_FilterQuery_ReturnsErrorWhenQueryIsInvalid(t *testing.T) {
+	// given
+	query := `$[*] ? (@ == "foo" &&)`
+	filter := []*labelfilter.LabelFilter{{Key: "Scenarios", Query: &query}}
+
+	// when
+	_, _, err := FilterQuery(model.RuntimeLabelableObject, IntersectSet, uuid.New(), filter, 2)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, `while parsing query of label filter with key Scenarios: unexpected ")", expected predicate at position 22`)
+}
+
+func Test_FilterQuery_ReturnsErrorWhenGroupIsEmpty(t *testing.T) {
+	// given
+	filter := []*labelfilter.LabelFilter{{Key: "foo"}, {Or: []*labelfilter.LabelFilter{}}}
+
+	// when
+	_, _, err := FilterQuery(model.RuntimeLabelableObject, IntersectSet, uuid.New(), filter, 2)
+
+	// then
+	require.EqualError(t, err, "label filter group cannot be empty")
+}
+
+func Test_FilterQuery_NegatedFilterForIntegrationSystems(t *testing.T) {
+	// given
+	tenantID := uuid.New()
+	filter := []*labelfilter.LabelFilter{{Not: &labelfilter.LabelFilter{Key: "Foo"}}}
+
+	// when
+	queryFilter, args, err := FilterQuery(model.IntegrationSystemLabelableObject, IntersectSet, tenantID, filter, 1)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `(SELECT "id" FROM public.integration_systems EXCEPT (SELECT "int_sys_id" FROM public.labels `+
+		`WHERE "int_sys_id" IS NOT NULL AND "tenant_id" = '`+tenantID.String()+`' AND "key" = $1))`, queryFilter)
+	assert.Equal(t, []interface{}{"Foo"}, args)
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
@@ -17,7 +19,18 @@ import (
 
 const tableName string = "public.labels"
 
-var tableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "key", "value"}
+var tableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "api_def_id", "event_api_def_id", "int_sys_id", "key", "value"}
+
+// conflictingColumns has to match the unique index on the labels table
+var conflictingColumns = []string{
+	"tenant_id",
+	"coalesce(app_id, '00000000-0000-0000-0000-000000000000')",
+	"coalesce(runtime_id, '00000000-0000-0000-0000-000000000000')",
+	"coalesce(api_def_id, '00000000-0000-0000-0000-000000000000')",
+	"coalesce(event_api_def_id, '00000000-0000-0000-0000-000000000000')",
+	"coalesce(int_sys_id, '00000000-0000-0000-0000-000000000000')",
+	"key",
+}
 
 //go:generate mockery -name=Converter -output=automock -outpkg=automock -case=underscore
 type Converter interface {
@@ -33,7 +46,7 @@ type repository struct {
 
 func NewRepository(conv Converter) *repository {
 	return &repository{
-		upserter: repo.NewUpserter(tableName, tableColumns, []string{"tenant_id", "coalesce(app_id, '00000000-0000-0000-0000-000000000000')", "coalesce(runtime_id, '00000000-0000-0000-0000-000000000000')", "key"}, []string{"value"}),
+		upserter: repo.NewUpserter(tableName, tableColumns, conflictingColumns, []string{"value"}),
 		conv:     conv,
 	}
 }
@@ -135,6 +148,32 @@ func (r *repository) ListByKey(ctx context.Context, tenant, key string) ([]*mode
 	return labels, nil
 }
 
+// ListMatchingObjectIDs returns IDs of objects of given type which match the filter
+func (r *repository) ListMatchingObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, filter *labelfilter.LabelFilter) ([]string, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching persistence from context")
+	}
+
+	tenantID, err := uuid.Parse(tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing tenant as UUID")
+	}
+
+	filterSubquery, args, err := FilterQuery(objectType, IntersectSet, tenantID, []*labelfilter.LabelFilter{filter}, 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building filter query")
+	}
+
+	var ids []string
+	err = persist.Select(&ids, fmt.Sprintf(`SELECT matching.id FROM (%s) AS matching(id)`, filterSubquery), args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing %s objects matching the filter", objectType)
+	}
+
+	return ids, nil
+}
+
 func (r *repository) Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
@@ -180,6 +219,29 @@ func labelableObjectField(objectType model.LabelableObject) string {
 		return "app_id"
 	case model.RuntimeLabelableObject:
 		return "runtime_id"
+	case model.APIDefinitionLabelableObject:
+		return "api_def_id"
+	case model.EventAPIDefinitionLabelableObject:
+		return "event_api_def_id"
+	case model.IntegrationSystemLabelableObject:
+		return "int_sys_id"
+	}
+
+	return ""
+}
+
+func labelableObjectTable(objectType model.LabelableObject) string {
+	switch objectType {
+	case model.ApplicationLabelableObject:
+		return "public.applications"
+	case model.RuntimeLabelableObject:
+		return "public.runtimes"
+	case model.APIDefinitionLabelableObject:
+		return "public.api_definitions"
+	case model.EventAPIDefinitionLabelableObject:
+		return "public.event_api_definitions"
+	case model.IntegrationSystemLabelableObject:
+		return "public.integration_systems"
 	}
 
 	return ""
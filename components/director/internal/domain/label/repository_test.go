@@ -11,6 +11,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/pkg/errors"
@@ -56,8 +57,8 @@ func TestRepository_Upsert(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.labels ( id, tenant_id, app_id, runtime_id, key, value ) VALUES ( ?, ?, ?, ?, ?, ? ) ON CONFLICT ( tenant_id, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), key ) DO UPDATE SET value=EXCLUDED.value`)
-		dbMock.ExpectExec(escapedQuery).WithArgs(labelEntity.ID, labelEntity.TenantID, labelEntity.AppID, labelEntity.RuntimeID, labelEntity.Key, labelEntity.Value).WillReturnResult(sqlmock.NewResult(1, 1))
+		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.labels ( id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( tenant_id, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), coalesce(api_def_id, '00000000-0000-0000-0000-000000000000'), coalesce(event_api_def_id, '00000000-0000-0000-0000-000000000000'), coalesce(int_sys_id, '00000000-0000-0000-0000-000000000000'), key ) DO UPDATE SET value=EXCLUDED.value`)
+		dbMock.ExpectExec(escapedQuery).WithArgs(labelEntity.ID, labelEntity.TenantID, labelEntity.AppID, labelEntity.RuntimeID, labelEntity.APIDefID, labelEntity.EventAPIDefID, labelEntity.IntSysID, labelEntity.Key, labelEntity.Value).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		ctx := context.TODO()
 		ctx = persistence.SaveToContext(ctx, db)
@@ -104,8 +105,8 @@ func TestRepository_Upsert(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.labels ( id, tenant_id, app_id, runtime_id, key, value ) VALUES ( ?, ?, ?, ?, ?, ? ) ON CONFLICT ( tenant_id, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), key ) DO UPDATE SET value=EXCLUDED.value`)
-		dbMock.ExpectExec(escapedQuery).WithArgs(labelEntity.ID, labelEntity.TenantID, labelEntity.AppID, labelEntity.RuntimeID, labelEntity.Key, labelEntity.Value).WillReturnResult(sqlmock.NewResult(1, 1))
+		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.labels ( id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( tenant_id, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), coalesce(api_def_id, '00000000-0000-0000-0000-000000000000'), coalesce(event_api_def_id, '00000000-0000-0000-0000-000000000000'), coalesce(int_sys_id, '00000000-0000-0000-0000-000000000000'), key ) DO UPDATE SET value=EXCLUDED.value`)
+		dbMock.ExpectExec(escapedQuery).WithArgs(labelEntity.ID, labelEntity.TenantID, labelEntity.AppID, labelEntity.RuntimeID, labelEntity.APIDefID, labelEntity.EventAPIDefID, labelEntity.IntSysID, labelEntity.Key, labelEntity.Value).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		ctx := context.TODO()
 		ctx = persistence.SaveToContext(ctx, db)
@@ -153,8 +154,8 @@ func TestRepository_Upsert(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.labels ( id, tenant_id, app_id, runtime_id, key, value ) VALUES ( ?, ?, ?, ?, ?, ? ) ON CONFLICT ( tenant_id, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), key ) DO UPDATE SET value=EXCLUDED.value`)
-		dbMock.ExpectExec(escapedQuery).WithArgs(labelEntity.ID, labelEntity.TenantID, labelEntity.AppID, labelEntity.RuntimeID, labelEntity.Key, labelEntity.Value).WillReturnError(testErr)
+		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.labels ( id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( tenant_id, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), coalesce(api_def_id, '00000000-0000-0000-0000-000000000000'), coalesce(event_api_def_id, '00000000-0000-0000-0000-000000000000'), coalesce(int_sys_id, '00000000-0000-0000-0000-000000000000'), key ) DO UPDATE SET value=EXCLUDED.value`)
+		dbMock.ExpectExec(escapedQuery).WithArgs(labelEntity.ID, labelEntity.TenantID, labelEntity.AppID, labelEntity.RuntimeID, labelEntity.APIDefID, labelEntity.EventAPIDefID, labelEntity.IntSysID, labelEntity.Key, labelEntity.Value).WillReturnError(testErr)
 
 		ctx := context.TODO()
 		ctx = persistence.SaveToContext(ctx, db)
@@ -202,7 +203,7 @@ func TestRepository_GetByKey(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE key = $1 AND runtime_id = $2 AND tenant_id = $3`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE key = $1 AND runtime_id = $2 AND tenant_id = $3`)
 		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id"}).AddRow(id, tnt, key, value, nil, objID)
 		dbMock.ExpectQuery(escapedQuery).WithArgs(key, sql.NullString{Valid: true, String: objID}, tnt).WillReturnRows(mockedRows)
 
@@ -251,7 +252,7 @@ func TestRepository_GetByKey(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE key = $1 AND app_id = $2 AND tenant_id = $3`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE key = $1 AND app_id = $2 AND tenant_id = $3`)
 		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id"}).AddRow(id, tnt, key, value, objID, nil)
 		dbMock.ExpectQuery(escapedQuery).WithArgs(key, sql.NullString{Valid: true, String: objID}, tnt).WillReturnRows(mockedRows)
 
@@ -279,7 +280,7 @@ func TestRepository_GetByKey(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE key = $1 AND app_id = $2 AND tenant_id = $3`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE key = $1 AND app_id = $2 AND tenant_id = $3`)
 		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id"})
 		dbMock.ExpectQuery(escapedQuery).WithArgs(key, sql.NullString{Valid: true, String: objID}, tnt).WillReturnRows(mockedRows)
 
@@ -303,7 +304,7 @@ func TestRepository_GetByKey(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE key = $1 AND app_id = $2 AND tenant_id = $3`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE key = $1 AND app_id = $2 AND tenant_id = $3`)
 		dbMock.ExpectQuery(escapedQuery).WithArgs(key, sql.NullString{Valid: true, String: objID}, tnt).WillReturnError(errors.New("persistence error"))
 
 		ctx := context.TODO()
@@ -356,7 +357,7 @@ func TestRepository_ListForObject(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE runtime_id = $1 AND tenant_id = $2`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE runtime_id = $1 AND tenant_id = $2`)
 		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id"}).
 			AddRow("1", tnt, "foo", "test1", nil, objID).
 			AddRow("2", tnt, "bar", "test2", nil, objID)
@@ -397,7 +398,7 @@ func TestRepository_ListForObject(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE app_id = $1 AND tenant_id = $2`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE app_id = $1 AND tenant_id = $2`)
 		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id"}).
 			AddRow("1", tnt, "foo", "test1", objID, nil).
 			AddRow("2", tnt, "bar", "test2", objID, nil)
@@ -427,7 +428,7 @@ func TestRepository_ListForObject(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE app_id = $1 AND tenant_id = $2`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE app_id = $1 AND tenant_id = $2`)
 		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id"})
 		dbMock.ExpectQuery(escapedQuery).WithArgs(sql.NullString{Valid: true, String: objID}, tnt).WillReturnRows(mockedRows)
 
@@ -450,7 +451,7 @@ func TestRepository_ListForObject(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE app_id = $1 AND tenant_id = $2`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE app_id = $1 AND tenant_id = $2`)
 		dbMock.ExpectQuery(escapedQuery).WithArgs(sql.NullString{Valid: true, String: objID}, tnt).WillReturnError(errors.New("persistence error"))
 
 		ctx := context.TODO()
@@ -503,7 +504,7 @@ func TestRepository_ListByKey(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE key = $1 AND tenant_id = $2`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE key = $1 AND tenant_id = $2`)
 		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id"}).
 			AddRow("1", tnt, labelKey, "test1", nil, rtmObjID).
 			AddRow("2", tnt, labelKey, "test2", appObjID, nil)
@@ -530,7 +531,7 @@ func TestRepository_ListByKey(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE key = $1 AND tenant_id = $2`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE key = $1 AND tenant_id = $2`)
 		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id"})
 		dbMock.ExpectQuery(escapedQuery).WithArgs("key", tnt).WillReturnRows(mockedRows)
 
@@ -551,7 +552,7 @@ func TestRepository_ListByKey(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, key, value FROM public.labels WHERE key = $1 AND tenant_id = $2`)
+		escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id, key, value FROM public.labels WHERE key = $1 AND tenant_id = $2`)
 		dbMock.ExpectQuery(escapedQuery).WithArgs("key", tnt).WillReturnError(errors.New("persistence error"))
 
 		ctx := context.TODO()
@@ -575,6 +576,70 @@ func TestRepository_ListByKey(t *testing.T) {
 	})
 }
 
+func TestRepository_ListMatchingObjectIDs(t *testing.T) {
+	tnt := "dddddddd-dddd-dddd-dddd-dddddddddddd"
+	filter := &labelfilter.LabelFilter{Key: "region"}
+	escapedQuery := regexp.QuoteMeta(`SELECT matching.id FROM (SELECT "app_id" FROM public.labels WHERE "app_id" IS NOT NULL AND "tenant_id" = 'dddddddd-dddd-dddd-dddd-dddddddddddd' AND "key" = $1) AS matching(id)`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		repo := label.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		mockedRows := sqlmock.NewRows([]string{"id"}).AddRow("foo").AddRow("bar")
+		dbMock.ExpectQuery(escapedQuery).WithArgs("region").WillReturnRows(mockedRows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		actual, err := repo.ListMatchingObjectIDs(ctx, tnt, model.ApplicationLabelableObject, filter)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo", "bar"}, actual)
+	})
+
+	t.Run("Error - Select error", func(t *testing.T) {
+		// GIVEN
+		repo := label.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(escapedQuery).WithArgs("region").WillReturnError(errors.New("persistence error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		_, err := repo.ListMatchingObjectIDs(ctx, tnt, model.ApplicationLabelableObject, filter)
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "persistence error")
+	})
+
+	t.Run("Error - Invalid tenant", func(t *testing.T) {
+		// GIVEN
+		repo := label.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		_, err := repo.ListMatchingObjectIDs(ctx, "tenant", model.ApplicationLabelableObject, filter)
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while parsing tenant as UUID")
+	})
+
+	t.Run("Error - Missing persistence", func(t *testing.T) {
+		// GIVEN
+		repo := label.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.ListMatchingObjectIDs(context.TODO(), tnt, model.ApplicationLabelableObject, filter)
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unable to fetch database from context")
+	})
+}
+
 func TestRepository_Delete(t *testing.T) {
 	t.Run("Success - Label for Runtime", func(t *testing.T) {
 		// GIVEN
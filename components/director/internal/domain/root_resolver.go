@@ -13,9 +13,12 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apidiff"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apiruntimeauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/bulklabel"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changefeed"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventapi"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
@@ -25,9 +28,12 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
 	"github.com/kyma-incubator/compass/components/director/internal/graphql_client"
 	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
@@ -37,22 +43,28 @@ import (
 var _ graphql.ResolverRoot = &RootResolver{}
 
 type RootResolver struct {
-	app         *application.Resolver
-	appTemplate *apptemplate.Resolver
-	api         *api.Resolver
-	eventAPI    *eventapi.Resolver
-	doc         *document.Resolver
-	runtime     *runtime.Resolver
-	healthCheck *healthcheck.Resolver
-	webhook     *webhook.Resolver
-	labelDef    *labeldef.Resolver
-	token       *onetimetoken.Resolver
-	systemAuth  *systemauth.Resolver
-	oAuth20     *oauth20.Resolver
-	intSys      *integrationsystem.Resolver
-}
-
-func NewRootResolver(transact persistence.Transactioner, scopeCfgProvider *scope.Provider, oneTimeTokenCfg onetimetoken.Config, oAuth20Cfg oauth20.Config, eventCfg event.Config) *RootResolver {
+	app                *application.Resolver
+	appTemplate        *apptemplate.Resolver
+	api                *api.Resolver
+	apiDiff            *apidiff.Resolver
+	eventAPI           *eventapi.Resolver
+	doc                *document.Resolver
+	runtime            *runtime.Resolver
+	healthCheck        *healthcheck.Resolver
+	webhook            *webhook.Resolver
+	labelDef           *labeldef.Resolver
+	token              *onetimetoken.Resolver
+	systemAuth         *systemauth.Resolver
+	oAuth20            *oauth20.Resolver
+	intSys             *integrationsystem.Resolver
+	webhookDelivery    *webhookdelivery.Resolver
+	changeFeed         *changefeed.Resolver
+	scenarioAssignment *scenarioassignment.Resolver
+	scenario           *scenario.Resolver
+	bulkLabel          *bulklabel.Resolver
+}
+
+func NewRootResolver(transact persistence.Transactioner, scopeCfgProvider *scope.Provider, oneTimeTokenCfg onetimetoken.Config, oAuth20Cfg oauth20.Config, eventCfg event.Config, fetchRequestCfg fetchrequest.Config, outboundClient fetchrequest.HTTPClient, changeSubscriber changefeed.ChangeSubscriber) *RootResolver {
 	authConverter := auth.NewConverter()
 	apiRtmAuthConverter := apiruntimeauth.NewConverter(authConverter)
 	runtimeConverter := runtime.NewConverter()
@@ -62,15 +74,21 @@ func NewRootResolver(transact persistence.Transactioner, scopeCfgProvider *scope
 	webhookConverter := webhook.NewConverter(authConverter)
 	apiConverter := api.NewConverter(authConverter, frConverter, versionConverter)
 	eventAPIConverter := eventapi.NewConverter(frConverter, versionConverter)
-	appConverter := application.NewConverter(webhookConverter, apiConverter, eventAPIConverter, docConverter)
+	appConverter := application.NewConverter(webhookConverter, apiConverter, eventAPIConverter, docConverter, authConverter)
 	labelDefConverter := labeldef.NewConverter()
 	labelConverter := label.NewConverter()
 	tokenConverter := onetimetoken.NewConverter()
 	systemAuthConverter := systemauth.NewConverter(authConverter)
 	intSysConverter := integrationsystem.NewConverter()
 	appTemplateConverter := apptemplate.NewConverter(appConverter)
-
-	healthcheckRepo := healthcheck.NewRepository()
+	apiDiffConverter := apidiff.NewConverter()
+	webhookDeliveryConverter := webhookdelivery.NewConverter()
+	healthCheckConverter := healthcheck.NewConverter()
+	changeEventConverter := changefeed.NewConverter()
+	scenarioAssignmentConverter := scenarioassignment.NewConverter()
+	scenarioConverter := scenario.NewConverter()
+
+	healthcheckRepo := healthcheck.NewRepository(healthCheckConverter)
 	runtimeRepo := runtime.NewRepository()
 	applicationRepo := application.NewRepository(appConverter)
 	appTemplateRepo := apptemplate.NewRepository(appTemplateConverter)
@@ -82,8 +100,10 @@ func NewRootResolver(transact persistence.Transactioner, scopeCfgProvider *scope
 	docRepo := document.NewRepository(docConverter)
 	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
 	apiRtmAuthRepo := apiruntimeauth.NewRepository(apiRtmAuthConverter)
+	webhookDeliveryRepo := webhookdelivery.NewRepository(webhookDeliveryConverter)
 	systemAuthRepo := systemauth.NewRepository(systemAuthConverter)
 	intSysRepo := integrationsystem.NewRepository(intSysConverter)
+	scenarioAssignmentRepo := scenarioassignment.NewRepository(scenarioAssignmentConverter)
 
 	connectorGCLI := graphql_client.NewGraphQLClient(oneTimeTokenCfg.OneTimeTokenURL)
 
@@ -91,34 +111,48 @@ func NewRootResolver(transact persistence.Transactioner, scopeCfgProvider *scope
 	apiRtmAuthSvc := apiruntimeauth.NewService(apiRtmAuthRepo, uidSvc)
 	labelUpsertSvc := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidSvc)
 	scenariosSvc := labeldef.NewScenariosService(labelDefRepo, uidSvc)
-	appSvc := application.NewService(applicationRepo, webhookRepo, apiRepo, eventAPIRepo, docRepo, runtimeRepo, labelRepo, fetchRequestRepo, intSysRepo, labelUpsertSvc, scenariosSvc, uidSvc)
-	appTemplateSvc := apptemplate.NewService(appTemplateRepo, uidSvc)
-	apiSvc := api.NewService(apiRepo, fetchRequestRepo, uidSvc)
-	eventAPISvc := eventapi.NewService(eventAPIRepo, fetchRequestRepo, uidSvc)
+	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, outboundClient, fetchRequestCfg)
+	webhookDeliverySvc := webhookdelivery.NewService(webhookDeliveryRepo, webhookRepo, uidSvc)
+	scenarioAssignmentEngine := scenarioassignment.NewEngine(scenarioAssignmentRepo, labelRepo, labelUpsertSvc, webhookDeliverySvc)
+	scenarioAssignmentSvc := scenarioassignment.NewService(scenarioAssignmentRepo, labelDefRepo, scenarioAssignmentEngine, uidSvc)
+	appSvc := application.NewService(applicationRepo, webhookRepo, apiRepo, eventAPIRepo, docRepo, runtimeRepo, labelRepo, fetchRequestRepo, intSysRepo, labelUpsertSvc, scenariosSvc, fetchRequestSvc, uidSvc, scenarioAssignmentEngine, webhookDeliverySvc)
+	appTemplateSvc := apptemplate.NewService(appTemplateRepo, uidSvc, scopeCfgProvider)
+	apiSvc := api.NewService(apiRepo, fetchRequestRepo, fetchRequestSvc, uidSvc, labelRepo, labelUpsertSvc, webhookDeliverySvc)
+	eventAPISvc := eventapi.NewService(eventAPIRepo, fetchRequestRepo, fetchRequestSvc, uidSvc, labelRepo, labelUpsertSvc, webhookDeliverySvc)
 	webhookSvc := webhook.NewService(webhookRepo, uidSvc)
-	docSvc := document.NewService(docRepo, fetchRequestRepo, uidSvc)
-	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc)
+	docSvc := document.NewService(docRepo, fetchRequestRepo, fetchRequestSvc, uidSvc, webhookDeliverySvc)
+	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc, scenarioAssignmentEngine)
 	healthCheckSvc := healthcheck.NewService(healthcheckRepo)
 	labelDefSvc := labeldef.NewService(labelDefRepo, labelRepo, uidSvc)
 	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
 	tokenSvc := onetimetoken.NewTokenService(connectorGCLI, systemAuthSvc, oneTimeTokenCfg.ConnectorURL)
 	oAuth20Svc := oauth20.NewService(scopeCfgProvider, uidSvc, oAuth20Cfg)
-	intSysSvc := integrationsystem.NewService(intSysRepo, uidSvc)
+	intSysSvc := integrationsystem.NewService(intSysRepo, labelRepo, labelUpsertSvc, uidSvc)
+	apiDiffSvc := apidiff.NewService(apiRepo, eventAPIRepo)
+	scenarioSvc := scenario.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, scenariosSvc, appSvc, runtimeSvc)
+	eventSvc := event.NewService(labelRepo, eventCfg.DefaultEventURL)
+	bulkLabelSvc := bulklabel.NewService(labelRepo, appSvc, runtimeSvc)
 
 	return &RootResolver{
-		app:         application.NewResolver(transact, appSvc, apiSvc, eventAPISvc, docSvc, webhookSvc, systemAuthSvc, oAuth20Svc, appConverter, docConverter, webhookConverter, apiConverter, eventAPIConverter, systemAuthConverter, eventCfg.DefaultEventURL),
-		appTemplate: apptemplate.NewResolver(transact, appTemplateSvc, appTemplateConverter),
-		api:         api.NewResolver(transact, apiSvc, appSvc, runtimeSvc, apiRtmAuthSvc, apiConverter, authConverter, frConverter, apiRtmAuthConverter),
-		eventAPI:    eventapi.NewResolver(transact, eventAPISvc, appSvc, eventAPIConverter, frConverter),
-		doc:         document.NewResolver(transact, docSvc, appSvc, frConverter),
-		runtime:     runtime.NewResolver(transact, runtimeSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter),
-		healthCheck: healthcheck.NewResolver(healthCheckSvc),
-		webhook:     webhook.NewResolver(transact, webhookSvc, appSvc, webhookConverter),
-		labelDef:    labeldef.NewResolver(transact, labelDefSvc, labelDefConverter),
-		token:       onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter),
-		systemAuth:  systemauth.NewResolver(transact, systemAuthSvc, oAuth20Svc, systemAuthConverter),
-		oAuth20:     oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, intSysSvc, systemAuthSvc, systemAuthConverter),
-		intSys:      integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter),
+		app:                application.NewResolver(transact, appSvc, apiSvc, eventAPISvc, docSvc, webhookSvc, systemAuthSvc, oAuth20Svc, appConverter, docConverter, webhookConverter, apiConverter, eventAPIConverter, systemAuthConverter, eventSvc, webhookDeliverySvc, fetchRequestSvc),
+		appTemplate:        apptemplate.NewResolver(transact, appSvc, appConverter, appTemplateSvc, appTemplateConverter, webhookDeliverySvc, fetchRequestSvc),
+		api:                api.NewResolver(transact, apiSvc, appSvc, runtimeSvc, apiRtmAuthSvc, apiConverter, authConverter, frConverter, apiRtmAuthConverter, fetchRequestSvc),
+		apiDiff:            apidiff.NewResolver(transact, apiDiffSvc, apiDiffConverter),
+		eventAPI:           eventapi.NewResolver(transact, eventAPISvc, appSvc, eventAPIConverter, frConverter, fetchRequestSvc),
+		doc:                document.NewResolver(transact, docSvc, appSvc, frConverter, fetchRequestSvc),
+		runtime:            runtime.NewResolver(transact, runtimeSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter),
+		healthCheck:        healthcheck.NewResolver(transact, healthCheckSvc, healthCheckConverter),
+		webhook:            webhook.NewResolver(transact, webhookSvc, appSvc, webhookConverter),
+		labelDef:           labeldef.NewResolver(transact, labelDefSvc, labelDefConverter),
+		token:              onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter),
+		systemAuth:         systemauth.NewResolver(transact, systemAuthSvc, oAuth20Svc, systemAuthConverter),
+		oAuth20:            oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, intSysSvc, systemAuthSvc, systemAuthConverter),
+		intSys:             integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter),
+		webhookDelivery:    webhookdelivery.NewResolver(transact, webhookDeliverySvc, webhookDeliveryConverter),
+		changeFeed:         changefeed.NewResolver(transact, changeSubscriber, appSvc, runtimeSvc, labelRepo, changeEventConverter, scope.NewDirective(scopeCfgProvider)),
+		scenarioAssignment: scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, scenarioAssignmentConverter),
+		scenario:           scenario.NewResolver(transact, scenarioSvc, scenarioConverter),
+		bulkLabel:          bulklabel.NewResolver(transact, bulkLabelSvc, scope.NewDirective(scopeCfgProvider)),
 	}
 }
 
@@ -128,6 +162,9 @@ func (r *RootResolver) Mutation() graphql.MutationResolver {
 func (r *RootResolver) Query() graphql.QueryResolver {
 	return &queryResolver{r}
 }
+func (r *RootResolver) Subscription() graphql.SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
 func (r *RootResolver) Application() graphql.ApplicationResolver {
 	return &applicationResolver{r}
 }
@@ -143,6 +180,9 @@ func (r *RootResolver) APISpec() graphql.APISpecResolver {
 func (r *RootResolver) Document() graphql.DocumentResolver {
 	return &documentResolver{r}
 }
+func (r *RootResolver) EventAPIDefinition() graphql.EventAPIDefinitionResolver {
+	return &eventAPIDefinitionResolver{r}
+}
 func (r *RootResolver) EventAPISpec() graphql.EventAPISpecResolver {
 	return &eventAPISpecResolver{r}
 }
@@ -150,13 +190,16 @@ func (r *RootResolver) EventAPISpec() graphql.EventAPISpecResolver {
 func (r *RootResolver) IntegrationSystem() graphql.IntegrationSystemResolver {
 	return &integrationSystemResolver{r}
 }
+func (r *RootResolver) WebhookDelivery() graphql.WebhookDeliveryResolver {
+	return &webhookDeliveryResolver{r}
+}
 
 type queryResolver struct {
 	*RootResolver
 }
 
-func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
-	return r.app.Applications(ctx, filter, first, after)
+func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, filterExpression *graphql.LabelFilterExpression, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	return r.app.Applications(ctx, filter, filterExpression, first, after)
 }
 func (r *queryResolver) Application(ctx context.Context, id string) (*graphql.Application, error) {
 	return r.app.Application(ctx, id)
@@ -170,8 +213,8 @@ func (r *queryResolver) ApplicationTemplate(ctx context.Context, id string) (*gr
 func (r *queryResolver) ApplicationsForRuntime(ctx context.Context, runtimeID string, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
 	return r.app.ApplicationsForRuntime(ctx, runtimeID, first, after)
 }
-func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
-	return r.runtime.Runtimes(ctx, filter, first, after)
+func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, filterExpression *graphql.LabelFilterExpression, statusConditions []graphql.RuntimeStatusCondition, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+	return r.runtime.Runtimes(ctx, filter, filterExpression, statusConditions, first, after)
 }
 func (r *queryResolver) Runtime(ctx context.Context, id string) (*graphql.Runtime, error) {
 	return r.runtime.Runtime(ctx, id)
@@ -185,12 +228,33 @@ func (r *queryResolver) LabelDefinition(ctx context.Context, key string) (*graph
 func (r *queryResolver) HealthChecks(ctx context.Context, types []graphql.HealthCheckType, origin *string, first *int, after *graphql.PageCursor) (*graphql.HealthCheckPage, error) {
 	return r.healthCheck.HealthChecks(ctx, types, origin, first, after)
 }
-func (r *queryResolver) IntegrationSystems(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.IntegrationSystemPage, error) {
-	return r.intSys.IntegrationSystems(ctx, first, after)
+func (r *queryResolver) IntegrationSystems(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.IntegrationSystemPage, error) {
+	return r.intSys.IntegrationSystems(ctx, filter, first, after)
 }
 func (r *queryResolver) IntegrationSystem(ctx context.Context, id string) (*graphql.IntegrationSystem, error) {
 	return r.intSys.IntegrationSystem(ctx, id)
 }
+func (r *queryResolver) APIDiff(ctx context.Context, fromID string, toID string) (*graphql.APIDiff, error) {
+	return r.apiDiff.APIDiff(ctx, fromID, toID)
+}
+func (r *queryResolver) EventAPIDiff(ctx context.Context, fromID string, toID string) (*graphql.APIDiff, error) {
+	return r.apiDiff.EventAPIDiff(ctx, fromID, toID)
+}
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, webhookID string, first *int, after *graphql.PageCursor) (*graphql.WebhookDeliveryPage, error) {
+	return r.webhookDelivery.WebhookDeliveries(ctx, webhookID, first, after)
+}
+func (r *queryResolver) ScenarioAssignments(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.ScenarioAssignmentPage, error) {
+	return r.scenarioAssignment.ScenarioAssignments(ctx, first, after)
+}
+func (r *queryResolver) ScenarioAssignment(ctx context.Context, id string) (*graphql.ScenarioAssignment, error) {
+	return r.scenarioAssignment.ScenarioAssignment(ctx, id)
+}
+func (r *queryResolver) Scenarios(ctx context.Context) ([]*graphql.Scenario, error) {
+	return r.scenario.Scenarios(ctx)
+}
+func (r *queryResolver) Scenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	return r.scenario.Scenario(ctx, name)
+}
 
 type mutationResolver struct {
 	*RootResolver
@@ -205,6 +269,12 @@ func (r *mutationResolver) UpdateApplication(ctx context.Context, id string, in
 func (r *mutationResolver) DeleteApplication(ctx context.Context, id string) (*graphql.Application, error) {
 	return r.app.DeleteApplication(ctx, id)
 }
+func (r *mutationResolver) ConfirmApplicationDeletion(ctx context.Context, id string) (*graphql.Application, error) {
+	return r.app.ConfirmApplicationDeletion(ctx, id)
+}
+func (r *mutationResolver) ReportApplicationStatus(ctx context.Context, id string, condition graphql.ApplicationStatusCondition) (*graphql.Application, error) {
+	return r.app.ReportApplicationStatus(ctx, id, condition)
+}
 func (r *mutationResolver) CreateApplicationTemplate(ctx context.Context, in graphql.ApplicationTemplateInput) (*graphql.ApplicationTemplate, error) {
 	return r.appTemplate.CreateApplicationTemplate(ctx, in)
 }
@@ -214,6 +284,12 @@ func (r *mutationResolver) UpdateApplicationTemplate(ctx context.Context, id str
 func (r *mutationResolver) DeleteApplicationTemplate(ctx context.Context, id string) (*graphql.ApplicationTemplate, error) {
 	return r.appTemplate.DeleteApplicationTemplate(ctx, id)
 }
+func (r *mutationResolver) RegisterApplicationFromTemplate(ctx context.Context, templateName string, values []*graphql.TemplateValueInput) (*graphql.Application, error) {
+	return r.appTemplate.RegisterApplicationFromTemplate(ctx, templateName, values)
+}
+func (r *mutationResolver) UpgradeApplicationFromTemplate(ctx context.Context, appID string, values []*graphql.TemplateValueInput) (*graphql.Application, error) {
+	return r.appTemplate.UpgradeApplicationFromTemplate(ctx, appID, values)
+}
 func (r *mutationResolver) AddWebhook(ctx context.Context, applicationID string, in graphql.WebhookInput) (*graphql.Webhook, error) {
 	return r.webhook.AddApplicationWebhook(ctx, applicationID, in)
 }
@@ -262,6 +338,12 @@ func (r *mutationResolver) UpdateRuntime(ctx context.Context, id string, in grap
 func (r *mutationResolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
 	return r.runtime.DeleteRuntime(ctx, id)
 }
+func (r *mutationResolver) ReportRuntimeStatus(ctx context.Context, id string, condition graphql.RuntimeStatusCondition) (*graphql.Runtime, error) {
+	return r.runtime.ReportRuntimeStatus(ctx, id, condition)
+}
+func (r *mutationResolver) ReportRuntimeHeartbeat(ctx context.Context, id string, in graphql.RuntimeHeartbeatInput) (*graphql.Runtime, error) {
+	return r.runtime.ReportRuntimeHeartbeat(ctx, id, in)
+}
 func (r *mutationResolver) AddDocument(ctx context.Context, applicationID string, in graphql.DocumentInput) (*graphql.Document, error) {
 	return r.doc.AddDocument(ctx, applicationID, in)
 }
@@ -289,6 +371,30 @@ func (r *mutationResolver) SetRuntimeLabel(ctx context.Context, runtimeID string
 func (r *mutationResolver) DeleteRuntimeLabel(ctx context.Context, runtimeID string, key string) (*graphql.Label, error) {
 	return r.runtime.DeleteRuntimeLabel(ctx, runtimeID, key)
 }
+func (r *mutationResolver) SetAPILabel(ctx context.Context, apiID string, key string, value interface{}) (*graphql.Label, error) {
+	return r.api.SetAPILabel(ctx, apiID, key, value)
+}
+func (r *mutationResolver) DeleteAPILabel(ctx context.Context, apiID string, key string) (*graphql.Label, error) {
+	return r.api.DeleteAPILabel(ctx, apiID, key)
+}
+func (r *mutationResolver) SetEventAPILabel(ctx context.Context, eventAPIID string, key string, value interface{}) (*graphql.Label, error) {
+	return r.eventAPI.SetEventAPILabel(ctx, eventAPIID, key, value)
+}
+func (r *mutationResolver) DeleteEventAPILabel(ctx context.Context, eventAPIID string, key string) (*graphql.Label, error) {
+	return r.eventAPI.DeleteEventAPILabel(ctx, eventAPIID, key)
+}
+func (r *mutationResolver) SetIntegrationSystemLabel(ctx context.Context, integrationSystemID string, key string, value interface{}) (*graphql.Label, error) {
+	return r.intSys.SetIntegrationSystemLabel(ctx, integrationSystemID, key, value)
+}
+func (r *mutationResolver) DeleteIntegrationSystemLabel(ctx context.Context, integrationSystemID string, key string) (*graphql.Label, error) {
+	return r.intSys.DeleteIntegrationSystemLabel(ctx, integrationSystemID, key)
+}
+func (r *mutationResolver) SetLabelsByFilter(ctx context.Context, objectType graphql.LabelableObject, filter graphql.LabelFilterExpression, key string, value interface{}, dryRun *bool) ([]string, error) {
+	return r.bulkLabel.SetLabelsByFilter(ctx, objectType, filter, key, value, dryRun)
+}
+func (r *mutationResolver) DeleteLabelsByFilter(ctx context.Context, objectType graphql.LabelableObject, filter graphql.LabelFilterExpression, key string, dryRun *bool) ([]string, error) {
+	return r.bulkLabel.DeleteLabelsByFilter(ctx, objectType, filter, key, dryRun)
+}
 func (r *mutationResolver) GenerateOneTimeTokenForApplication(ctx context.Context, id string) (*graphql.OneTimeToken, error) {
 	return r.token.GenerateOneTimeTokenForApplication(ctx, id)
 }
@@ -325,6 +431,38 @@ func (r *mutationResolver) UpdateIntegrationSystem(ctx context.Context, id strin
 func (r *mutationResolver) DeleteIntegrationSystem(ctx context.Context, id string) (*graphql.IntegrationSystem, error) {
 	return r.intSys.DeleteIntegrationSystem(ctx, id)
 }
+func (r *mutationResolver) CreateScenarioAssignment(ctx context.Context, in graphql.ScenarioAssignmentInput) (*graphql.ScenarioAssignment, error) {
+	return r.scenarioAssignment.CreateScenarioAssignment(ctx, in)
+}
+func (r *mutationResolver) UpdateScenarioAssignment(ctx context.Context, id string, in graphql.ScenarioAssignmentInput) (*graphql.ScenarioAssignment, error) {
+	return r.scenarioAssignment.UpdateScenarioAssignment(ctx, id, in)
+}
+func (r *mutationResolver) DeleteScenarioAssignment(ctx context.Context, id string) (*graphql.ScenarioAssignment, error) {
+	return r.scenarioAssignment.DeleteScenarioAssignment(ctx, id)
+}
+func (r *mutationResolver) CreateScenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	return r.scenario.CreateScenario(ctx, name)
+}
+func (r *mutationResolver) DeleteScenario(ctx context.Context, name string, removeFromLabels *bool) (*graphql.Scenario, error) {
+	return r.scenario.DeleteScenario(ctx, name, removeFromLabels)
+}
+func (r *mutationResolver) RenameScenario(ctx context.Context, name string, newName string) (*graphql.Scenario, error) {
+	return r.scenario.RenameScenario(ctx, name, newName)
+}
+
+type subscriptionResolver struct {
+	*RootResolver
+}
+
+func (r *subscriptionResolver) ApplicationChanged(ctx context.Context, id string) (<-chan *graphql.ApplicationEvent, error) {
+	return r.changeFeed.ApplicationChanged(ctx, id)
+}
+func (r *subscriptionResolver) ApplicationsForRuntimeChanged(ctx context.Context, runtimeID string) (<-chan *graphql.ApplicationEvent, error) {
+	return r.changeFeed.ApplicationsForRuntimeChanged(ctx, runtimeID)
+}
+func (r *subscriptionResolver) LabelChanged(ctx context.Context, objectType graphql.LabelableObject, objectID string) (<-chan *graphql.LabelEvent, error) {
+	return r.changeFeed.LabelChanged(ctx, objectType, objectID)
+}
 
 type applicationResolver struct {
 	*RootResolver
@@ -340,11 +478,11 @@ func (r *applicationResolver) Labels(ctx context.Context, obj *graphql.Applicati
 func (r *applicationResolver) Webhooks(ctx context.Context, obj *graphql.Application) ([]*graphql.Webhook, error) {
 	return r.app.Webhooks(ctx, obj)
 }
-func (r *applicationResolver) Apis(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
-	return r.app.Apis(ctx, obj, group, first, after)
+func (r *applicationResolver) Apis(ctx context.Context, obj *graphql.Application, group *string, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
+	return r.app.Apis(ctx, obj, group, filter, first, after)
 }
-func (r *applicationResolver) EventAPIs(ctx context.Context, obj *graphql.Application, group *string, first *int, after *graphql.PageCursor) (*graphql.EventAPIDefinitionPage, error) {
-	return r.app.EventAPIs(ctx, obj, group, first, after)
+func (r *applicationResolver) EventAPIs(ctx context.Context, obj *graphql.Application, group *string, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.EventAPIDefinitionPage, error) {
+	return r.app.EventAPIs(ctx, obj, group, filter, first, after)
 }
 func (r *applicationResolver) API(ctx context.Context, obj *graphql.Application, id string) (*graphql.APIDefinition, error) {
 	return r.app.API(ctx, id, obj)
@@ -382,9 +520,16 @@ func (r *apiDefinitionResolver) Auth(ctx context.Context, obj *graphql.APIDefini
 func (r *apiDefinitionResolver) Auths(ctx context.Context, obj *graphql.APIDefinition) ([]*graphql.APIRuntimeAuth, error) {
 	return r.api.Auths(ctx, obj)
 }
+func (r *apiDefinitionResolver) Labels(ctx context.Context, obj *graphql.APIDefinition, key *string) (graphql.Labels, error) {
+	return r.api.Labels(ctx, obj, key)
+}
 
 type apiSpecResolver struct{ *RootResolver }
 
+func (r *apiSpecResolver) Data(ctx context.Context, obj *graphql.APISpec, format *graphql.SpecFormat) (*graphql.CLOB, error) {
+	return r.api.Data(ctx, obj, format)
+}
+
 func (r *apiSpecResolver) FetchRequest(ctx context.Context, obj *graphql.APISpec) (*graphql.FetchRequest, error) {
 	return r.api.FetchRequest(ctx, obj)
 }
@@ -395,8 +540,18 @@ func (r *documentResolver) FetchRequest(ctx context.Context, obj *graphql.Docume
 	return r.doc.FetchRequest(ctx, obj)
 }
 
+type eventAPIDefinitionResolver struct{ *RootResolver }
+
+func (r *eventAPIDefinitionResolver) Labels(ctx context.Context, obj *graphql.EventAPIDefinition, key *string) (graphql.Labels, error) {
+	return r.eventAPI.Labels(ctx, obj, key)
+}
+
 type eventAPISpecResolver struct{ *RootResolver }
 
+func (r *eventAPISpecResolver) Data(ctx context.Context, obj *graphql.EventAPISpec, format *graphql.SpecFormat) (*graphql.CLOB, error) {
+	return r.eventAPI.Data(ctx, obj, format)
+}
+
 func (r *eventAPISpecResolver) FetchRequest(ctx context.Context, obj *graphql.EventAPISpec) (*graphql.FetchRequest, error) {
 	return r.eventAPI.FetchRequest(ctx, obj)
 }
@@ -406,3 +561,12 @@ type integrationSystemResolver struct{ *RootResolver }
 func (r *integrationSystemResolver) Auths(ctx context.Context, obj *graphql.IntegrationSystem) ([]*graphql.SystemAuth, error) {
 	return r.intSys.Auths(ctx, obj)
 }
+func (r *integrationSystemResolver) Labels(ctx context.Context, obj *graphql.IntegrationSystem, key *string) (graphql.Labels, error) {
+	return r.intSys.Labels(ctx, obj, key)
+}
+
+type webhookDeliveryResolver struct{ *RootResolver }
+
+func (r *webhookDeliveryResolver) Attempts(ctx context.Context, obj *graphql.WebhookDelivery) ([]*graphql.WebhookDeliveryAttempt, error) {
+	return r.webhookDelivery.Attempts(ctx, obj)
+}
@@ -0,0 +1,38 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// HeartbeatRepository is an autogenerated mock type for the HeartbeatRepository type
+type HeartbeatRepository struct {
+	mock.Mock
+}
+
+// UpdateStatusIfHeartbeatOlderThan provides a mock function with given fields: ctx, status, currentConditions, heartbeatBefore
+func (_m *HeartbeatRepository) UpdateStatusIfHeartbeatOlderThan(ctx context.Context, status model.RuntimeStatus, currentConditions []model.RuntimeStatusCondition, heartbeatBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, status, currentConditions, heartbeatBefore)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, model.RuntimeStatus, []model.RuntimeStatusCondition, time.Time) int64); ok {
+		r0 = rf(ctx, status, currentConditions, heartbeatBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.RuntimeStatus, []model.RuntimeStatusCondition, time.Time) error); ok {
+		r1 = rf(ctx, status, currentConditions, heartbeatBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
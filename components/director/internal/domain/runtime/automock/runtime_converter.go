@@ -11,6 +11,20 @@ type RuntimeConverter struct {
 	mock.Mock
 }
 
+// HeartbeatInputFromGraphQL provides a mock function with given fields: in
+func (_m *RuntimeConverter) HeartbeatInputFromGraphQL(in graphql.RuntimeHeartbeatInput) model.RuntimeHeartbeatInput {
+	ret := _m.Called(in)
+
+	var r0 model.RuntimeHeartbeatInput
+	if rf, ok := ret.Get(0).(func(graphql.RuntimeHeartbeatInput) model.RuntimeHeartbeatInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.RuntimeHeartbeatInput)
+	}
+
+	return r0
+}
+
 // InputFromGraphQL provides a mock function with given fields: in
 func (_m *RuntimeConverter) InputFromGraphQL(in graphql.RuntimeInput) model.RuntimeInput {
 	ret := _m.Called(in)
@@ -2,10 +2,13 @@
 
 package automock
 
-import context "context"
-import labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // RuntimeRepository is an autogenerated mock type for the RuntimeRepository type
 type RuntimeRepository struct {
@@ -84,13 +87,13 @@ func (_m *RuntimeRepository) GetByID(ctx context.Context, tenant string, id stri
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, tenant, filter, conditions, pageSize, cursor
+func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, conditions []model.RuntimeStatusCondition, pageSize int, cursor string) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, tenant, filter, conditions, pageSize, cursor)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, []model.RuntimeStatusCondition, int, string) *model.RuntimePage); ok {
+		r0 = rf(ctx, tenant, filter, conditions, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -98,8 +101,8 @@ func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, []model.RuntimeStatusCondition, int, string) error); ok {
+		r1 = rf(ctx, tenant, filter, conditions, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -120,3 +123,31 @@ func (_m *RuntimeRepository) Update(ctx context.Context, item *model.Runtime) er
 
 	return r0
 }
+
+// UpdateHeartbeat provides a mock function with given fields: ctx, tenant, id, heartbeat
+func (_m *RuntimeRepository) UpdateHeartbeat(ctx context.Context, tenant string, id string, heartbeat model.RuntimeHeartbeat) error {
+	ret := _m.Called(ctx, tenant, id, heartbeat)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.RuntimeHeartbeat) error); ok {
+		r0 = rf(ctx, tenant, id, heartbeat)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatusIfCondition provides a mock function with given fields: ctx, tenant, id, status, currentConditions
+func (_m *RuntimeRepository) UpdateStatusIfCondition(ctx context.Context, tenant string, id string, status model.RuntimeStatus, currentConditions []model.RuntimeStatusCondition) error {
+	ret := _m.Called(ctx, tenant, id, status, currentConditions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.RuntimeStatus, []model.RuntimeStatusCondition) error); ok {
+		r0 = rf(ctx, tenant, id, status, currentConditions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -107,13 +107,13 @@ func (_m *RuntimeService) GetLabel(ctx context.Context, runtimeID string, key st
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, filter, pageSize, cursor
-func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, filter, conditions, pageSize, cursor
+func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, conditions []model.RuntimeStatusCondition, pageSize int, cursor string) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, filter, conditions, pageSize, cursor)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, []model.RuntimeStatusCondition, int, string) *model.RuntimePage); ok {
+		r0 = rf(ctx, filter, conditions, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -121,8 +121,8 @@ func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelF
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, []model.RuntimeStatusCondition, int, string) error); ok {
+		r1 = rf(ctx, filter, conditions, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -153,6 +153,20 @@ func (_m *RuntimeService) ListLabels(ctx context.Context, runtimeID string) (map
 	return r0, r1
 }
 
+// ReportHeartbeat provides a mock function with given fields: ctx, id, in
+func (_m *RuntimeService) ReportHeartbeat(ctx context.Context, id string, in model.RuntimeHeartbeatInput) error {
+	ret := _m.Called(ctx, id, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.RuntimeHeartbeatInput) error); ok {
+		r0 = rf(ctx, id, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLabel provides a mock function with given fields: ctx, label
 func (_m *RuntimeService) SetLabel(ctx context.Context, label *model.LabelInput) error {
 	ret := _m.Called(ctx, label)
@@ -167,6 +181,20 @@ func (_m *RuntimeService) SetLabel(ctx context.Context, label *model.LabelInput)
 	return r0
 }
 
+// SetStatusCondition provides a mock function with given fields: ctx, id, condition
+func (_m *RuntimeService) SetStatusCondition(ctx context.Context, id string, condition model.RuntimeStatusCondition) error {
+	ret := _m.Called(ctx, id, condition)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.RuntimeStatusCondition) error); ok {
+		r0 = rf(ctx, id, condition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *RuntimeService) Update(ctx context.Context, id string, in model.RuntimeInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -0,0 +1,29 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioAssignmentEngine is an autogenerated mock type for the ScenarioAssignmentEngine type
+type ScenarioAssignmentEngine struct {
+	mock.Mock
+}
+
+// EvaluateForObject provides a mock function with given fields: ctx, tenant, objectType, objectID
+func (_m *ScenarioAssignmentEngine) EvaluateForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) error {
+	ret := _m.Called(ctx, tenant, objectType, objectID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, string) error); ok {
+		r0 = rf(ctx, tenant, objectType, objectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,8 @@
+package runtime
+
+import "time"
+
+type HeartbeatConfig struct {
+	Period  time.Duration `envconfig:"default=1m"`
+	Timeout time.Duration `envconfig:"default=5m"`
+}
@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"time"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
@@ -21,6 +23,7 @@ func (c *converter) ToGraphQL(in *model.Runtime) *graphql.Runtime {
 		Status:      c.statusToGraphQL(in.Status),
 		Name:        in.Name,
 		Description: in.Description,
+		Heartbeat:   c.heartbeatToGraphQL(in.Heartbeat),
 	}
 }
 
@@ -50,6 +53,47 @@ func (c *converter) InputFromGraphQL(in graphql.RuntimeInput) model.RuntimeInput
 	}
 }
 
+func (c *converter) HeartbeatInputFromGraphQL(in graphql.RuntimeHeartbeatInput) model.RuntimeHeartbeatInput {
+	var connection *model.RuntimeConnection
+	if in.Connection != nil {
+		connection = &model.RuntimeConnection{
+			Endpoint: in.Connection.Endpoint,
+		}
+		if in.Connection.CertificateExpiresAt != nil {
+			expiresAt := time.Time(*in.Connection.CertificateExpiresAt)
+			connection.CertificateExpiresAt = &expiresAt
+		}
+	}
+
+	return model.RuntimeHeartbeatInput{
+		AgentVersion: in.AgentVersion,
+		Connection:   connection,
+	}
+}
+
+func (c *converter) heartbeatToGraphQL(in *model.RuntimeHeartbeat) *graphql.RuntimeHeartbeat {
+	if in == nil {
+		return nil
+	}
+
+	var connection *graphql.RuntimeConnection
+	if in.Connection != nil {
+		connection = &graphql.RuntimeConnection{
+			Endpoint: in.Connection.Endpoint,
+		}
+		if in.Connection.CertificateExpiresAt != nil {
+			expiresAt := graphql.Timestamp(*in.Connection.CertificateExpiresAt)
+			connection.CertificateExpiresAt = &expiresAt
+		}
+	}
+
+	return &graphql.RuntimeHeartbeat{
+		AgentVersion: in.AgentVersion,
+		Connection:   connection,
+		Timestamp:    graphql.Timestamp(in.Timestamp),
+	}
+}
+
 func (c *converter) statusToGraphQL(in *model.RuntimeStatus) *graphql.RuntimeStatus {
 	if in == nil {
 		return &graphql.RuntimeStatus{
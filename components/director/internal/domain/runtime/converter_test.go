@@ -108,3 +108,34 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_HeartbeatInputFromGraphQL(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    graphql.RuntimeHeartbeatInput
+		Expected model.RuntimeHeartbeatInput
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixGQLRuntimeHeartbeatInput(),
+			Expected: fixModelRuntimeHeartbeatInput(),
+		},
+		{
+			Name:     "Empty",
+			Input:    graphql.RuntimeHeartbeatInput{},
+			Expected: model.RuntimeHeartbeatInput{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			converter := runtime.NewConverter()
+			res := converter.HeartbeatInputFromGraphQL(testCase.Input)
+
+			// then
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
@@ -2,21 +2,26 @@ package runtime
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
 )
 
 type runtimeStatusCondition string
 
 // Runtime struct represents database entity for Runtime
 type Runtime struct {
-	ID              string         `db:"id"`
-	TenantID        string         `db:"tenant_id"`
-	Name            string         `db:"name"`
-	Description     sql.NullString `db:"description"`
-	StatusCondition string         `db:"status_condition"`
-	StatusTimestamp time.Time      `db:"status_timestamp"`
+	ID                    string         `db:"id"`
+	TenantID              string         `db:"tenant_id"`
+	Name                  string         `db:"name"`
+	Description           sql.NullString `db:"description"`
+	StatusCondition       string         `db:"status_condition"`
+	StatusTimestamp       time.Time      `db:"status_timestamp"`
+	HeartbeatAgentVersion sql.NullString `db:"heartbeat_agent_version"`
+	HeartbeatConnection   sql.NullString `db:"heartbeat_connection"`
+	HeartbeatTimestamp    *time.Time     `db:"heartbeat_timestamp"`
 }
 
 // EntityFromRuntimeModel converts Runtime model to Runtime entity
@@ -29,14 +34,21 @@ func EntityFromRuntimeModel(model *model.Runtime) (*Runtime, error) {
 		}
 	}
 
-	return &Runtime{
+	runtime := &Runtime{
 		ID:              model.ID,
 		TenantID:        model.Tenant,
 		Name:            model.Name,
 		Description:     nullDescription,
 		StatusCondition: string(model.Status.Condition),
 		StatusTimestamp: model.Status.Timestamp,
-	}, nil
+	}
+
+	err := runtime.setHeartbeat(model.Heartbeat)
+	if err != nil {
+		return nil, err
+	}
+
+	return runtime, nil
 }
 
 // ToModel converts Runtime entity to Runtime model
@@ -47,6 +59,11 @@ func (e Runtime) ToModel() (*model.Runtime, error) {
 		*description = e.Description.String
 	}
 
+	heartbeat, err := e.heartbeatToModel()
+	if err != nil {
+		return nil, err
+	}
+
 	return &model.Runtime{
 		ID:          e.ID,
 		Tenant:      e.TenantID,
@@ -56,5 +73,49 @@ func (e Runtime) ToModel() (*model.Runtime, error) {
 			Condition: model.RuntimeStatusCondition(e.StatusCondition),
 			Timestamp: e.StatusTimestamp,
 		},
+		Heartbeat: heartbeat,
 	}, nil
 }
+
+func (e *Runtime) setHeartbeat(in *model.RuntimeHeartbeat) error {
+	if in == nil {
+		return nil
+	}
+
+	e.HeartbeatAgentVersion = sql.NullString{String: in.AgentVersion, Valid: true}
+	timestamp := in.Timestamp
+	e.HeartbeatTimestamp = &timestamp
+
+	if in.Connection == nil {
+		return nil
+	}
+
+	connection, err := json.Marshal(in.Connection)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling heartbeat connection")
+	}
+	e.HeartbeatConnection = sql.NullString{String: string(connection), Valid: true}
+
+	return nil
+}
+
+func (e Runtime) heartbeatToModel() (*model.RuntimeHeartbeat, error) {
+	if e.HeartbeatTimestamp == nil {
+		return nil, nil
+	}
+
+	heartbeat := &model.RuntimeHeartbeat{
+		AgentVersion: e.HeartbeatAgentVersion.String,
+		Timestamp:    *e.HeartbeatTimestamp,
+	}
+
+	if e.HeartbeatConnection.Valid {
+		heartbeat.Connection = &model.RuntimeConnection{}
+		err := json.Unmarshal([]byte(e.HeartbeatConnection.String), heartbeat.Connection)
+		if err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling heartbeat connection")
+		}
+	}
+
+	return heartbeat, nil
+}
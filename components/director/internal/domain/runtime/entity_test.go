@@ -136,3 +136,54 @@ func TestEntity_RuntimeToModel_RuntimeWithoutDescription(t *testing.T) {
 	assert.Equal(t, entityRuntime.StatusCondition, string(modelRuntime.Status.Condition))
 	assert.Equal(t, entityRuntime.StatusTimestamp, modelRuntime.Status.Timestamp)
 }
+
+func TestEntity_Heartbeat(t *testing.T) {
+	// given
+	timestamp := time.Date(2019, 11, 29, 12, 0, 0, 0, time.UTC)
+	endpoint := "https://agent.kyma.local"
+
+	modelRuntime := model.Runtime{
+		ID:     uuid.New().String(),
+		Tenant: uuid.New().String(),
+		Name:   "Runtime XYZ",
+		Status: &model.RuntimeStatus{
+			Condition: model.RuntimeStatusConditionReady,
+			Timestamp: timestamp,
+		},
+		Heartbeat: &model.RuntimeHeartbeat{
+			AgentVersion: "1.7.0",
+			Connection:   &model.RuntimeConnection{Endpoint: &endpoint},
+			Timestamp:    timestamp,
+		},
+	}
+
+	// when
+	entityRuntime, err := runtime.EntityFromRuntimeModel(&modelRuntime)
+	require.NoError(t, err)
+	result, err := entityRuntime.ToModel()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, sql.NullString{String: "1.7.0", Valid: true}, entityRuntime.HeartbeatAgentVersion)
+	assert.True(t, entityRuntime.HeartbeatConnection.Valid)
+	require.NotNil(t, entityRuntime.HeartbeatTimestamp)
+	assert.Equal(t, timestamp, *entityRuntime.HeartbeatTimestamp)
+	assert.Equal(t, modelRuntime.Heartbeat, result.Heartbeat)
+}
+
+func TestEntity_RuntimeToModel_RuntimeWithoutHeartbeat(t *testing.T) {
+	// given
+	entityRuntime := runtime.Runtime{
+		ID:              uuid.New().String(),
+		TenantID:        uuid.New().String(),
+		Name:            "Runtime AZE",
+		StatusCondition: "INITIAL",
+	}
+
+	// when
+	modelRuntime, err := entityRuntime.ToModel()
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, modelRuntime.Heartbeat)
+}
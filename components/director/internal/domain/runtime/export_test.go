@@ -0,0 +1,11 @@
+package runtime
+
+import "time"
+
+func (s *service) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
+
+func (s *heartbeatSweeper) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
@@ -70,6 +70,7 @@ func fixDetailedModelRuntime(t *testing.T, id, name, description string) *model.
 		Name:        name,
 		Description: &description,
 		Tenant:      "tenant",
+		Heartbeat:   fixModelRuntimeHeartbeat(time),
 	}
 }
 
@@ -85,6 +86,7 @@ func fixDetailedGQLRuntime(t *testing.T, id, name, description string) *graphql.
 		},
 		Name:        name,
 		Description: &description,
+		Heartbeat:   fixGQLRuntimeHeartbeat(time),
 	}
 }
 
@@ -110,6 +112,51 @@ func fixGQLRuntimeInput(name, description string) graphql.RuntimeInput {
 	}
 }
 
+func fixModelRuntimeHeartbeat(timestamp time.Time) *model.RuntimeHeartbeat {
+	input := fixModelRuntimeHeartbeatInput()
+	return input.ToRuntimeHeartbeat(timestamp)
+}
+
+func fixGQLRuntimeHeartbeat(timestamp time.Time) *graphql.RuntimeHeartbeat {
+	endpoint := "https://agent.kyma.local"
+	expiresAt := graphql.Timestamp(time.Date(2020, 11, 29, 12, 0, 0, 0, time.UTC))
+
+	return &graphql.RuntimeHeartbeat{
+		AgentVersion: "1.7.0",
+		Connection: &graphql.RuntimeConnection{
+			Endpoint:             &endpoint,
+			CertificateExpiresAt: &expiresAt,
+		},
+		Timestamp: graphql.Timestamp(timestamp),
+	}
+}
+
+func fixModelRuntimeHeartbeatInput() model.RuntimeHeartbeatInput {
+	endpoint := "https://agent.kyma.local"
+	expiresAt := time.Date(2020, 11, 29, 12, 0, 0, 0, time.UTC)
+
+	return model.RuntimeHeartbeatInput{
+		AgentVersion: "1.7.0",
+		Connection: &model.RuntimeConnection{
+			Endpoint:             &endpoint,
+			CertificateExpiresAt: &expiresAt,
+		},
+	}
+}
+
+func fixGQLRuntimeHeartbeatInput() graphql.RuntimeHeartbeatInput {
+	endpoint := "https://agent.kyma.local"
+	expiresAt := graphql.Timestamp(time.Date(2020, 11, 29, 12, 0, 0, 0, time.UTC))
+
+	return graphql.RuntimeHeartbeatInput{
+		AgentVersion: "1.7.0",
+		Connection: &graphql.RuntimeConnectionInput{
+			Endpoint:             &endpoint,
+			CertificateExpiresAt: &expiresAt,
+		},
+	}
+}
+
 func fixApplicationPage(applications []*model.Application) *model.ApplicationPage {
 	return &model.ApplicationPage{
 		Data: applications,
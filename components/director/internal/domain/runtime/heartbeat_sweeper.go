@@ -0,0 +1,66 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=HeartbeatRepository -output=automock -outpkg=automock -case=underscore
+type HeartbeatRepository interface {
+	UpdateStatusIfHeartbeatOlderThan(ctx context.Context, status model.RuntimeStatus, currentConditions []model.RuntimeStatusCondition, heartbeatBefore time.Time) (int64, error)
+}
+
+type heartbeatSweeper struct {
+	transact     persistence.Transactioner
+	repo         HeartbeatRepository
+	timeout      time.Duration
+	timestampGen timestamp.Generator
+}
+
+func NewHeartbeatSweeper(transact persistence.Transactioner, repo HeartbeatRepository, timeout time.Duration) *heartbeatSweeper {
+	return &heartbeatSweeper{
+		transact:     transact,
+		repo:         repo,
+		timeout:      timeout,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// MarkStaleAsFailed makes FAILED every READY Runtime which has not reported a heartbeat within the timeout.
+func (s *heartbeatSweeper) MarkStaleAsFailed(ctx context.Context) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer s.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	now := s.timestampGen()
+	status := model.RuntimeStatus{
+		Condition: model.RuntimeStatusConditionFailed,
+		Timestamp: now,
+	}
+	currentConditions := []model.RuntimeStatusCondition{model.RuntimeStatusConditionReady}
+
+	updated, err := s.repo.UpdateStatusIfHeartbeatOlderThan(ctx, status, currentConditions, now.Add(-s.timeout))
+	if err != nil {
+		return errors.Wrap(err, "while updating status of Runtimes with stale heartbeat")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "while committing transaction")
+	}
+
+	if updated > 0 {
+		log.Infof("%d Runtime(s) marked as FAILED, because they did not report a heartbeat within %s", updated, s.timeout)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeartbeatSweeper_MarkStaleAsFailed(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	timestamp := time.Date(2019, 11, 29, 12, 0, 0, 0, time.UTC)
+	timeout := 5 * time.Minute
+	heartbeatBefore := timestamp.Add(-timeout)
+	status := model.RuntimeStatus{Condition: model.RuntimeStatusConditionFailed, Timestamp: timestamp}
+	currentConditions := []model.RuntimeStatusCondition{model.RuntimeStatusConditionReady}
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := txGen.ThatSucceeds()
+
+		repo := &automock.HeartbeatRepository{}
+		repo.On("UpdateStatusIfHeartbeatOlderThan", txtest.CtxWithDBMatcher(), status, currentConditions, heartbeatBefore).Return(int64(2), nil).Once()
+
+		sweeper := runtime.NewHeartbeatSweeper(transact, repo, timeout)
+		sweeper.SetTimestampGen(func() time.Time { return timestamp })
+
+		// when
+		err := sweeper.MarkStaleAsFailed(context.TODO())
+
+		// then
+		require.NoError(t, err)
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when updating status failed", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntExpectCommit()
+
+		repo := &automock.HeartbeatRepository{}
+		repo.On("UpdateStatusIfHeartbeatOlderThan", txtest.CtxWithDBMatcher(), status, currentConditions, heartbeatBefore).Return(int64(0), testErr).Once()
+
+		sweeper := runtime.NewHeartbeatSweeper(transact, repo, timeout)
+		sweeper.SetTimestampGen(func() time.Time { return timestamp })
+
+		// when
+		err := sweeper.MarkStaleAsFailed(context.TODO())
+
+		// then
+		require.EqualError(t, err, "while updating status of Runtimes with stale heartbeat: Test error")
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when committing transaction failed", func(t *testing.T) {
+		persistTx, transact := txGen.ThatFailsOnCommit()
+
+		repo := &automock.HeartbeatRepository{}
+		repo.On("UpdateStatusIfHeartbeatOlderThan", txtest.CtxWithDBMatcher(), status, currentConditions, heartbeatBefore).Return(int64(0), nil).Once()
+
+		sweeper := runtime.NewHeartbeatSweeper(transact, repo, timeout)
+		sweeper.SetTimestampGen(func() time.Time { return timestamp })
+
+		// when
+		err := sweeper.MarkStaleAsFailed(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while committing transaction")
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+}
@@ -3,10 +3,14 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
@@ -16,27 +20,29 @@ import (
 const runtimeTable string = `public.runtimes`
 
 var (
-	runtimeColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp"}
+	runtimeColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "heartbeat_agent_version", "heartbeat_connection", "heartbeat_timestamp"}
 	tenantColumn   = "tenant_id"
 )
 
 type pgRepository struct {
-	existQuerier    repo.ExistQuerier
-	singleGetter    repo.SingleGetter
-	deleter         repo.Deleter
-	pageableQuerier repo.PageableQuerier
-	creator         repo.Creator
-	updater         repo.Updater
+	existQuerier     repo.ExistQuerier
+	singleGetter     repo.SingleGetter
+	deleter          repo.Deleter
+	pageableQuerier  repo.PageableQuerier
+	creator          repo.Creator
+	updater          repo.Updater
+	heartbeatUpdater repo.Updater
 }
 
 func NewRepository() *pgRepository {
 	return &pgRepository{
-		existQuerier:    repo.NewExistQuerier(runtimeTable, tenantColumn),
-		singleGetter:    repo.NewSingleGetter(runtimeTable, tenantColumn, runtimeColumns),
-		deleter:         repo.NewDeleter(runtimeTable, tenantColumn),
-		pageableQuerier: repo.NewPageableQuerier(runtimeTable, tenantColumn, runtimeColumns),
-		creator:         repo.NewCreator(runtimeTable, runtimeColumns),
-		updater:         repo.NewUpdater(runtimeTable, []string{"name", "description", "status_condition", "status_timestamp"}, tenantColumn, []string{"id"}),
+		existQuerier:     repo.NewExistQuerier(runtimeTable, tenantColumn),
+		singleGetter:     repo.NewSingleGetter(runtimeTable, tenantColumn, runtimeColumns),
+		deleter:          repo.NewDeleter(runtimeTable, tenantColumn),
+		pageableQuerier:  repo.NewPageableQuerier(runtimeTable, tenantColumn, runtimeColumns),
+		creator:          repo.NewCreator(runtimeTable, runtimeColumns),
+		updater:          repo.NewUpdater(runtimeTable, []string{"name", "description", "status_condition", "status_timestamp"}, tenantColumn, []string{"id"}),
+		heartbeatUpdater: repo.NewUpdater(runtimeTable, []string{"heartbeat_agent_version", "heartbeat_connection", "heartbeat_timestamp"}, tenantColumn, []string{"id"}),
 	}
 }
 
@@ -68,13 +74,15 @@ func (r RuntimeCollection) Len() int {
 	return len(r)
 }
 
-func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
+// List returns the Runtimes matching the label filter and in one of the given status conditions.
+// Empty conditions match Runtimes in any status condition.
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, conditions []model.RuntimeStatusCondition, pageSize int, cursor string) (*model.RuntimePage, error) {
 	var runtimesCollection RuntimeCollection
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
 		return nil, errors.Wrap(err, "while parsing tenant as UUID")
 	}
-	filterSubquery, err := label.FilterQuery(model.RuntimeLabelableObject, label.IntersectSet, tenantID, filter)
+	filterSubquery, args, err := label.FilterQuery(model.RuntimeLabelableObject, label.IntersectSet, tenantID, filter, 2)
 	if err != nil {
 		return nil, errors.Wrap(err, "while building filter query")
 	}
@@ -82,8 +90,15 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 	if filterSubquery != "" {
 		additionalConditions = append(additionalConditions, fmt.Sprintf(`"id" IN (%s)`, filterSubquery))
 	}
+	if len(conditions) > 0 {
+		var quotedConditions []string
+		for _, condition := range conditions {
+			quotedConditions = append(quotedConditions, pq.QuoteLiteral(string(condition)))
+		}
+		additionalConditions = append(additionalConditions, fmt.Sprintf("status_condition IN (%s)", strings.Join(quotedConditions, ", ")))
+	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &runtimesCollection, additionalConditions...)
+	page, totalCount, err := r.pageableQuerier.ListWithArgs(ctx, tenant, pageSize, cursor, "id", &runtimesCollection, additionalConditions, args)
 
 	if err != nil {
 		return nil, err
@@ -125,3 +140,65 @@ func (r *pgRepository) Update(ctx context.Context, item *model.Runtime) error {
 	}
 	return r.updater.UpdateSingle(ctx, runtimeEnt)
 }
+
+// UpdateStatusIfCondition sets the status of the Runtime only if its current status condition is one of the given conditions
+func (r *pgRepository) UpdateStatusIfCondition(ctx context.Context, tenant, id string, status model.RuntimeStatus, currentConditions []model.RuntimeStatusCondition) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	conditions := make([]string, 0, len(currentConditions))
+	for _, condition := range currentConditions {
+		conditions = append(conditions, string(condition))
+	}
+
+	stmt := fmt.Sprintf(`UPDATE %s SET status_condition = $1, status_timestamp = $2 WHERE tenant_id = $3 AND id = $4 AND status_condition::text = ANY($5)`, runtimeTable)
+
+	_, err = persist.Exec(stmt, string(status.Condition), status.Timestamp, tenant, id, pq.Array(conditions))
+	if err != nil {
+		return errors.Wrap(err, "while updating Runtime status")
+	}
+
+	return nil
+}
+
+// UpdateHeartbeat stores the heartbeat of the Runtime, leaving its other fields unchanged
+func (r *pgRepository) UpdateHeartbeat(ctx context.Context, tenant, id string, heartbeat model.RuntimeHeartbeat) error {
+	runtimeEnt := &Runtime{ID: id, TenantID: tenant}
+	err := runtimeEnt.setHeartbeat(&heartbeat)
+	if err != nil {
+		return errors.Wrap(err, "while creating runtime entity from heartbeat")
+	}
+
+	return r.heartbeatUpdater.UpdateSingle(ctx, runtimeEnt)
+}
+
+// UpdateStatusIfHeartbeatOlderThan sets the status of every Runtime, in all tenants, whose current status condition is one of the given conditions
+// and whose last heartbeat is older than the given time. Runtimes which have never reported a heartbeat are not updated.
+// It returns the number of updated Runtimes.
+func (r *pgRepository) UpdateStatusIfHeartbeatOlderThan(ctx context.Context, status model.RuntimeStatus, currentConditions []model.RuntimeStatusCondition, heartbeatBefore time.Time) (int64, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	conditions := make([]string, 0, len(currentConditions))
+	for _, condition := range currentConditions {
+		conditions = append(conditions, string(condition))
+	}
+
+	stmt := fmt.Sprintf(`UPDATE %s SET status_condition = $1, status_timestamp = $2 WHERE status_condition::text = ANY($3) AND heartbeat_timestamp < $4`, runtimeTable)
+
+	res, err := persist.Exec(stmt, string(status.Condition), status.Timestamp, pq.Array(conditions), heartbeatBefore)
+	if err != nil {
+		return 0, errors.Wrap(err, "while updating Runtime status")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "while checking affected rows")
+	}
+
+	return affected, nil
+}
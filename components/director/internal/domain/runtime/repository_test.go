@@ -20,6 +20,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -121,7 +122,7 @@ func TestPgRepository_List(t *testing.T) {
 				WillReturnRows(countRow)
 
 			//THEN
-			modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, testCase.InputPageSize, testCase.InputCursor)
+			modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, nil, testCase.InputPageSize, testCase.InputCursor)
 
 			//THEN
 			require.NoError(t, err)
@@ -144,7 +145,7 @@ func TestPgRepository_List(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		pgRepository := runtime.NewRepository()
 		//THEN
-		_, err := pgRepository.List(ctx, tenantID, nil, 2, convertIntToBase64String(-3))
+		_, err := pgRepository.List(ctx, tenantID, nil, nil, 2, convertIntToBase64String(-3))
 
 		//THEN
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct")
@@ -172,19 +173,19 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 						\(SELECT "runtime_id" FROM public.labels 
 							WHERE "runtime_id" IS NOT NULL 
 							AND "tenant_id" = '%s' 
-							AND "key" = 'foo'\)`, tenantID)
+							AND "key" = \$2\)`, tenantID)
 	sqlQuery := fmt.Sprintf(`^SELECT (.+) FROM public.runtimes 
 								WHERE tenant_id=\$1 %s ORDER BY id LIMIT %d OFFSET 0`, filterQuery, rowSize)
 
 	sqlMock.ExpectQuery(sqlQuery).
-		WithArgs(tenantID).
+		WithArgs(tenantID, "foo").
 		WillReturnRows(rows)
 
 	countRows := sqlMock.NewRows([]string{"count"}).AddRow(rowSize)
 
 	countQuery := fmt.Sprintf(`^SELECT COUNT\(\*\) FROM public.runtimes WHERE tenant_id=\$1 %s`, filterQuery)
 	sqlMock.ExpectQuery(countQuery).
-		WithArgs(tenantID).
+		WithArgs(tenantID, "foo").
 		WillReturnRows(countRows)
 
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -197,7 +198,7 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 	pgRepository := runtime.NewRepository()
 
 	// when
-	modelRuntimePage, err := pgRepository.List(ctx, tenantID, filter, rowSize, "")
+	modelRuntimePage, err := pgRepository.List(ctx, tenantID, filter, nil, rowSize, "")
 
 	//then
 	assert.NoError(t, err)
@@ -235,7 +236,7 @@ func TestPgRepository_Create_ShouldCreateRuntimeEntityFromValidModel(t *testing.
 	defer sqlMock.AssertExpectations(t)
 
 	sqlMock.ExpectExec(`^INSERT INTO public.runtimes \(.+\) VALUES \(.+\)$`).
-		WithArgs(modelRuntime.ID, modelRuntime.Tenant, modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp).
+		WithArgs(modelRuntime.ID, modelRuntime.Tenant, modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, nil, nil, nil).
 		WillReturnResult(sqlmock.NewResult(-1, 1))
 
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -286,6 +287,139 @@ func TestPgRepository_Update_ShouldUpdateRuntimeEntityFromValidModel(t *testing.
 	assert.NoError(t, err)
 }
 
+func TestPgRepository_UpdateStatusIfCondition(t *testing.T) {
+	// given
+	runtimeID := uuid.New().String()
+	tenantID := uuid.New().String()
+	timestamp := time.Date(2019, 11, 27, 12, 0, 0, 0, time.UTC)
+	status := model.RuntimeStatus{Condition: model.RuntimeStatusConditionReady, Timestamp: timestamp}
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = $1, status_timestamp = $2 WHERE tenant_id = $3 AND id = $4 AND status_condition::text = ANY($5)`)).
+		WithArgs(string(model.RuntimeStatusConditionReady), timestamp, tenantID, runtimeID, pq.Array([]string{"INITIAL"})).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	err := pgRepository.UpdateStatusIfCondition(ctx, tenantID, runtimeID, status, []model.RuntimeStatusCondition{model.RuntimeStatusConditionInitial})
+
+	// then
+	assert.NoError(t, err)
+}
+
+func TestPgRepository_List_WithStatusConditions(t *testing.T) {
+	// given
+	runtimeID := uuid.New().String()
+	tenantID := uuid.New().String()
+	timestamp := time.Date(2019, 11, 29, 12, 0, 0, 0, time.UTC)
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "heartbeat_agent_version", "heartbeat_connection", "heartbeat_timestamp"}).
+		AddRow(runtimeID, tenantID, "Runtime ABC", "Description for runtime ABC", "FAILED", timestamp, "1.7.0", `{"Endpoint":"https://agent.kyma.local"}`, timestamp)
+
+	conditionsQuery := regexp.QuoteMeta(`AND status_condition IN ('FAILED', 'INITIAL')`)
+	sqlMock.ExpectQuery(fmt.Sprintf(`^SELECT (.+) FROM public.runtimes WHERE tenant_id=\$1 %s ORDER BY id LIMIT 2 OFFSET 0$`, conditionsQuery)).
+		WithArgs(tenantID).
+		WillReturnRows(rows)
+	sqlMock.ExpectQuery(fmt.Sprintf(`^SELECT COUNT\(\*\) FROM public.runtimes WHERE tenant_id=\$1 %s$`, conditionsQuery)).
+		WithArgs(tenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, []model.RuntimeStatusCondition{model.RuntimeStatusConditionFailed, model.RuntimeStatusConditionInitial}, 2, "")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, modelRuntimePage.Data, 1)
+	assert.Equal(t, model.RuntimeStatusConditionFailed, modelRuntimePage.Data[0].Status.Condition)
+	require.NotNil(t, modelRuntimePage.Data[0].Heartbeat)
+	assert.Equal(t, "1.7.0", modelRuntimePage.Data[0].Heartbeat.AgentVersion)
+	assert.Equal(t, timestamp, modelRuntimePage.Data[0].Heartbeat.Timestamp)
+}
+
+func TestPgRepository_UpdateHeartbeat(t *testing.T) {
+	// given
+	runtimeID := uuid.New().String()
+	tenantID := uuid.New().String()
+	timestamp := time.Date(2019, 11, 29, 12, 0, 0, 0, time.UTC)
+	heartbeat := model.RuntimeHeartbeat{AgentVersion: "1.7.0", Timestamp: timestamp}
+
+	t.Run("Success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+
+		sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET heartbeat_agent_version = ?, heartbeat_connection = ?, heartbeat_timestamp = ? WHERE tenant_id = ? AND id = ?`)).
+			WithArgs("1.7.0", nil, timestamp, tenantID, runtimeID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		pgRepository := runtime.NewRepository()
+
+		// when
+		err := pgRepository.UpdateHeartbeat(ctx, tenantID, runtimeID, heartbeat)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("Returns error when Runtime does not exist", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+
+		sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET heartbeat_agent_version = ?, heartbeat_connection = ?, heartbeat_timestamp = ? WHERE tenant_id = ? AND id = ?`)).
+			WithArgs("1.7.0", nil, timestamp, tenantID, runtimeID).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		pgRepository := runtime.NewRepository()
+
+		// when
+		err := pgRepository.UpdateHeartbeat(ctx, tenantID, runtimeID, heartbeat)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "should update single row, but updated 0 rows")
+	})
+}
+
+func TestPgRepository_UpdateStatusIfHeartbeatOlderThan(t *testing.T) {
+	// given
+	timestamp := time.Date(2019, 11, 29, 12, 0, 0, 0, time.UTC)
+	heartbeatBefore := timestamp.Add(-5 * time.Minute)
+	status := model.RuntimeStatus{Condition: model.RuntimeStatusConditionFailed, Timestamp: timestamp}
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = $1, status_timestamp = $2 WHERE status_condition::text = ANY($3) AND heartbeat_timestamp < $4`)).
+		WithArgs(string(model.RuntimeStatusConditionFailed), timestamp, pq.Array([]string{"READY"}), heartbeatBefore).
+		WillReturnResult(sqlmock.NewResult(-1, 2))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	updated, err := pgRepository.UpdateStatusIfHeartbeatOlderThan(ctx, status, []model.RuntimeStatusCondition{model.RuntimeStatusConditionReady}, heartbeatBefore)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), updated)
+}
+
 func TestPgRepository_Delete_ShouldDeleteRuntimeEntityUsingValidModel(t *testing.T) {
 	// given
 	runtimeID := uuid.New().String()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/pkg/errors"
@@ -23,7 +24,9 @@ type RuntimeService interface {
 	Update(ctx context.Context, id string, in model.RuntimeInput) error
 	Get(ctx context.Context, id string) (*model.Runtime, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	SetStatusCondition(ctx context.Context, id string, condition model.RuntimeStatusCondition) error
+	ReportHeartbeat(ctx context.Context, id string, in model.RuntimeHeartbeatInput) error
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, conditions []model.RuntimeStatusCondition, pageSize int, cursor string) (*model.RuntimePage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, runtimeID string) (map[string]*model.Label, error)
@@ -40,6 +43,7 @@ type RuntimeConverter interface {
 	ToGraphQL(in *model.Runtime) *graphql.Runtime
 	MultipleToGraphQL(in []*model.Runtime) []*graphql.Runtime
 	InputFromGraphQL(in graphql.RuntimeInput) model.RuntimeInput
+	HeartbeatInputFromGraphQL(in graphql.RuntimeHeartbeatInput) model.RuntimeHeartbeatInput
 }
 
 //go:generate mockery -name=SystemAuthConverter -output=automock -outpkg=automock -case=underscore
@@ -73,8 +77,16 @@ func NewResolver(transact persistence.Transactioner, svc RuntimeService, sysAuth
 }
 
 // TODO: Proper error handling
-func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
-	labelFilter := labelfilter.MultipleFromGraphQL(filter)
+func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, filterExpression *graphql.LabelFilterExpression, statusConditions []graphql.RuntimeStatusCondition, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+	labelFilter, err := labelfilter.MultipleWithExpressionFromGraphQL(filter, filterExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	var conditions []model.RuntimeStatusCondition
+	for _, condition := range statusConditions {
+		conditions = append(conditions, model.RuntimeStatusCondition(condition))
+	}
 
 	var cursor string
 	if after != nil {
@@ -93,7 +105,7 @@ func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter,
 		return nil, errors.New("missing required parameter 'first'")
 	}
 
-	runtimesPage, err := r.svc.List(ctx, labelFilter, *first, cursor)
+	runtimesPage, err := r.svc.List(ctx, labelFilter, conditions, *first, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -241,6 +253,80 @@ func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runti
 	return deletedRuntime, nil
 }
 
+func (r *Resolver) ReportRuntimeStatus(ctx context.Context, id string, condition graphql.RuntimeStatusCondition) (*graphql.Runtime, error) {
+	c, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.CanActOn(consumer.Runtime, id) {
+		return nil, errors.Errorf("%s with ID %s cannot report status of Runtime with ID %s", c.ConsumerType, c.ConsumerID, id)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = r.svc.SetStatusCondition(ctx, id, model.RuntimeStatusCondition(condition))
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(runtime), nil
+}
+
+func (r *Resolver) ReportRuntimeHeartbeat(ctx context.Context, id string, in graphql.RuntimeHeartbeatInput) (*graphql.Runtime, error) {
+	c, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.CanActOn(consumer.Runtime, id) {
+		return nil, errors.Errorf("%s with ID %s cannot report heartbeat of Runtime with ID %s", c.ConsumerType, c.ConsumerID, id)
+	}
+
+	convertedIn := r.converter.HeartbeatInputFromGraphQL(in)
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = r.svc.ReportHeartbeat(ctx, id, convertedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.ToGraphQL(runtime), nil
+}
+
 func (r *Resolver) SetRuntimeLabel(ctx context.Context, runtimeID string, key string, value interface{}) (*graphql.Label, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 
 	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
@@ -642,6 +643,283 @@ func TestResolver_Runtime(t *testing.T) {
 	}
 }
 
+func TestResolver_ReportRuntimeStatus(t *testing.T) {
+	// given
+	modelRuntime := fixModelRuntime("foo", "tenant-foo", "Foo", "Bar")
+	gqlRuntime := fixGQLRuntime("foo", "Foo", "Bar")
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	runtimeConsumer := consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Runtime}
+
+	testCases := []struct {
+		Name            string
+		Consumer        consumer.Consumer
+		TxFn            func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.RuntimeService
+		ConverterFn     func() *automock.RuntimeConverter
+		ExpectedRuntime *graphql.Runtime
+		ExpectedErr     string
+	}{
+		{
+			Name:     "Success",
+			Consumer: runtimeConsumer,
+			TxFn:     txGen.ThatSucceeds,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("SetStatusCondition", contextParam, "foo", model.RuntimeStatusConditionReady).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+				return conv
+			},
+			ExpectedRuntime: gqlRuntime,
+		},
+		{
+			Name:     "Returns error when other Runtime reports status",
+			Consumer: consumer.Consumer{ConsumerID: "bar", ConsumerType: consumer.Runtime},
+			TxFn:     txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				return &automock.RuntimeConverter{}
+			},
+			ExpectedErr: "Runtime with ID bar cannot report status of Runtime with ID foo",
+		},
+		{
+			Name:     "Returns error when consumer of unknown type reports status",
+			Consumer: consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.ConsumerType("Unknown")},
+			TxFn:     txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				return &automock.RuntimeConverter{}
+			},
+			ExpectedErr: "Unknown with ID foo cannot report status of Runtime with ID foo",
+		},
+		{
+			Name:     "Returns error when setting status failed",
+			Consumer: runtimeConsumer,
+			TxFn:     txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("SetStatusCondition", contextParam, "foo", model.RuntimeStatusConditionReady).Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				return &automock.RuntimeConverter{}
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:     "Returns error when committing transaction failed",
+			Consumer: runtimeConsumer,
+			TxFn:     txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("SetStatusCondition", contextParam, "foo", model.RuntimeStatusConditionReady).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				return &automock.RuntimeConverter{}
+			},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+			ctx := consumer.SaveToContext(context.TODO(), testCase.Consumer)
+
+			resolver := runtime.NewResolver(transact, svc, nil, nil, converter, nil)
+
+			// when
+			result, err := resolver.ReportRuntimeStatus(ctx, "foo", graphql.RuntimeStatusConditionReady)
+
+			// then
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedRuntime, result)
+
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+			persistTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when consumer is missing", func(t *testing.T) {
+		persistTx, transact := txGen.ThatDoesntStartTransaction()
+		resolver := runtime.NewResolver(transact, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.ReportRuntimeStatus(context.TODO(), "foo", graphql.RuntimeStatusConditionReady)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, consumer.NoConsumerError, err)
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+	})
+}
+
+func TestResolver_ReportRuntimeHeartbeat(t *testing.T) {
+	// given
+	modelRuntime := fixModelRuntime("foo", "tenant-foo", "Foo", "Bar")
+	gqlRuntime := fixGQLRuntime("foo", "Foo", "Bar")
+	gqlInput := fixGQLRuntimeHeartbeatInput()
+	modelInput := fixModelRuntimeHeartbeatInput()
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	runtimeConsumer := consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Runtime}
+
+	testCases := []struct {
+		Name            string
+		Consumer        consumer.Consumer
+		TxFn            func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.RuntimeService
+		ConverterFn     func() *automock.RuntimeConverter
+		ExpectedRuntime *graphql.Runtime
+		ExpectedErr     string
+	}{
+		{
+			Name:     "Success",
+			Consumer: runtimeConsumer,
+			TxFn:     txGen.ThatSucceeds,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("ReportHeartbeat", contextParam, "foo", modelInput).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("HeartbeatInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				conv.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+				return conv
+			},
+			ExpectedRuntime: gqlRuntime,
+		},
+		{
+			Name:     "Returns error when other Runtime reports heartbeat",
+			Consumer: consumer.Consumer{ConsumerID: "bar", ConsumerType: consumer.Runtime},
+			TxFn:     txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				return &automock.RuntimeConverter{}
+			},
+			ExpectedErr: "Runtime with ID bar cannot report heartbeat of Runtime with ID foo",
+		},
+		{
+			Name:     "Success when user reports heartbeat",
+			Consumer: consumer.Consumer{ConsumerID: "admin", ConsumerType: consumer.User},
+			TxFn:     txGen.ThatSucceeds,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("ReportHeartbeat", contextParam, "foo", modelInput).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("HeartbeatInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				conv.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+				return conv
+			},
+			ExpectedRuntime: gqlRuntime,
+		},
+		{
+			Name:     "Returns error when application reports heartbeat",
+			Consumer: consumer.Consumer{ConsumerID: "foo", ConsumerType: consumer.Application},
+			TxFn:     txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				return &automock.RuntimeConverter{}
+			},
+			ExpectedErr: "Application with ID foo cannot report heartbeat of Runtime with ID foo",
+		},
+		{
+			Name:     "Returns error when reporting heartbeat failed",
+			Consumer: runtimeConsumer,
+			TxFn:     txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("ReportHeartbeat", contextParam, "foo", modelInput).Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("HeartbeatInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:     "Returns error when committing transaction failed",
+			Consumer: runtimeConsumer,
+			TxFn:     txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("ReportHeartbeat", contextParam, "foo", modelInput).Return(nil).Once()
+				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("HeartbeatInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+			ctx := consumer.SaveToContext(context.TODO(), testCase.Consumer)
+
+			resolver := runtime.NewResolver(transact, svc, nil, nil, converter, nil)
+
+			// when
+			result, err := resolver.ReportRuntimeHeartbeat(ctx, "foo", gqlInput)
+
+			// then
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedRuntime, result)
+
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+			persistTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_Runtimes(t *testing.T) {
 	// given
 	modelRuntimes := []*model.Runtime{
@@ -659,19 +937,22 @@ func TestResolver_Runtimes(t *testing.T) {
 	after := "test"
 	filter := []*labelfilter.LabelFilter{{Key: ""}}
 	gqlFilter := []*graphql.LabelFilter{{Key: ""}}
+	conditions := []model.RuntimeStatusCondition{model.RuntimeStatusConditionFailed}
+	gqlConditions := []graphql.RuntimeStatusCondition{graphql.RuntimeStatusConditionFailed}
 	testErr := errors.New("Test error")
 
 	testCases := []struct {
-		Name              string
-		PersistenceFn     func() *persistenceautomock.PersistenceTx
-		TransactionerFn   func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
-		ServiceFn         func() *automock.RuntimeService
-		ConverterFn       func() *automock.RuntimeConverter
-		InputLabelFilters []*graphql.LabelFilter
-		InputFirst        *int
-		InputAfter        *graphql.PageCursor
-		ExpectedResult    *graphql.RuntimePage
-		ExpectedErr       error
+		Name                  string
+		PersistenceFn         func() *persistenceautomock.PersistenceTx
+		TransactionerFn       func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
+		ServiceFn             func() *automock.RuntimeService
+		ConverterFn           func() *automock.RuntimeConverter
+		InputLabelFilters     []*graphql.LabelFilter
+		InputFilterExpression *graphql.LabelFilterExpression
+		InputFirst            *int
+		InputAfter            *graphql.PageCursor
+		ExpectedResult        *graphql.RuntimePage
+		ExpectedErr           error
 	}{
 		{
 			Name: "Success",
@@ -689,7 +970,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			},
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("List", contextParam, filter, first, after).Return(fixRuntimePage(modelRuntimes), nil).Once()
+				svc.On("List", contextParam, filter, conditions, first, after).Return(fixRuntimePage(modelRuntimes), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.RuntimeConverter {
@@ -703,6 +984,30 @@ func TestResolver_Runtimes(t *testing.T) {
 			ExpectedResult:    fixGQLRuntimePage(gqlRuntimes),
 			ExpectedErr:       nil,
 		},
+		{
+			Name:            "Success with filter expression",
+			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.RuntimeService {
+				expressionFilter := append(filter, &labelfilter.LabelFilter{Not: &labelfilter.LabelFilter{Key: "bar"}})
+				svc := &automock.RuntimeService{}
+				svc.On("List", contextParam, expressionFilter, conditions, first, after).Return(fixRuntimePage(modelRuntimes), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("MultipleToGraphQL", modelRuntimes).Return(gqlRuntimes).Once()
+				return conv
+			},
+			InputFirst:        &first,
+			InputAfter:        &gqlAfter,
+			InputLabelFilters: gqlFilter,
+			InputFilterExpression: &graphql.LabelFilterExpression{
+				Not: &graphql.LabelFilterExpression{Label: &graphql.LabelFilter{Key: "bar"}},
+			},
+			ExpectedResult: fixGQLRuntimePage(gqlRuntimes),
+			ExpectedErr:    nil,
+		},
 		{
 			Name: "Returns error when runtime listing failed",
 			PersistenceFn: func() *persistenceautomock.PersistenceTx {
@@ -718,7 +1023,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			},
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("List", contextParam, filter, first, after).Return(nil, testErr).Once()
+				svc.On("List", contextParam, filter, conditions, first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.RuntimeConverter {
@@ -743,7 +1048,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			resolver := runtime.NewResolver(transact, svc, nil, nil, converter, nil)
 
 			// when
-			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, testCase.InputFirst, testCase.InputAfter)
+			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, testCase.InputFilterExpression, gqlConditions, testCase.InputFirst, testCase.InputAfter)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
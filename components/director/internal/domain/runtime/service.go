@@ -3,10 +3,10 @@ package runtime
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
 	"github.com/kyma-incubator/compass/components/director/internal/tenant"
 	"github.com/pkg/errors"
@@ -16,9 +16,11 @@ import (
 type RuntimeRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Runtime, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, conditions []model.RuntimeStatusCondition, pageSize int, cursor string) (*model.RuntimePage, error)
 	Create(ctx context.Context, item *model.Runtime) error
 	Update(ctx context.Context, item *model.Runtime) error
+	UpdateHeartbeat(ctx context.Context, tenant, id string, heartbeat model.RuntimeHeartbeat) error
+	UpdateStatusIfCondition(ctx context.Context, tenant, id string, status model.RuntimeStatus, currentConditions []model.RuntimeStatusCondition) error
 	Delete(ctx context.Context, tenant, id string) error
 }
 
@@ -41,6 +43,11 @@ type ScenariosService interface {
 	EnsureScenariosLabelDefinitionExists(ctx context.Context, tenant string) error
 }
 
+//go:generate mockery -name=ScenarioAssignmentEngine -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentEngine interface {
+	EvaluateForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) error
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -50,16 +57,18 @@ type service struct {
 	repo      RuntimeRepository
 	labelRepo LabelRepository
 
-	labelUpsertService LabelUpsertService
-	uidService         UIDService
-	scenariosService   ScenariosService
+	labelUpsertService       LabelUpsertService
+	uidService               UIDService
+	scenariosService         ScenariosService
+	scenarioAssignmentEngine ScenarioAssignmentEngine
+	timestampGen             timestamp.Generator
 }
 
-func NewService(repo RuntimeRepository, labelRepo LabelRepository, scenariosService ScenariosService, labelUpsertService LabelUpsertService, uidService UIDService) *service {
-	return &service{repo: repo, labelRepo: labelRepo, scenariosService: scenariosService, labelUpsertService: labelUpsertService, uidService: uidService}
+func NewService(repo RuntimeRepository, labelRepo LabelRepository, scenariosService ScenariosService, labelUpsertService LabelUpsertService, uidService UIDService, scenarioAssignmentEngine ScenarioAssignmentEngine) *service {
+	return &service{repo: repo, labelRepo: labelRepo, scenariosService: scenariosService, labelUpsertService: labelUpsertService, uidService: uidService, scenarioAssignmentEngine: scenarioAssignmentEngine, timestampGen: timestamp.DefaultGenerator()}
 }
 
-func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
+func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, conditions []model.RuntimeStatusCondition, pageSize int, cursor string) (*model.RuntimePage, error) {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
@@ -69,7 +78,7 @@ func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, p
 		return nil, errors.New("page size must be between 1 and 100")
 	}
 
-	return s.repo.List(ctx, rtmTenant, filter, pageSize, cursor)
+	return s.repo.List(ctx, rtmTenant, filter, conditions, pageSize, cursor)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.Runtime, error) {
@@ -115,7 +124,7 @@ func (s *service) Create(ctx context.Context, in model.RuntimeInput) (string, er
 
 	rtm.Status = &model.RuntimeStatus{
 		Condition: model.RuntimeStatusConditionInitial,
-		Timestamp: time.Now(),
+		Timestamp: s.timestampGen(),
 	}
 
 	err = s.repo.Create(ctx, rtm)
@@ -133,6 +142,11 @@ func (s *service) Create(ctx context.Context, in model.RuntimeInput) (string, er
 		return id, errors.Wrapf(err, "while creating multiple labels for Runtime")
 	}
 
+	err = s.scenarioAssignmentEngine.EvaluateForObject(ctx, rtmTenant, model.RuntimeLabelableObject, id)
+	if err != nil {
+		return "", errors.Wrap(err, "while evaluating Scenario Assignments for Runtime")
+	}
+
 	return id, nil
 }
 
@@ -175,6 +189,11 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeInput)
 		return errors.Wrapf(err, "while creating multiple labels for Runtime")
 	}
 
+	err = s.scenarioAssignmentEngine.EvaluateForObject(ctx, rtmTenant, model.RuntimeLabelableObject, id)
+	if err != nil {
+		return errors.Wrap(err, "while evaluating Scenario Assignments for Runtime")
+	}
+
 	return nil
 }
 
@@ -194,6 +213,67 @@ func (s *service) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+func (s *service) SetStatusCondition(ctx context.Context, id string, condition model.RuntimeStatusCondition) error {
+	rtm, err := s.Get(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "while getting Runtime")
+	}
+
+	currentCondition := model.RuntimeStatusConditionInitial
+	if rtm.Status != nil {
+		currentCondition = rtm.Status.Condition
+	}
+
+	if currentCondition == condition {
+		return nil
+	}
+
+	if !currentCondition.CanTransitionTo(condition) {
+		return errors.Errorf("Runtime status cannot change from %s to %s", currentCondition, condition)
+	}
+
+	rtm.Status = &model.RuntimeStatus{
+		Condition: condition,
+		Timestamp: s.timestampGen(),
+	}
+
+	err = s.repo.Update(ctx, rtm)
+	if err != nil {
+		return errors.Wrap(err, "while updating Runtime")
+	}
+
+	return nil
+}
+
+// ReportHeartbeat stores the heartbeat of the Runtime with the current timestamp. It does not change the Runtime status.
+func (s *service) ReportHeartbeat(ctx context.Context, id string, in model.RuntimeHeartbeatInput) error {
+	err := in.Validate()
+	if err != nil {
+		return errors.Wrap(err, "while validating Runtime heartbeat input")
+	}
+
+	rtmTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	heartbeat := in.ToRuntimeHeartbeat(s.timestampGen())
+
+	err = s.repo.UpdateHeartbeat(ctx, rtmTenant, id, *heartbeat)
+	if err != nil {
+		return errors.Wrapf(err, "while updating heartbeat of Runtime with ID %s", id)
+	}
+
+	// a Runtime reporting heartbeats again recovers from the failure caused by the missing ones
+	status := model.RuntimeStatus{Condition: model.RuntimeStatusConditionReady, Timestamp: heartbeat.Timestamp}
+	err = s.repo.UpdateStatusIfCondition(ctx, rtmTenant, id, status, model.RuntimeStatusConditionsRestoredByHeartbeat)
+	if err != nil {
+		return errors.Wrapf(err, "while updating status of Runtime with ID %s", id)
+	}
+
+	return nil
+}
+
 func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -213,6 +293,11 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		return errors.Wrapf(err, "while creating label for Runtime")
 	}
 
+	err = s.scenarioAssignmentEngine.EvaluateForObject(ctx, rtmTenant, model.RuntimeLabelableObject, labelInput.ObjectID)
+	if err != nil {
+		return errors.Wrap(err, "while evaluating Scenario Assignments for Runtime")
+	}
+
 	return nil
 }
 
@@ -280,5 +365,10 @@ func (s *service) DeleteLabel(ctx context.Context, runtimeID string, key string)
 		return errors.Wrapf(err, "while deleting Runtime label")
 	}
 
+	err = s.scenarioAssignmentEngine.EvaluateForObject(ctx, rtmTenant, model.RuntimeLabelableObject, runtimeID)
+	if err != nil {
+		return errors.Wrap(err, "while evaluating Scenario Assignments for Runtime")
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package runtime_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime/automock"
@@ -38,13 +39,14 @@ func TestService_Create(t *testing.T) {
 	ctx = tenant.SaveToContext(ctx, tnt)
 
 	testCases := []struct {
-		Name                 string
-		RuntimeRepositoryFn  func() *automock.RuntimeRepository
-		ScenariosServiceFn   func() *automock.ScenariosService
-		LabelUpsertServiceFn func() *automock.LabelUpsertService
-		UIDServiceFn         func() *automock.UIDService
-		Input                model.RuntimeInput
-		ExpectedErr          error
+		Name                       string
+		RuntimeRepositoryFn        func() *automock.RuntimeRepository
+		ScenariosServiceFn         func() *automock.ScenariosService
+		LabelUpsertServiceFn       func() *automock.LabelUpsertService
+		UIDServiceFn               func() *automock.UIDService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		Input                      model.RuntimeInput
+		ExpectedErr                error
 	}{
 		{
 			Name: "Success",
@@ -68,6 +70,11 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id)
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.RuntimeLabelableObject, id).Return(nil).Once()
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: nil,
 		},
@@ -92,6 +99,10 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return(id)
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
@@ -113,6 +124,10 @@ func TestService_Create(t *testing.T) {
 				svc := &automock.UIDService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:       model.RuntimeInput{Name: ""},
 			ExpectedErr: errors.New("a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")},
 		{
@@ -133,6 +148,10 @@ func TestService_Create(t *testing.T) {
 				svc := &automock.UIDService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:       model.RuntimeInput{Name: "upperCase"},
 			ExpectedErr: errors.New("a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"),
 		},
@@ -156,6 +175,10 @@ func TestService_Create(t *testing.T) {
 				svc.On("Generate").Return("").Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
@@ -167,7 +190,8 @@ func TestService_Create(t *testing.T) {
 			idSvc := testCase.UIDServiceFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
 			scenariosSvc := testCase.ScenariosServiceFn()
-			svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, idSvc)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, idSvc, engine)
 
 			// when
 			result, err := svc.Create(ctx, testCase.Input)
@@ -184,6 +208,7 @@ func TestService_Create(t *testing.T) {
 			idSvc.AssertExpectations(t)
 			labelSvc.AssertExpectations(t)
 			scenariosSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 }
@@ -216,13 +241,14 @@ func TestService_Update(t *testing.T) {
 	ctx = tenant.SaveToContext(ctx, tnt)
 
 	testCases := []struct {
-		Name                 string
-		RepositoryFn         func() *automock.RuntimeRepository
-		LabelRepositoryFn    func() *automock.LabelRepository
-		LabelUpsertServiceFn func() *automock.LabelUpsertService
-		Input                model.RuntimeInput
-		InputID              string
-		ExpectedErrMessage   string
+		Name                       string
+		RepositoryFn               func() *automock.RuntimeRepository
+		LabelRepositoryFn          func() *automock.LabelRepository
+		LabelUpsertServiceFn       func() *automock.LabelUpsertService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		Input                      model.RuntimeInput
+		InputID                    string
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -242,6 +268,11 @@ func TestService_Update(t *testing.T) {
 				repo.On("UpsertMultipleLabels", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID, modelInput.Labels).Return(nil).Once()
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeModel.ID).Return(nil).Once()
+				return engine
+			},
 			InputID:            "foo",
 			Input:              modelInput,
 			ExpectedErrMessage: "",
@@ -260,6 +291,10 @@ func TestService_Update(t *testing.T) {
 				repo := &automock.LabelUpsertService{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			Input:              model.RuntimeInput{Name: ""},
 			ExpectedErrMessage: "a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character",
 		},
@@ -279,6 +314,10 @@ func TestService_Update(t *testing.T) {
 				repo := &automock.LabelUpsertService{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              modelInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -298,6 +337,10 @@ func TestService_Update(t *testing.T) {
 				repo := &automock.LabelUpsertService{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputID:            "foo",
 			Input:              modelInput,
 			ExpectedErrMessage: testErr.Error(),
@@ -309,7 +352,8 @@ func TestService_Update(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
-			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engine)
 
 			// when
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -324,6 +368,7 @@ func TestService_Update(t *testing.T) {
 			repo.AssertExpectations(t)
 			labelRepo.AssertExpectations(t)
 			labelSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 }
@@ -379,7 +424,7 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := runtime.NewService(repo, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -396,6 +441,201 @@ func TestService_Delete(t *testing.T) {
 	}
 }
 
+func TestService_SetStatusCondition(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	id := "foo"
+	tnt := "tenant"
+	timestamp := time.Now()
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	runtimeModel := func(condition model.RuntimeStatusCondition) *model.Runtime {
+		return &model.Runtime{
+			ID:     id,
+			Name:   "foo",
+			Tenant: tnt,
+			Status: &model.RuntimeStatus{Condition: condition},
+		}
+	}
+	readyModel := runtimeModel(model.RuntimeStatusConditionReady)
+	readyModel.Status.Timestamp = timestamp
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.RuntimeRepository
+		Condition          model.RuntimeStatusCondition
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(runtimeModel(model.RuntimeStatusConditionInitial), nil).Once()
+				repo.On("Update", ctx, readyModel).Return(nil).Once()
+				return repo
+			},
+			Condition: model.RuntimeStatusConditionReady,
+		},
+		{
+			Name: "Keeps timestamp when condition does not change",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(runtimeModel(model.RuntimeStatusConditionReady), nil).Once()
+				return repo
+			},
+			Condition: model.RuntimeStatusConditionReady,
+		},
+		{
+			Name: "Returns error when transition is not allowed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(runtimeModel(model.RuntimeStatusConditionReady), nil).Once()
+				return repo
+			},
+			Condition:          model.RuntimeStatusConditionInitial,
+			ExpectedErrMessage: "Runtime status cannot change from READY to INITIAL",
+		},
+		{
+			Name: "Returns error when getting runtime failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(nil, testErr).Once()
+				return repo
+			},
+			Condition:          model.RuntimeStatusConditionReady,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when updating runtime failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(runtimeModel(model.RuntimeStatusConditionInitial), nil).Once()
+				repo.On("Update", ctx, readyModel).Return(testErr).Once()
+				return repo
+			},
+			Condition:          model.RuntimeStatusConditionReady,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			// when
+			err := svc.SetStatusCondition(ctx, id, testCase.Condition)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ReportHeartbeat(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	id := "foo"
+	tnt := "tenant"
+	timestamp := time.Now()
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt)
+
+	input := fixModelRuntimeHeartbeatInput()
+	heartbeat := fixModelRuntimeHeartbeat(timestamp)
+	readyStatus := model.RuntimeStatus{Condition: model.RuntimeStatusConditionReady, Timestamp: timestamp}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.RuntimeRepository
+		Input              model.RuntimeHeartbeatInput
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("UpdateHeartbeat", ctx, tnt, id, *heartbeat).Return(nil).Once()
+				repo.On("UpdateStatusIfCondition", ctx, tnt, id, readyStatus, []model.RuntimeStatusCondition{model.RuntimeStatusConditionFailed}).Return(nil).Once()
+				return repo
+			},
+			Input: input,
+		},
+		{
+			Name: "Returns error when input is invalid",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+			Input:              model.RuntimeHeartbeatInput{},
+			ExpectedErrMessage: "agent version cannot be empty",
+		},
+		{
+			Name: "Returns error when updating heartbeat failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("UpdateHeartbeat", ctx, tnt, id, *heartbeat).Return(testErr).Once()
+				return repo
+			},
+			Input:              input,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when restoring status failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("UpdateHeartbeat", ctx, tnt, id, *heartbeat).Return(nil).Once()
+				repo.On("UpdateStatusIfCondition", ctx, tnt, id, readyStatus, []model.RuntimeStatusCondition{model.RuntimeStatusConditionFailed}).Return(testErr).Once()
+				return repo
+			},
+			Input:              input,
+			ExpectedErrMessage: "while updating status of Runtime with ID foo: Test error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			// when
+			err := svc.ReportHeartbeat(ctx, id, testCase.Input)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when tenant is missing", func(t *testing.T) {
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := svc.ReportHeartbeat(context.TODO(), id, input)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
 func TestService_Get(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -449,7 +689,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
 			rtm, err := svc.Get(ctx, testCase.InputID)
@@ -521,7 +761,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//GIVEN
 			rtmRepo := testCase.RepositoryFn()
-			svc := runtime.NewService(rtmRepo, nil, nil, nil, nil)
+			svc := runtime.NewService(rtmRepo, nil, nil, nil, nil, nil)
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputRuntimeID)
@@ -561,6 +801,7 @@ func TestService_List(t *testing.T) {
 	first := 2
 	after := "test"
 	filter := []*labelfilter.LabelFilter{{Key: ""}}
+	conditions := []model.RuntimeStatusCondition{model.RuntimeStatusConditionFailed}
 
 	tnt := "tenant"
 
@@ -580,7 +821,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(runtimePage, nil).Once()
+				repo.On("List", ctx, tnt, filter, conditions, first, after).Return(runtimePage, nil).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -593,7 +834,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when runtime listing failed",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, conditions, first, after).Return(nil, testErr).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -632,10 +873,10 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil)
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
-			rtm, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, testCase.InputCursor)
+			rtm, err := svc.List(ctx, testCase.InputLabelFilters, conditions, testCase.InputPageSize, testCase.InputCursor)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -744,7 +985,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil)
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.GetLabel(ctx, testCase.InputRuntimeID, testCase.InputLabel.Key)
@@ -858,7 +1099,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil)
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.ListLabels(ctx, testCase.InputRuntimeID)
@@ -895,12 +1136,13 @@ func TestService_SetLabel(t *testing.T) {
 	}
 
 	testCases := []struct {
-		Name                 string
-		RepositoryFn         func() *automock.RuntimeRepository
-		LabelUpsertServiceFn func() *automock.LabelUpsertService
-		InputRuntimeID       string
-		InputLabel           *model.LabelInput
-		ExpectedErrMessage   string
+		Name                       string
+		RepositoryFn               func() *automock.RuntimeRepository
+		LabelUpsertServiceFn       func() *automock.LabelUpsertService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		InputRuntimeID             string
+		InputLabel                 *model.LabelInput
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -914,10 +1156,36 @@ func TestService_SetLabel(t *testing.T) {
 				svc.On("UpsertLabel", ctx, tnt, &modelLabel).Return(nil).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeID).Return(nil).Once()
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputLabel:         &modelLabel,
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Returns error when evaluating Scenario Assignments failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, &modelLabel).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeID).Return(testErr).Once()
+				return engine
+			},
+			InputRuntimeID:     runtimeID,
+			InputLabel:         &modelLabel,
+			ExpectedErrMessage: testErr.Error(),
+		},
 		{
 			Name: "Returns error when runtime update failed",
 			RepositoryFn: func() *automock.RuntimeRepository {
@@ -930,6 +1198,10 @@ func TestService_SetLabel(t *testing.T) {
 				svc.On("UpsertLabel", ctx, tnt, &modelLabel).Return(testErr).Once()
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputLabel:         &modelLabel,
 			ExpectedErrMessage: testErr.Error(),
@@ -945,6 +1217,10 @@ func TestService_SetLabel(t *testing.T) {
 				svc := &automock.LabelUpsertService{}
 				return svc
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputLabel:         &modelLabel,
 			ExpectedErrMessage: testErr.Error(),
@@ -955,7 +1231,8 @@ func TestService_SetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
-			svc := runtime.NewService(repo, nil, nil, labelSvc, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, nil, nil, labelSvc, nil, engine)
 
 			// when
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -969,6 +1246,7 @@ func TestService_SetLabel(t *testing.T) {
 
 			repo.AssertExpectations(t)
 			labelSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 }
@@ -986,12 +1264,13 @@ func TestService_DeleteLabel(t *testing.T) {
 	labelKey := "key"
 
 	testCases := []struct {
-		Name               string
-		RepositoryFn       func() *automock.RuntimeRepository
-		LabelRepositoryFn  func() *automock.LabelRepository
-		InputRuntimeID     string
-		InputKey           string
-		ExpectedErrMessage string
+		Name                       string
+		RepositoryFn               func() *automock.RuntimeRepository
+		LabelRepositoryFn          func() *automock.LabelRepository
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
+		InputRuntimeID             string
+		InputKey                   string
+		ExpectedErrMessage         string
 	}{
 		{
 			Name: "Success",
@@ -1005,6 +1284,11 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, runtimeID, labelKey).Return(nil).Once()
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("EvaluateForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeID).Return(nil).Once()
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: "",
@@ -1021,6 +1305,10 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, runtimeID, labelKey).Return(testErr).Once()
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: testErr.Error(),
@@ -1036,6 +1324,10 @@ func TestService_DeleteLabel(t *testing.T) {
 				repo := &automock.LabelRepository{}
 				return repo
 			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				return engine
+			},
 			InputRuntimeID:     runtimeID,
 			InputKey:           labelKey,
 			ExpectedErrMessage: testErr.Error(),
@@ -1046,7 +1338,8 @@ func TestService_DeleteLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil)
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, engine)
 
 			// when
 			err := svc.DeleteLabel(ctx, testCase.InputRuntimeID, testCase.InputKey)
@@ -1060,6 +1353,7 @@ func TestService_DeleteLabel(t *testing.T) {
 			}
 
 			repo.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,85 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LabelRepository is an autogenerated mock type for the LabelRepository type
+type LabelRepository struct {
+	mock.Mock
+}
+
+// GetByKey provides a mock function with given fields: ctx, tenant, objectType, objectID, key
+func (_m *LabelRepository) GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) (*model.Label, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectID, key)
+
+	var r0 *model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, string, string) *model.Label); ok {
+		r0 = rf(ctx, tenant, objectType, objectID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, string, string) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByKey provides a mock function with given fields: ctx, tenant, key
+func (_m *LabelRepository) ListByKey(ctx context.Context, tenant string, key string) ([]*model.Label, error) {
+	ret := _m.Called(ctx, tenant, key)
+
+	var r0 []*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.Label); ok {
+		r0 = rf(ctx, tenant, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMatchingObjectIDs provides a mock function with given fields: ctx, tenant, objectType, filter
+func (_m *LabelRepository) ListMatchingObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, filter *labelfilter.LabelFilter) ([]string, error) {
+	ret := _m.Called(ctx, tenant, objectType, filter)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, *labelfilter.LabelFilter) []string); ok {
+		r0 = rf(ctx, tenant, objectType, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, *labelfilter.LabelFilter) error); ok {
+		r1 = rf(ctx, tenant, objectType, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,49 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioAssignmentRepository is an autogenerated mock type for the ScenarioAssignmentRepository type
+type ScenarioAssignmentRepository struct {
+	mock.Mock
+}
+
+// ExistsForScenario provides a mock function with given fields: ctx, tenant, _a2
+func (_m *ScenarioAssignmentRepository) ExistsForScenario(ctx context.Context, tenant string, _a2 string) (bool, error) {
+	ret := _m.Called(ctx, tenant, _a2)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, tenant, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenameScenario provides a mock function with given fields: ctx, tenant, _a2, newScenario
+func (_m *ScenarioAssignmentRepository) RenameScenario(ctx context.Context, tenant string, _a2 string, newScenario string) error {
+	ret := _m.Called(ctx, tenant, _a2, newScenario)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, tenant, _a2, newScenario)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioConverter is an autogenerated mock type for the ScenarioConverter type
+type ScenarioConverter struct {
+	mock.Mock
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *ScenarioConverter) MultipleToGraphQL(in []*model.Scenario) []*graphql.Scenario {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.Scenario
+	if rf, ok := ret.Get(0).(func([]*model.Scenario) []*graphql.Scenario); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.Scenario)
+		}
+	}
+
+	return r0
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *ScenarioConverter) ToGraphQL(in *model.Scenario) *graphql.Scenario {
+	ret := _m.Called(in)
+
+	var r0 *graphql.Scenario
+	if rf, ok := ret.Get(0).(func(*model.Scenario) *graphql.Scenario); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Scenario)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,121 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioService is an autogenerated mock type for the ScenarioService type
+type ScenarioService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, name
+func (_m *ScenarioService) Create(ctx context.Context, name string) (*model.Scenario, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Scenario); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, name, removeFromLabels
+func (_m *ScenarioService) Delete(ctx context.Context, name string, removeFromLabels bool) error {
+	ret := _m.Called(ctx, name, removeFromLabels)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, name, removeFromLabels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *ScenarioService) Get(ctx context.Context, name string) (*model.Scenario, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Scenario); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *ScenarioService) List(ctx context.Context) ([]*model.Scenario, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Scenario); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rename provides a mock function with given fields: ctx, name, newName
+func (_m *ScenarioService) Rename(ctx context.Context, name string, newName string) (*model.Scenario, error) {
+	ret := _m.Called(ctx, name, newName)
+
+	var r0 *model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Scenario); ok {
+		r0 = rf(ctx, name, newName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, newName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
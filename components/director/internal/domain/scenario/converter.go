@@ -0,0 +1,36 @@
+package scenario
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.Scenario) *graphql.Scenario {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Scenario{
+		Name:             in.Name,
+		ApplicationCount: in.ApplicationCount,
+		RuntimeCount:     in.RuntimeCount,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.Scenario) []*graphql.Scenario {
+	var scenarios []*graphql.Scenario
+	for _, s := range in {
+		if s == nil {
+			continue
+		}
+		scenarios = append(scenarios, c.ToGraphQL(s))
+	}
+
+	return scenarios
+}
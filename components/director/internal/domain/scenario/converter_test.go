@@ -0,0 +1,34 @@
+package scenario_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := scenario.NewConverter()
+
+	// WHEN
+	result := conv.ToGraphQL(fixModelScenario(testScenario, 2, 1))
+
+	// THEN
+	assert.Equal(t, fixGQLScenario(testScenario, 2, 1), result)
+	assert.Nil(t, conv.ToGraphQL(nil))
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := scenario.NewConverter()
+	in := []*model.Scenario{fixModelScenario("DEFAULT", 3, 0), nil, fixModelScenario(testScenario, 1, 2)}
+
+	// WHEN
+	result := conv.MultipleToGraphQL(in)
+
+	// THEN
+	assert.Equal(t, []*graphql.Scenario{fixGQLScenario("DEFAULT", 3, 0), fixGQLScenario(testScenario, 1, 2)}, result)
+}
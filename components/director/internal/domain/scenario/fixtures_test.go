@@ -0,0 +1,71 @@
+package scenario_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	testTenant   = "tenant"
+	testScenario = "PRODUCTION"
+)
+
+var testError = errors.New("test error")
+
+func fixModelScenario(name string, applicationCount, runtimeCount int) *model.Scenario {
+	return &model.Scenario{
+		Name:             name,
+		ApplicationCount: applicationCount,
+		RuntimeCount:     runtimeCount,
+	}
+}
+
+func fixGQLScenario(name string, applicationCount, runtimeCount int) *graphql.Scenario {
+	return &graphql.Scenario{
+		Name:             name,
+		ApplicationCount: applicationCount,
+		RuntimeCount:     runtimeCount,
+	}
+}
+
+func fixScenariosLabelDefinition(scenarios ...string) *model.LabelDefinition {
+	schema, err := model.SchemaWithScenarios(model.ScenariosSchema, scenarios)
+	if err != nil {
+		panic(err)
+	}
+	return &model.LabelDefinition{
+		ID:     "ld",
+		Tenant: testTenant,
+		Key:    model.ScenariosKey,
+		Schema: &schema,
+	}
+}
+
+func fixScenariosLabel(objectType model.LabelableObject, objectID string, scenarios ...interface{}) *model.Label {
+	return &model.Label{
+		ID:         objectID + "-label",
+		Tenant:     testTenant,
+		Key:        model.ScenariosKey,
+		Value:      scenarios,
+		ObjectID:   objectID,
+		ObjectType: objectType,
+	}
+}
+
+func fixScenariosLabelInput(objectType model.LabelableObject, objectID string, scenarios ...interface{}) *model.LabelInput {
+	return &model.LabelInput{
+		Key:        model.ScenariosKey,
+		Value:      scenarios,
+		ObjectID:   objectID,
+		ObjectType: objectType,
+	}
+}
+
+func fixScenarioFilter(scenario string) *labelfilter.LabelFilter {
+	query := fmt.Sprintf(`$[*] ? (@ == %q)`, scenario)
+	return &labelfilter.LabelFilter{Key: model.ScenariosKey, Query: &query}
+}
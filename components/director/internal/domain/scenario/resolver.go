@@ -0,0 +1,158 @@
+package scenario
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+//go:generate mockery -name=ScenarioService -output=automock -outpkg=automock -case=underscore
+type ScenarioService interface {
+	List(ctx context.Context) ([]*model.Scenario, error)
+	Get(ctx context.Context, name string) (*model.Scenario, error)
+	Create(ctx context.Context, name string) (*model.Scenario, error)
+	Delete(ctx context.Context, name string, removeFromLabels bool) error
+	Rename(ctx context.Context, name, newName string) (*model.Scenario, error)
+}
+
+//go:generate mockery -name=ScenarioConverter -output=automock -outpkg=automock -case=underscore
+type ScenarioConverter interface {
+	ToGraphQL(in *model.Scenario) *graphql.Scenario
+	MultipleToGraphQL(in []*model.Scenario) []*graphql.Scenario
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	svc  ScenarioService
+	conv ScenarioConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc ScenarioService, conv ScenarioConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+func (r *Resolver) Scenarios(ctx context.Context) ([]*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenarios, err := r.svc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleToGraphQL(scenarios), nil
+}
+
+func (r *Resolver) Scenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenario, err := r.svc.Get(ctx, name)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(scenario), nil
+}
+
+func (r *Resolver) CreateScenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenario, err := r.svc.Create(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(scenario), nil
+}
+
+func (r *Resolver) DeleteScenario(ctx context.Context, name string, removeFromLabels *bool) (*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenario, err := r.svc.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.svc.Delete(ctx, name, removeFromLabels != nil && *removeFromLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(scenario), nil
+}
+
+func (r *Resolver) RenameScenario(ctx context.Context, name string, newName string) (*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenario, err := r.svc.Rename(ctx, name, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(scenario), nil
+}
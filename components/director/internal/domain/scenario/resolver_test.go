@@ -0,0 +1,284 @@
+package scenario_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Scenarios(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	scenarios := []*model.Scenario{fixModelScenario("DEFAULT", 1, 0)}
+	gqlScenarios := []*graphql.Scenario{fixGQLScenario("DEFAULT", 1, 0)}
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(scenarios, nil).Once()
+
+		conv := &automock.ScenarioConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("MultipleToGraphQL", scenarios).Return(gqlScenarios).Once()
+
+		resolver := scenario.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.Scenarios(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, gqlScenarios, result)
+	})
+
+	t.Run("Returns error when listing failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, testError).Once()
+
+		resolver := scenario.NewResolver(transact, svc, nil)
+
+		// WHEN
+		_, err := resolver.Scenarios(context.TODO())
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+
+	t.Run("Returns error when starting transaction failed", func(t *testing.T) {
+		persist, transact := txGen.ThatFailsOnBegin()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		resolver := scenario.NewResolver(transact, nil, nil)
+
+		// WHEN
+		_, err := resolver.Scenarios(context.TODO())
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
+
+func TestResolver_Scenario(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testScenario).Return(fixModelScenario(testScenario, 1, 2), nil).Once()
+
+		conv := &automock.ScenarioConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", fixModelScenario(testScenario, 1, 2)).Return(fixGQLScenario(testScenario, 1, 2)).Once()
+
+		resolver := scenario.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.Scenario(context.TODO(), testScenario)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLScenario(testScenario, 1, 2), result)
+	})
+
+	t.Run("Returns nil when scenario not found", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testScenario).Return(nil, apperrors.NewNotFoundError(testScenario)).Once()
+
+		resolver := scenario.NewResolver(transact, svc, nil)
+
+		// WHEN
+		result, err := resolver.Scenario(context.TODO(), testScenario)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_CreateScenario(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), testScenario).Return(fixModelScenario(testScenario, 0, 0), nil).Once()
+
+		conv := &automock.ScenarioConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", fixModelScenario(testScenario, 0, 0)).Return(fixGQLScenario(testScenario, 0, 0)).Once()
+
+		resolver := scenario.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.CreateScenario(context.TODO(), testScenario)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLScenario(testScenario, 0, 0), result)
+	})
+
+	t.Run("Returns error when creating failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), testScenario).Return(nil, testError).Once()
+
+		resolver := scenario.NewResolver(transact, svc, nil)
+
+		// WHEN
+		_, err := resolver.CreateScenario(context.TODO(), testScenario)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
+
+func TestResolver_DeleteScenario(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	removeFromLabels := true
+
+	testCases := []struct {
+		Name                     string
+		RemoveFromLabels         *bool
+		ExpectedRemoveFromLabels bool
+	}{
+		{
+			Name:                     "Success",
+			ExpectedRemoveFromLabels: false,
+		},
+		{
+			Name:                     "Success when removing scenario from labels",
+			RemoveFromLabels:         &removeFromLabels,
+			ExpectedRemoveFromLabels: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := txGen.ThatSucceeds()
+			defer persist.AssertExpectations(t)
+			defer transact.AssertExpectations(t)
+
+			svc := &automock.ScenarioService{}
+			defer svc.AssertExpectations(t)
+			svc.On("Get", txtest.CtxWithDBMatcher(), testScenario).Return(fixModelScenario(testScenario, 1, 0), nil).Once()
+			svc.On("Delete", txtest.CtxWithDBMatcher(), testScenario, testCase.ExpectedRemoveFromLabels).Return(nil).Once()
+
+			conv := &automock.ScenarioConverter{}
+			defer conv.AssertExpectations(t)
+			conv.On("ToGraphQL", fixModelScenario(testScenario, 1, 0)).Return(fixGQLScenario(testScenario, 1, 0)).Once()
+
+			resolver := scenario.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.DeleteScenario(context.TODO(), testScenario, testCase.RemoveFromLabels)
+
+			// THEN
+			require.NoError(t, err)
+			assert.Equal(t, fixGQLScenario(testScenario, 1, 0), result)
+		})
+	}
+
+	t.Run("Returns error when deleting failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testScenario).Return(fixModelScenario(testScenario, 1, 0), nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), testScenario, false).Return(testError).Once()
+
+		resolver := scenario.NewResolver(transact, svc, nil)
+
+		// WHEN
+		_, err := resolver.DeleteScenario(context.TODO(), testScenario, nil)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
+
+func TestResolver_RenameScenario(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	newName := "STAGING"
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Rename", txtest.CtxWithDBMatcher(), testScenario, newName).Return(fixModelScenario(newName, 1, 1), nil).Once()
+
+		conv := &automock.ScenarioConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", fixModelScenario(newName, 1, 1)).Return(fixGQLScenario(newName, 1, 1)).Once()
+
+		resolver := scenario.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.RenameScenario(context.TODO(), testScenario, newName)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLScenario(newName, 1, 1), result)
+	})
+
+	t.Run("Returns error when renaming failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Rename", txtest.CtxWithDBMatcher(), testScenario, newName).Return(nil, testError).Once()
+
+		resolver := scenario.NewResolver(transact, svc, nil)
+
+		// WHEN
+		_, err := resolver.RenameScenario(context.TODO(), testScenario, newName)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
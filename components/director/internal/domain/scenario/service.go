@@ -0,0 +1,432 @@
+package scenario
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+const defaultScenario = "DEFAULT"
+
+//go:generate mockery -name=LabelDefinitionRepository -output=automock -outpkg=automock -case=underscore
+type LabelDefinitionRepository interface {
+	GetByKey(ctx context.Context, tenant string, key string) (*model.LabelDefinition, error)
+	Update(ctx context.Context, def model.LabelDefinition) error
+}
+
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	ListByKey(ctx context.Context, tenant, key string) ([]*model.Label, error)
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+	ListMatchingObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, filter *labelfilter.LabelFilter) ([]string, error)
+}
+
+//go:generate mockery -name=ScenarioAssignmentRepository -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentRepository interface {
+	ExistsForScenario(ctx context.Context, tenant, scenario string) (bool, error)
+	RenameScenario(ctx context.Context, tenant, scenario, newScenario string) error
+}
+
+//go:generate mockery -name=ScenariosService -output=automock -outpkg=automock -case=underscore
+type ScenariosService interface {
+	EnsureScenariosLabelDefinitionExists(ctx context.Context, tenant string) error
+}
+
+//go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
+type ApplicationService interface {
+	SetLabel(ctx context.Context, label *model.LabelInput) error
+}
+
+//go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
+type RuntimeService interface {
+	SetLabel(ctx context.Context, label *model.LabelInput) error
+	DeleteLabel(ctx context.Context, runtimeID string, key string) error
+}
+
+type service struct {
+	labelDefRepo   LabelDefinitionRepository
+	labelRepo      LabelRepository
+	assignmentRepo ScenarioAssignmentRepository
+
+	scenariosService ScenariosService
+	appService       ApplicationService
+	runtimeService   RuntimeService
+}
+
+func NewService(labelDefRepo LabelDefinitionRepository, labelRepo LabelRepository, assignmentRepo ScenarioAssignmentRepository, scenariosService ScenariosService, appService ApplicationService, runtimeService RuntimeService) *service {
+	return &service{
+		labelDefRepo:     labelDefRepo,
+		labelRepo:        labelRepo,
+		assignmentRepo:   assignmentRepo,
+		scenariosService: scenariosService,
+		appService:       appService,
+		runtimeService:   runtimeService,
+	}
+}
+
+// List returns scenarios defined in the scenarios Label Definition with counts of Applications and Runtimes labeled with them
+func (s *service) List(ctx context.Context) ([]*model.Scenario, error) {
+	scenarioTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	names, err := s.listNames(ctx, scenarioTenant)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.labelRepo.ListByKey(ctx, scenarioTenant, model.ScenariosKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing scenarios labels")
+	}
+
+	scenarios := make([]*model.Scenario, 0, len(names))
+	for _, name := range names {
+		scenario := &model.Scenario{Name: name}
+		for _, label := range labels {
+			if !hasScenario(labelScenarios(label), name) {
+				continue
+			}
+			switch label.ObjectType {
+			case model.ApplicationLabelableObject:
+				scenario.ApplicationCount++
+			case model.RuntimeLabelableObject:
+				scenario.RuntimeCount++
+			}
+		}
+		scenarios = append(scenarios, scenario)
+	}
+
+	return scenarios, nil
+}
+
+// Get returns the scenario with counts of Applications and Runtimes labeled with it
+func (s *service) Get(ctx context.Context, name string) (*model.Scenario, error) {
+	scenarioTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	names, err := s.listNames(ctx, scenarioTenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if !containsName(names, name) {
+		return nil, apperrors.NewNotFoundError(name)
+	}
+
+	objects, err := s.listLabeledObjects(ctx, scenarioTenant, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return objects.toScenario(name), nil
+}
+
+// Create adds the scenario to the scenarios Label Definition and returns it. No object can be labeled with the new scenario yet.
+func (s *service) Create(ctx context.Context, name string) (*model.Scenario, error) {
+	labelDef, names, err := s.getDefinition(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if containsName(names, name) {
+		return nil, errors.Errorf("scenario %s already exists", name)
+	}
+
+	if err := s.updateDefinition(ctx, labelDef, append(names, name)); err != nil {
+		return nil, err
+	}
+
+	return &model.Scenario{Name: name}, nil
+}
+
+// Delete removes the scenario from the scenarios Label Definition. If removeFromLabels is set, the scenario is removed from all labels first.
+// Applications left without scenarios fall back to the default scenario.
+//
+// Labels are changed through the Application and Runtime services, so Scenario Assignments are evaluated for every changed object.
+func (s *service) Delete(ctx context.Context, name string, removeFromLabels bool) error {
+	if name == defaultScenario {
+		return errors.Errorf("scenario %s cannot be deleted", defaultScenario)
+	}
+
+	labelDef, names, err := s.getDefinition(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !containsName(names, name) {
+		return apperrors.NewNotFoundError(name)
+	}
+
+	assigned, err := s.assignmentRepo.ExistsForScenario(ctx, labelDef.Tenant, name)
+	if err != nil {
+		return errors.Wrap(err, "while checking Scenario Assignments")
+	}
+	if assigned {
+		return errors.Errorf("scenario %s is used by at least one Scenario Assignment", name)
+	}
+
+	objects, err := s.listLabeledObjects(ctx, labelDef.Tenant, name)
+	if err != nil {
+		return err
+	}
+	if !objects.empty() && !removeFromLabels {
+		return errors.Errorf("scenario %s is used by at least one label", name)
+	}
+
+	if err := s.replaceInLabels(ctx, labelDef.Tenant, objects, name, ""); err != nil {
+		return err
+	}
+
+	var remaining []string
+	for _, n := range names {
+		if n != name {
+			remaining = append(remaining, n)
+		}
+	}
+
+	return s.updateDefinition(ctx, labelDef, remaining)
+}
+
+// Rename replaces the scenario in the scenarios Label Definition, all Scenario Assignments and all labels, and returns the renamed scenario.
+//
+// Labels are changed through the Application and Runtime services, so Scenario Assignments are evaluated for every changed object.
+func (s *service) Rename(ctx context.Context, name, newName string) (*model.Scenario, error) {
+	if name == defaultScenario {
+		return nil, errors.Errorf("scenario %s cannot be renamed", defaultScenario)
+	}
+
+	labelDef, names, err := s.getDefinition(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !containsName(names, name) {
+		return nil, apperrors.NewNotFoundError(name)
+	}
+	if containsName(names, newName) {
+		return nil, errors.Errorf("scenario %s already exists", newName)
+	}
+
+	renamed := make([]string, 0, len(names))
+	for _, n := range names {
+		if n == name {
+			n = newName
+		}
+		renamed = append(renamed, n)
+	}
+
+	if err := s.updateDefinition(ctx, labelDef, renamed); err != nil {
+		return nil, err
+	}
+
+	if err := s.assignmentRepo.RenameScenario(ctx, labelDef.Tenant, name, newName); err != nil {
+		return nil, errors.Wrap(err, "while renaming scenario in Scenario Assignments")
+	}
+
+	objects, err := s.listLabeledObjects(ctx, labelDef.Tenant, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.replaceInLabels(ctx, labelDef.Tenant, objects, name, newName); err != nil {
+		return nil, err
+	}
+
+	return objects.toScenario(newName), nil
+}
+
+type labeledObjects struct {
+	applicationIDs []string
+	runtimeIDs     []string
+}
+
+func (o labeledObjects) empty() bool {
+	return len(o.applicationIDs) == 0 && len(o.runtimeIDs) == 0
+}
+
+func (o labeledObjects) toScenario(name string) *model.Scenario {
+	return &model.Scenario{
+		Name:             name,
+		ApplicationCount: len(o.applicationIDs),
+		RuntimeCount:     len(o.runtimeIDs),
+	}
+}
+
+func (s *service) listNames(ctx context.Context, scenarioTenant string) ([]string, error) {
+	var schema interface{} = model.ScenariosSchema
+	labelDef, err := s.labelDefRepo.GetByKey(ctx, scenarioTenant, model.ScenariosKey)
+	switch {
+	case err != nil && !apperrors.IsNotFoundError(err):
+		return nil, errors.Wrap(err, "while getting scenarios Label Definition")
+	case err == nil && labelDef.Schema != nil:
+		schema = *labelDef.Schema
+	}
+
+	return model.ScenariosFromSchema(schema)
+}
+
+func (s *service) listLabeledObjects(ctx context.Context, scenarioTenant, name string) (labeledObjects, error) {
+	filter := labelfilter.NewForArrayElement(model.ScenariosKey, name)
+
+	appIDs, err := s.labelRepo.ListMatchingObjectIDs(ctx, scenarioTenant, model.ApplicationLabelableObject, filter)
+	if err != nil {
+		return labeledObjects{}, errors.Wrapf(err, "while listing Applications with scenario %s", name)
+	}
+
+	runtimeIDs, err := s.labelRepo.ListMatchingObjectIDs(ctx, scenarioTenant, model.RuntimeLabelableObject, filter)
+	if err != nil {
+		return labeledObjects{}, errors.Wrapf(err, "while listing Runtimes with scenario %s", name)
+	}
+
+	return labeledObjects{applicationIDs: appIDs, runtimeIDs: runtimeIDs}, nil
+}
+
+// replaceInLabels replaces the scenario with the new one in the scenarios label of every object, or removes it if the new one is empty
+func (s *service) replaceInLabels(ctx context.Context, scenarioTenant string, objects labeledObjects, name, newName string) error {
+	for _, appID := range objects.applicationIDs {
+		scenarios, err := s.replaceScenario(ctx, scenarioTenant, model.ApplicationLabelableObject, appID, name, newName)
+		if err != nil {
+			return err
+		}
+		if len(scenarios) == 0 {
+			scenarios = model.ScenariosDefaultValue
+		}
+
+		err = s.appService.SetLabel(ctx, &model.LabelInput{
+			Key:        model.ScenariosKey,
+			Value:      scenarios,
+			ObjectID:   appID,
+			ObjectType: model.ApplicationLabelableObject,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "while updating scenarios of Application with ID %s", appID)
+		}
+	}
+
+	for _, runtimeID := range objects.runtimeIDs {
+		scenarios, err := s.replaceScenario(ctx, scenarioTenant, model.RuntimeLabelableObject, runtimeID, name, newName)
+		if err != nil {
+			return err
+		}
+
+		if len(scenarios) == 0 {
+			err = s.runtimeService.DeleteLabel(ctx, runtimeID, model.ScenariosKey)
+		} else {
+			err = s.runtimeService.SetLabel(ctx, &model.LabelInput{
+				Key:        model.ScenariosKey,
+				Value:      scenarios,
+				ObjectID:   runtimeID,
+				ObjectType: model.RuntimeLabelableObject,
+			})
+		}
+		if err != nil {
+			return errors.Wrapf(err, "while updating scenarios of Runtime with ID %s", runtimeID)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) replaceScenario(ctx context.Context, scenarioTenant string, objectType model.LabelableObject, objectID, name, newName string) ([]interface{}, error) {
+	label, err := s.labelRepo.GetByKey(ctx, scenarioTenant, objectType, objectID, model.ScenariosKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting scenarios of %s with ID %s", objectType, objectID)
+	}
+
+	var scenarios []interface{}
+	for _, scenario := range labelScenarios(label) {
+		switch {
+		case scenario != name:
+			scenarios = append(scenarios, scenario)
+		case newName != "":
+			scenarios = append(scenarios, newName)
+		}
+	}
+
+	return scenarios, nil
+}
+
+func (s *service) getDefinition(ctx context.Context) (*model.LabelDefinition, []string, error) {
+	scenarioTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if err := s.scenariosService.EnsureScenariosLabelDefinitionExists(ctx, scenarioTenant); err != nil {
+		return nil, nil, err
+	}
+
+	labelDef, err := s.labelDefRepo.GetByKey(ctx, scenarioTenant, model.ScenariosKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while getting scenarios Label Definition")
+	}
+
+	var schema interface{} = model.ScenariosSchema
+	if labelDef.Schema != nil {
+		schema = *labelDef.Schema
+	}
+
+	names, err := model.ScenariosFromSchema(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return labelDef, names, nil
+}
+
+func (s *service) updateDefinition(ctx context.Context, labelDef *model.LabelDefinition, names []string) error {
+	var schema interface{} = model.ScenariosSchema
+	if labelDef.Schema != nil {
+		schema = *labelDef.Schema
+	}
+
+	newSchema, err := model.SchemaWithScenarios(schema, names)
+	if err != nil {
+		return err
+	}
+	labelDef.Schema = &newSchema
+
+	if err := labelDef.ValidateForUpdate(); err != nil {
+		return errors.Wrap(err, "while validating scenarios Label Definition")
+	}
+
+	if err := s.labelDefRepo.Update(ctx, *labelDef); err != nil {
+		return errors.Wrap(err, "while updating scenarios Label Definition")
+	}
+
+	return nil
+}
+
+func labelScenarios(label *model.Label) []interface{} {
+	scenarios, ok := label.Value.([]interface{})
+	if !ok {
+		return nil
+	}
+	return scenarios
+}
+
+func hasScenario(scenarios []interface{}, name string) bool {
+	for _, scenario := range scenarios {
+		if scenario == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
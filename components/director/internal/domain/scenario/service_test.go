@@ -0,0 +1,662 @@
+package scenario_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_List(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	labels := []*model.Label{
+		fixScenariosLabel(model.ApplicationLabelableObject, "app1", "DEFAULT", testScenario),
+		fixScenariosLabel(model.ApplicationLabelableObject, "app2", "DEFAULT"),
+		fixScenariosLabel(model.RuntimeLabelableObject, "rt1", testScenario),
+	}
+
+	testCases := []struct {
+		Name               string
+		LabelDefRepoFn     func() *automock.LabelDefinitionRepository
+		LabelRepoFn        func() *automock.LabelRepository
+		ExpectedResult     []*model.Scenario
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", ctx, testTenant, model.ScenariosKey).Return(labels, nil).Once()
+				return repo
+			},
+			ExpectedResult: []*model.Scenario{fixModelScenario("DEFAULT", 2, 0), fixModelScenario(testScenario, 1, 1)},
+		},
+		{
+			Name: "Success when Label Definition does not exist",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(model.ScenariosKey)).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", ctx, testTenant, model.ScenariosKey).Return(nil, nil).Once()
+				return repo
+			},
+			ExpectedResult: []*model.Scenario{fixModelScenario("DEFAULT", 0, 0)},
+		},
+		{
+			Name: "Returns error when getting Label Definition failed",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(nil, testError).Once()
+				return repo
+			},
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			ExpectedErrMessage: "while getting scenarios Label Definition",
+		},
+		{
+			Name: "Returns error when listing labels failed",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT"), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", ctx, testTenant, model.ScenariosKey).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while listing scenarios labels",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelDefRepo := testCase.LabelDefRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+
+			svc := scenario.NewService(labelDefRepo, labelRepo, nil, nil, nil, nil)
+
+			// WHEN
+			result, err := svc.List(ctx)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelDefRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	filter := fixScenarioFilter(testScenario)
+
+	testCases := []struct {
+		Name               string
+		InputName          string
+		LabelDefRepoFn     func() *automock.LabelDefinitionRepository
+		LabelRepoFn        func() *automock.LabelRepository
+		ExpectedResult     *model.Scenario
+		ExpectedErrMessage string
+	}{
+		{
+			Name:      "Success",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return([]string{"app1", "app2"}, nil).Once()
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, filter).Return([]string{"rt1"}, nil).Once()
+				return repo
+			},
+			ExpectedResult: fixModelScenario(testScenario, 2, 1),
+		},
+		{
+			Name:      "Success when scenario name contains quotes",
+			InputName: `say "hi"`,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", `say "hi"`), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				query := `$[*] ? (@ == "say \"hi\"")`
+				quotedFilter := &labelfilter.LabelFilter{Key: model.ScenariosKey, Query: &query}
+
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, quotedFilter).Return([]string{"app1"}, nil).Once()
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, quotedFilter).Return(nil, nil).Once()
+				return repo
+			},
+			ExpectedResult: fixModelScenario(`say "hi"`, 1, 0),
+		},
+		{
+			Name:      "Returns not found error when scenario does not exist",
+			InputName: "STAGING",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			ExpectedErrMessage: "Object STAGING not found",
+		},
+		{
+			Name:      "Returns error when listing labeled objects failed",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while listing Applications with scenario PRODUCTION",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelDefRepo := testCase.LabelDefRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+
+			svc := scenario.NewService(labelDefRepo, labelRepo, nil, nil, nil, nil)
+
+			// WHEN
+			result, err := svc.Get(ctx, testCase.InputName)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelDefRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	testCases := []struct {
+		Name               string
+		InputName          string
+		LabelDefRepoFn     func() *automock.LabelDefinitionRepository
+		ScenariosSvcFn     func() *automock.ScenariosService
+		ExpectedErrMessage string
+	}{
+		{
+			Name:      "Success",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT"), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLabelDefinition("DEFAULT", testScenario)).Return(nil).Once()
+				return repo
+			},
+			ScenariosSvcFn: fixScenariosServiceThatSucceeds(ctx),
+		},
+		{
+			Name:      "Returns error when scenario already exists",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			ScenariosSvcFn:     fixScenariosServiceThatSucceeds(ctx),
+			ExpectedErrMessage: "scenario PRODUCTION already exists",
+		},
+		{
+			Name:      "Returns error when scenario name is invalid",
+			InputName: "-invalid",
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT"), nil).Once()
+				return repo
+			},
+			ScenariosSvcFn:     fixScenariosServiceThatSucceeds(ctx),
+			ExpectedErrMessage: "while validating scenarios Label Definition",
+		},
+		{
+			Name:           "Returns error when ensuring Label Definition failed",
+			InputName:      testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository { return &automock.LabelDefinitionRepository{} },
+			ScenariosSvcFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("EnsureScenariosLabelDefinitionExists", ctx, testTenant).Return(testError).Once()
+				return svc
+			},
+			ExpectedErrMessage: testError.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelDefRepo := testCase.LabelDefRepoFn()
+			scenariosSvc := testCase.ScenariosSvcFn()
+
+			svc := scenario.NewService(labelDefRepo, nil, nil, scenariosSvc, nil, nil)
+
+			// WHEN
+			result, err := svc.Create(ctx, testCase.InputName)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, fixModelScenario(testCase.InputName, 0, 0), result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelDefRepo.AssertExpectations(t)
+			scenariosSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	filter := fixScenarioFilter(testScenario)
+
+	labeledObjectsRepo := func() *automock.LabelRepository {
+		repo := &automock.LabelRepository{}
+		repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return([]string{"app1", "app2"}, nil).Once()
+		repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, filter).Return([]string{"rt1", "rt2"}, nil).Once()
+		return repo
+	}
+
+	testCases := []struct {
+		Name               string
+		InputName          string
+		RemoveFromLabels   bool
+		LabelDefRepoFn     func() *automock.LabelDefinitionRepository
+		LabelRepoFn        func() *automock.LabelRepository
+		AssignmentRepoFn   func() *automock.ScenarioAssignmentRepository
+		AppSvcFn           func() *automock.ApplicationService
+		RuntimeSvcFn       func() *automock.RuntimeService
+		ExpectedErrMessage string
+	}{
+		{
+			Name:      "Success when scenario is not used",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLabelDefinition("DEFAULT")).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return(nil, nil).Once()
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, filter).Return(nil, nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: fixAssignmentRepoWithoutAssignments(ctx),
+		},
+		{
+			Name:             "Success when scenario is removed from labels",
+			InputName:        testScenario,
+			RemoveFromLabels: true,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLabelDefinition("DEFAULT")).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := labeledObjectsRepo()
+				repo.On("GetByKey", ctx, testTenant, model.ApplicationLabelableObject, "app1", model.ScenariosKey).Return(fixScenariosLabel(model.ApplicationLabelableObject, "app1", "DEFAULT", testScenario), nil).Once()
+				repo.On("GetByKey", ctx, testTenant, model.ApplicationLabelableObject, "app2", model.ScenariosKey).Return(fixScenariosLabel(model.ApplicationLabelableObject, "app2", testScenario), nil).Once()
+				repo.On("GetByKey", ctx, testTenant, model.RuntimeLabelableObject, "rt1", model.ScenariosKey).Return(fixScenariosLabel(model.RuntimeLabelableObject, "rt1", testScenario), nil).Once()
+				repo.On("GetByKey", ctx, testTenant, model.RuntimeLabelableObject, "rt2", model.ScenariosKey).Return(fixScenariosLabel(model.RuntimeLabelableObject, "rt2", "DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: fixAssignmentRepoWithoutAssignments(ctx),
+			AppSvcFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetLabel", ctx, fixScenariosLabelInput(model.ApplicationLabelableObject, "app1", "DEFAULT")).Return(nil).Once()
+				svc.On("SetLabel", ctx, fixScenariosLabelInput(model.ApplicationLabelableObject, "app2", "DEFAULT")).Return(nil).Once()
+				return svc
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("DeleteLabel", ctx, "rt1", model.ScenariosKey).Return(nil).Once()
+				svc.On("SetLabel", ctx, fixScenariosLabelInput(model.RuntimeLabelableObject, "rt2", "DEFAULT")).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:             "Returns error when updating scenarios of Runtime failed",
+			InputName:        testScenario,
+			RemoveFromLabels: true,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return(nil, nil).Once()
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, filter).Return([]string{"rt1"}, nil).Once()
+				repo.On("GetByKey", ctx, testTenant, model.RuntimeLabelableObject, "rt1", model.ScenariosKey).Return(fixScenariosLabel(model.RuntimeLabelableObject, "rt1", testScenario), nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: fixAssignmentRepoWithoutAssignments(ctx),
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("DeleteLabel", ctx, "rt1", model.ScenariosKey).Return(testError).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while updating scenarios of Runtime with ID rt1",
+		},
+		{
+			Name:      "Returns error when scenario is used by label",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			LabelRepoFn:        labeledObjectsRepo,
+			AssignmentRepoFn:   fixAssignmentRepoWithoutAssignments(ctx),
+			ExpectedErrMessage: "scenario PRODUCTION is used by at least one label",
+		},
+		{
+			Name:      "Returns error when scenario is used by Scenario Assignment",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ExistsForScenario", ctx, testTenant, testScenario).Return(true, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "scenario PRODUCTION is used by at least one Scenario Assignment",
+		},
+		{
+			Name:      "Returns not found error when scenario does not exist",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT"), nil).Once()
+				return repo
+			},
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			AssignmentRepoFn:   func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			ExpectedErrMessage: "Object PRODUCTION not found",
+		},
+		{
+			Name:               "Returns error when deleting default scenario",
+			InputName:          "DEFAULT",
+			LabelDefRepoFn:     func() *automock.LabelDefinitionRepository { return &automock.LabelDefinitionRepository{} },
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			AssignmentRepoFn:   func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			ExpectedErrMessage: "scenario DEFAULT cannot be deleted",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelDefRepo := testCase.LabelDefRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			assignmentRepo := testCase.AssignmentRepoFn()
+			scenariosSvc := &automock.ScenariosService{}
+			scenariosSvc.On("EnsureScenariosLabelDefinitionExists", ctx, testTenant).Return(nil).Maybe()
+			appSvc := &automock.ApplicationService{}
+			if testCase.AppSvcFn != nil {
+				appSvc = testCase.AppSvcFn()
+			}
+			runtimeSvc := &automock.RuntimeService{}
+			if testCase.RuntimeSvcFn != nil {
+				runtimeSvc = testCase.RuntimeSvcFn()
+			}
+
+			svc := scenario.NewService(labelDefRepo, labelRepo, assignmentRepo, scenariosSvc, appSvc, runtimeSvc)
+
+			// WHEN
+			err := svc.Delete(ctx, testCase.InputName, testCase.RemoveFromLabels)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelDefRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			assignmentRepo.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			runtimeSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Rename(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	newName := "STAGING"
+	filter := fixScenarioFilter(testScenario)
+
+	testCases := []struct {
+		Name               string
+		InputName          string
+		LabelDefRepoFn     func() *automock.LabelDefinitionRepository
+		LabelRepoFn        func() *automock.LabelRepository
+		AssignmentRepoFn   func() *automock.ScenarioAssignmentRepository
+		AppSvcFn           func() *automock.ApplicationService
+		RuntimeSvcFn       func() *automock.RuntimeService
+		ExpectedResult     *model.Scenario
+		ExpectedErrMessage string
+	}{
+		{
+			Name:      "Success",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLabelDefinition("DEFAULT", newName)).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return([]string{"app1"}, nil).Once()
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, filter).Return([]string{"rt1"}, nil).Once()
+				repo.On("GetByKey", ctx, testTenant, model.ApplicationLabelableObject, "app1", model.ScenariosKey).Return(fixScenariosLabel(model.ApplicationLabelableObject, "app1", "DEFAULT", testScenario), nil).Once()
+				repo.On("GetByKey", ctx, testTenant, model.RuntimeLabelableObject, "rt1", model.ScenariosKey).Return(fixScenariosLabel(model.RuntimeLabelableObject, "rt1", testScenario), nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("RenameScenario", ctx, testTenant, testScenario, newName).Return(nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetLabel", ctx, fixScenariosLabelInput(model.ApplicationLabelableObject, "app1", "DEFAULT", newName)).Return(nil).Once()
+				return svc
+			},
+			RuntimeSvcFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("SetLabel", ctx, fixScenariosLabelInput(model.RuntimeLabelableObject, "rt1", newName)).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: fixModelScenario(newName, 1, 1),
+		},
+		{
+			Name:      "Returns error when updating scenarios of Application failed",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLabelDefinition("DEFAULT", newName)).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.ApplicationLabelableObject, filter).Return([]string{"app1"}, nil).Once()
+				repo.On("ListMatchingObjectIDs", ctx, testTenant, model.RuntimeLabelableObject, filter).Return(nil, nil).Once()
+				repo.On("GetByKey", ctx, testTenant, model.ApplicationLabelableObject, "app1", model.ScenariosKey).Return(fixScenariosLabel(model.ApplicationLabelableObject, "app1", testScenario), nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("RenameScenario", ctx, testTenant, testScenario, newName).Return(nil).Once()
+				return repo
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("SetLabel", ctx, fixScenariosLabelInput(model.ApplicationLabelableObject, "app1", newName)).Return(testError).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while updating scenarios of Application with ID app1",
+		},
+		{
+			Name:      "Returns error when new name already exists",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario, newName), nil).Once()
+				return repo
+			},
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			AssignmentRepoFn:   func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			ExpectedErrMessage: "scenario STAGING already exists",
+		},
+		{
+			Name:      "Returns not found error when scenario does not exist",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT"), nil).Once()
+				return repo
+			},
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			AssignmentRepoFn:   func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			ExpectedErrMessage: "Object PRODUCTION not found",
+		},
+		{
+			Name:               "Returns error when renaming default scenario",
+			InputName:          "DEFAULT",
+			LabelDefRepoFn:     func() *automock.LabelDefinitionRepository { return &automock.LabelDefinitionRepository{} },
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			AssignmentRepoFn:   func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			ExpectedErrMessage: "scenario DEFAULT cannot be renamed",
+		},
+		{
+			Name:      "Returns error when renaming scenario in Scenario Assignments failed",
+			InputName: testScenario,
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				repo.On("Update", ctx, *fixScenariosLabelDefinition("DEFAULT", newName)).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			AssignmentRepoFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("RenameScenario", ctx, testTenant, testScenario, newName).Return(testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while renaming scenario in Scenario Assignments",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelDefRepo := testCase.LabelDefRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			assignmentRepo := testCase.AssignmentRepoFn()
+			scenariosSvc := &automock.ScenariosService{}
+			scenariosSvc.On("EnsureScenariosLabelDefinitionExists", ctx, testTenant).Return(nil).Maybe()
+			appSvc := &automock.ApplicationService{}
+			if testCase.AppSvcFn != nil {
+				appSvc = testCase.AppSvcFn()
+			}
+			runtimeSvc := &automock.RuntimeService{}
+			if testCase.RuntimeSvcFn != nil {
+				runtimeSvc = testCase.RuntimeSvcFn()
+			}
+
+			svc := scenario.NewService(labelDefRepo, labelRepo, assignmentRepo, scenariosSvc, appSvc, runtimeSvc)
+
+			// WHEN
+			result, err := svc.Rename(ctx, testCase.InputName, newName)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelDefRepo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			assignmentRepo.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			runtimeSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func fixScenariosServiceThatSucceeds(ctx context.Context) func() *automock.ScenariosService {
+	return func() *automock.ScenariosService {
+		svc := &automock.ScenariosService{}
+		svc.On("EnsureScenariosLabelDefinitionExists", ctx, testTenant).Return(nil).Once()
+		return svc
+	}
+}
+
+func fixAssignmentRepoWithoutAssignments(ctx context.Context) func() *automock.ScenarioAssignmentRepository {
+	return func() *automock.ScenarioAssignmentRepository {
+		repo := &automock.ScenarioAssignmentRepository{}
+		repo.On("ExistsForScenario", ctx, testTenant, testScenario).Return(false, nil).Once()
+		return repo
+	}
+}
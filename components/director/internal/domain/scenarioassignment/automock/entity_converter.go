@@ -0,0 +1,56 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	scenarioassignment "github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) FromEntity(in scenarioassignment.Entity) (model.ScenarioAssignment, error) {
+	ret := _m.Called(in)
+
+	var r0 model.ScenarioAssignment
+	if rf, ok := ret.Get(0).(func(scenarioassignment.Entity) model.ScenarioAssignment); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.ScenarioAssignment)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(scenarioassignment.Entity) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in model.ScenarioAssignment) (scenarioassignment.Entity, error) {
+	ret := _m.Called(in)
+
+	var r0 scenarioassignment.Entity
+	if rf, ok := ret.Get(0).(func(model.ScenarioAssignment) scenarioassignment.Entity); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(scenarioassignment.Entity)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(model.ScenarioAssignment) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
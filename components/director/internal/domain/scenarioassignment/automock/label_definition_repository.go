@@ -0,0 +1,38 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LabelDefinitionRepository is an autogenerated mock type for the LabelDefinitionRepository type
+type LabelDefinitionRepository struct {
+	mock.Mock
+}
+
+// GetByKey provides a mock function with given fields: ctx, tenant, key
+func (_m *LabelDefinitionRepository) GetByKey(ctx context.Context, tenant string, key string) (*model.LabelDefinition, error) {
+	ret := _m.Called(ctx, tenant, key)
+
+	var r0 *model.LabelDefinition
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.LabelDefinition); ok {
+		r0 = rf(ctx, tenant, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LabelDefinition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,67 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioAssignmentConverter is an autogenerated mock type for the ScenarioAssignmentConverter type
+type ScenarioAssignmentConverter struct {
+	mock.Mock
+}
+
+// InputFromGraphQL provides a mock function with given fields: in
+func (_m *ScenarioAssignmentConverter) InputFromGraphQL(in graphql.ScenarioAssignmentInput) (model.ScenarioAssignmentInput, error) {
+	ret := _m.Called(in)
+
+	var r0 model.ScenarioAssignmentInput
+	if rf, ok := ret.Get(0).(func(graphql.ScenarioAssignmentInput) model.ScenarioAssignmentInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.ScenarioAssignmentInput)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(graphql.ScenarioAssignmentInput) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *ScenarioAssignmentConverter) MultipleToGraphQL(in []*model.ScenarioAssignment) []*graphql.ScenarioAssignment {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.ScenarioAssignment
+	if rf, ok := ret.Get(0).(func([]*model.ScenarioAssignment) []*graphql.ScenarioAssignment); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.ScenarioAssignment)
+		}
+	}
+
+	return r0
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *ScenarioAssignmentConverter) ToGraphQL(in *model.ScenarioAssignment) *graphql.ScenarioAssignment {
+	ret := _m.Called(in)
+
+	var r0 *graphql.ScenarioAssignment
+	if rf, ok := ret.Get(0).(func(*model.ScenarioAssignment) *graphql.ScenarioAssignment); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ScenarioAssignment)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,264 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioAssignmentRepository is an autogenerated mock type for the ScenarioAssignmentRepository type
+type ScenarioAssignmentRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, item
+func (_m *ScenarioAssignmentRepository) Create(ctx context.Context, item *model.ScenarioAssignment) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ScenarioAssignment) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTarget provides a mock function with given fields: ctx, tenant, assignmentID, objectType, objectID
+func (_m *ScenarioAssignmentRepository) CreateTarget(ctx context.Context, tenant string, assignmentID string, objectType model.LabelableObject, objectID string) error {
+	ret := _m.Called(ctx, tenant, assignmentID, objectType, objectID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.LabelableObject, string) error); ok {
+		r0 = rf(ctx, tenant, assignmentID, objectType, objectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, tenant, id
+func (_m *ScenarioAssignmentRepository) Delete(ctx context.Context, tenant string, id string) error {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTarget provides a mock function with given fields: ctx, tenant, assignmentID, objectType, objectID
+func (_m *ScenarioAssignmentRepository) DeleteTarget(ctx context.Context, tenant string, assignmentID string, objectType model.LabelableObject, objectID string) error {
+	ret := _m.Called(ctx, tenant, assignmentID, objectType, objectID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.LabelableObject, string) error); ok {
+		r0 = rf(ctx, tenant, assignmentID, objectType, objectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, tenant, id
+func (_m *ScenarioAssignmentRepository) GetByID(ctx context.Context, tenant string, id string) (*model.ScenarioAssignment, error) {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 *model.ScenarioAssignment
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ScenarioAssignment); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScenarioAssignment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, tenant, pageSize, cursor
+func (_m *ScenarioAssignmentRepository) List(ctx context.Context, tenant string, pageSize int, cursor string) (*model.ScenarioAssignmentPage, error) {
+	ret := _m.Called(ctx, tenant, pageSize, cursor)
+
+	var r0 *model.ScenarioAssignmentPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.ScenarioAssignmentPage); ok {
+		r0 = rf(ctx, tenant, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScenarioAssignmentPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, tenant, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForTargetType provides a mock function with given fields: ctx, tenant, targetType
+func (_m *ScenarioAssignmentRepository) ListForTargetType(ctx context.Context, tenant string, targetType model.LabelableObject) ([]*model.ScenarioAssignment, error) {
+	ret := _m.Called(ctx, tenant, targetType)
+
+	var r0 []*model.ScenarioAssignment
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject) []*model.ScenarioAssignment); ok {
+		r0 = rf(ctx, tenant, targetType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ScenarioAssignment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject) error); ok {
+		r1 = rf(ctx, tenant, targetType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMatchingObjectIDs provides a mock function with given fields: ctx, tenant, objectType, filter
+func (_m *ScenarioAssignmentRepository) ListMatchingObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, filter *labelfilter.LabelFilter) ([]string, error) {
+	ret := _m.Called(ctx, tenant, objectType, filter)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, *labelfilter.LabelFilter) []string); ok {
+		r0 = rf(ctx, tenant, objectType, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, *labelfilter.LabelFilter) error); ok {
+		r1 = rf(ctx, tenant, objectType, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTargetObjectIDs provides a mock function with given fields: ctx, tenant, assignmentID
+func (_m *ScenarioAssignmentRepository) ListTargetObjectIDs(ctx context.Context, tenant string, assignmentID string) ([]string, error) {
+	ret := _m.Called(ctx, tenant, assignmentID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = rf(ctx, tenant, assignmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, assignmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Matches provides a mock function with given fields: ctx, tenant, objectType, objectID, filter
+func (_m *ScenarioAssignmentRepository) Matches(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, filter *labelfilter.LabelFilter) (bool, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectID, filter)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, string, *labelfilter.LabelFilter) bool); ok {
+		r0 = rf(ctx, tenant, objectType, objectID, filter)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, string, *labelfilter.LabelFilter) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScenarioTargetExists provides a mock function with given fields: ctx, tenant, scenario, objectType, objectID, excludedAssignmentID
+func (_m *ScenarioAssignmentRepository) ScenarioTargetExists(ctx context.Context, tenant string, scenario string, objectType model.LabelableObject, objectID string, excludedAssignmentID string) (bool, error) {
+	ret := _m.Called(ctx, tenant, scenario, objectType, objectID, excludedAssignmentID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.LabelableObject, string, string) bool); ok {
+		r0 = rf(ctx, tenant, scenario, objectType, objectID, excludedAssignmentID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.LabelableObject, string, string) error); ok {
+		r1 = rf(ctx, tenant, scenario, objectType, objectID, excludedAssignmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TargetExists provides a mock function with given fields: ctx, tenant, assignmentID, objectType, objectID
+func (_m *ScenarioAssignmentRepository) TargetExists(ctx context.Context, tenant string, assignmentID string, objectType model.LabelableObject, objectID string) (bool, error) {
+	ret := _m.Called(ctx, tenant, assignmentID, objectType, objectID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.LabelableObject, string) bool); ok {
+		r0 = rf(ctx, tenant, assignmentID, objectType, objectID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.LabelableObject, string) error); ok {
+		r1 = rf(ctx, tenant, assignmentID, objectType, objectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *ScenarioAssignmentRepository) Update(ctx context.Context, item *model.ScenarioAssignment) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ScenarioAssignment) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
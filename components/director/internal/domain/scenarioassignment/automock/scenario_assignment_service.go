@@ -0,0 +1,110 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioAssignmentService is an autogenerated mock type for the ScenarioAssignmentService type
+type ScenarioAssignmentService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, in
+func (_m *ScenarioAssignmentService) Create(ctx context.Context, in model.ScenarioAssignmentInput) (string, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, model.ScenarioAssignmentInput) string); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.ScenarioAssignmentInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *ScenarioAssignmentService) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ScenarioAssignmentService) Get(ctx context.Context, id string) (*model.ScenarioAssignment, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.ScenarioAssignment
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ScenarioAssignment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScenarioAssignment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, pageSize, cursor
+func (_m *ScenarioAssignmentService) List(ctx context.Context, pageSize int, cursor string) (*model.ScenarioAssignmentPage, error) {
+	ret := _m.Called(ctx, pageSize, cursor)
+
+	var r0 *model.ScenarioAssignmentPage
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *model.ScenarioAssignmentPage); ok {
+		r0 = rf(ctx, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScenarioAssignmentPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, in
+func (_m *ScenarioAssignmentService) Update(ctx context.Context, id string, in model.ScenarioAssignmentInput) error {
+	ret := _m.Called(ctx, id, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ScenarioAssignmentInput) error); ok {
+		r0 = rf(ctx, id, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
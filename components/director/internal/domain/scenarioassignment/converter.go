@@ -0,0 +1,129 @@
+package scenarioassignment
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.ScenarioAssignment) *graphql.ScenarioAssignment {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.ScenarioAssignment{
+		ID:         in.ID,
+		Scenario:   in.Scenario,
+		TargetType: targetTypeToGraphQL(in.TargetType),
+		Filter:     filterToGraphQL(in.Filter),
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.ScenarioAssignment) []*graphql.ScenarioAssignment {
+	var assignments []*graphql.ScenarioAssignment
+	for _, a := range in {
+		if a == nil {
+			continue
+		}
+
+		assignments = append(assignments, c.ToGraphQL(a))
+	}
+
+	return assignments
+}
+
+func (c *converter) InputFromGraphQL(in graphql.ScenarioAssignmentInput) (model.ScenarioAssignmentInput, error) {
+	filter, err := labelfilter.ExpressionFromGraphQL(in.Filter)
+	if err != nil {
+		return model.ScenarioAssignmentInput{}, errors.Wrap(err, "while converting filter")
+	}
+
+	return model.ScenarioAssignmentInput{
+		Scenario:   in.Scenario,
+		TargetType: targetTypeFromGraphQL(in.TargetType),
+		Filter:     filter,
+	}, nil
+}
+
+func (c *converter) ToEntity(in model.ScenarioAssignment) (Entity, error) {
+	filter, err := json.Marshal(in.Filter)
+	if err != nil {
+		return Entity{}, errors.Wrap(err, "while marshalling Filter")
+	}
+
+	return Entity{
+		ID:         in.ID,
+		TenantID:   in.Tenant,
+		Scenario:   in.Scenario,
+		TargetType: string(in.TargetType),
+		Filter:     string(filter),
+	}, nil
+}
+
+func (c *converter) FromEntity(in Entity) (model.ScenarioAssignment, error) {
+	var filter labelfilter.LabelFilter
+	if err := json.Unmarshal([]byte(in.Filter), &filter); err != nil {
+		return model.ScenarioAssignment{}, errors.Wrap(err, "while unmarshalling Filter")
+	}
+
+	return model.ScenarioAssignment{
+		ID:         in.ID,
+		Tenant:     in.TenantID,
+		Scenario:   in.Scenario,
+		TargetType: model.LabelableObject(in.TargetType),
+		Filter:     &filter,
+	}, nil
+}
+
+func targetTypeToGraphQL(in model.LabelableObject) graphql.ScenarioAssignmentTargetType {
+	if in == model.RuntimeLabelableObject {
+		return graphql.ScenarioAssignmentTargetTypeRuntime
+	}
+	return graphql.ScenarioAssignmentTargetTypeApplication
+}
+
+func targetTypeFromGraphQL(in graphql.ScenarioAssignmentTargetType) model.LabelableObject {
+	if in == graphql.ScenarioAssignmentTargetTypeRuntime {
+		return model.RuntimeLabelableObject
+	}
+	return model.ApplicationLabelableObject
+}
+
+// filterToGraphQL returns the filter in the shape of the LabelFilterExpression input
+func filterToGraphQL(in *labelfilter.LabelFilter) map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+
+	switch {
+	case in.And != nil:
+		return map[string]interface{}{"and": multipleFiltersToGraphQL(in.And)}
+	case in.Or != nil:
+		return map[string]interface{}{"or": multipleFiltersToGraphQL(in.Or)}
+	case in.Not != nil:
+		return map[string]interface{}{"not": filterToGraphQL(in.Not)}
+	}
+
+	label := map[string]interface{}{"key": in.Key}
+	if in.Query != nil {
+		label["query"] = *in.Query
+	}
+	return map[string]interface{}{"label": label}
+}
+
+func multipleFiltersToGraphQL(in []*labelfilter.LabelFilter) []interface{} {
+	filters := make([]interface{}, 0, len(in))
+	for _, f := range in {
+		filters = append(filters, filterToGraphQL(f))
+	}
+	return filters
+}
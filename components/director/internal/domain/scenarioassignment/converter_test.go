@@ -0,0 +1,123 @@
+package scenarioassignment_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := scenarioassignment.NewConverter()
+
+		// WHEN
+		result := conv.ToGraphQL(fixModelScenarioAssignment(testID, model.RuntimeLabelableObject))
+
+		// THEN
+		assert.Equal(t, fixGQLScenarioAssignment(testID), result)
+	})
+
+	t.Run("Converts filter to the shape of the input", func(t *testing.T) {
+		// GIVEN
+		conv := scenarioassignment.NewConverter()
+		in := fixModelScenarioAssignment(testID, model.ApplicationLabelableObject)
+		in.Filter = &labelfilter.LabelFilter{
+			And: []*labelfilter.LabelFilter{
+				{Key: "region", Query: str.Ptr(`$ ? (@ == "eu")`)},
+				{Not: &labelfilter.LabelFilter{Key: "deprecated"}},
+			},
+		}
+
+		// WHEN
+		result := conv.ToGraphQL(in)
+
+		// THEN
+		assert.Equal(t, graphql.ScenarioAssignmentTargetTypeApplication, result.TargetType)
+		assert.Equal(t, map[string]interface{}{
+			"and": []interface{}{
+				map[string]interface{}{"label": map[string]interface{}{"key": "region", "query": `$ ? (@ == "eu")`}},
+				map[string]interface{}{"not": map[string]interface{}{"label": map[string]interface{}{"key": "deprecated"}}},
+			},
+		}, result.Filter)
+	})
+
+	t.Run("Returns nil for nil input", func(t *testing.T) {
+		assert.Nil(t, scenarioassignment.NewConverter().ToGraphQL(nil))
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := scenarioassignment.NewConverter()
+	in := []*model.ScenarioAssignment{
+		fixModelScenarioAssignment("foo", model.RuntimeLabelableObject),
+		nil,
+		fixModelScenarioAssignment("bar", model.RuntimeLabelableObject),
+	}
+
+	// WHEN
+	result := conv.MultipleToGraphQL(in)
+
+	// THEN
+	assert.Equal(t, []*graphql.ScenarioAssignment{fixGQLScenarioAssignment("foo"), fixGQLScenarioAssignment("bar")}, result)
+}
+
+func TestConverter_InputFromGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		result, err := scenarioassignment.NewConverter().InputFromGraphQL(fixGQLScenarioAssignmentInput())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixModelScenarioAssignmentInput(), result)
+	})
+
+	t.Run("Returns error when filter is invalid", func(t *testing.T) {
+		// GIVEN
+		in := fixGQLScenarioAssignmentInput()
+		in.Filter = &graphql.LabelFilterExpression{}
+
+		// WHEN
+		_, err := scenarioassignment.NewConverter().InputFromGraphQL(in)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while converting filter")
+	})
+}
+
+func TestConverter_EntityRoundTrip(t *testing.T) {
+	// GIVEN
+	conv := scenarioassignment.NewConverter()
+	in := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+
+	// WHEN
+	entity, err := conv.ToEntity(*in)
+	require.NoError(t, err)
+	result, err := conv.FromEntity(entity)
+	require.NoError(t, err)
+
+	// THEN
+	assert.Equal(t, fixEntityScenarioAssignment(testID), entity)
+	assert.Equal(t, *in, result)
+}
+
+func TestConverter_FromEntity_InvalidFilter(t *testing.T) {
+	// GIVEN
+	entity := fixEntityScenarioAssignment(testID)
+	entity.Filter = "{"
+
+	// WHEN
+	_, err := scenarioassignment.NewConverter().FromEntity(entity)
+
+	// THEN
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "while unmarshalling Filter")
+}
@@ -0,0 +1,245 @@
+package scenarioassignment
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
+}
+
+//go:generate mockery -name=LabelUpsertService -output=automock -outpkg=automock -case=underscore
+type LabelUpsertService interface {
+	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
+}
+
+//go:generate mockery -name=WebhookNotifier -output=automock -outpkg=automock -case=underscore
+type WebhookNotifier interface {
+	NotifyConfigurationChanged(ctx context.Context, applicationID string, change model.ConfigurationChange) error
+}
+
+// engine keeps the scenarios label of Applications and Runtimes in line with Scenario Assignments.
+//
+// Every object to which an assignment adds its scenario is recorded as a target of the assignment.
+// A scenario which was already present on the object when the assignment started matching it is considered manually set,
+// so it is not recorded and it is kept when the assignment no longer matches the object or is deleted.
+type engine struct {
+	repo      ScenarioAssignmentRepository
+	labelRepo LabelRepository
+
+	labelUpsertService LabelUpsertService
+	notifier           WebhookNotifier
+}
+
+func NewEngine(repo ScenarioAssignmentRepository, labelRepo LabelRepository, labelUpsertService LabelUpsertService, notifier WebhookNotifier) *engine {
+	return &engine{
+		repo:               repo,
+		labelRepo:          labelRepo,
+		labelUpsertService: labelUpsertService,
+		notifier:           notifier,
+	}
+}
+
+// EvaluateForObject adds scenarios of assignments matching the object and removes scenarios added by assignments which no longer match it
+func (e *engine) EvaluateForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) error {
+	assignments, err := e.repo.ListForTargetType(ctx, tenant, objectType)
+	if err != nil {
+		return errors.Wrapf(err, "while listing Scenario Assignments for %s", objectType)
+	}
+
+	for _, assignment := range assignments {
+		matches, err := e.repo.Matches(ctx, tenant, objectType, objectID, assignment.Filter)
+		if err != nil {
+			return errors.Wrapf(err, "while checking if %s with ID %s matches Scenario Assignment with ID %s", objectType, objectID, assignment.ID)
+		}
+
+		if matches {
+			err = e.assign(ctx, *assignment, objectID)
+		} else {
+			err = e.unassign(ctx, *assignment, objectID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AssignAll adds the scenario to every object matching the assignment
+func (e *engine) AssignAll(ctx context.Context, assignment model.ScenarioAssignment) error {
+	objectIDs, err := e.repo.ListMatchingObjectIDs(ctx, assignment.Tenant, assignment.TargetType, assignment.Filter)
+	if err != nil {
+		return errors.Wrapf(err, "while listing objects matching Scenario Assignment with ID %s", assignment.ID)
+	}
+
+	for _, objectID := range objectIDs {
+		if err := e.assign(ctx, assignment, objectID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnassignAll removes the scenario from every object to which it was added by the assignment
+func (e *engine) UnassignAll(ctx context.Context, assignment model.ScenarioAssignment) error {
+	objectIDs, err := e.repo.ListTargetObjectIDs(ctx, assignment.Tenant, assignment.ID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing targets of Scenario Assignment with ID %s", assignment.ID)
+	}
+
+	for _, objectID := range objectIDs {
+		if err := e.unassign(ctx, assignment, objectID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *engine) assign(ctx context.Context, assignment model.ScenarioAssignment, objectID string) error {
+	targeted, err := e.repo.TargetExists(ctx, assignment.Tenant, assignment.ID, assignment.TargetType, objectID)
+	if err != nil {
+		return errors.Wrap(err, "while checking Scenario Assignment target existence")
+	}
+
+	scenarios, err := e.getScenarios(ctx, assignment.Tenant, assignment.TargetType, objectID)
+	if err != nil {
+		return err
+	}
+
+	if !containsScenario(scenarios, assignment.Scenario) {
+		err = e.setScenarios(ctx, assignment.Tenant, assignment.TargetType, objectID, append(scenarios, assignment.Scenario))
+		if err != nil {
+			return err
+		}
+	} else if !targeted {
+		addedByOther, err := e.repo.ScenarioTargetExists(ctx, assignment.Tenant, assignment.Scenario, assignment.TargetType, objectID, assignment.ID)
+		if err != nil {
+			return errors.Wrap(err, "while checking Scenario Assignment targets")
+		}
+		if !addedByOther {
+			return nil
+		}
+	}
+
+	if targeted {
+		return nil
+	}
+
+	err = e.repo.CreateTarget(ctx, assignment.Tenant, assignment.ID, assignment.TargetType, objectID)
+	if err != nil {
+		return errors.Wrapf(err, "while creating target of Scenario Assignment with ID %s", assignment.ID)
+	}
+
+	return nil
+}
+
+func (e *engine) unassign(ctx context.Context, assignment model.ScenarioAssignment, objectID string) error {
+	targeted, err := e.repo.TargetExists(ctx, assignment.Tenant, assignment.ID, assignment.TargetType, objectID)
+	if err != nil {
+		return errors.Wrap(err, "while checking Scenario Assignment target existence")
+	}
+	if !targeted {
+		return nil
+	}
+
+	err = e.repo.DeleteTarget(ctx, assignment.Tenant, assignment.ID, assignment.TargetType, objectID)
+	if err != nil {
+		return errors.Wrapf(err, "while deleting target of Scenario Assignment with ID %s", assignment.ID)
+	}
+
+	addedByOther, err := e.repo.ScenarioTargetExists(ctx, assignment.Tenant, assignment.Scenario, assignment.TargetType, objectID, assignment.ID)
+	if err != nil {
+		return errors.Wrap(err, "while checking Scenario Assignment targets")
+	}
+	if addedByOther {
+		return nil
+	}
+
+	scenarios, err := e.getScenarios(ctx, assignment.Tenant, assignment.TargetType, objectID)
+	if err != nil {
+		return err
+	}
+	if !containsScenario(scenarios, assignment.Scenario) {
+		return nil
+	}
+
+	var remaining []interface{}
+	for _, scenario := range scenarios {
+		if scenario != assignment.Scenario {
+			remaining = append(remaining, scenario)
+		}
+	}
+
+	return e.setScenarios(ctx, assignment.Tenant, assignment.TargetType, objectID, remaining)
+}
+
+func (e *engine) getScenarios(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) ([]interface{}, error) {
+	label, err := e.labelRepo.GetByKey(ctx, tenant, objectType, objectID, model.ScenariosKey)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while getting scenarios of %s with ID %s", objectType, objectID)
+	}
+
+	scenarios, ok := label.Value.([]interface{})
+	if !ok {
+		return nil, errors.Errorf("scenarios of %s with ID %s are not a list", objectType, objectID)
+	}
+
+	return scenarios, nil
+}
+
+// setScenarios replaces the scenarios of the object. Applications without scenarios fall back to the default scenario
+// and are notified about the change.
+func (e *engine) setScenarios(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, scenarios []interface{}) error {
+	if len(scenarios) == 0 {
+		if objectType != model.ApplicationLabelableObject {
+			err := e.labelRepo.Delete(ctx, tenant, objectType, objectID, model.ScenariosKey)
+			if err != nil {
+				return errors.Wrapf(err, "while deleting scenarios of %s with ID %s", objectType, objectID)
+			}
+			return nil
+		}
+		scenarios = model.ScenariosDefaultValue
+	}
+
+	err := e.labelUpsertService.UpsertLabel(ctx, tenant, &model.LabelInput{
+		Key:        model.ScenariosKey,
+		Value:      scenarios,
+		ObjectID:   objectID,
+		ObjectType: objectType,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while setting scenarios of %s with ID %s", objectType, objectID)
+	}
+
+	if objectType != model.ApplicationLabelableObject {
+		return nil
+	}
+
+	err = e.notifier.NotifyConfigurationChanged(ctx, objectID, model.NewLabelConfigurationChange(model.ScenariosKey, model.ConfigurationChangeOperationUpdated))
+	if err != nil {
+		return errors.Wrapf(err, "while notifying about change of scenarios of Application with ID %s", objectID)
+	}
+
+	return nil
+}
+
+func containsScenario(scenarios []interface{}, scenario string) bool {
+	for _, s := range scenarios {
+		if s == scenario {
+			return true
+		}
+	}
+	return false
+}
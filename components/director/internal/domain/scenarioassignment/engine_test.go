@@ -0,0 +1,396 @@
+package scenarioassignment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_EvaluateForObject(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	assignment := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+	objectType := model.RuntimeLabelableObject
+
+	testCases := []struct {
+		Name                 string
+		RepositoryFn         func() *automock.ScenarioAssignmentRepository
+		LabelRepositoryFn    func() *automock.LabelRepository
+		LabelUpsertServiceFn func() *automock.LabelUpsertService
+		ExpectedErrMessage   string
+	}{
+		{
+			Name: "Success when matching object gets the scenario",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(true, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(false, nil).Once()
+				repo.On("CreateTarget", ctx, testTenant, testID, objectType, testObjectID).Return(nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel("DEFAULT"), nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, testTenant, fixScenariosLabelInput(objectType, "DEFAULT", testScenario)).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Success when manually set scenario is not recorded as a target",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(true, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(false, nil).Once()
+				repo.On("ScenarioTargetExists", ctx, testTenant, testScenario, objectType, testObjectID, testID).Return(false, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel(testScenario), nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+		},
+		{
+			Name: "Success when scenario added by another assignment is recorded as a target",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(true, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(false, nil).Once()
+				repo.On("ScenarioTargetExists", ctx, testTenant, testScenario, objectType, testObjectID, testID).Return(true, nil).Once()
+				repo.On("CreateTarget", ctx, testTenant, testID, objectType, testObjectID).Return(nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel(testScenario), nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+		},
+		{
+			Name: "Success when object without scenarios label gets the scenario",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(true, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(testObjectID)).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, testTenant, fixScenariosLabelInput(objectType, testScenario)).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Success when scenario is removed from object which no longer matches",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(false, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(true, nil).Once()
+				repo.On("DeleteTarget", ctx, testTenant, testID, objectType, testObjectID).Return(nil).Once()
+				repo.On("ScenarioTargetExists", ctx, testTenant, testScenario, objectType, testObjectID, testID).Return(false, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, testTenant, fixScenariosLabelInput(objectType, "DEFAULT")).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Success when scenarios label of Runtime is deleted after removing the last scenario",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(false, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(true, nil).Once()
+				repo.On("DeleteTarget", ctx, testTenant, testID, objectType, testObjectID).Return(nil).Once()
+				repo.On("ScenarioTargetExists", ctx, testTenant, testScenario, objectType, testObjectID, testID).Return(false, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel(testScenario), nil).Once()
+				repo.On("Delete", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+		},
+		{
+			Name: "Success when scenario added by another assignment is kept on object which no longer matches",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(false, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(true, nil).Once()
+				repo.On("DeleteTarget", ctx, testTenant, testID, objectType, testObjectID).Return(nil).Once()
+				repo.On("ScenarioTargetExists", ctx, testTenant, testScenario, objectType, testObjectID, testID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+		},
+		{
+			Name: "Success when non-matching object is not a target",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(false, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(false, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+		},
+		{
+			Name: "Returns error when listing Scenario Assignments failed",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return(nil, testError).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			ExpectedErrMessage: "while listing Scenario Assignments for Runtime",
+		},
+		{
+			Name: "Returns error when matching failed",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(false, testError).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			ExpectedErrMessage: testError.Error(),
+		},
+		{
+			Name: "Returns error when upserting scenarios failed",
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("ListForTargetType", ctx, testTenant, objectType).Return([]*model.ScenarioAssignment{assignment}, nil).Once()
+				repo.On("Matches", ctx, testTenant, objectType, testObjectID, assignment.Filter).Return(true, nil).Once()
+				repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(false, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel(), nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, testTenant, fixScenariosLabelInput(objectType, testScenario)).Return(testError).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while setting scenarios of Runtime",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelRepo := testCase.LabelRepositoryFn()
+			labelUpsertSvc := testCase.LabelUpsertServiceFn()
+			notifier := &automock.WebhookNotifier{}
+
+			engine := scenarioassignment.NewEngine(repo, labelRepo, labelUpsertSvc, notifier)
+
+			// WHEN
+			err := engine.EvaluateForObject(ctx, testTenant, objectType, testObjectID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+			labelUpsertSvc.AssertExpectations(t)
+			notifier.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEngine_AssignAll(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	assignment := fixModelScenarioAssignment(testID, model.ApplicationLabelableObject)
+	objectType := model.ApplicationLabelableObject
+	scenariosChange := model.NewLabelConfigurationChange(model.ScenariosKey, model.ConfigurationChangeOperationUpdated)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListMatchingObjectIDs", ctx, testTenant, objectType, assignment.Filter).Return([]string{testObjectID}, nil).Once()
+		repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(false, nil).Once()
+		repo.On("CreateTarget", ctx, testTenant, testID, objectType, testObjectID).Return(nil).Once()
+
+		labelRepo := &automock.LabelRepository{}
+		defer labelRepo.AssertExpectations(t)
+		labelRepo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel("DEFAULT"), nil).Once()
+
+		labelUpsertSvc := &automock.LabelUpsertService{}
+		defer labelUpsertSvc.AssertExpectations(t)
+		labelUpsertSvc.On("UpsertLabel", ctx, testTenant, fixScenariosLabelInput(objectType, "DEFAULT", testScenario)).Return(nil).Once()
+
+		notifier := &automock.WebhookNotifier{}
+		defer notifier.AssertExpectations(t)
+		notifier.On("NotifyConfigurationChanged", ctx, testObjectID, scenariosChange).Return(nil).Once()
+
+		engine := scenarioassignment.NewEngine(repo, labelRepo, labelUpsertSvc, notifier)
+
+		// WHEN
+		err := engine.AssignAll(ctx, *assignment)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when notifying about changed scenarios failed", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListMatchingObjectIDs", ctx, testTenant, objectType, assignment.Filter).Return([]string{testObjectID}, nil).Once()
+		repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(false, nil).Once()
+
+		labelRepo := &automock.LabelRepository{}
+		defer labelRepo.AssertExpectations(t)
+		labelRepo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel("DEFAULT"), nil).Once()
+
+		labelUpsertSvc := &automock.LabelUpsertService{}
+		defer labelUpsertSvc.AssertExpectations(t)
+		labelUpsertSvc.On("UpsertLabel", ctx, testTenant, fixScenariosLabelInput(objectType, "DEFAULT", testScenario)).Return(nil).Once()
+
+		notifier := &automock.WebhookNotifier{}
+		defer notifier.AssertExpectations(t)
+		notifier.On("NotifyConfigurationChanged", ctx, testObjectID, scenariosChange).Return(testError).Once()
+
+		engine := scenarioassignment.NewEngine(repo, labelRepo, labelUpsertSvc, notifier)
+
+		// WHEN
+		err := engine.AssignAll(ctx, *assignment)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while notifying about change of scenarios of Application")
+	})
+
+	t.Run("Returns error when listing matching objects failed", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListMatchingObjectIDs", ctx, testTenant, objectType, assignment.Filter).Return(nil, testError).Once()
+
+		engine := scenarioassignment.NewEngine(repo, nil, nil, nil)
+
+		// WHEN
+		err := engine.AssignAll(ctx, *assignment)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
+
+func TestEngine_UnassignAll(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	assignment := fixModelScenarioAssignment(testID, model.ApplicationLabelableObject)
+	objectType := model.ApplicationLabelableObject
+	scenariosChange := model.NewLabelConfigurationChange(model.ScenariosKey, model.ConfigurationChangeOperationUpdated)
+
+	t.Run("Success when Application falls back to the default scenario", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListTargetObjectIDs", ctx, testTenant, testID).Return([]string{testObjectID}, nil).Once()
+		repo.On("TargetExists", ctx, testTenant, testID, objectType, testObjectID).Return(true, nil).Once()
+		repo.On("DeleteTarget", ctx, testTenant, testID, objectType, testObjectID).Return(nil).Once()
+		repo.On("ScenarioTargetExists", ctx, testTenant, testScenario, objectType, testObjectID, testID).Return(false, nil).Once()
+
+		labelRepo := &automock.LabelRepository{}
+		defer labelRepo.AssertExpectations(t)
+		labelRepo.On("GetByKey", ctx, testTenant, objectType, testObjectID, model.ScenariosKey).Return(fixScenariosLabel(testScenario), nil).Once()
+
+		labelUpsertSvc := &automock.LabelUpsertService{}
+		defer labelUpsertSvc.AssertExpectations(t)
+		labelUpsertSvc.On("UpsertLabel", ctx, testTenant, fixScenariosLabelInput(objectType, "DEFAULT")).Return(nil).Once()
+
+		notifier := &automock.WebhookNotifier{}
+		defer notifier.AssertExpectations(t)
+		notifier.On("NotifyConfigurationChanged", ctx, testObjectID, scenariosChange).Return(nil).Once()
+
+		engine := scenarioassignment.NewEngine(repo, labelRepo, labelUpsertSvc, notifier)
+
+		// WHEN
+		err := engine.UnassignAll(ctx, *assignment)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when listing targets failed", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListTargetObjectIDs", ctx, testTenant, testID).Return(nil, testError).Once()
+
+		engine := scenarioassignment.NewEngine(repo, nil, nil, nil)
+
+		// WHEN
+		err := engine.UnassignAll(ctx, *assignment)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
@@ -0,0 +1,31 @@
+package scenarioassignment
+
+import "database/sql"
+
+type Entity struct {
+	ID         string `db:"id"`
+	TenantID   string `db:"tenant_id"`
+	Scenario   string `db:"scenario"`
+	TargetType string `db:"target_type"`
+	Filter     string `db:"filter"`
+}
+
+type Collection []Entity
+
+func (c Collection) Len() int {
+	return len(c)
+}
+
+// TargetEntity records that the scenario of the assignment was added to the Application or Runtime by the assignment
+type TargetEntity struct {
+	AssignmentID string         `db:"assignment_id"`
+	TenantID     string         `db:"tenant_id"`
+	AppID        sql.NullString `db:"app_id"`
+	RuntimeID    sql.NullString `db:"runtime_id"`
+}
+
+type TargetCollection []TargetEntity
+
+func (c TargetCollection) Len() int {
+	return len(c)
+}
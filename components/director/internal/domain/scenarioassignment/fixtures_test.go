@@ -0,0 +1,113 @@
+package scenarioassignment_test
+
+import (
+	"errors"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	testTenant   = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	testID       = "foo"
+	testScenario = "PRODUCTION"
+	testObjectID = "c1d4b8a1-8e83-4b1f-9e5f-2a6f0f1d2c3b"
+	testFilter   = `{"key":"region"}`
+)
+
+var (
+	testError        = errors.New("test error")
+	testTableColumns = []string{"id", "tenant_id", "scenario", "target_type", "filter"}
+)
+
+func fixLabelFilter() *labelfilter.LabelFilter {
+	return &labelfilter.LabelFilter{Key: "region"}
+}
+
+func fixModelScenarioAssignment(id string, targetType model.LabelableObject) *model.ScenarioAssignment {
+	return &model.ScenarioAssignment{
+		ID:         id,
+		Tenant:     testTenant,
+		Scenario:   testScenario,
+		TargetType: targetType,
+		Filter:     fixLabelFilter(),
+	}
+}
+
+func fixModelScenarioAssignmentInput() model.ScenarioAssignmentInput {
+	return model.ScenarioAssignmentInput{
+		Scenario:   testScenario,
+		TargetType: model.RuntimeLabelableObject,
+		Filter:     fixLabelFilter(),
+	}
+}
+
+func fixGQLScenarioAssignment(id string) *graphql.ScenarioAssignment {
+	return &graphql.ScenarioAssignment{
+		ID:         id,
+		Scenario:   testScenario,
+		TargetType: graphql.ScenarioAssignmentTargetTypeRuntime,
+		Filter: map[string]interface{}{
+			"label": map[string]interface{}{"key": "region"},
+		},
+	}
+}
+
+func fixGQLScenarioAssignmentInput() graphql.ScenarioAssignmentInput {
+	return graphql.ScenarioAssignmentInput{
+		Scenario:   testScenario,
+		TargetType: graphql.ScenarioAssignmentTargetTypeRuntime,
+		Filter: &graphql.LabelFilterExpression{
+			Label: &graphql.LabelFilter{Key: "region"},
+		},
+	}
+}
+
+func fixEntityScenarioAssignment(id string) scenarioassignment.Entity {
+	return scenarioassignment.Entity{
+		ID:         id,
+		TenantID:   testTenant,
+		Scenario:   testScenario,
+		TargetType: string(model.RuntimeLabelableObject),
+		Filter:     testFilter,
+	}
+}
+
+func fixScenariosLabel(scenarios ...interface{}) *model.Label {
+	return &model.Label{
+		Tenant:     testTenant,
+		Key:        model.ScenariosKey,
+		Value:      scenarios,
+		ObjectID:   testObjectID,
+		ObjectType: model.RuntimeLabelableObject,
+	}
+}
+
+func fixScenariosLabelInput(objectType model.LabelableObject, scenarios ...interface{}) *model.LabelInput {
+	return &model.LabelInput{
+		Key:        model.ScenariosKey,
+		Value:      scenarios,
+		ObjectID:   testObjectID,
+		ObjectType: objectType,
+	}
+}
+
+func fixScenariosLabelDefinition(scenarios ...string) *model.LabelDefinition {
+	var schema interface{} = map[string]interface{}{
+		"type":        "array",
+		"minItems":    1,
+		"uniqueItems": true,
+		"items": map[string]interface{}{
+			"type": "string",
+			"enum": scenarios,
+		},
+	}
+	return &model.LabelDefinition{
+		ID:     "ld",
+		Tenant: testTenant,
+		Key:    model.ScenariosKey,
+		Schema: &schema,
+	}
+}
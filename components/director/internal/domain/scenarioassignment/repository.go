@@ -0,0 +1,326 @@
+package scenarioassignment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableName       string = `public.scenario_assignments`
+	targetTableName string = `public.scenario_assignment_targets`
+	tenantColumn    string = `tenant_id`
+)
+
+var (
+	tableColumns       = []string{"id", "tenant_id", "scenario", "target_type", "filter"}
+	updatableColumns   = []string{"scenario", "target_type", "filter"}
+	targetTableColumns = []string{"assignment_id", "tenant_id", "app_id", "runtime_id"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in model.ScenarioAssignment) (Entity, error)
+	FromEntity(in Entity) (model.ScenarioAssignment, error)
+}
+
+type repository struct {
+	creator         repo.Creator
+	singleGetter    repo.SingleGetter
+	pageableQuerier repo.PageableQuerier
+	lister          repo.Lister
+	updater         repo.Updater
+	deleter         repo.Deleter
+	existQuerier    repo.ExistQuerier
+
+	targetCreator      repo.Creator
+	targetExistQuerier repo.ExistQuerier
+	targetLister       repo.Lister
+	targetDeleter      repo.Deleter
+
+	conv EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		creator:            repo.NewCreator(tableName, tableColumns),
+		singleGetter:       repo.NewSingleGetter(tableName, tenantColumn, tableColumns),
+		pageableQuerier:    repo.NewPageableQuerier(tableName, tenantColumn, tableColumns),
+		lister:             repo.NewLister(tableName, tenantColumn, tableColumns),
+		updater:            repo.NewUpdater(tableName, updatableColumns, tenantColumn, []string{"id"}),
+		deleter:            repo.NewDeleter(tableName, tenantColumn),
+		existQuerier:       repo.NewExistQuerier(tableName, tenantColumn),
+		targetCreator:      repo.NewCreator(targetTableName, targetTableColumns),
+		targetExistQuerier: repo.NewExistQuerier(targetTableName, tenantColumn),
+		targetLister:       repo.NewLister(targetTableName, tenantColumn, targetTableColumns),
+		targetDeleter:      repo.NewDeleter(targetTableName, tenantColumn),
+		conv:               conv,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, item *model.ScenarioAssignment) error {
+	if item == nil {
+		return errors.New("item cannot be nil")
+	}
+
+	entity, err := r.conv.ToEntity(*item)
+	if err != nil {
+		return errors.Wrap(err, "while creating Scenario Assignment entity from model")
+	}
+
+	return r.creator.Create(ctx, entity)
+}
+
+func (r *repository) GetByID(ctx context.Context, tenant, id string) (*model.ScenarioAssignment, error) {
+	var entity Entity
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, &entity); err != nil {
+		return nil, err
+	}
+
+	assignment, err := r.conv.FromEntity(entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating Scenario Assignment model from entity")
+	}
+
+	return &assignment, nil
+}
+
+func (r *repository) List(ctx context.Context, tenant string, pageSize int, cursor string) (*model.ScenarioAssignmentPage, error) {
+	var entities Collection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &entities)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.multipleFromEntities(entities)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ScenarioAssignmentPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
+func (r *repository) ListForTargetType(ctx context.Context, tenant string, targetType model.LabelableObject) ([]*model.ScenarioAssignment, error) {
+	var entities Collection
+	if err := r.lister.List(ctx, tenant, &entities, fmt.Sprintf("target_type = %s", pq.QuoteLiteral(string(targetType)))); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *repository) Update(ctx context.Context, item *model.ScenarioAssignment) error {
+	if item == nil {
+		return errors.New("item cannot be nil")
+	}
+
+	entity, err := r.conv.ToEntity(*item)
+	if err != nil {
+		return errors.Wrap(err, "while creating Scenario Assignment entity from model")
+	}
+
+	return r.updater.UpdateSingle(ctx, entity)
+}
+
+func (r *repository) Delete(ctx context.Context, tenant, id string) error {
+	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *repository) ExistsForScenario(ctx context.Context, tenant, scenario string) (bool, error) {
+	return r.existQuerier.Exists(ctx, tenant, repo.Conditions{repo.NewEqualCondition("scenario", scenario)})
+}
+
+func (r *repository) RenameScenario(ctx context.Context, tenant, scenario, newScenario string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf(`UPDATE %s SET scenario = $1 WHERE tenant_id = $2 AND scenario = $3`, tableName)
+	_, err = persist.Exec(stmt, newScenario, tenant, scenario)
+	if err != nil {
+		return errors.Wrap(err, "while renaming scenario in Scenario Assignments")
+	}
+
+	return nil
+}
+
+func (r *repository) CreateTarget(ctx context.Context, tenant, assignmentID string, objectType model.LabelableObject, objectID string) error {
+	entity := TargetEntity{AssignmentID: assignmentID, TenantID: tenant}
+	switch objectType {
+	case model.ApplicationLabelableObject:
+		entity.AppID = repo.NewValidNullableString(objectID)
+	case model.RuntimeLabelableObject:
+		entity.RuntimeID = repo.NewValidNullableString(objectID)
+	default:
+		return errors.Errorf("invalid target type %s", objectType)
+	}
+
+	return r.targetCreator.Create(ctx, entity)
+}
+
+func (r *repository) TargetExists(ctx context.Context, tenant, assignmentID string, objectType model.LabelableObject, objectID string) (bool, error) {
+	conditions, err := targetConditions(assignmentID, objectType, objectID)
+	if err != nil {
+		return false, err
+	}
+
+	return r.targetExistQuerier.Exists(ctx, tenant, conditions)
+}
+
+func (r *repository) DeleteTarget(ctx context.Context, tenant, assignmentID string, objectType model.LabelableObject, objectID string) error {
+	conditions, err := targetConditions(assignmentID, objectType, objectID)
+	if err != nil {
+		return err
+	}
+
+	return r.targetDeleter.DeleteOne(ctx, tenant, conditions)
+}
+
+// ListTargetObjectIDs returns IDs of objects to which the scenario was added by the assignment
+func (r *repository) ListTargetObjectIDs(ctx context.Context, tenant, assignmentID string) ([]string, error) {
+	var entities TargetCollection
+	if err := r.targetLister.List(ctx, tenant, &entities, fmt.Sprintf("assignment_id = %s", pq.QuoteLiteral(assignmentID))); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(entities))
+	for _, entity := range entities {
+		if entity.AppID.Valid {
+			ids = append(ids, entity.AppID.String)
+		} else if entity.RuntimeID.Valid {
+			ids = append(ids, entity.RuntimeID.String)
+		}
+	}
+
+	return ids, nil
+}
+
+// ScenarioTargetExists checks whether any assignment other than the excluded one added the scenario to the object
+func (r *repository) ScenarioTargetExists(ctx context.Context, tenant, scenario string, objectType model.LabelableObject, objectID, excludedAssignmentID string) (bool, error) {
+	objectColumn, err := targetObjectColumn(objectType)
+	if err != nil {
+		return false, err
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s AS t JOIN %s AS a ON a.id = t.assignment_id
+		WHERE t.tenant_id = $1 AND t.%s = $2 AND a.scenario = $3 AND a.id <> $4)`, targetTableName, tableName, objectColumn)
+
+	var exists bool
+	err = persist.Get(&exists, stmt, tenant, objectID, scenario, excludedAssignmentID)
+	if err != nil {
+		return false, errors.Wrap(err, "while checking Scenario Assignment targets")
+	}
+
+	return exists, nil
+}
+
+// ListMatchingObjectIDs returns IDs of objects of given type which match the filter
+func (r *repository) ListMatchingObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, filter *labelfilter.LabelFilter) ([]string, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filterSubquery, args, err := filterQuery(tenant, objectType, filter, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	err = persist.Select(&ids, fmt.Sprintf(`SELECT matching.id FROM (%s) AS matching(id)`, filterSubquery), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing objects matching the filter")
+	}
+
+	return ids, nil
+}
+
+// Matches checks whether the object of given type matches the filter
+func (r *repository) Matches(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, filter *labelfilter.LabelFilter) (bool, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	filterSubquery, args, err := filterQuery(tenant, objectType, filter, 2)
+	if err != nil {
+		return false, err
+	}
+
+	var matches bool
+	stmt := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM (%s) AS matching(id) WHERE matching.id = $1)`, filterSubquery)
+	err = persist.Get(&matches, stmt, append([]interface{}{objectID}, args...)...)
+	if err != nil {
+		return false, errors.Wrap(err, "while checking if object matches the filter")
+	}
+
+	return matches, nil
+}
+
+func (r *repository) multipleFromEntities(entities Collection) ([]*model.ScenarioAssignment, error) {
+	var items []*model.ScenarioAssignment
+	for _, entity := range entities {
+		assignment, err := r.conv.FromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Scenario Assignment model from entity")
+		}
+		items = append(items, &assignment)
+	}
+
+	return items, nil
+}
+
+func filterQuery(tenant string, objectType model.LabelableObject, filter *labelfilter.LabelFilter, argsStartIdx int) (string, []interface{}, error) {
+	tenantID, err := uuid.Parse(tenant)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "while parsing tenant as UUID")
+	}
+
+	query, args, err := label.FilterQuery(objectType, label.IntersectSet, tenantID, []*labelfilter.LabelFilter{filter}, argsStartIdx)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "while building filter query")
+	}
+
+	return query, args, nil
+}
+
+func targetConditions(assignmentID string, objectType model.LabelableObject, objectID string) (repo.Conditions, error) {
+	objectColumn, err := targetObjectColumn(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.Conditions{
+		repo.NewEqualCondition("assignment_id", assignmentID),
+		repo.NewEqualCondition(objectColumn, objectID),
+	}, nil
+}
+
+func targetObjectColumn(objectType model.LabelableObject) (string, error) {
+	switch objectType {
+	case model.ApplicationLabelableObject:
+		return "app_id", nil
+	case model.RuntimeLabelableObject:
+		return "runtime_id", nil
+	}
+
+	return "", errors.Errorf("invalid target type %s", objectType)
+}
@@ -0,0 +1,430 @@
+package scenarioassignment_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToEntity", *fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)).Return(fixEntityScenarioAssignment(testID), nil).Once()
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.scenario_assignments ( id, tenant_id, scenario, target_type, filter ) VALUES ( ?, ?, ?, ?, ? )`)).
+			WithArgs(testID, testTenant, testScenario, "Runtime", testFilter).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		repo := scenarioassignment.NewRepository(conv)
+
+		// WHEN
+		err := repo.Create(ctx, fixModelScenarioAssignment(testID, model.RuntimeLabelableObject))
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when conversion failed", func(t *testing.T) {
+		// GIVEN
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToEntity", *fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)).Return(scenarioassignment.Entity{}, testError).Once()
+
+		repo := scenarioassignment.NewRepository(conv)
+
+		// WHEN
+		err := repo.Create(context.TODO(), fixModelScenarioAssignment(testID, model.RuntimeLabelableObject))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+
+	t.Run("Returns error when item is nil", func(t *testing.T) {
+		// WHEN
+		err := scenarioassignment.NewRepository(nil).Create(context.TODO(), nil)
+
+		// THEN
+		require.EqualError(t, err, "item cannot be nil")
+	})
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("FromEntity", fixEntityScenarioAssignment(testID)).Return(*fixModelScenarioAssignment(testID, model.RuntimeLabelableObject), nil).Once()
+
+		rows := sqlmock.NewRows(testTableColumns).AddRow(testID, testTenant, testScenario, "Runtime", testFilter)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, tenant_id, scenario, target_type, filter FROM public.scenario_assignments WHERE tenant_id = $1 AND id = $2`)).
+			WithArgs(testTenant, testID).
+			WillReturnRows(rows)
+
+		repo := scenarioassignment.NewRepository(conv)
+
+		// WHEN
+		result, err := repo.GetByID(ctx, testTenant, testID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixModelScenarioAssignment(testID, model.RuntimeLabelableObject), result)
+	})
+
+	t.Run("Returns error when query failed", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(`SELECT .* FROM public.scenario_assignments`).WillReturnError(testError)
+
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.GetByID(ctx, testTenant, testID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
+
+func TestRepository_ListForTargetType(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	conv := &automock.EntityConverter{}
+	defer conv.AssertExpectations(t)
+	conv.On("FromEntity", fixEntityScenarioAssignment("foo")).Return(*fixModelScenarioAssignment("foo", model.RuntimeLabelableObject), nil).Once()
+	conv.On("FromEntity", fixEntityScenarioAssignment("bar")).Return(*fixModelScenarioAssignment("bar", model.RuntimeLabelableObject), nil).Once()
+
+	rows := sqlmock.NewRows(testTableColumns).
+		AddRow("foo", testTenant, testScenario, "Runtime", testFilter).
+		AddRow("bar", testTenant, testScenario, "Runtime", testFilter)
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, tenant_id, scenario, target_type, filter FROM public.scenario_assignments WHERE tenant_id=$1 AND target_type = 'Runtime'`)).
+		WithArgs(testTenant).
+		WillReturnRows(rows)
+
+	repo := scenarioassignment.NewRepository(conv)
+
+	// WHEN
+	result, err := repo.ListForTargetType(ctx, testTenant, model.RuntimeLabelableObject)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ScenarioAssignment{
+		fixModelScenarioAssignment("foo", model.RuntimeLabelableObject),
+		fixModelScenarioAssignment("bar", model.RuntimeLabelableObject),
+	}, result)
+}
+
+func TestRepository_Update(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	conv := &automock.EntityConverter{}
+	defer conv.AssertExpectations(t)
+	conv.On("ToEntity", *fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)).Return(fixEntityScenarioAssignment(testID), nil).Once()
+
+	dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.scenario_assignments SET scenario = ?, target_type = ?, filter = ? WHERE tenant_id = ? AND id = ?`)).
+		WithArgs(testScenario, "Runtime", testFilter, testTenant, testID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	repo := scenarioassignment.NewRepository(conv)
+
+	// WHEN
+	err := repo.Update(ctx, fixModelScenarioAssignment(testID, model.RuntimeLabelableObject))
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_Delete(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.scenario_assignments WHERE tenant_id = $1 AND id = $2`)).
+		WithArgs(testTenant, testID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	repo := scenarioassignment.NewRepository(nil)
+
+	// WHEN
+	err := repo.Delete(ctx, testTenant, testID)
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_CreateTarget(t *testing.T) {
+	insertQuery := regexp.QuoteMeta(`INSERT INTO public.scenario_assignment_targets ( assignment_id, tenant_id, app_id, runtime_id ) VALUES ( ?, ?, ?, ? )`)
+
+	testCases := []struct {
+		Name         string
+		ObjectType   model.LabelableObject
+		ExpectedArgs []driver.Value
+	}{
+		{
+			Name:         "Success for Application",
+			ObjectType:   model.ApplicationLabelableObject,
+			ExpectedArgs: []driver.Value{testID, testTenant, testObjectID, nil},
+		},
+		{
+			Name:         "Success for Runtime",
+			ObjectType:   model.RuntimeLabelableObject,
+			ExpectedArgs: []driver.Value{testID, testTenant, nil, testObjectID},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			db, dbMock := testdb.MockDatabase(t)
+			defer dbMock.AssertExpectations(t)
+			ctx := persistence.SaveToContext(context.TODO(), db)
+
+			dbMock.ExpectExec(insertQuery).WithArgs(testCase.ExpectedArgs...).WillReturnResult(sqlmock.NewResult(1, 1))
+
+			repo := scenarioassignment.NewRepository(nil)
+
+			// WHEN
+			err := repo.CreateTarget(ctx, testTenant, testID, testCase.ObjectType, testObjectID)
+
+			// THEN
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("Returns error for invalid target type", func(t *testing.T) {
+		// WHEN
+		err := scenarioassignment.NewRepository(nil).CreateTarget(context.TODO(), testTenant, testID, model.LabelableObject("Foo"), testObjectID)
+
+		// THEN
+		require.EqualError(t, err, "invalid target type Foo")
+	})
+}
+
+func TestRepository_TargetExists(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.scenario_assignment_targets WHERE tenant_id = $1 AND assignment_id = $2 AND app_id = $3`)).
+		WithArgs(testTenant, testID, testObjectID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+
+	repo := scenarioassignment.NewRepository(nil)
+
+	// WHEN
+	exists, err := repo.TargetExists(ctx, testTenant, testID, model.ApplicationLabelableObject, testObjectID)
+
+	// THEN
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestRepository_DeleteTarget(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.scenario_assignment_targets WHERE tenant_id = $1 AND assignment_id = $2 AND runtime_id = $3`)).
+		WithArgs(testTenant, testID, testObjectID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	repo := scenarioassignment.NewRepository(nil)
+
+	// WHEN
+	err := repo.DeleteTarget(ctx, testTenant, testID, model.RuntimeLabelableObject, testObjectID)
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_ListTargetObjectIDs(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	rows := sqlmock.NewRows([]string{"assignment_id", "tenant_id", "app_id", "runtime_id"}).
+		AddRow(testID, testTenant, nil, "foo").
+		AddRow(testID, testTenant, nil, "bar")
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT assignment_id, tenant_id, app_id, runtime_id FROM public.scenario_assignment_targets WHERE tenant_id=$1 AND assignment_id = 'foo'`)).
+		WithArgs(testTenant).
+		WillReturnRows(rows)
+
+	repo := scenarioassignment.NewRepository(nil)
+
+	// WHEN
+	ids, err := repo.ListTargetObjectIDs(ctx, testTenant, testID)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, ids)
+}
+
+func TestRepository_ScenarioTargetExists(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM public.scenario_assignment_targets AS t JOIN public.scenario_assignments AS a ON a.id = t.assignment_id\s+WHERE t.tenant_id = \$1 AND t.runtime_id = \$2 AND a.scenario = \$3 AND a.id <> \$4\)`).
+		WithArgs(testTenant, testObjectID, testScenario, testID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	repo := scenarioassignment.NewRepository(nil)
+
+	// WHEN
+	exists, err := repo.ScenarioTargetExists(ctx, testTenant, testScenario, model.RuntimeLabelableObject, testObjectID, testID)
+
+	// THEN
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestRepository_ListMatchingObjectIDs(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT matching.id FROM (SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND "tenant_id" = 'b91b59f7-2563-40b2-aba9-fef726037aa3' AND "key" = $1) AS matching(id)`)).
+		WithArgs("region").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("foo").AddRow("bar"))
+
+	repo := scenarioassignment.NewRepository(nil)
+
+	// WHEN
+	ids, err := repo.ListMatchingObjectIDs(ctx, testTenant, model.RuntimeLabelableObject, fixLabelFilter())
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, ids)
+}
+
+func TestRepository_Matches(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS (SELECT 1 FROM (SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND "tenant_id" = 'b91b59f7-2563-40b2-aba9-fef726037aa3' AND "key" = $2) AS matching(id) WHERE matching.id = $1)`)).
+			WithArgs(testObjectID, "region").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		matches, err := repo.Matches(ctx, testTenant, model.RuntimeLabelableObject, testObjectID, fixLabelFilter())
+
+		// THEN
+		require.NoError(t, err)
+		assert.True(t, matches)
+	})
+
+	t.Run("Returns error when tenant is not UUID", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.Matches(ctx, "not-uuid", model.RuntimeLabelableObject, testObjectID, fixLabelFilter())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing tenant as UUID")
+	})
+}
+
+func TestRepository_ExistsForScenario(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	ctx := persistence.SaveToContext(context.TODO(), db)
+
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.scenario_assignments WHERE tenant_id = $1 AND scenario = $2`)).
+		WithArgs(testTenant, testScenario).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+
+	repo := scenarioassignment.NewRepository(nil)
+
+	// WHEN
+	exists, err := repo.ExistsForScenario(ctx, testTenant, testScenario)
+
+	// THEN
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestRepository_RenameScenario(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.scenario_assignments SET scenario = $1 WHERE tenant_id = $2 AND scenario = $3`)).
+			WithArgs("STAGING", testTenant, testScenario).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		err := repo.RenameScenario(ctx, testTenant, testScenario, "STAGING")
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when update failed", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectExec(`UPDATE public.scenario_assignments`).WillReturnError(testError)
+
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		err := repo.RenameScenario(ctx, testTenant, testScenario, "STAGING")
+
+		// THEN
+		require.EqualError(t, err, "while renaming scenario in Scenario Assignments: test error")
+	})
+}
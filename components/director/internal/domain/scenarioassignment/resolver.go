@@ -0,0 +1,196 @@
+package scenarioassignment
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=ScenarioAssignmentService -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentService interface {
+	Create(ctx context.Context, in model.ScenarioAssignmentInput) (string, error)
+	Get(ctx context.Context, id string) (*model.ScenarioAssignment, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.ScenarioAssignmentPage, error)
+	Update(ctx context.Context, id string, in model.ScenarioAssignmentInput) error
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery -name=ScenarioAssignmentConverter -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentConverter interface {
+	ToGraphQL(in *model.ScenarioAssignment) *graphql.ScenarioAssignment
+	MultipleToGraphQL(in []*model.ScenarioAssignment) []*graphql.ScenarioAssignment
+	InputFromGraphQL(in graphql.ScenarioAssignmentInput) (model.ScenarioAssignmentInput, error)
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	svc  ScenarioAssignmentService
+	conv ScenarioAssignmentConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc ScenarioAssignmentService, conv ScenarioAssignmentConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+func (r *Resolver) ScenarioAssignment(ctx context.Context, id string) (*graphql.ScenarioAssignment, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	assignment, err := r.svc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(assignment), nil
+}
+
+func (r *Resolver) ScenarioAssignments(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.ScenarioAssignmentPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, errors.New("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	page, err := r.svc.List(ctx, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.ScenarioAssignmentPage{
+		Data:       r.conv.MultipleToGraphQL(page.Data),
+		TotalCount: page.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+			HasNextPage: page.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+func (r *Resolver) CreateScenarioAssignment(ctx context.Context, in graphql.ScenarioAssignmentInput) (*graphql.ScenarioAssignment, error) {
+	convertedIn, err := r.conv.InputFromGraphQL(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Scenario Assignment input")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.Create(ctx, convertedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(assignment), nil
+}
+
+func (r *Resolver) UpdateScenarioAssignment(ctx context.Context, id string, in graphql.ScenarioAssignmentInput) (*graphql.ScenarioAssignment, error) {
+	convertedIn, err := r.conv.InputFromGraphQL(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Scenario Assignment input")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = r.svc.Update(ctx, id, convertedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(assignment), nil
+}
+
+func (r *Resolver) DeleteScenarioAssignment(ctx context.Context, id string) (*graphql.ScenarioAssignment, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	assignment, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.svc.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(assignment), nil
+}
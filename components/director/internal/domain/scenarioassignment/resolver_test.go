@@ -0,0 +1,317 @@
+package scenarioassignment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ScenarioAssignment(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	assignment := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(assignment, nil).Once()
+
+		conv := &automock.ScenarioAssignmentConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", assignment).Return(fixGQLScenarioAssignment(testID)).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.ScenarioAssignment(context.TODO(), testID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLScenarioAssignment(testID), result)
+	})
+
+	t.Run("Returns nil when Scenario Assignment not found", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(nil, apperrors.NewNotFoundError(testID)).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, nil)
+
+		// WHEN
+		result, err := resolver.ScenarioAssignment(context.TODO(), testID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Returns error when starting transaction failed", func(t *testing.T) {
+		persist, transact := txGen.ThatFailsOnBegin()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		resolver := scenarioassignment.NewResolver(transact, nil, nil)
+
+		// WHEN
+		_, err := resolver.ScenarioAssignment(context.TODO(), testID)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
+
+func TestResolver_ScenarioAssignments(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	first := 2
+	after := graphql.PageCursor("start")
+	assignments := []*model.ScenarioAssignment{fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)}
+	gqlAssignments := []*graphql.ScenarioAssignment{fixGQLScenarioAssignment(testID)}
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher(), first, string(after)).Return(&model.ScenarioAssignmentPage{
+			Data:       assignments,
+			TotalCount: 1,
+			PageInfo:   &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: false},
+		}, nil).Once()
+
+		conv := &automock.ScenarioAssignmentConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("MultipleToGraphQL", assignments).Return(gqlAssignments).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.ScenarioAssignments(context.TODO(), &first, &after)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.ScenarioAssignmentPage{
+			Data:       gqlAssignments,
+			TotalCount: 1,
+			PageInfo:   &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: false},
+		}, result)
+	})
+
+	t.Run("Returns error when listing failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("List", txtest.CtxWithDBMatcher(), first, string(after)).Return(nil, testError).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, nil)
+
+		// WHEN
+		_, err := resolver.ScenarioAssignments(context.TODO(), &first, &after)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		// WHEN
+		_, err := scenarioassignment.NewResolver(nil, nil, nil).ScenarioAssignments(context.TODO(), nil, nil)
+
+		// THEN
+		require.EqualError(t, err, "missing required parameter 'first'")
+	})
+}
+
+func TestResolver_CreateScenarioAssignment(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	gqlInput := fixGQLScenarioAssignmentInput()
+	input := fixModelScenarioAssignmentInput()
+	assignment := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), input).Return(testID, nil).Once()
+		svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(assignment, nil).Once()
+
+		conv := &automock.ScenarioAssignmentConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", gqlInput).Return(input, nil).Once()
+		conv.On("ToGraphQL", assignment).Return(fixGQLScenarioAssignment(testID)).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.CreateScenarioAssignment(context.TODO(), gqlInput)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLScenarioAssignment(testID), result)
+	})
+
+	t.Run("Returns error when converting input failed", func(t *testing.T) {
+		conv := &automock.ScenarioAssignmentConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", gqlInput).Return(model.ScenarioAssignmentInput{}, testError).Once()
+
+		resolver := scenarioassignment.NewResolver(nil, nil, conv)
+
+		// WHEN
+		_, err := resolver.CreateScenarioAssignment(context.TODO(), gqlInput)
+
+		// THEN
+		require.EqualError(t, err, "while converting Scenario Assignment input: test error")
+	})
+
+	t.Run("Returns error when creating failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Create", txtest.CtxWithDBMatcher(), input).Return("", testError).Once()
+
+		conv := &automock.ScenarioAssignmentConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", gqlInput).Return(input, nil).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// WHEN
+		_, err := resolver.CreateScenarioAssignment(context.TODO(), gqlInput)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
+
+func TestResolver_UpdateScenarioAssignment(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	gqlInput := fixGQLScenarioAssignmentInput()
+	input := fixModelScenarioAssignmentInput()
+	assignment := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Update", txtest.CtxWithDBMatcher(), testID, input).Return(nil).Once()
+		svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(assignment, nil).Once()
+
+		conv := &automock.ScenarioAssignmentConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", gqlInput).Return(input, nil).Once()
+		conv.On("ToGraphQL", assignment).Return(fixGQLScenarioAssignment(testID)).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.UpdateScenarioAssignment(context.TODO(), testID, gqlInput)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLScenarioAssignment(testID), result)
+	})
+
+	t.Run("Returns error when updating failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Update", txtest.CtxWithDBMatcher(), testID, input).Return(testError).Once()
+
+		conv := &automock.ScenarioAssignmentConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("InputFromGraphQL", gqlInput).Return(input, nil).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// WHEN
+		_, err := resolver.UpdateScenarioAssignment(context.TODO(), testID, gqlInput)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
+
+func TestResolver_DeleteScenarioAssignment(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	assignment := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(assignment, nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), testID).Return(nil).Once()
+
+		conv := &automock.ScenarioAssignmentConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToGraphQL", assignment).Return(fixGQLScenarioAssignment(testID)).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, err := resolver.DeleteScenarioAssignment(context.TODO(), testID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLScenarioAssignment(testID), result)
+	})
+
+	t.Run("Returns error when deleting failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		defer persist.AssertExpectations(t)
+		defer transact.AssertExpectations(t)
+
+		svc := &automock.ScenarioAssignmentService{}
+		defer svc.AssertExpectations(t)
+		svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(assignment, nil).Once()
+		svc.On("Delete", txtest.CtxWithDBMatcher(), testID).Return(testError).Once()
+
+		resolver := scenarioassignment.NewResolver(transact, svc, nil)
+
+		// WHEN
+		_, err := resolver.DeleteScenarioAssignment(context.TODO(), testID)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
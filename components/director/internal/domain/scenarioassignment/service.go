@@ -0,0 +1,202 @@
+package scenarioassignment
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=ScenarioAssignmentRepository -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentRepository interface {
+	Create(ctx context.Context, item *model.ScenarioAssignment) error
+	GetByID(ctx context.Context, tenant, id string) (*model.ScenarioAssignment, error)
+	List(ctx context.Context, tenant string, pageSize int, cursor string) (*model.ScenarioAssignmentPage, error)
+	ListForTargetType(ctx context.Context, tenant string, targetType model.LabelableObject) ([]*model.ScenarioAssignment, error)
+	Update(ctx context.Context, item *model.ScenarioAssignment) error
+	Delete(ctx context.Context, tenant, id string) error
+	CreateTarget(ctx context.Context, tenant, assignmentID string, objectType model.LabelableObject, objectID string) error
+	TargetExists(ctx context.Context, tenant, assignmentID string, objectType model.LabelableObject, objectID string) (bool, error)
+	DeleteTarget(ctx context.Context, tenant, assignmentID string, objectType model.LabelableObject, objectID string) error
+	ListTargetObjectIDs(ctx context.Context, tenant, assignmentID string) ([]string, error)
+	ScenarioTargetExists(ctx context.Context, tenant, scenario string, objectType model.LabelableObject, objectID, excludedAssignmentID string) (bool, error)
+	ListMatchingObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, filter *labelfilter.LabelFilter) ([]string, error)
+	Matches(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, filter *labelfilter.LabelFilter) (bool, error)
+}
+
+//go:generate mockery -name=Engine -output=automock -outpkg=automock -case=underscore
+type Engine interface {
+	AssignAll(ctx context.Context, assignment model.ScenarioAssignment) error
+	UnassignAll(ctx context.Context, assignment model.ScenarioAssignment) error
+}
+
+//go:generate mockery -name=LabelDefinitionRepository -output=automock -outpkg=automock -case=underscore
+type LabelDefinitionRepository interface {
+	GetByKey(ctx context.Context, tenant string, key string) (*model.LabelDefinition, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo         ScenarioAssignmentRepository
+	labelDefRepo LabelDefinitionRepository
+
+	engine     Engine
+	uidService UIDService
+}
+
+func NewService(repo ScenarioAssignmentRepository, labelDefRepo LabelDefinitionRepository, engine Engine, uidService UIDService) *service {
+	return &service{
+		repo:         repo,
+		labelDefRepo: labelDefRepo,
+		engine:       engine,
+		uidService:   uidService,
+	}
+}
+
+func (s *service) Create(ctx context.Context, in model.ScenarioAssignmentInput) (string, error) {
+	assignmentTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if err := s.validate(ctx, assignmentTenant, in); err != nil {
+		return "", err
+	}
+
+	id := s.uidService.Generate()
+	assignment := in.ToScenarioAssignment(id, assignmentTenant)
+
+	err = s.repo.Create(ctx, assignment)
+	if err != nil {
+		return "", errors.Wrap(err, "while creating Scenario Assignment")
+	}
+
+	err = s.engine.AssignAll(ctx, *assignment)
+	if err != nil {
+		return "", errors.Wrap(err, "while assigning scenario to matching objects")
+	}
+
+	return id, nil
+}
+
+func (s *service) Get(ctx context.Context, id string) (*model.ScenarioAssignment, error) {
+	assignmentTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	assignment, err := s.repo.GetByID(ctx, assignmentTenant, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Scenario Assignment with ID %s", id)
+	}
+
+	return assignment, nil
+}
+
+func (s *service) List(ctx context.Context, pageSize int, cursor string) (*model.ScenarioAssignmentPage, error) {
+	assignmentTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if pageSize < 1 || pageSize > 100 {
+		return nil, errors.New("page size must be between 1 and 100")
+	}
+
+	return s.repo.List(ctx, assignmentTenant, pageSize, cursor)
+}
+
+// Update removes the scenario from objects to which the assignment added it and applies the assignment again with the new input
+func (s *service) Update(ctx context.Context, id string, in model.ScenarioAssignmentInput) error {
+	assignmentTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if err := s.validate(ctx, assignmentTenant, in); err != nil {
+		return err
+	}
+
+	current, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.engine.UnassignAll(ctx, *current)
+	if err != nil {
+		return errors.Wrap(err, "while removing scenario from objects")
+	}
+
+	assignment := in.ToScenarioAssignment(id, assignmentTenant)
+	err = s.repo.Update(ctx, assignment)
+	if err != nil {
+		return errors.Wrapf(err, "while updating Scenario Assignment with ID %s", id)
+	}
+
+	err = s.engine.AssignAll(ctx, *assignment)
+	if err != nil {
+		return errors.Wrap(err, "while assigning scenario to matching objects")
+	}
+
+	return nil
+}
+
+// Delete removes the scenario only from objects to which the assignment added it
+func (s *service) Delete(ctx context.Context, id string) error {
+	assignmentTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	assignment, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.engine.UnassignAll(ctx, *assignment)
+	if err != nil {
+		return errors.Wrap(err, "while removing scenario from objects")
+	}
+
+	err = s.repo.Delete(ctx, assignmentTenant, id)
+	if err != nil {
+		return errors.Wrapf(err, "while deleting Scenario Assignment with ID %s", id)
+	}
+
+	return nil
+}
+
+func (s *service) validate(ctx context.Context, assignmentTenant string, in model.ScenarioAssignmentInput) error {
+	if err := in.Validate(); err != nil {
+		return errors.Wrap(err, "while validating Scenario Assignment input")
+	}
+
+	var schema interface{} = model.ScenariosSchema
+	labelDef, err := s.labelDefRepo.GetByKey(ctx, assignmentTenant, model.ScenariosKey)
+	switch {
+	case err != nil && !apperrors.IsNotFoundError(err):
+		return errors.Wrap(err, "while getting scenarios Label Definition")
+	case err == nil && labelDef.Schema != nil:
+		schema = *labelDef.Schema
+	}
+
+	scenarios, err := model.ScenariosFromSchema(schema)
+	if err != nil {
+		return err
+	}
+
+	for _, scenario := range scenarios {
+		if scenario == in.Scenario {
+			return nil
+		}
+	}
+
+	return errors.Errorf("scenario %s does not exist", in.Scenario)
+}
@@ -0,0 +1,370 @@
+package scenarioassignment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	in := fixModelScenarioAssignmentInput()
+	assignment := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+
+	testCases := []struct {
+		Name               string
+		Input              model.ScenarioAssignmentInput
+		RepositoryFn       func() *automock.ScenarioAssignmentRepository
+		LabelDefRepoFn     func() *automock.LabelDefinitionRepository
+		EngineFn           func() *automock.Engine
+		UIDServiceFn       func() *automock.UIDService
+		ExpectedErrMessage string
+	}{
+		{
+			Name:  "Success",
+			Input: in,
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("Create", ctx, assignment).Return(nil).Once()
+				return repo
+			},
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition("DEFAULT", testScenario), nil).Once()
+				return repo
+			},
+			EngineFn: func() *automock.Engine {
+				engine := &automock.Engine{}
+				engine.On("AssignAll", ctx, *assignment).Return(nil).Once()
+				return engine
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+		},
+		{
+			Name:         "Returns error when scenario does not exist",
+			Input:        in,
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(model.ScenariosKey)).Once()
+				return repo
+			},
+			EngineFn:           func() *automock.Engine { return &automock.Engine{} },
+			UIDServiceFn:       func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedErrMessage: "scenario PRODUCTION does not exist",
+		},
+		{
+			Name:               "Returns error when input is invalid",
+			Input:              model.ScenarioAssignmentInput{TargetType: model.RuntimeLabelableObject, Filter: fixLabelFilter()},
+			RepositoryFn:       func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			LabelDefRepoFn:     func() *automock.LabelDefinitionRepository { return &automock.LabelDefinitionRepository{} },
+			EngineFn:           func() *automock.Engine { return &automock.Engine{} },
+			UIDServiceFn:       func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedErrMessage: "while validating Scenario Assignment input",
+		},
+		{
+			Name:         "Returns error when getting Label Definition failed",
+			Input:        in,
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(nil, testError).Once()
+				return repo
+			},
+			EngineFn:           func() *automock.Engine { return &automock.Engine{} },
+			UIDServiceFn:       func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedErrMessage: "while getting scenarios Label Definition",
+		},
+		{
+			Name:  "Returns error when creating Scenario Assignment failed",
+			Input: in,
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("Create", ctx, assignment).Return(testError).Once()
+				return repo
+			},
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(testScenario), nil).Once()
+				return repo
+			},
+			EngineFn: func() *automock.Engine { return &automock.Engine{} },
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while creating Scenario Assignment",
+		},
+		{
+			Name:  "Returns error when assigning scenario failed",
+			Input: in,
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("Create", ctx, assignment).Return(nil).Once()
+				return repo
+			},
+			LabelDefRepoFn: func() *automock.LabelDefinitionRepository {
+				repo := &automock.LabelDefinitionRepository{}
+				repo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(testScenario), nil).Once()
+				return repo
+			},
+			EngineFn: func() *automock.Engine {
+				engine := &automock.Engine{}
+				engine.On("AssignAll", ctx, *assignment).Return(testError).Once()
+				return engine
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while assigning scenario to matching objects",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelDefRepo := testCase.LabelDefRepoFn()
+			engine := testCase.EngineFn()
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := scenarioassignment.NewService(repo, labelDefRepo, engine, uidSvc)
+
+			// WHEN
+			result, err := svc.Create(ctx, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testID, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+			labelDefRepo.AssertExpectations(t)
+			engine.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when tenant is missing in context", func(t *testing.T) {
+		// WHEN
+		_, err := scenarioassignment.NewService(nil, nil, nil, nil).Create(context.TODO(), in)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, testTenant, testID).Return(fixModelScenarioAssignment(testID, model.RuntimeLabelableObject), nil).Once()
+
+		svc := scenarioassignment.NewService(repo, nil, nil, nil)
+
+		// WHEN
+		result, err := svc.Get(ctx, testID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixModelScenarioAssignment(testID, model.RuntimeLabelableObject), result)
+	})
+
+	t.Run("Returns error when getting Scenario Assignment failed", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, testTenant, testID).Return(nil, testError).Once()
+
+		svc := scenarioassignment.NewService(repo, nil, nil, nil)
+
+		// WHEN
+		_, err := svc.Get(ctx, testID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
+
+func TestService_List(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	page := &model.ScenarioAssignmentPage{
+		Data:       []*model.ScenarioAssignment{fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)},
+		TotalCount: 1,
+		PageInfo:   &pagination.Page{},
+	}
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.ScenarioAssignmentRepository
+		ExpectedResult     *model.ScenarioAssignmentPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name:     "Success",
+			PageSize: 2,
+			RepositoryFn: func() *automock.ScenarioAssignmentRepository {
+				repo := &automock.ScenarioAssignmentRepository{}
+				repo.On("List", ctx, testTenant, 2, "").Return(page, nil).Once()
+				return repo
+			},
+			ExpectedResult: page,
+		},
+		{
+			Name:               "Returns error when page size is too small",
+			PageSize:           0,
+			RepositoryFn:       func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			ExpectedErrMessage: "page size must be between 1 and 100",
+		},
+		{
+			Name:               "Returns error when page size is too big",
+			PageSize:           101,
+			RepositoryFn:       func() *automock.ScenarioAssignmentRepository { return &automock.ScenarioAssignmentRepository{} },
+			ExpectedErrMessage: "page size must be between 1 and 100",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := scenarioassignment.NewService(repo, nil, nil, nil)
+
+			// WHEN
+			result, err := svc.List(ctx, testCase.PageSize, "")
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.EqualError(t, err, testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	current := fixModelScenarioAssignment(testID, model.ApplicationLabelableObject)
+	updated := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, testTenant, testID).Return(current, nil).Once()
+		repo.On("Update", ctx, updated).Return(nil).Once()
+
+		labelDefRepo := &automock.LabelDefinitionRepository{}
+		defer labelDefRepo.AssertExpectations(t)
+		labelDefRepo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(testScenario), nil).Once()
+
+		engine := &automock.Engine{}
+		defer engine.AssertExpectations(t)
+		engine.On("UnassignAll", ctx, *current).Return(nil).Once()
+		engine.On("AssignAll", ctx, *updated).Return(nil).Once()
+
+		svc := scenarioassignment.NewService(repo, labelDefRepo, engine, nil)
+
+		// WHEN
+		err := svc.Update(ctx, testID, fixModelScenarioAssignmentInput())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when removing scenario failed", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, testTenant, testID).Return(current, nil).Once()
+
+		labelDefRepo := &automock.LabelDefinitionRepository{}
+		defer labelDefRepo.AssertExpectations(t)
+		labelDefRepo.On("GetByKey", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(testScenario), nil).Once()
+
+		engine := &automock.Engine{}
+		defer engine.AssertExpectations(t)
+		engine.On("UnassignAll", ctx, *current).Return(testError).Once()
+
+		svc := scenarioassignment.NewService(repo, labelDefRepo, engine, nil)
+
+		// WHEN
+		err := svc.Update(ctx, testID, fixModelScenarioAssignmentInput())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while removing scenario from objects")
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	assignment := fixModelScenarioAssignment(testID, model.RuntimeLabelableObject)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, testTenant, testID).Return(assignment, nil).Once()
+		repo.On("Delete", ctx, testTenant, testID).Return(nil).Once()
+
+		engine := &automock.Engine{}
+		defer engine.AssertExpectations(t)
+		engine.On("UnassignAll", ctx, *assignment).Return(nil).Once()
+
+		svc := scenarioassignment.NewService(repo, nil, engine, nil)
+
+		// WHEN
+		err := svc.Delete(ctx, testID)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when deleting Scenario Assignment failed", func(t *testing.T) {
+		repo := &automock.ScenarioAssignmentRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("GetByID", ctx, testTenant, testID).Return(assignment, nil).Once()
+		repo.On("Delete", ctx, testTenant, testID).Return(testError).Once()
+
+		engine := &automock.Engine{}
+		defer engine.AssertExpectations(t)
+		engine.On("UnassignAll", ctx, *assignment).Return(nil).Once()
+
+		svc := scenarioassignment.NewService(repo, nil, engine, nil)
+
+		// WHEN
+		err := svc.Delete(ctx, testID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while deleting Scenario Assignment with ID foo")
+	})
+}
@@ -77,5 +77,7 @@ func (c *converter) FromEntity(in Entity) (model.SystemAuth, error) {
 		RuntimeID:           repo.StringPtrFromNullableString(in.RuntimeID),
 		IntegrationSystemID: repo.StringPtrFromNullableString(in.IntegrationSystemID),
 		Value:               value,
+
+		ReferenceObjectStatusCondition: in.ReferenceObjectStatusCondition.String,
 	}, nil
 }
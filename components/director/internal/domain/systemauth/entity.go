@@ -9,6 +9,8 @@ type Entity struct {
 	RuntimeID           sql.NullString `db:"runtime_id"`
 	IntegrationSystemID sql.NullString `db:"integration_system_id"`
 	Value               sql.NullString `db:"value"`
+
+	ReferenceObjectStatusCondition sql.NullString `db:"reference_object_status_condition"`
 }
 
 type Collection []Entity
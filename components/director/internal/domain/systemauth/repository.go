@@ -16,6 +16,11 @@ const tableName string = `public.system_auths`
 var (
 	tableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}
 	tenantColumn = "tenant_id"
+
+	// globalTableColumns load also the status condition of the referenced object, so the owner authenticating
+	// with the system auth does not have to be fetched separately
+	globalTableColumns = append(tableColumns[:len(tableColumns):len(tableColumns)],
+		`COALESCE((SELECT status_condition::text FROM public.applications WHERE id = app_id), (SELECT status_condition::text FROM public.runtimes WHERE id = runtime_id)) AS reference_object_status_condition`)
 )
 
 //go:generate mockery -name=Converter -output=automock -outpkg=automock -case=underscore
@@ -38,7 +43,7 @@ func NewRepository(conv Converter) *repository {
 	return &repository{
 		creator:            repo.NewCreator(tableName, tableColumns),
 		singleGetter:       repo.NewSingleGetter(tableName, tenantColumn, tableColumns),
-		singleGetterGlobal: repo.NewSingleGetterGlobal(tableName, tableColumns),
+		singleGetterGlobal: repo.NewSingleGetterGlobal(tableName, globalTableColumns),
 		lister:             repo.NewLister(tableName, tenantColumn, tableColumns),
 		deleter:            repo.NewDeleter(tableName, tenantColumn),
 		conv:               conv,
@@ -2,6 +2,7 @@ package systemauth_test
 
 import (
 	"context"
+	"database/sql"
 	"regexp"
 	"testing"
 
@@ -228,6 +229,8 @@ func TestRepository_GetByIDGlobal(t *testing.T) {
 		// GIVEN
 		saModel := fixModelSystemAuth(saID, model.RuntimeReference, objectID, fixModelAuth())
 		saEntity := fixEntity(saID, model.RuntimeReference, objectID, true)
+		saModel.ReferenceObjectStatusCondition = "READY"
+		saEntity.ReferenceObjectStatusCondition = sql.NullString{String: "READY", Valid: true}
 
 		mockConverter := &automock.Converter{}
 		mockConverter.On("FromEntity", saEntity).Return(*saModel, nil).Once()
@@ -237,10 +240,10 @@ func TestRepository_GetByIDGlobal(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
-			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value)
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value", "reference_object_status_condition"}).
+			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value, saEntity.ReferenceObjectStatusCondition)
 
-		query := "SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE id = $1"
+		query := "SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value, COALESCE((SELECT status_condition::text FROM public.applications WHERE id = app_id), (SELECT status_condition::text FROM public.runtimes WHERE id = runtime_id)) AS reference_object_status_condition FROM public.system_auths WHERE id = $1"
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(saID).WillReturnRows(rows)
 
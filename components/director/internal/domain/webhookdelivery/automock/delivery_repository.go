@@ -0,0 +1,67 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+	time "time"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DeliveryRepository is an autogenerated mock type for the DeliveryRepository type
+type DeliveryRepository struct {
+	mock.Mock
+}
+
+// CreateAttempt provides a mock function with given fields: ctx, item
+func (_m *DeliveryRepository) CreateAttempt(ctx context.Context, item *model.WebhookDeliveryAttempt) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDeliveryAttempt) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LockNextPending provides a mock function with given fields: ctx, scheduledBefore
+func (_m *DeliveryRepository) LockNextPending(ctx context.Context, scheduledBefore time.Time) (*model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, scheduledBefore)
+
+	var r0 *model.WebhookDelivery
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *model.WebhookDelivery); ok {
+		r0 = rf(ctx, scheduledBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDelivery)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, scheduledBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *DeliveryRepository) Update(ctx context.Context, item *model.WebhookDelivery) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,70 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	webhookdelivery "github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// AttemptFromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) AttemptFromEntity(in webhookdelivery.AttemptEntity) model.WebhookDeliveryAttempt {
+	ret := _m.Called(in)
+
+	var r0 model.WebhookDeliveryAttempt
+	if rf, ok := ret.Get(0).(func(webhookdelivery.AttemptEntity) model.WebhookDeliveryAttempt); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDeliveryAttempt)
+	}
+
+	return r0
+}
+
+// AttemptToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) AttemptToEntity(in model.WebhookDeliveryAttempt) webhookdelivery.AttemptEntity {
+	ret := _m.Called(in)
+
+	var r0 webhookdelivery.AttemptEntity
+	if rf, ok := ret.Get(0).(func(model.WebhookDeliveryAttempt) webhookdelivery.AttemptEntity); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(webhookdelivery.AttemptEntity)
+	}
+
+	return r0
+}
+
+// FromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) FromEntity(in webhookdelivery.Entity) model.WebhookDelivery {
+	ret := _m.Called(in)
+
+	var r0 model.WebhookDelivery
+	if rf, ok := ret.Get(0).(func(webhookdelivery.Entity) model.WebhookDelivery); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDelivery)
+	}
+
+	return r0
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in model.WebhookDelivery) webhookdelivery.Entity {
+	ret := _m.Called(in)
+
+	var r0 webhookdelivery.Entity
+	if rf, ok := ret.Get(0).(func(model.WebhookDelivery) webhookdelivery.Entity); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(webhookdelivery.Entity)
+	}
+
+	return r0
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookDeliveryConverter is an autogenerated mock type for the WebhookDeliveryConverter type
+type WebhookDeliveryConverter struct {
+	mock.Mock
+}
+
+// MultipleAttemptsToGraphQL provides a mock function with given fields: in
+func (_m *WebhookDeliveryConverter) MultipleAttemptsToGraphQL(in []*model.WebhookDeliveryAttempt) []*graphql.WebhookDeliveryAttempt {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.WebhookDeliveryAttempt
+	if rf, ok := ret.Get(0).(func([]*model.WebhookDeliveryAttempt) []*graphql.WebhookDeliveryAttempt); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.WebhookDeliveryAttempt)
+		}
+	}
+
+	return r0
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *WebhookDeliveryConverter) MultipleToGraphQL(in []*model.WebhookDelivery) []*graphql.WebhookDelivery {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.WebhookDelivery
+	if rf, ok := ret.Get(0).(func([]*model.WebhookDelivery) []*graphql.WebhookDelivery); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.WebhookDelivery)
+		}
+	}
+
+	return r0
+}
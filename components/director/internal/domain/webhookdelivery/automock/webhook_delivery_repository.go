@@ -0,0 +1,75 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookDeliveryRepository is an autogenerated mock type for the WebhookDeliveryRepository type
+type WebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, item
+func (_m *WebhookDeliveryRepository) Create(ctx context.Context, item *model.WebhookDelivery) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAttemptsByDeliveryID provides a mock function with given fields: ctx, tenant, deliveryID
+func (_m *WebhookDeliveryRepository) ListAttemptsByDeliveryID(ctx context.Context, tenant string, deliveryID string) ([]*model.WebhookDeliveryAttempt, error) {
+	ret := _m.Called(ctx, tenant, deliveryID)
+
+	var r0 []*model.WebhookDeliveryAttempt
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.WebhookDeliveryAttempt); ok {
+		r0 = rf(ctx, tenant, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebhookDeliveryAttempt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByWebhookID provides a mock function with given fields: ctx, tenant, webhookID, pageSize, cursor
+func (_m *WebhookDeliveryRepository) ListByWebhookID(ctx context.Context, tenant string, webhookID string, pageSize int, cursor string) (*model.WebhookDeliveryPage, error) {
+	ret := _m.Called(ctx, tenant, webhookID, pageSize, cursor)
+
+	var r0 *model.WebhookDeliveryPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.WebhookDeliveryPage); ok {
+		r0 = rf(ctx, tenant, webhookID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDeliveryPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
+		r1 = rf(ctx, tenant, webhookID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookDeliveryService is an autogenerated mock type for the WebhookDeliveryService type
+type WebhookDeliveryService struct {
+	mock.Mock
+}
+
+// ListAttempts provides a mock function with given fields: ctx, deliveryID
+func (_m *WebhookDeliveryService) ListAttempts(ctx context.Context, deliveryID string) ([]*model.WebhookDeliveryAttempt, error) {
+	ret := _m.Called(ctx, deliveryID)
+
+	var r0 []*model.WebhookDeliveryAttempt
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.WebhookDeliveryAttempt); ok {
+		r0 = rf(ctx, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebhookDeliveryAttempt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByWebhookID provides a mock function with given fields: ctx, webhookID, pageSize, cursor
+func (_m *WebhookDeliveryService) ListByWebhookID(ctx context.Context, webhookID string, pageSize int, cursor string) (*model.WebhookDeliveryPage, error) {
+	ret := _m.Called(ctx, webhookID, pageSize, cursor)
+
+	var r0 *model.WebhookDeliveryPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.WebhookDeliveryPage); ok {
+		r0 = rf(ctx, webhookID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDeliveryPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, webhookID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,12 @@
+package webhookdelivery
+
+import "time"
+
+type Config struct {
+	Period         time.Duration `envconfig:"default=10s"`
+	SigningKey     string        `envconfig:"optional"`
+	MaxAttempts    int           `envconfig:"default=10"`
+	InitialBackoff time.Duration `envconfig:"default=10s"`
+	MaxBackoff     time.Duration `envconfig:"default=1h"`
+	LeaseDuration  time.Duration `envconfig:"default=5m"`
+}
@@ -0,0 +1,118 @@
+package webhookdelivery
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.WebhookDelivery) *graphql.WebhookDelivery {
+	if in == nil {
+		return nil
+	}
+
+	var nextAttemptAt *graphql.Timestamp
+	if in.NextAttemptAt != nil {
+		timestamp := graphql.Timestamp(*in.NextAttemptAt)
+		nextAttemptAt = &timestamp
+	}
+
+	return &graphql.WebhookDelivery{
+		ID:            in.ID,
+		WebhookID:     in.WebhookID,
+		Payload:       in.Payload,
+		Status:        graphql.WebhookDeliveryStatus(in.Status),
+		CreatedAt:     graphql.Timestamp(in.CreatedAt),
+		NextAttemptAt: nextAttemptAt,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.WebhookDelivery) []*graphql.WebhookDelivery {
+	var deliveries []*graphql.WebhookDelivery
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+		deliveries = append(deliveries, c.ToGraphQL(r))
+	}
+
+	return deliveries
+}
+
+func (c *converter) AttemptToGraphQL(in *model.WebhookDeliveryAttempt) *graphql.WebhookDeliveryAttempt {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.WebhookDeliveryAttempt{
+		Timestamp:    graphql.Timestamp(in.Timestamp),
+		ResponseCode: in.ResponseCode,
+		Error:        in.Error,
+	}
+}
+
+func (c *converter) MultipleAttemptsToGraphQL(in []*model.WebhookDeliveryAttempt) []*graphql.WebhookDeliveryAttempt {
+	attempts := []*graphql.WebhookDeliveryAttempt{}
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+		attempts = append(attempts, c.AttemptToGraphQL(r))
+	}
+
+	return attempts
+}
+
+func (c *converter) ToEntity(in model.WebhookDelivery) Entity {
+	return Entity{
+		ID:            in.ID,
+		TenantID:      in.Tenant,
+		WebhookID:     in.WebhookID,
+		Payload:       in.Payload,
+		Status:        string(in.Status),
+		AttemptCount:  in.AttemptCount,
+		NextAttemptAt: in.NextAttemptAt,
+		CreatedAt:     in.CreatedAt,
+	}
+}
+
+func (c *converter) FromEntity(in Entity) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		ID:            in.ID,
+		Tenant:        in.TenantID,
+		WebhookID:     in.WebhookID,
+		Payload:       in.Payload,
+		Status:        model.WebhookDeliveryStatus(in.Status),
+		AttemptCount:  in.AttemptCount,
+		NextAttemptAt: in.NextAttemptAt,
+		CreatedAt:     in.CreatedAt,
+	}
+}
+
+func (c *converter) AttemptToEntity(in model.WebhookDeliveryAttempt) AttemptEntity {
+	return AttemptEntity{
+		ID:           in.ID,
+		TenantID:     in.Tenant,
+		DeliveryID:   in.DeliveryID,
+		Timestamp:    in.Timestamp,
+		ResponseCode: repo.NewNullableInt(in.ResponseCode),
+		Error:        repo.NewNullableString(in.Error),
+	}
+}
+
+func (c *converter) AttemptFromEntity(in AttemptEntity) model.WebhookDeliveryAttempt {
+	return model.WebhookDeliveryAttempt{
+		ID:           in.ID,
+		Tenant:       in.TenantID,
+		DeliveryID:   in.DeliveryID,
+		Timestamp:    in.Timestamp,
+		ResponseCode: repo.IntPtrFromNullableInt(in.ResponseCode),
+		Error:        repo.StringPtrFromNullableString(in.Error),
+	}
+}
@@ -0,0 +1,159 @@
+package webhookdelivery_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    *model.WebhookDelivery
+		Expected *graphql.WebhookDelivery
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixModelWebhookDelivery(testID, model.WebhookDeliveryStatusPending, 0),
+			Expected: fixGQLWebhookDelivery(testID),
+		},
+		{
+			Name: "Without next attempt",
+			Input: &model.WebhookDelivery{
+				ID:        testID,
+				WebhookID: testWebhookID,
+				Payload:   testPayload,
+				Status:    model.WebhookDeliveryStatusSucceeded,
+				CreatedAt: testTimestamp,
+			},
+			Expected: &graphql.WebhookDelivery{
+				ID:        testID,
+				WebhookID: testWebhookID,
+				Payload:   testPayload,
+				Status:    graphql.WebhookDeliveryStatusSucceeded,
+				CreatedAt: graphql.Timestamp(testTimestamp),
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			converter := webhookdelivery.NewConverter()
+			res := converter.ToGraphQL(testCase.Input)
+
+			// then
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// given
+	input := []*model.WebhookDelivery{
+		fixModelWebhookDelivery("foo", model.WebhookDeliveryStatusPending, 0),
+		nil,
+		fixModelWebhookDelivery("bar", model.WebhookDeliveryStatusPending, 0),
+	}
+	expected := []*graphql.WebhookDelivery{
+		fixGQLWebhookDelivery("foo"),
+		fixGQLWebhookDelivery("bar"),
+	}
+
+	// when
+	converter := webhookdelivery.NewConverter()
+	res := converter.MultipleToGraphQL(input)
+
+	// then
+	assert.Equal(t, expected, res)
+}
+
+func TestConverter_MultipleAttemptsToGraphQL(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    []*model.WebhookDeliveryAttempt
+		Expected []*graphql.WebhookDeliveryAttempt
+	}{
+		{
+			Name: "Multiple attempts",
+			Input: []*model.WebhookDeliveryAttempt{
+				fixModelAttempt("foo", intPtr(500), strPtr("unexpected response status: 500 Internal Server Error")),
+				nil,
+				fixModelAttempt("bar", intPtr(200), nil),
+			},
+			Expected: []*graphql.WebhookDeliveryAttempt{
+				{Timestamp: graphql.Timestamp(testTimestamp), ResponseCode: intPtr(500), Error: strPtr("unexpected response status: 500 Internal Server Error")},
+				{Timestamp: graphql.Timestamp(testTimestamp), ResponseCode: intPtr(200)},
+			},
+		},
+		{
+			Name:     "Empty list when there are no attempts",
+			Input:    nil,
+			Expected: []*graphql.WebhookDeliveryAttempt{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			converter := webhookdelivery.NewConverter()
+			res := converter.MultipleAttemptsToGraphQL(testCase.Input)
+
+			// then
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_EntityConversion(t *testing.T) {
+	// given
+	delivery := fixModelWebhookDelivery(testID, model.WebhookDeliveryStatusPending, 2)
+	converter := webhookdelivery.NewConverter()
+
+	// when
+	entity := converter.ToEntity(*delivery)
+	res := converter.FromEntity(entity)
+
+	// then
+	assert.Equal(t, *delivery, res)
+}
+
+func TestConverter_AttemptEntityConversion(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name  string
+		Input *model.WebhookDeliveryAttempt
+	}{
+		{
+			Name:  "With response code",
+			Input: fixModelAttempt(testAttemptID, intPtr(200), nil),
+		},
+		{
+			Name:  "With error",
+			Input: fixModelAttempt(testAttemptID, nil, strPtr("while calling Webhook: timeout")),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			converter := webhookdelivery.NewConverter()
+
+			// when
+			entity := converter.AttemptToEntity(*testCase.Input)
+			res := converter.AttemptFromEntity(entity)
+
+			// then
+			assert.Equal(t, *testCase.Input, res)
+		})
+	}
+}
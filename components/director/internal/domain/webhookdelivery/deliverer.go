@@ -0,0 +1,247 @@
+package webhookdelivery
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/httpclient"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DeliveryHeader  = "X-Compass-Delivery"
+	SignatureHeader = "X-Compass-Signature"
+)
+
+//go:generate mockery -name=DeliveryRepository -output=automock -outpkg=automock -case=underscore
+type DeliveryRepository interface {
+	LockNextPending(ctx context.Context, scheduledBefore time.Time) (*model.WebhookDelivery, error)
+	Update(ctx context.Context, item *model.WebhookDelivery) error
+	CreateAttempt(ctx context.Context, item *model.WebhookDeliveryAttempt) error
+}
+
+//go:generate mockery -name=HTTPClient -output=automock -outpkg=automock -case=underscore
+type HTTPClient interface {
+	Do(req *http.Request, auth *model.Auth) (*http.Response, error)
+}
+
+type deliverer struct {
+	transact     persistence.Transactioner
+	repo         DeliveryRepository
+	webhookRepo  WebhookRepository
+	httpClient   HTTPClient
+	uidService   UIDService
+	cfg          Config
+	timestampGen timestamp.Generator
+}
+
+// NewDeliverer returns deliverer which signs the deliveries with the configured key. Without the key, deliveries are sent unsigned.
+func NewDeliverer(transact persistence.Transactioner, repo DeliveryRepository, webhookRepo WebhookRepository, httpClient HTTPClient, uidService UIDService, cfg Config) *deliverer {
+	if cfg.SigningKey == "" {
+		log.Warn("Webhook deliveries are sent without signature, as signing key is not configured")
+	}
+
+	return &deliverer{
+		transact:     transact,
+		repo:         repo,
+		webhookRepo:  webhookRepo,
+		httpClient:   httpClient,
+		uidService:   uidService,
+		cfg:          cfg,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// DeliverAll sends every pending Webhook Delivery which is due. No transaction is open while a delivery is being sent.
+// Failed deliveries are retried with exponential backoff until the maximum number of attempts is reached.
+func (d *deliverer) DeliverAll(ctx context.Context) error {
+	scheduledBefore := d.timestampGen()
+
+	for {
+		processed, err := d.deliverNext(ctx, scheduledBefore)
+		if err != nil {
+			return err
+		}
+		if !processed {
+			return nil
+		}
+	}
+}
+
+func (d *deliverer) deliverNext(ctx context.Context, scheduledBefore time.Time) (bool, error) {
+	delivery, webhook, err := d.claimNext(ctx, scheduledBefore)
+	if err != nil {
+		return false, err
+	}
+	if delivery == nil {
+		return false, nil
+	}
+
+	attempt := d.send(ctx, delivery, webhook)
+	d.scheduleNextAttempt(delivery, attempt)
+
+	err = d.recordAttempt(ctx, delivery, attempt)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// claimNext postpones the next attempt of the due delivery by the lease duration, so that other replicas skip it while it is being sent outside of the transaction
+func (d *deliverer) claimNext(ctx context.Context, scheduledBefore time.Time) (*model.WebhookDelivery, *model.Webhook, error) {
+	tx, err := d.transact.Begin()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while opening transaction")
+	}
+	defer d.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	delivery, err := d.repo.LockNextPending(ctx, scheduledBefore)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, errors.Wrap(err, "while getting WebhookDelivery to send")
+	}
+
+	webhook, err := d.webhookRepo.GetByID(ctx, delivery.Tenant, delivery.WebhookID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while getting Webhook with ID %s", delivery.WebhookID)
+	}
+
+	leaseExpiresAt := d.timestampGen().Add(d.cfg.LeaseDuration)
+	delivery.NextAttemptAt = &leaseExpiresAt
+	err = d.repo.Update(ctx, delivery)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while claiming WebhookDelivery with ID %s", delivery.ID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return delivery, webhook, nil
+}
+
+func (d *deliverer) recordAttempt(ctx context.Context, delivery *model.WebhookDelivery, attempt *model.WebhookDeliveryAttempt) error {
+	tx, err := d.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer d.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = d.repo.CreateAttempt(ctx, attempt)
+	if err != nil {
+		return errors.Wrapf(err, "while creating attempt of WebhookDelivery with ID %s", delivery.ID)
+	}
+
+	err = d.repo.Update(ctx, delivery)
+	if err != nil {
+		return errors.Wrapf(err, "while updating WebhookDelivery with ID %s", delivery.ID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errors.Wrap(err, "while committing transaction")
+	}
+
+	return nil
+}
+
+func (d *deliverer) send(ctx context.Context, delivery *model.WebhookDelivery, webhook *model.Webhook) *model.WebhookDeliveryAttempt {
+	attempt := &model.WebhookDeliveryAttempt{
+		ID:         d.uidService.Generate(),
+		Tenant:     delivery.Tenant,
+		DeliveryID: delivery.ID,
+		Timestamp:  d.timestampGen(),
+	}
+
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = errorMessage(errors.Wrap(err, "while creating request"))
+		return attempt
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	if d.cfg.SigningKey != "" {
+		req.Header.Set(SignatureHeader, Signature(d.cfg.SigningKey, delivery.Payload))
+	}
+
+	resp, err := d.httpClient.Do(req, webhook.Auth)
+	if err != nil {
+		attempt.Error = errorMessage(errors.Wrap(err, "while calling Webhook"))
+		return attempt
+	}
+	defer func() {
+		if err := httpclient.CloseBody(resp.Body); err != nil {
+			log.Warnf("Failed to close response body of WebhookDelivery with ID %s: %s", delivery.ID, err)
+		}
+	}()
+
+	responseCode := resp.StatusCode
+	attempt.ResponseCode = &responseCode
+	if responseCode < http.StatusOK || responseCode >= http.StatusMultipleChoices {
+		attempt.Error = errorMessage(fmt.Errorf("unexpected response status: %s", resp.Status))
+	}
+
+	return attempt
+}
+
+// scheduleNextAttempt marks the delivery as succeeded or failed, or schedules the retry after the backoff doubled with every failed attempt
+func (d *deliverer) scheduleNextAttempt(delivery *model.WebhookDelivery, attempt *model.WebhookDeliveryAttempt) {
+	delivery.AttemptCount++
+
+	switch {
+	case attempt.Error == nil:
+		delivery.Status = model.WebhookDeliveryStatusSucceeded
+		delivery.NextAttemptAt = nil
+	case delivery.AttemptCount >= d.cfg.MaxAttempts:
+		log.Warnf("WebhookDelivery with ID %s failed after %d attempts: %s", delivery.ID, delivery.AttemptCount, *attempt.Error)
+		delivery.Status = model.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = nil
+	default:
+		nextAttemptAt := attempt.Timestamp.Add(d.backoff(delivery.AttemptCount))
+		delivery.NextAttemptAt = &nextAttemptAt
+	}
+}
+
+func (d *deliverer) backoff(attemptCount int) time.Duration {
+	backoff := d.cfg.InitialBackoff
+	for i := 1; i < attemptCount; i++ {
+		backoff *= 2
+		if backoff >= d.cfg.MaxBackoff {
+			return d.cfg.MaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// Signature returns the value of the signature header, which is HMAC-SHA256 of the payload computed with the signing key
+func Signature(key, payload string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func errorMessage(err error) *string {
+	message := err.Error()
+	return &message
+}
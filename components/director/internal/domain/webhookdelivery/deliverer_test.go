@@ -0,0 +1,279 @@
+package webhookdelivery_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverer_DeliverAll(t *testing.T) {
+	// given
+	webhookURL := "http://foo.bar/webhook"
+	webhook := fixModelWebhook(testWebhookID, model.WebhookTypeConfigurationChanged, webhookURL)
+	webhook.Auth = &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "user", Password: "pass"}}}
+	cfg := webhookdelivery.Config{
+		SigningKey:     testSigningKey,
+		MaxAttempts:    3,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     90 * time.Second,
+		LeaseDuration:  5 * time.Minute,
+	}
+
+	signedRequest := mock.MatchedBy(func(req *http.Request) bool {
+		body, err := ioutil.ReadAll(req.Body)
+		return err == nil &&
+			req.Method == http.MethodPost &&
+			req.URL.String() == webhookURL &&
+			req.Header.Get("Content-Type") == "application/json" &&
+			req.Header.Get(webhookdelivery.DeliveryHeader) == testID &&
+			req.Header.Get(webhookdelivery.SignatureHeader) == webhookdelivery.Signature(testSigningKey, testPayload) &&
+			string(body) == testPayload
+	})
+	unsignedRequest := mock.MatchedBy(func(req *http.Request) bool {
+		_, signed := req.Header[webhookdelivery.SignatureHeader]
+		return req.URL.String() == webhookURL && !signed
+	})
+	response := func(statusCode int) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Status:     http.StatusText(statusCode),
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}
+	}
+	delivery := func(status model.WebhookDeliveryStatus, attemptCount int, nextAttemptAt *time.Time) *model.WebhookDelivery {
+		delivery := fixModelWebhookDelivery(testID, status, attemptCount)
+		delivery.NextAttemptAt = nextAttemptAt
+		return delivery
+	}
+	timePtr := func(in time.Time) *time.Time {
+		return &in
+	}
+
+	testCases := []struct {
+		Name             string
+		Unsigned         bool
+		LockedDelivery   *model.WebhookDelivery
+		HTTPClientFn     func() *automock.HTTPClient
+		ExpectedAttempt  *model.WebhookDeliveryAttempt
+		ExpectedDelivery *model.WebhookDelivery
+	}{
+		{
+			Name:           "Success",
+			LockedDelivery: delivery(model.WebhookDeliveryStatusPending, 0, &testTimestamp),
+			HTTPClientFn: func() *automock.HTTPClient {
+				client := &automock.HTTPClient{}
+				client.On("Do", signedRequest, webhook.Auth).Return(response(http.StatusOK), nil).Once()
+				return client
+			},
+			ExpectedAttempt:  fixModelAttempt(testAttemptID, intPtr(http.StatusOK), nil),
+			ExpectedDelivery: delivery(model.WebhookDeliveryStatusSucceeded, 1, nil),
+		},
+		{
+			Name:           "Success without signature when signing key is not configured",
+			Unsigned:       true,
+			LockedDelivery: delivery(model.WebhookDeliveryStatusPending, 0, &testTimestamp),
+			HTTPClientFn: func() *automock.HTTPClient {
+				client := &automock.HTTPClient{}
+				client.On("Do", unsignedRequest, webhook.Auth).Return(response(http.StatusOK), nil).Once()
+				return client
+			},
+			ExpectedAttempt:  fixModelAttempt(testAttemptID, intPtr(http.StatusOK), nil),
+			ExpectedDelivery: delivery(model.WebhookDeliveryStatusSucceeded, 1, nil),
+		},
+		{
+			Name:           "Schedules retry after initial backoff when Webhook responds with error",
+			LockedDelivery: delivery(model.WebhookDeliveryStatusPending, 0, &testTimestamp),
+			HTTPClientFn: func() *automock.HTTPClient {
+				client := &automock.HTTPClient{}
+				client.On("Do", signedRequest, webhook.Auth).Return(response(http.StatusInternalServerError), nil).Once()
+				return client
+			},
+			ExpectedAttempt:  fixModelAttempt(testAttemptID, intPtr(http.StatusInternalServerError), strPtr("unexpected response status: Internal Server Error")),
+			ExpectedDelivery: delivery(model.WebhookDeliveryStatusPending, 1, timePtr(testTimestamp.Add(time.Minute))),
+		},
+		{
+			Name:           "Schedules retry after maximum backoff when Webhook cannot be called",
+			LockedDelivery: delivery(model.WebhookDeliveryStatusPending, 1, &testTimestamp),
+			HTTPClientFn: func() *automock.HTTPClient {
+				client := &automock.HTTPClient{}
+				client.On("Do", signedRequest, webhook.Auth).Return(nil, testErr).Once()
+				return client
+			},
+			ExpectedAttempt:  fixModelAttempt(testAttemptID, nil, strPtr("while calling Webhook: test error")),
+			ExpectedDelivery: delivery(model.WebhookDeliveryStatusPending, 2, timePtr(testTimestamp.Add(90*time.Second))),
+		},
+		{
+			Name:           "Marks delivery as failed when maximum number of attempts is reached",
+			LockedDelivery: delivery(model.WebhookDeliveryStatusPending, 2, &testTimestamp),
+			HTTPClientFn: func() *automock.HTTPClient {
+				client := &automock.HTTPClient{}
+				client.On("Do", signedRequest, webhook.Auth).Return(response(http.StatusNotFound), nil).Once()
+				return client
+			},
+			ExpectedAttempt:  fixModelAttempt(testAttemptID, intPtr(http.StatusNotFound), strPtr("unexpected response status: Not Found")),
+			ExpectedDelivery: delivery(model.WebhookDeliveryStatusFailed, 3, nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			claimTx := txtest.PersistenceContextThatExpectsCommit()
+			recordTx := txtest.PersistenceContextThatExpectsCommit()
+			emptyTx := txtest.PersistenceContextThatDoesntExpectCommit()
+			transact := &persistenceautomock.Transactioner{}
+			transact.On("Begin").Return(claimTx, nil).Once()
+			transact.On("Begin").Return(recordTx, nil).Once()
+			transact.On("Begin").Return(emptyTx, nil).Once()
+			transact.On("RollbackUnlessCommited", claimTx).Return().Once()
+			transact.On("RollbackUnlessCommited", recordTx).Return().Once()
+			transact.On("RollbackUnlessCommited", emptyTx).Return().Once()
+
+			claimedDelivery := *testCase.LockedDelivery
+			claimedDelivery.NextAttemptAt = timePtr(testTimestamp.Add(cfg.LeaseDuration))
+
+			repo := &automock.DeliveryRepository{}
+			repo.On("LockNextPending", txtest.CtxWithDBMatcher(), testTimestamp).Return(testCase.LockedDelivery, nil).Once()
+			repo.On("Update", txtest.CtxWithDBMatcher(), &claimedDelivery).Return(nil).Once()
+			repo.On("CreateAttempt", txtest.CtxWithDBMatcher(), testCase.ExpectedAttempt).Return(nil).Once()
+			repo.On("Update", txtest.CtxWithDBMatcher(), testCase.ExpectedDelivery).Return(nil).Once()
+			repo.On("LockNextPending", txtest.CtxWithDBMatcher(), testTimestamp).Return(nil, apperrors.NewNotFoundError("")).Once()
+
+			webhookRepo := &automock.WebhookRepository{}
+			webhookRepo.On("GetByID", txtest.CtxWithDBMatcher(), testTenant, testWebhookID).Return(webhook, nil).Once()
+
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(testAttemptID).Once()
+
+			httpClient := testCase.HTTPClientFn()
+
+			deliveryCfg := cfg
+			if testCase.Unsigned {
+				deliveryCfg.SigningKey = ""
+			}
+
+			deliverer := webhookdelivery.NewDeliverer(transact, repo, webhookRepo, httpClient, uidSvc, deliveryCfg)
+			deliverer.SetTimestampGen(func() time.Time { return testTimestamp })
+
+			// when
+			err := deliverer.DeliverAll(context.TODO())
+
+			// then
+			require.NoError(t, err)
+
+			claimTx.AssertExpectations(t)
+			recordTx.AssertExpectations(t)
+			emptyTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			repo.AssertExpectations(t)
+			webhookRepo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+			httpClient.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when locking delivery failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+
+		repo := &automock.DeliveryRepository{}
+		repo.On("LockNextPending", txtest.CtxWithDBMatcher(), testTimestamp).Return(nil, testErr).Once()
+
+		deliverer := webhookdelivery.NewDeliverer(transact, repo, nil, nil, nil, cfg)
+		deliverer.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		// when
+		err := deliverer.DeliverAll(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error without sending delivery when claiming it failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+
+		repo := &automock.DeliveryRepository{}
+		repo.On("LockNextPending", txtest.CtxWithDBMatcher(), testTimestamp).Return(delivery(model.WebhookDeliveryStatusPending, 0, &testTimestamp), nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), delivery(model.WebhookDeliveryStatusPending, 0, timePtr(testTimestamp.Add(cfg.LeaseDuration)))).Return(testErr).Once()
+
+		webhookRepo := &automock.WebhookRepository{}
+		webhookRepo.On("GetByID", txtest.CtxWithDBMatcher(), testTenant, testWebhookID).Return(webhook, nil).Once()
+
+		httpClient := &automock.HTTPClient{}
+
+		deliverer := webhookdelivery.NewDeliverer(transact, repo, webhookRepo, httpClient, nil, cfg)
+		deliverer.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		// when
+		err := deliverer.DeliverAll(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while claiming WebhookDelivery")
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+		webhookRepo.AssertExpectations(t)
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when recording attempt failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommitAfterSucceeding(1)
+
+		repo := &automock.DeliveryRepository{}
+		repo.On("LockNextPending", txtest.CtxWithDBMatcher(), testTimestamp).Return(delivery(model.WebhookDeliveryStatusPending, 0, &testTimestamp), nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), delivery(model.WebhookDeliveryStatusPending, 0, timePtr(testTimestamp.Add(cfg.LeaseDuration)))).Return(nil).Once()
+		repo.On("CreateAttempt", txtest.CtxWithDBMatcher(), fixModelAttempt(testAttemptID, intPtr(http.StatusOK), nil)).Return(testErr).Once()
+
+		webhookRepo := &automock.WebhookRepository{}
+		webhookRepo.On("GetByID", txtest.CtxWithDBMatcher(), testTenant, testWebhookID).Return(webhook, nil).Once()
+
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(testAttemptID).Once()
+
+		httpClient := &automock.HTTPClient{}
+		httpClient.On("Do", signedRequest, webhook.Auth).Return(response(http.StatusOK), nil).Once()
+
+		deliverer := webhookdelivery.NewDeliverer(transact, repo, webhookRepo, httpClient, uidSvc, cfg)
+		deliverer.SetTimestampGen(func() time.Time { return testTimestamp })
+
+		// when
+		err := deliverer.DeliverAll(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while creating attempt of WebhookDelivery")
+
+		persistTx.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		repo.AssertExpectations(t)
+		webhookRepo.AssertExpectations(t)
+		uidSvc.AssertExpectations(t)
+		httpClient.AssertExpectations(t)
+	})
+}
+
+func TestSignature(t *testing.T) {
+	// when
+	result := webhookdelivery.Signature("key", "The quick brown fox jumps over the lazy dog")
+
+	// then
+	assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", result)
+}
@@ -0,0 +1,38 @@
+package webhookdelivery
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID            string     `db:"id"`
+	TenantID      string     `db:"tenant_id"`
+	WebhookID     string     `db:"webhook_id"`
+	Payload       string     `db:"payload"`
+	Status        string     `db:"status"`
+	AttemptCount  int        `db:"attempt_count"`
+	NextAttemptAt *time.Time `db:"next_attempt_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+}
+
+type Collection []Entity
+
+func (c Collection) Len() int {
+	return len(c)
+}
+
+type AttemptEntity struct {
+	ID           string         `db:"id"`
+	TenantID     string         `db:"tenant_id"`
+	DeliveryID   string         `db:"delivery_id"`
+	Timestamp    time.Time      `db:"timestamp"`
+	ResponseCode sql.NullInt64  `db:"response_code"`
+	Error        sql.NullString `db:"error"`
+}
+
+type AttemptCollection []AttemptEntity
+
+func (c AttemptCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,11 @@
+package webhookdelivery
+
+import "time"
+
+func (s *service) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
+
+func (d *deliverer) SetTimestampGen(timestampGen func() time.Time) {
+	d.timestampGen = timestampGen
+}
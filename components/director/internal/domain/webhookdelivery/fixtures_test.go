@@ -0,0 +1,118 @@
+package webhookdelivery_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	testTenant     = "tnt"
+	testID         = "dddddddd-dddd-dddd-dddd-dddddddddddd"
+	testWebhookID  = "wwwwwwww-wwww-wwww-wwww-wwwwwwwwwwww"
+	testAttemptID  = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	testAppID      = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+	testPayload    = `{"eventType":"CONFIGURATION_CHANGED"}`
+	testSigningKey = "secret"
+)
+
+var (
+	testErr       = errors.New("test error")
+	testTimestamp = time.Date(2019, time.November, 22, 12, 0, 0, 0, time.UTC)
+)
+
+func fixModelWebhookDelivery(id string, status model.WebhookDeliveryStatus, attemptCount int) *model.WebhookDelivery {
+	nextAttemptAt := testTimestamp
+	return &model.WebhookDelivery{
+		ID:            id,
+		Tenant:        testTenant,
+		WebhookID:     testWebhookID,
+		Payload:       testPayload,
+		Status:        status,
+		AttemptCount:  attemptCount,
+		NextAttemptAt: &nextAttemptAt,
+		CreatedAt:     testTimestamp,
+	}
+}
+
+func fixGQLWebhookDelivery(id string) *graphql.WebhookDelivery {
+	nextAttemptAt := graphql.Timestamp(testTimestamp)
+	return &graphql.WebhookDelivery{
+		ID:            id,
+		WebhookID:     testWebhookID,
+		Payload:       testPayload,
+		Status:        graphql.WebhookDeliveryStatusPending,
+		CreatedAt:     graphql.Timestamp(testTimestamp),
+		NextAttemptAt: &nextAttemptAt,
+	}
+}
+
+func fixEntityWebhookDelivery(id string) webhookdelivery.Entity {
+	nextAttemptAt := testTimestamp
+	return webhookdelivery.Entity{
+		ID:            id,
+		TenantID:      testTenant,
+		WebhookID:     testWebhookID,
+		Payload:       testPayload,
+		Status:        string(model.WebhookDeliveryStatusPending),
+		NextAttemptAt: &nextAttemptAt,
+		CreatedAt:     testTimestamp,
+	}
+}
+
+func fixModelAttempt(id string, responseCode *int, errMessage *string) *model.WebhookDeliveryAttempt {
+	return &model.WebhookDeliveryAttempt{
+		ID:           id,
+		Tenant:       testTenant,
+		DeliveryID:   testID,
+		Timestamp:    testTimestamp,
+		ResponseCode: responseCode,
+		Error:        errMessage,
+	}
+}
+
+func fixEntityAttempt(id string, responseCode int64) webhookdelivery.AttemptEntity {
+	return webhookdelivery.AttemptEntity{
+		ID:           id,
+		TenantID:     testTenant,
+		DeliveryID:   testID,
+		Timestamp:    testTimestamp,
+		ResponseCode: sql.NullInt64{Int64: responseCode, Valid: true},
+	}
+}
+
+func fixModelWebhook(id string, webhookType model.WebhookType, url string) *model.Webhook {
+	return &model.Webhook{
+		ID:            id,
+		Tenant:        testTenant,
+		ApplicationID: testAppID,
+		Type:          webhookType,
+		URL:           url,
+	}
+}
+
+func fixDeliveryRows(entities ...webhookdelivery.Entity) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "webhook_id", "payload", "status", "attempt_count", "next_attempt_at", "created_at"})
+	for _, e := range entities {
+		rows.AddRow(e.ID, e.TenantID, e.WebhookID, e.Payload, e.Status, e.AttemptCount, e.NextAttemptAt, e.CreatedAt)
+	}
+	return rows
+}
+
+func fixDeliveryCreateArgs(e webhookdelivery.Entity) []driver.Value {
+	return []driver.Value{e.ID, e.TenantID, e.WebhookID, e.Payload, e.Status, e.AttemptCount, e.NextAttemptAt, e.CreatedAt}
+}
+
+func intPtr(in int) *int {
+	return &in
+}
+
+func strPtr(in string) *string {
+	return &in
+}
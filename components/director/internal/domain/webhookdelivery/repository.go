@@ -0,0 +1,146 @@
+package webhookdelivery
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	deliveryTable string = `public.webhook_deliveries`
+	attemptTable  string = `public.webhook_delivery_attempts`
+	tenantColumn  string = `tenant_id`
+)
+
+var (
+	deliveryColumns  = []string{"id", "tenant_id", "webhook_id", "payload", "status", "attempt_count", "next_attempt_at", "created_at"}
+	updatableColumns = []string{"status", "attempt_count", "next_attempt_at"}
+	attemptColumns   = []string{"id", "tenant_id", "delivery_id", "timestamp", "response_code", "error"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in model.WebhookDelivery) Entity
+	FromEntity(in Entity) model.WebhookDelivery
+	AttemptToEntity(in model.WebhookDeliveryAttempt) AttemptEntity
+	AttemptFromEntity(in AttemptEntity) model.WebhookDeliveryAttempt
+}
+
+type repository struct {
+	creator         repo.Creator
+	updater         repo.Updater
+	pageableQuerier repo.PageableQuerier
+	attemptCreator  repo.Creator
+	attemptLister   repo.Lister
+	conv            EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		creator:         repo.NewCreator(deliveryTable, deliveryColumns),
+		updater:         repo.NewUpdater(deliveryTable, updatableColumns, tenantColumn, []string{"id"}),
+		pageableQuerier: repo.NewPageableQuerier(deliveryTable, tenantColumn, deliveryColumns),
+		attemptCreator:  repo.NewCreator(attemptTable, attemptColumns),
+		attemptLister:   repo.NewLister(attemptTable, tenantColumn, attemptColumns),
+		conv:            conv,
+	}
+}
+
+func (r *repository) Create(ctx context.Context, item *model.WebhookDelivery) error {
+	if item == nil {
+		return errors.New("item cannot be nil")
+	}
+
+	return r.creator.Create(ctx, r.conv.ToEntity(*item))
+}
+
+func (r *repository) Update(ctx context.Context, item *model.WebhookDelivery) error {
+	if item == nil {
+		return errors.New("item cannot be nil")
+	}
+
+	return r.updater.UpdateSingle(ctx, r.conv.ToEntity(*item))
+}
+
+func (r *repository) ListByWebhookID(ctx context.Context, tenant, webhookID string, pageSize int, cursor string) (*model.WebhookDeliveryPage, error) {
+	webhookCondition := fmt.Sprintf("webhook_id = %s", pq.QuoteLiteral(webhookID))
+
+	var entities Collection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "created_at", &entities, webhookCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*model.WebhookDelivery
+	for _, entity := range entities {
+		delivery := r.conv.FromEntity(entity)
+		items = append(items, &delivery)
+	}
+
+	return &model.WebhookDeliveryPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
+// LockNextPending returns the pending Webhook Delivery scheduled before the given time and locks it until the end of the transaction.
+// Deliveries locked by other transactions are skipped, so that concurrent callers never deliver the same payload at the same time.
+func (r *repository) LockNextPending(ctx context.Context, scheduledBefore time.Time) (*model.WebhookDelivery, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at LIMIT 1 FOR UPDATE SKIP LOCKED`, strings.Join(deliveryColumns, ", "), deliveryTable)
+
+	var entity Entity
+	err = persist.Get(&entity, stmt, string(model.WebhookDeliveryStatusPending), scheduledBefore)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, apperrors.NewNotFoundError("")
+	case err != nil:
+		return nil, errors.Wrap(err, "while getting pending WebhookDelivery from DB")
+	}
+
+	delivery := r.conv.FromEntity(entity)
+	return &delivery, nil
+}
+
+func (r *repository) CreateAttempt(ctx context.Context, item *model.WebhookDeliveryAttempt) error {
+	if item == nil {
+		return errors.New("item cannot be nil")
+	}
+
+	return r.attemptCreator.Create(ctx, r.conv.AttemptToEntity(*item))
+}
+
+func (r *repository) ListAttemptsByDeliveryID(ctx context.Context, tenant, deliveryID string) ([]*model.WebhookDeliveryAttempt, error) {
+	var entities AttemptCollection
+	if err := r.attemptLister.List(ctx, tenant, &entities, fmt.Sprintf("delivery_id = %s", pq.QuoteLiteral(deliveryID))); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Timestamp.Before(entities[j].Timestamp)
+	})
+
+	var items []*model.WebhookDeliveryAttempt
+	for _, entity := range entities {
+		attempt := r.conv.AttemptFromEntity(entity)
+		items = append(items, &attempt)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,256 @@
+package webhookdelivery_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// given
+		delivery := fixModelWebhookDelivery(testID, model.WebhookDeliveryStatusPending, 0)
+		entity := fixEntityWebhookDelivery(testID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", *delivery).Return(entity).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.webhook_deliveries ( id, tenant_id, webhook_id, payload, status, attempt_count, next_attempt_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )`)).
+			WithArgs(fixDeliveryCreateArgs(entity)...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := webhookdelivery.NewRepository(mockConverter)
+
+		// when
+		err := repo.Create(ctx, delivery)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when item is nil", func(t *testing.T) {
+		// given
+		repo := webhookdelivery.NewRepository(nil)
+
+		// when
+		err := repo.Create(context.TODO(), nil)
+
+		// then
+		require.EqualError(t, err, "item cannot be nil")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	// given
+	delivery := fixModelWebhookDelivery(testID, model.WebhookDeliveryStatusPending, 1)
+	entity := fixEntityWebhookDelivery(testID)
+	entity.AttemptCount = 1
+
+	mockConverter := &automock.EntityConverter{}
+	defer mockConverter.AssertExpectations(t)
+	mockConverter.On("ToEntity", *delivery).Return(entity).Once()
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.webhook_deliveries SET status = ?, attempt_count = ?, next_attempt_at = ? WHERE tenant_id = ? AND id = ?`)).
+		WithArgs(entity.Status, entity.AttemptCount, entity.NextAttemptAt, testTenant, testID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := webhookdelivery.NewRepository(mockConverter)
+
+	// when
+	err := repo.Update(ctx, delivery)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestRepository_ListByWebhookID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// given
+		firstEntity := fixEntityWebhookDelivery("foo")
+		secondEntity := fixEntityWebhookDelivery("bar")
+		firstDelivery := fixModelWebhookDelivery("foo", model.WebhookDeliveryStatusPending, 0)
+		secondDelivery := fixModelWebhookDelivery("bar", model.WebhookDeliveryStatusPending, 0)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", firstEntity).Return(*firstDelivery).Once()
+		mockConverter.On("FromEntity", secondEntity).Return(*secondDelivery).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, tenant_id, webhook_id, payload, status, attempt_count, next_attempt_at, created_at FROM public.webhook_deliveries WHERE tenant_id=$1 AND webhook_id = 'wwwwwwww-wwww-wwww-wwww-wwwwwwwwwwww' ORDER BY created_at LIMIT 2 OFFSET 0`)).
+			WithArgs(testTenant).
+			WillReturnRows(fixDeliveryRows(firstEntity, secondEntity))
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.webhook_deliveries WHERE tenant_id=$1 AND webhook_id = 'wwwwwwww-wwww-wwww-wwww-wwwwwwwwwwww'`)).
+			WithArgs(testTenant).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := webhookdelivery.NewRepository(mockConverter)
+
+		// when
+		page, err := repo.ListByWebhookID(ctx, testTenant, testWebhookID, 2, "")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.WebhookDelivery{firstDelivery, secondDelivery}, page.Data)
+		assert.Equal(t, 3, page.TotalCount)
+		assert.True(t, page.PageInfo.HasNextPage)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery("SELECT .*").WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := webhookdelivery.NewRepository(nil)
+
+		// when
+		_, err := repo.ListByWebhookID(ctx, testTenant, testWebhookID, 2, "")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestRepository_LockNextPending(t *testing.T) {
+	stmt := regexp.QuoteMeta(`SELECT id, tenant_id, webhook_id, payload, status, attempt_count, next_attempt_at, created_at FROM public.webhook_deliveries WHERE status = $1 AND next_attempt_at <= $2
+		ORDER BY next_attempt_at LIMIT 1 FOR UPDATE SKIP LOCKED`)
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		entity := fixEntityWebhookDelivery(testID)
+		delivery := fixModelWebhookDelivery(testID, model.WebhookDeliveryStatusPending, 0)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", entity).Return(*delivery).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.WebhookDeliveryStatusPending), testTimestamp).
+			WillReturnRows(fixDeliveryRows(entity))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := webhookdelivery.NewRepository(mockConverter)
+
+		// when
+		result, err := repo.LockNextPending(ctx, testTimestamp)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, delivery, result)
+	})
+
+	t.Run("Returns not found error when there are no pending deliveries", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.WebhookDeliveryStatusPending), testTimestamp).
+			WillReturnRows(fixDeliveryRows())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := webhookdelivery.NewRepository(nil)
+
+		// when
+		_, err := repo.LockNextPending(ctx, testTimestamp)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error when getting", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(stmt).
+			WithArgs(string(model.WebhookDeliveryStatusPending), testTimestamp).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := webhookdelivery.NewRepository(nil)
+
+		// when
+		_, err := repo.LockNextPending(ctx, testTimestamp)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestRepository_CreateAttempt(t *testing.T) {
+	// given
+	attempt := fixModelAttempt(testAttemptID, intPtr(200), nil)
+	entity := fixEntityAttempt(testAttemptID, 200)
+
+	mockConverter := &automock.EntityConverter{}
+	defer mockConverter.AssertExpectations(t)
+	mockConverter.On("AttemptToEntity", *attempt).Return(entity).Once()
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.webhook_delivery_attempts ( id, tenant_id, delivery_id, timestamp, response_code, error ) VALUES ( ?, ?, ?, ?, ?, ? )`)).
+		WithArgs(entity.ID, entity.TenantID, entity.DeliveryID, entity.Timestamp, entity.ResponseCode, entity.Error).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := webhookdelivery.NewRepository(mockConverter)
+
+	// when
+	err := repo.CreateAttempt(ctx, attempt)
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestRepository_ListAttemptsByDeliveryID(t *testing.T) {
+	// given
+	laterEntity := fixEntityAttempt("later", 200)
+	laterEntity.Timestamp = testTimestamp.Add(time.Minute)
+	earlierEntity := fixEntityAttempt("earlier", 500)
+	laterAttempt := fixModelAttempt("later", intPtr(200), nil)
+	earlierAttempt := fixModelAttempt("earlier", intPtr(500), nil)
+
+	mockConverter := &automock.EntityConverter{}
+	defer mockConverter.AssertExpectations(t)
+	mockConverter.On("AttemptFromEntity", earlierEntity).Return(*earlierAttempt).Once()
+	mockConverter.On("AttemptFromEntity", laterEntity).Return(*laterAttempt).Once()
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "delivery_id", "timestamp", "response_code", "error"}).
+		AddRow(laterEntity.ID, laterEntity.TenantID, laterEntity.DeliveryID, laterEntity.Timestamp, laterEntity.ResponseCode, laterEntity.Error).
+		AddRow(earlierEntity.ID, earlierEntity.TenantID, earlierEntity.DeliveryID, earlierEntity.Timestamp, earlierEntity.ResponseCode, earlierEntity.Error)
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, tenant_id, delivery_id, timestamp, response_code, error FROM public.webhook_delivery_attempts WHERE tenant_id=$1 AND delivery_id = 'dddddddd-dddd-dddd-dddd-dddddddddddd'`)).
+		WithArgs(testTenant).
+		WillReturnRows(rows)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := webhookdelivery.NewRepository(mockConverter)
+
+	// when
+	result, err := repo.ListAttemptsByDeliveryID(ctx, testTenant, testID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []*model.WebhookDeliveryAttempt{earlierAttempt, laterAttempt}, result)
+}
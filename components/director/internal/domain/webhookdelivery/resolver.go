@@ -0,0 +1,100 @@
+package webhookdelivery
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=WebhookDeliveryService -output=automock -outpkg=automock -case=underscore
+type WebhookDeliveryService interface {
+	ListByWebhookID(ctx context.Context, webhookID string, pageSize int, cursor string) (*model.WebhookDeliveryPage, error)
+	ListAttempts(ctx context.Context, deliveryID string) ([]*model.WebhookDeliveryAttempt, error)
+}
+
+//go:generate mockery -name=WebhookDeliveryConverter -output=automock -outpkg=automock -case=underscore
+type WebhookDeliveryConverter interface {
+	MultipleToGraphQL(in []*model.WebhookDelivery) []*graphql.WebhookDelivery
+	MultipleAttemptsToGraphQL(in []*model.WebhookDeliveryAttempt) []*graphql.WebhookDeliveryAttempt
+}
+
+type Resolver struct {
+	transact  persistence.Transactioner
+	svc       WebhookDeliveryService
+	converter WebhookDeliveryConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc WebhookDeliveryService, converter WebhookDeliveryConverter) *Resolver {
+	return &Resolver{
+		transact:  transact,
+		svc:       svc,
+		converter: converter,
+	}
+}
+
+func (r *Resolver) WebhookDeliveries(ctx context.Context, webhookID string, first *int, after *graphql.PageCursor) (*graphql.WebhookDeliveryPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, errors.New("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	page, err := r.svc.ListByWebhookID(ctx, webhookID, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.WebhookDeliveryPage{
+		Data:       r.converter.MultipleToGraphQL(page.Data),
+		TotalCount: page.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+			HasNextPage: page.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+func (r *Resolver) Attempts(ctx context.Context, obj *graphql.WebhookDelivery) ([]*graphql.WebhookDeliveryAttempt, error) {
+	if obj == nil {
+		return nil, errors.New("WebhookDelivery cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	attempts, err := r.svc.ListAttempts(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.MultipleAttemptsToGraphQL(attempts), nil
+}
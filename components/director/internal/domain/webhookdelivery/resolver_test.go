@@ -0,0 +1,225 @@
+package webhookdelivery_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_WebhookDeliveries(t *testing.T) {
+	// given
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelDeliveries := []*model.WebhookDelivery{
+		fixModelWebhookDelivery("foo", model.WebhookDeliveryStatusPending, 0),
+		fixModelWebhookDelivery("bar", model.WebhookDeliveryStatusPending, 0),
+	}
+	gqlDeliveries := []*graphql.WebhookDelivery{
+		fixGQLWebhookDelivery("foo"),
+		fixGQLWebhookDelivery("bar"),
+	}
+	modelPage := &model.WebhookDeliveryPage{
+		Data:       modelDeliveries,
+		TotalCount: 3,
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: true,
+		},
+	}
+	first := 2
+	after := "start"
+	gqlAfter := graphql.PageCursor(after)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.WebhookDeliveryService
+		ConverterFn    func() *automock.WebhookDeliveryConverter
+		ExpectedOutput *graphql.WebhookDeliveryPage
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.WebhookDeliveryService {
+				svc := &automock.WebhookDeliveryService{}
+				svc.On("ListByWebhookID", txtest.CtxWithDBMatcher(), testWebhookID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.WebhookDeliveryConverter {
+				conv := &automock.WebhookDeliveryConverter{}
+				conv.On("MultipleToGraphQL", modelDeliveries).Return(gqlDeliveries).Once()
+				return conv
+			},
+			ExpectedOutput: &graphql.WebhookDeliveryPage{
+				Data:       gqlDeliveries,
+				TotalCount: 3,
+				PageInfo: &graphql.PageInfo{
+					StartCursor: "start",
+					EndCursor:   "end",
+					HasNextPage: true,
+				},
+			},
+		},
+		{
+			Name: "Returns error when listing deliveries failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.WebhookDeliveryService {
+				svc := &automock.WebhookDeliveryService{}
+				svc.On("ListByWebhookID", txtest.CtxWithDBMatcher(), testWebhookID, first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.WebhookDeliveryConverter {
+				return &automock.WebhookDeliveryConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.WebhookDeliveryService {
+				return &automock.WebhookDeliveryService{}
+			},
+			ConverterFn: func() *automock.WebhookDeliveryConverter {
+				return &automock.WebhookDeliveryConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.WebhookDeliveryService {
+				svc := &automock.WebhookDeliveryService{}
+				svc.On("ListByWebhookID", txtest.CtxWithDBMatcher(), testWebhookID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.WebhookDeliveryConverter {
+				return &automock.WebhookDeliveryConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := webhookdelivery.NewResolver(transact, svc, converter)
+
+			// when
+			result, err := resolver.WebhookDeliveries(context.TODO(), testWebhookID, &first, &gqlAfter)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_Attempts(t *testing.T) {
+	// given
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelAttempts := []*model.WebhookDeliveryAttempt{fixModelAttempt(testAttemptID, intPtr(200), nil)}
+	gqlAttempts := []*graphql.WebhookDeliveryAttempt{{Timestamp: graphql.Timestamp(testTimestamp), ResponseCode: intPtr(200)}}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.WebhookDeliveryService
+		ConverterFn    func() *automock.WebhookDeliveryConverter
+		ExpectedOutput []*graphql.WebhookDeliveryAttempt
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.WebhookDeliveryService {
+				svc := &automock.WebhookDeliveryService{}
+				svc.On("ListAttempts", txtest.CtxWithDBMatcher(), testID).Return(modelAttempts, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.WebhookDeliveryConverter {
+				conv := &automock.WebhookDeliveryConverter{}
+				conv.On("MultipleAttemptsToGraphQL", modelAttempts).Return(gqlAttempts).Once()
+				return conv
+			},
+			ExpectedOutput: gqlAttempts,
+		},
+		{
+			Name: "Returns error when listing attempts failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.WebhookDeliveryService {
+				svc := &automock.WebhookDeliveryService{}
+				svc.On("ListAttempts", txtest.CtxWithDBMatcher(), testID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.WebhookDeliveryConverter {
+				return &automock.WebhookDeliveryConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.WebhookDeliveryService {
+				svc := &automock.WebhookDeliveryService{}
+				svc.On("ListAttempts", txtest.CtxWithDBMatcher(), testID).Return(modelAttempts, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.WebhookDeliveryConverter {
+				return &automock.WebhookDeliveryConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := webhookdelivery.NewResolver(transact, svc, converter)
+
+			// when
+			result, err := resolver.Attempts(context.TODO(), fixGQLWebhookDelivery(testID))
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+}
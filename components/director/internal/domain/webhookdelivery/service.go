@@ -0,0 +1,178 @@
+package webhookdelivery
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=WebhookDeliveryRepository -output=automock -outpkg=automock -case=underscore
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, item *model.WebhookDelivery) error
+	ListByWebhookID(ctx context.Context, tenant, webhookID string, pageSize int, cursor string) (*model.WebhookDeliveryPage, error)
+	ListAttemptsByDeliveryID(ctx context.Context, tenant, deliveryID string) ([]*model.WebhookDeliveryAttempt, error)
+}
+
+//go:generate mockery -name=WebhookRepository -output=automock -outpkg=automock -case=underscore
+type WebhookRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Webhook, error)
+	ListByApplicationID(ctx context.Context, tenant, applicationID string) ([]*model.Webhook, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+// configurationChangedPayload is the body sent to CONFIGURATION_CHANGED Webhooks
+type configurationChangedPayload struct {
+	EventType     model.WebhookType                     `json:"eventType"`
+	ApplicationID string                                `json:"applicationID"`
+	ResourceType  model.ConfigurationChangeResourceType `json:"resourceType"`
+	ResourceID    string                                `json:"resourceID"`
+	Operation     model.ConfigurationChangeOperation    `json:"operation"`
+	Timestamp     time.Time                             `json:"timestamp"`
+}
+
+// applicationLifecyclePayload is the body sent to REGISTER_APPLICATION and UNREGISTER_APPLICATION Webhooks
+type applicationLifecyclePayload struct {
+	EventType     model.WebhookType `json:"eventType"`
+	ApplicationID string            `json:"applicationID"`
+	Timestamp     time.Time         `json:"timestamp"`
+}
+
+type service struct {
+	repo         WebhookDeliveryRepository
+	webhookRepo  WebhookRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+func NewService(repo WebhookDeliveryRepository, webhookRepo WebhookRepository, uidService UIDService) *service {
+	return &service{
+		repo:         repo,
+		webhookRepo:  webhookRepo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// NotifyConfigurationChanged schedules delivery of the change to all CONFIGURATION_CHANGED Webhooks of the Application.
+// Deliveries are stored in the transaction from the context, so they are sent only if the change itself is committed.
+func (s *service) NotifyConfigurationChanged(ctx context.Context, applicationID string, change model.ConfigurationChange) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := s.webhookRepo.ListByApplicationID(ctx, tnt, applicationID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing Webhooks of Application with ID %s", applicationID)
+	}
+
+	now := s.timestampGen()
+	payload, err := json.Marshal(configurationChangedPayload{
+		EventType:     model.WebhookTypeConfigurationChanged,
+		ApplicationID: applicationID,
+		ResourceType:  change.ResourceType,
+		ResourceID:    change.ResourceID,
+		Operation:     change.Operation,
+		Timestamp:     now,
+	})
+	if err != nil {
+		return errors.Wrap(err, "while marshalling configuration change")
+	}
+
+	_, err = s.createDeliveries(ctx, tnt, webhooks, model.WebhookTypeConfigurationChanged, string(payload), now)
+	return err
+}
+
+// NotifyApplicationLifecycle schedules delivery of the lifecycle event to all Webhooks of the given type of the Application.
+// It returns false if the Application has no such Webhooks, so the caller can complete the operation without waiting for them.
+func (s *service) NotifyApplicationLifecycle(ctx context.Context, applicationID string, webhookType model.WebhookType) (bool, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	webhooks, err := s.webhookRepo.ListByApplicationID(ctx, tnt, applicationID)
+	if err != nil {
+		return false, errors.Wrapf(err, "while listing Webhooks of Application with ID %s", applicationID)
+	}
+
+	now := s.timestampGen()
+	payload, err := json.Marshal(applicationLifecyclePayload{
+		EventType:     webhookType,
+		ApplicationID: applicationID,
+		Timestamp:     now,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "while marshalling lifecycle event")
+	}
+
+	count, err := s.createDeliveries(ctx, tnt, webhooks, webhookType, string(payload), now)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (s *service) ListByWebhookID(ctx context.Context, webhookID string, pageSize int, cursor string) (*model.WebhookDeliveryPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 100 {
+		return nil, errors.New("page size must be between 1 and 100")
+	}
+
+	_, err = s.webhookRepo.GetByID(ctx, tnt, webhookID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Webhook with ID %s", webhookID)
+	}
+
+	return s.repo.ListByWebhookID(ctx, tnt, webhookID, pageSize, cursor)
+}
+
+func (s *service) ListAttempts(ctx context.Context, deliveryID string) ([]*model.WebhookDeliveryAttempt, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListAttemptsByDeliveryID(ctx, tnt, deliveryID)
+}
+
+func (s *service) createDeliveries(ctx context.Context, tnt string, webhooks []*model.Webhook, webhookType model.WebhookType, payload string, now time.Time) (int, error) {
+	count := 0
+	for _, webhook := range webhooks {
+		if webhook.Type != webhookType {
+			continue
+		}
+
+		delivery := &model.WebhookDelivery{
+			ID:            s.uidService.Generate(),
+			Tenant:        tnt,
+			WebhookID:     webhook.ID,
+			Payload:       payload,
+			Status:        model.WebhookDeliveryStatusPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+		}
+
+		err := s.repo.Create(ctx, delivery)
+		if err != nil {
+			return 0, errors.Wrapf(err, "while creating delivery for Webhook with ID %s", webhook.ID)
+		}
+		count++
+	}
+
+	return count, nil
+}
@@ -0,0 +1,378 @@
+package webhookdelivery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_NotifyConfigurationChanged(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	change := model.ConfigurationChange{
+		ResourceType: model.ConfigurationChangeResourceTypeAPI,
+		ResourceID:   "foo",
+		Operation:    model.ConfigurationChangeOperationCreated,
+	}
+	expectedPayload := `{"eventType":"CONFIGURATION_CHANGED","applicationID":"cccccccc-cccc-cccc-cccc-cccccccccccc","resourceType":"API","resourceID":"foo","operation":"CREATED","timestamp":"2019-11-22T12:00:00Z"}`
+	expectedDelivery := &model.WebhookDelivery{
+		ID:            testID,
+		Tenant:        testTenant,
+		WebhookID:     testWebhookID,
+		Payload:       expectedPayload,
+		Status:        model.WebhookDeliveryStatusPending,
+		NextAttemptAt: &testTimestamp,
+		CreatedAt:     testTimestamp,
+	}
+	webhooks := []*model.Webhook{
+		fixModelWebhook(testWebhookID, model.WebhookTypeConfigurationChanged, "http://foo.bar"),
+		fixModelWebhook("other", model.WebhookType("OTHER"), "http://foo.bar"),
+	}
+
+	testCases := []struct {
+		Name          string
+		RepositoryFn  func() *automock.WebhookDeliveryRepository
+		WebhookRepoFn func() *automock.WebhookRepository
+		UIDServiceFn  func() *automock.UIDService
+		ExpectedErr   error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				repo := &automock.WebhookDeliveryRepository{}
+				repo.On("Create", ctx, expectedDelivery).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, testTenant, testAppID).Return(webhooks, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Success when Application has no Webhooks",
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				return &automock.WebhookDeliveryRepository{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, testTenant, testAppID).Return(nil, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Returns error when listing Webhooks failed",
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				return &automock.WebhookDeliveryRepository{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, testTenant, testAppID).Return(nil, testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when creating delivery failed",
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				repo := &automock.WebhookDeliveryRepository{}
+				repo.On("Create", ctx, expectedDelivery).Return(testErr).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, testTenant, testAppID).Return(webhooks, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			webhookRepo := testCase.WebhookRepoFn()
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := webhookdelivery.NewService(repo, webhookRepo, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return testTimestamp })
+
+			// when
+			err := svc.NotifyConfigurationChanged(ctx, testAppID, change)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+			webhookRepo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when tenant is missing in context", func(t *testing.T) {
+		svc := webhookdelivery.NewService(nil, nil, nil)
+
+		// when
+		err := svc.NotifyConfigurationChanged(context.TODO(), testAppID, change)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_NotifyApplicationLifecycle(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	expectedPayload := `{"eventType":"UNREGISTER_APPLICATION","applicationID":"cccccccc-cccc-cccc-cccc-cccccccccccc","timestamp":"2019-11-22T12:00:00Z"}`
+	expectedDelivery := &model.WebhookDelivery{
+		ID:            testID,
+		Tenant:        testTenant,
+		WebhookID:     testWebhookID,
+		Payload:       expectedPayload,
+		Status:        model.WebhookDeliveryStatusPending,
+		NextAttemptAt: &testTimestamp,
+		CreatedAt:     testTimestamp,
+	}
+	webhooks := []*model.Webhook{
+		fixModelWebhook(testWebhookID, model.WebhookTypeUnregisterApplication, "http://foo.bar"),
+		fixModelWebhook("other", model.WebhookTypeConfigurationChanged, "http://foo.bar"),
+	}
+
+	testCases := []struct {
+		Name           string
+		RepositoryFn   func() *automock.WebhookDeliveryRepository
+		WebhookRepoFn  func() *automock.WebhookRepository
+		UIDServiceFn   func() *automock.UIDService
+		ExpectedResult bool
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				repo := &automock.WebhookDeliveryRepository{}
+				repo.On("Create", ctx, expectedDelivery).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, testTenant, testAppID).Return(webhooks, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedResult: true,
+		},
+		{
+			Name: "Returns false when Application has no Webhooks of given type",
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				return &automock.WebhookDeliveryRepository{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, testTenant, testAppID).Return(webhooks[1:], nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedResult: false,
+		},
+		{
+			Name: "Returns error when listing Webhooks failed",
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				return &automock.WebhookDeliveryRepository{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, testTenant, testAppID).Return(nil, testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when creating delivery failed",
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				repo := &automock.WebhookDeliveryRepository{}
+				repo.On("Create", ctx, expectedDelivery).Return(testErr).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, testTenant, testAppID).Return(webhooks, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			webhookRepo := testCase.WebhookRepoFn()
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := webhookdelivery.NewService(repo, webhookRepo, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return testTimestamp })
+
+			// when
+			result, err := svc.NotifyApplicationLifecycle(ctx, testAppID, model.WebhookTypeUnregisterApplication)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			repo.AssertExpectations(t)
+			webhookRepo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListByWebhookID(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+
+	page := &model.WebhookDeliveryPage{
+		Data:       []*model.WebhookDelivery{fixModelWebhookDelivery(testID, model.WebhookDeliveryStatusPending, 0)},
+		TotalCount: 1,
+	}
+
+	testCases := []struct {
+		Name          string
+		PageSize      int
+		RepositoryFn  func() *automock.WebhookDeliveryRepository
+		WebhookRepoFn func() *automock.WebhookRepository
+		ExpectedPage  *model.WebhookDeliveryPage
+		ExpectedErr   string
+	}{
+		{
+			Name:     "Success",
+			PageSize: 2,
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				repo := &automock.WebhookDeliveryRepository{}
+				repo.On("ListByWebhookID", ctx, testTenant, testWebhookID, 2, "").Return(page, nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("GetByID", ctx, testTenant, testWebhookID).Return(fixModelWebhook(testWebhookID, model.WebhookTypeConfigurationChanged, "http://foo.bar"), nil).Once()
+				return repo
+			},
+			ExpectedPage: page,
+		},
+		{
+			Name:     "Returns error when page size is too big",
+			PageSize: 101,
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				return &automock.WebhookDeliveryRepository{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				return &automock.WebhookRepository{}
+			},
+			ExpectedErr: "page size must be between 1 and 100",
+		},
+		{
+			Name:     "Returns error when Webhook does not exist",
+			PageSize: 2,
+			RepositoryFn: func() *automock.WebhookDeliveryRepository {
+				return &automock.WebhookDeliveryRepository{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("GetByID", ctx, testTenant, testWebhookID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			webhookRepo := testCase.WebhookRepoFn()
+
+			svc := webhookdelivery.NewService(repo, webhookRepo, nil)
+
+			// when
+			result, err := svc.ListByWebhookID(ctx, testWebhookID, testCase.PageSize, "")
+
+			// then
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedPage, result)
+			}
+
+			repo.AssertExpectations(t)
+			webhookRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListAttempts(t *testing.T) {
+	// given
+	ctx := tenant.SaveToContext(context.TODO(), testTenant)
+	attempts := []*model.WebhookDeliveryAttempt{fixModelAttempt(testAttemptID, intPtr(200), nil)}
+
+	repo := &automock.WebhookDeliveryRepository{}
+	defer repo.AssertExpectations(t)
+	repo.On("ListAttemptsByDeliveryID", ctx, testTenant, testID).Return(attempts, nil).Once()
+
+	svc := webhookdelivery.NewService(repo, nil, nil)
+
+	// when
+	result, err := svc.ListAttempts(ctx, testID)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, attempts, result)
+}
@@ -0,0 +1,191 @@
+package httpclient
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	csrfTokenHeader    = "X-CSRF-Token"
+	csrfTokenFetchFlag = "Fetch"
+
+	// maxDrainedBodySize limits the unread part of response body, which is read to reuse the connection.
+	// Connection with a longer body is closed instead.
+	maxDrainedBodySize = 64 * 1024
+)
+
+type client struct {
+	httpClient   *http.Client
+	timestampGen timestamp.Generator
+
+	tokensMutex sync.Mutex
+	tokens      map[tokenKey]token
+}
+
+// NewClient returns outbound HTTP client which authenticates requests according to the provided Auth.
+// OAuth tokens are cached until they expire, so the same client instance should be reused.
+func NewClient(httpClient *http.Client) *client {
+	return &client{
+		httpClient:   httpClient,
+		timestampGen: timestamp.DefaultGenerator(),
+		tokens:       make(map[tokenKey]token),
+	}
+}
+
+// Do sends the request with credentials, CSRF token, additional headers and query parameters specified in the Auth.
+// Request is sent as is if Auth is nil.
+func (c *client) Do(req *http.Request, auth *model.Auth) (*http.Response, error) {
+	if req == nil {
+		return nil, errors.New("request cannot be nil")
+	}
+	if auth == nil {
+		return c.httpClient.Do(req)
+	}
+
+	req = cloneRequest(req)
+	applyAdditionalParams(req, auth.AdditionalHeaders, auth.AdditionalQueryParams)
+
+	if auth.RequestAuth != nil && auth.RequestAuth.Csrf != nil {
+		err := c.applyCSRFToken(req, auth.RequestAuth.Csrf)
+		if err != nil {
+			return nil, errors.Wrap(err, "while fetching CSRF token")
+		}
+	}
+
+	oauthKey, err := c.applyCredential(req, auth.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if oauthKey != nil && resp.StatusCode == http.StatusUnauthorized {
+		c.invalidateToken(*oauthKey)
+	}
+
+	return resp, nil
+}
+
+func (c *client) applyCredential(req *http.Request, credential model.CredentialData) (*tokenKey, error) {
+	switch {
+	case credential.Basic != nil:
+		req.SetBasicAuth(credential.Basic.Username, credential.Basic.Password)
+	case credential.Oauth != nil:
+		key := newTokenKey(credential.Oauth)
+		accessToken, err := c.getToken(req, key, credential.Oauth)
+		if err != nil {
+			return nil, errors.Wrap(err, "while fetching OAuth token")
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+		return &key, nil
+	}
+
+	return nil, nil
+}
+
+func (c *client) applyCSRFToken(req *http.Request, csrf *model.CSRFTokenCredentialRequestAuth) error {
+	tokenReq, err := http.NewRequest(http.MethodGet, csrf.TokenEndpointURL, nil)
+	if err != nil {
+		return errors.Wrap(err, "while creating request")
+	}
+	tokenReq = tokenReq.WithContext(req.Context())
+	tokenReq.Header.Set(csrfTokenHeader, csrfTokenFetchFlag)
+	applyAdditionalParams(tokenReq, csrf.AdditionalHeaders, csrf.AdditionalQueryParams)
+
+	if _, err := c.applyCredential(tokenReq, csrf.Credential); err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(tokenReq)
+	if err != nil {
+		return errors.Wrap(err, "while doing request")
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusOK)
+	}
+
+	csrfToken := resp.Header.Get(csrfTokenHeader)
+	if csrfToken == "" {
+		return fmt.Errorf("response does not contain %s header", csrfTokenHeader)
+	}
+
+	req.Header.Set(csrfTokenHeader, csrfToken)
+	// CSRF token is usually bound to the session, so the session cookies have to be sent along with it
+	for _, cookie := range resp.Cookies() {
+		req.AddCookie(cookie)
+	}
+
+	return nil
+}
+
+func applyAdditionalParams(req *http.Request, headers map[string][]string, queryParams map[string][]string) {
+	for name, values := range headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+
+	if len(queryParams) == 0 {
+		return
+	}
+
+	query := req.URL.Query()
+	for name, values := range queryParams {
+		for _, value := range values {
+			query.Add(name, value)
+		}
+	}
+	req.URL.RawQuery = query.Encode()
+}
+
+func cloneRequest(req *http.Request) *http.Request {
+	cloned := req.WithContext(req.Context())
+
+	cloned.Header = make(http.Header, len(req.Header))
+	for name, values := range req.Header {
+		cloned.Header[name] = append([]string(nil), values...)
+	}
+
+	if req.URL != nil {
+		clonedURL := *req.URL
+		cloned.URL = &clonedURL
+	}
+
+	return cloned
+}
+
+// CloseBody reads the rest of the response body up to a limit, so the connection can be reused, and closes it
+func CloseBody(body io.ReadCloser) error {
+	if _, err := io.Copy(ioutil.Discard, io.LimitReader(body, maxDrainedBodySize)); err != nil {
+		log.Error(err)
+	}
+
+	return body.Close()
+}
+
+func closeBody(body io.ReadCloser) {
+	if err := CloseBody(body); err != nil {
+		log.Error(err)
+	}
+}
+
+func newTokenKey(credential *model.OAuthCredentialData) tokenKey {
+	return tokenKey{
+		url:          credential.URL,
+		clientID:     credential.ClientID,
+		clientSecret: credential.ClientSecret,
+	}
+}
@@ -0,0 +1,285 @@
+package httpclient_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/httpclient"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	username     = "user"
+	password     = "pass"
+	clientID     = "client"
+	clientSecret = "secret"
+	accessToken  = "token"
+	csrfToken    = "csrf"
+	sessionID    = "session"
+)
+
+func TestClient_Do(t *testing.T) {
+	t.Run("Success - without Auth", func(t *testing.T) {
+		server, calls := fixServer(t)
+		defer server.Close()
+		cli := httpclient.NewClient(http.DefaultClient)
+
+		// WHEN
+		resp, err := cli.Do(fixRequest(t, server.URL+"/resource"), nil)
+
+		// THEN
+		require.NoError(t, err)
+		defer closeBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, calls.resource, 1)
+		assert.Empty(t, calls.resource[0].Header.Get("Authorization"))
+	})
+
+	t.Run("Success - basic auth with additional headers and query params", func(t *testing.T) {
+		server, calls := fixServer(t)
+		defer server.Close()
+		cli := httpclient.NewClient(http.DefaultClient)
+		auth := &model.Auth{
+			Credential:            model.CredentialData{Basic: &model.BasicCredentialData{Username: username, Password: password}},
+			AdditionalHeaders:     map[string][]string{"X-Custom": {"foo", "bar"}},
+			AdditionalQueryParams: map[string][]string{"param": {"baz"}},
+		}
+		req := fixRequest(t, server.URL+"/resource?existing=value")
+
+		// WHEN
+		resp, err := cli.Do(req, auth)
+
+		// THEN
+		require.NoError(t, err)
+		defer closeBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, calls.resource, 1)
+		called := calls.resource[0]
+		user, pass, ok := called.BasicAuth()
+		require.True(t, ok)
+		assert.Equal(t, username, user)
+		assert.Equal(t, password, pass)
+		assert.Equal(t, []string{"foo", "bar"}, called.Header["X-Custom"])
+		assert.Equal(t, "baz", called.URL.Query().Get("param"))
+		assert.Equal(t, "value", called.URL.Query().Get("existing"))
+		assert.Empty(t, req.Header.Get("X-Custom"), "original request should not be modified")
+	})
+
+	t.Run("Success - OAuth token is cached until it expires", func(t *testing.T) {
+		server, calls := fixServer(t)
+		defer server.Close()
+		now := time.Now()
+		cli := httpclient.NewClient(http.DefaultClient)
+		cli.SetTimestampGen(func() time.Time { return now })
+		auth := fixOAuth(server.URL + "/token")
+
+		// WHEN
+		for i := 0; i < 2; i++ {
+			resp, err := cli.Do(fixRequest(t, server.URL+"/resource"), auth)
+			require.NoError(t, err)
+			closeBody(t, resp)
+		}
+		now = now.Add(time.Hour)
+		resp, err := cli.Do(fixRequest(t, server.URL+"/resource"), auth)
+		require.NoError(t, err)
+		closeBody(t, resp)
+
+		// THEN
+		assert.Len(t, calls.token, 2)
+		require.Len(t, calls.resource, 3)
+		for _, called := range calls.resource {
+			assert.Equal(t, "Bearer "+accessToken, called.Header.Get("Authorization"))
+		}
+	})
+
+	t.Run("Success - OAuth token is dropped from cache when request is unauthorized", func(t *testing.T) {
+		server, calls := fixServer(t)
+		defer server.Close()
+		cli := httpclient.NewClient(http.DefaultClient)
+		auth := fixOAuth(server.URL + "/token")
+
+		// WHEN
+		resp, err := cli.Do(fixRequest(t, server.URL+"/unauthorized"), auth)
+		require.NoError(t, err)
+		closeBody(t, resp)
+		resp, err = cli.Do(fixRequest(t, server.URL+"/resource"), auth)
+		require.NoError(t, err)
+		closeBody(t, resp)
+
+		// THEN
+		assert.Len(t, calls.token, 2)
+	})
+
+	t.Run("Success - CSRF token", func(t *testing.T) {
+		server, calls := fixServer(t)
+		defer server.Close()
+		cli := httpclient.NewClient(http.DefaultClient)
+		auth := fixOAuth(server.URL + "/token")
+		auth.RequestAuth = &model.CredentialRequestAuth{
+			Csrf: &model.CSRFTokenCredentialRequestAuth{
+				TokenEndpointURL:  server.URL + "/csrf",
+				Credential:        model.CredentialData{Basic: &model.BasicCredentialData{Username: username, Password: password}},
+				AdditionalHeaders: map[string][]string{"X-Csrf-Custom": {"foo"}},
+			},
+		}
+
+		// WHEN
+		resp, err := cli.Do(fixRequest(t, server.URL+"/resource"), auth)
+
+		// THEN
+		require.NoError(t, err)
+		defer closeBody(t, resp)
+		require.Len(t, calls.csrf, 1)
+		csrfCall := calls.csrf[0]
+		assert.Equal(t, "Fetch", csrfCall.Header.Get("X-CSRF-Token"))
+		assert.Equal(t, "foo", csrfCall.Header.Get("X-Csrf-Custom"))
+		user, _, ok := csrfCall.BasicAuth()
+		require.True(t, ok)
+		assert.Equal(t, username, user)
+
+		require.Len(t, calls.resource, 1)
+		called := calls.resource[0]
+		assert.Equal(t, csrfToken, called.Header.Get("X-CSRF-Token"))
+		assert.Equal(t, "Bearer "+accessToken, called.Header.Get("Authorization"))
+		cookie, err := called.Cookie("SESSIONID")
+		require.NoError(t, err)
+		assert.Equal(t, sessionID, cookie.Value)
+	})
+
+	t.Run("Error - OAuth token endpoint failure", func(t *testing.T) {
+		server, calls := fixServer(t)
+		defer server.Close()
+		cli := httpclient.NewClient(http.DefaultClient)
+
+		// WHEN
+		_, err := cli.Do(fixRequest(t, server.URL+"/resource"), fixOAuth(server.URL+"/missing"))
+
+		// THEN
+		require.EqualError(t, err, "while fetching OAuth token: invalid HTTP status code: received: 404, expected 200")
+		assert.Empty(t, calls.resource)
+	})
+
+	t.Run("Error - CSRF token not returned", func(t *testing.T) {
+		server, calls := fixServer(t)
+		defer server.Close()
+		cli := httpclient.NewClient(http.DefaultClient)
+		auth := &model.Auth{
+			RequestAuth: &model.CredentialRequestAuth{
+				Csrf: &model.CSRFTokenCredentialRequestAuth{TokenEndpointURL: server.URL + "/resource"},
+			},
+		}
+
+		// WHEN
+		_, err := cli.Do(fixRequest(t, server.URL+"/resource"), auth)
+
+		// THEN
+		require.EqualError(t, err, "while fetching CSRF token: response does not contain X-CSRF-Token header")
+		assert.Len(t, calls.resource, 1)
+	})
+
+	t.Run("Error - nil request", func(t *testing.T) {
+		cli := httpclient.NewClient(http.DefaultClient)
+
+		// WHEN
+		_, err := cli.Do(nil, nil)
+
+		// THEN
+		require.EqualError(t, err, "request cannot be nil")
+	})
+}
+
+type serverCalls struct {
+	token    []*http.Request
+	csrf     []*http.Request
+	resource []*http.Request
+}
+
+func fixServer(t *testing.T) (*httptest.Server, *serverCalls) {
+	calls := &serverCalls{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			calls.token = append(calls.token, r)
+			id, secret, ok := r.BasicAuth()
+			require.True(t, ok)
+			assert.Equal(t, clientID, id)
+			assert.Equal(t, clientSecret, secret)
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+
+			w.Header().Set("Content-Type", "application/json")
+			err := json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": accessToken,
+				"token_type":   "bearer",
+				"expires_in":   3600,
+			})
+			require.NoError(t, err)
+		case "/csrf":
+			calls.csrf = append(calls.csrf, r)
+			http.SetCookie(w, &http.Cookie{Name: "SESSIONID", Value: sessionID})
+			w.Header().Set("X-CSRF-Token", csrfToken)
+		case "/resource":
+			calls.resource = append(calls.resource, r)
+		case "/unauthorized":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return server, calls
+}
+
+func fixOAuth(tokenURL string) *model.Auth {
+	return &model.Auth{
+		Credential: model.CredentialData{
+			Oauth: &model.OAuthCredentialData{
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				URL:          tokenURL,
+			},
+		},
+	}
+}
+
+func TestCloseBody(t *testing.T) {
+	// given
+	body := &readCloser{Reader: bytes.NewReader(make([]byte, 1024*1024))}
+
+	// when
+	err := httpclient.CloseBody(body)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, body.closed)
+	remaining, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.NotEmpty(t, remaining, "body should not be read above the limit")
+}
+
+type readCloser struct {
+	*bytes.Reader
+	closed bool
+}
+
+func (r *readCloser) Close() error {
+	r.closed = true
+	return nil
+}
+
+func fixRequest(t *testing.T, url string) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	return req
+}
+
+func closeBody(t *testing.T, resp *http.Response) {
+	require.NoError(t, resp.Body.Close())
+}
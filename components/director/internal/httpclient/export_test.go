@@ -0,0 +1,7 @@
+package httpclient
+
+import "github.com/kyma-incubator/compass/components/director/internal/timestamp"
+
+func (c *client) SetTimestampGen(timestampGen timestamp.Generator) {
+	c.timestampGen = timestampGen
+}
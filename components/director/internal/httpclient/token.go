@@ -0,0 +1,116 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+// tokenExpirationLeeway makes cached token to be refreshed slightly before it really expires
+const (
+	tokenExpirationLeeway = 10 * time.Second
+	maxTokenResponseSize  = 1024 * 1024
+)
+
+type tokenKey struct {
+	url          string
+	clientID     string
+	clientSecret string
+}
+
+type token struct {
+	accessToken string
+	expiresAt   time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func (c *client) getToken(req *http.Request, key tokenKey, credential *model.OAuthCredentialData) (string, error) {
+	if cached, ok := c.cachedToken(key); ok {
+		return cached, nil
+	}
+
+	tokenReq, err := http.NewRequest(http.MethodPost, credential.URL, strings.NewReader(url.Values{
+		"grant_type": []string{"client_credentials"},
+	}.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "while creating request")
+	}
+	tokenReq = tokenReq.WithContext(req.Context())
+	tokenReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	tokenReq.Header.Set("Accept", "application/json")
+	tokenReq.SetBasicAuth(url.QueryEscape(credential.ClientID), url.QueryEscape(credential.ClientSecret))
+
+	resp, err := c.httpClient.Do(tokenReq)
+	if err != nil {
+		return "", errors.Wrap(err, "while doing request")
+	}
+	defer closeBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var tokenResp tokenResponse
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxTokenResponseSize)).Decode(&tokenResp)
+	if err != nil {
+		return "", errors.Wrap(err, "while decoding token response")
+	}
+	if tokenResp.AccessToken == "" {
+		return "", errors.New("token response does not contain access token")
+	}
+	if tokenResp.TokenType != "" && !strings.EqualFold(tokenResp.TokenType, "bearer") {
+		return "", fmt.Errorf("unsupported token type %s", tokenResp.TokenType)
+	}
+
+	if tokenResp.ExpiresIn > 0 {
+		expiresIn := time.Duration(tokenResp.ExpiresIn) * time.Second
+		c.cacheToken(key, token{
+			accessToken: tokenResp.AccessToken,
+			expiresAt:   c.timestampGen().Add(expiresIn - tokenExpirationLeeway),
+		})
+	}
+
+	return tokenResp.AccessToken, nil
+}
+
+func (c *client) cachedToken(key tokenKey) (string, bool) {
+	c.tokensMutex.Lock()
+	defer c.tokensMutex.Unlock()
+
+	cached, ok := c.tokens[key]
+	if !ok {
+		return "", false
+	}
+	if !c.timestampGen().Before(cached.expiresAt) {
+		delete(c.tokens, key)
+		return "", false
+	}
+
+	return cached.accessToken, true
+}
+
+func (c *client) cacheToken(key tokenKey, t token) {
+	c.tokensMutex.Lock()
+	defer c.tokensMutex.Unlock()
+
+	c.tokens[key] = t
+}
+
+func (c *client) invalidateToken(key tokenKey) {
+	c.tokensMutex.Lock()
+	defer c.tokensMutex.Unlock()
+
+	delete(c.tokens, key)
+}
@@ -0,0 +1,86 @@
+package jsonpath
+
+// Path is a sequence of steps evaluated starting from the root item ($) or, inside a filter, from the current item (@).
+type Path struct {
+	Relative bool
+	Steps    []Step
+}
+
+type StepType int
+
+const (
+	// MemberStep is the member accessor, for example .key or ."some key"
+	MemberStep StepType = iota
+	// WildcardArrayStep is the wildcard array element accessor [*]
+	WildcardArrayStep
+	// FilterStep is the filter expression ? (...)
+	FilterStep
+)
+
+type Step struct {
+	Type   StepType
+	Key    string
+	Filter Predicate
+}
+
+// Predicate is a boolean expression used in the filter step
+type Predicate interface {
+	predicate()
+}
+
+type And struct {
+	Left  Predicate
+	Right Predicate
+}
+
+type Or struct {
+	Left  Predicate
+	Right Predicate
+}
+
+// Comparison compares the items of the path with the literal, for example @.size > 10
+type Comparison struct {
+	Path     *Path
+	Operator string
+	Value    Literal
+}
+
+// In checks if any item of the path is equal to one of the literals, for example @ in ("foo", "bar")
+type In struct {
+	Path   *Path
+	Values []Literal
+}
+
+// Exists checks if the path returns any item, for example exists(@.foo)
+type Exists struct {
+	Path *Path
+}
+
+// LikeRegex matches the string items of the path with the regular expression, for example @ like_regex "^foo" flag "i"
+type LikeRegex struct {
+	Path            *Path
+	Pattern         string
+	CaseInsensitive bool
+}
+
+func (And) predicate()        {}
+func (Or) predicate()         {}
+func (Comparison) predicate() {}
+func (In) predicate()         {}
+func (Exists) predicate()     {}
+func (LikeRegex) predicate()  {}
+
+type LiteralType int
+
+const (
+	StringLiteral LiteralType = iota
+	NumberLiteral
+	BoolLiteral
+	NullLiteral
+)
+
+// Literal is a JSON scalar. JSON holds its JSON representation, for example "foo" with the quotes or 1.5.
+type Literal struct {
+	Type LiteralType
+	JSON string
+}
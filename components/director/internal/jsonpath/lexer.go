@@ -0,0 +1,211 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenDollar
+	tokenAt
+	tokenDot
+	tokenLeftBracket
+	tokenRightBracket
+	tokenStar
+	tokenQuestion
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenComparison
+	tokenAnd
+	tokenOr
+	tokenString
+	tokenNumber
+	tokenIdentifier
+)
+
+type token struct {
+	Type tokenType
+	// Text is the token as written in the query, except for strings, where it is the unquoted value
+	Text string
+	// Position is the 1-based position of the first character of the token in the query
+	Position int
+}
+
+func (t token) String() string {
+	switch t.Type {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return fmt.Sprintf("string %q", t.Text)
+	}
+	return fmt.Sprintf("%q", t.Text)
+}
+
+// SyntaxError is returned when the query is not a valid expression of the supported SQL/JSON path subset
+type SyntaxError struct {
+	Message  string
+	Position int
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+func newSyntaxError(position int, format string, args ...interface{}) *SyntaxError {
+	return &SyntaxError{Message: fmt.Sprintf(format, args...), Position: position}
+}
+
+func tokenize(query string) ([]token, error) {
+	input := []rune(query)
+	var tokens []token
+
+	for i := 0; i < len(input); {
+		r := input[i]
+		position := i + 1
+
+		if unicode.IsSpace(r) {
+			i++
+			continue
+		}
+
+		if tokenType, ok := singleCharTokens[r]; ok {
+			tokens = append(tokens, token{Type: tokenType, Text: string(r), Position: position})
+			i++
+			continue
+		}
+
+		next := rune(0)
+		if i+1 < len(input) {
+			next = input[i+1]
+		}
+
+		switch {
+		case r == '=' && next == '=', r == '!' && next == '=', r == '<' && next == '>', r == '<' && next == '=', r == '>' && next == '=':
+			tokens = append(tokens, token{Type: tokenComparison, Text: string([]rune{r, next}), Position: position})
+			i += 2
+		case r == '<', r == '>':
+			tokens = append(tokens, token{Type: tokenComparison, Text: string(r), Position: position})
+			i++
+		case r == '&' && next == '&':
+			tokens = append(tokens, token{Type: tokenAnd, Text: "&&", Position: position})
+			i += 2
+		case r == '|' && next == '|':
+			tokens = append(tokens, token{Type: tokenOr, Text: "||", Position: position})
+			i += 2
+		case r == '"':
+			end, value, err := scanString(input, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{Type: tokenString, Text: value, Position: position})
+			i = end
+		case r == '-' || isDigit(r):
+			end, err := scanNumber(input, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{Type: tokenNumber, Text: string(input[i:end]), Position: position})
+			i = end
+		case isIdentifierStart(r):
+			end := i + 1
+			for end < len(input) && isIdentifierPart(input[end]) {
+				end++
+			}
+			tokens = append(tokens, token{Type: tokenIdentifier, Text: string(input[i:end]), Position: position})
+			i = end
+		default:
+			return nil, newSyntaxError(position, "unexpected character %q", r)
+		}
+	}
+
+	return append(tokens, token{Type: tokenEOF, Position: len(input) + 1}), nil
+}
+
+var singleCharTokens = map[rune]tokenType{
+	'$': tokenDollar,
+	'@': tokenAt,
+	'.': tokenDot,
+	'[': tokenLeftBracket,
+	']': tokenRightBracket,
+	'*': tokenStar,
+	'?': tokenQuestion,
+	'(': tokenLeftParen,
+	')': tokenRightParen,
+	',': tokenComma,
+}
+
+// scanString returns the index after the closing quote of the string starting at start and its unquoted value
+func scanString(input []rune, start int) (int, string, error) {
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			i++
+		case '"':
+			var value string
+			if err := json.Unmarshal([]byte(string(input[start:i+1])), &value); err != nil {
+				return 0, "", newSyntaxError(start+1, "invalid string")
+			}
+			return i + 1, value, nil
+		}
+	}
+
+	return 0, "", newSyntaxError(start+1, "unterminated string")
+}
+
+// scanNumber returns the index after the JSON number starting at start
+func scanNumber(input []rune, start int) (int, error) {
+	i := start
+	if input[i] == '-' {
+		i++
+	}
+
+	digits := func() int {
+		count := 0
+		for i < len(input) && isDigit(input[i]) {
+			i++
+			count++
+		}
+		return count
+	}
+
+	if digits() == 0 {
+		return 0, newSyntaxError(start+1, "invalid number")
+	}
+	if i < len(input) && input[i] == '.' {
+		i++
+		if digits() == 0 {
+			return 0, newSyntaxError(start+1, "invalid number")
+		}
+	}
+	if i < len(input) && (input[i] == 'e' || input[i] == 'E') {
+		i++
+		if i < len(input) && (input[i] == '+' || input[i] == '-') {
+			i++
+		}
+		if digits() == 0 {
+			return 0, newSyntaxError(start+1, "invalid number")
+		}
+	}
+	if i < len(input) && isIdentifierPart(input[i]) {
+		return 0, newSyntaxError(start+1, "invalid number")
+	}
+
+	return i, nil
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isIdentifierStart(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isIdentifierPart(r rune) bool {
+	return isIdentifierStart(r) || isDigit(r)
+}
@@ -0,0 +1,321 @@
+package jsonpath
+
+import (
+	"encoding/json"
+)
+
+const (
+	keywordExists    = "exists"
+	keywordIn        = "in"
+	keywordLikeRegex = "like_regex"
+	keywordFlag      = "flag"
+	keywordTrue      = "true"
+	keywordFalse     = "false"
+	keywordNull      = "null"
+)
+
+// Parse parses the query written in the supported subset of SQL/JSON path.
+// The query must be a path starting from the root item ($). Inside filters, paths can also start from the current item (@).
+// The supported path steps are member accessors (.key, ."key"), the wildcard array accessor ([*]) and filters (? (...)).
+// Filters support comparisons with literals (==, !=, <>, and <, <=, >, >= with numbers), in, exists, like_regex, && and ||.
+//
+// For a query which cannot be parsed, it returns *SyntaxError pointing at the failing position.
+func Parse(query string) (*Path, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	if p.peek().Type != tokenDollar {
+		return nil, p.unexpected("expected $")
+	}
+
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Type != tokenEOF {
+		return nil, p.unexpected("expected path step")
+	}
+
+	return path, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.Type != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.Type == tokenIdentifier && t.Text == keyword
+}
+
+func (p *parser) expect(tokenType tokenType, expected string) (token, error) {
+	if p.peek().Type != tokenType {
+		return token{}, p.unexpected("expected " + expected)
+	}
+	return p.next(), nil
+}
+
+func (p *parser) unexpected(expectation string) *SyntaxError {
+	t := p.peek()
+	return newSyntaxError(t.Position, "unexpected %s, %s", t, expectation)
+}
+
+// parsePath parses the path starting with $ or @
+func (p *parser) parsePath() (*Path, error) {
+	path := &Path{Relative: p.next().Type == tokenAt}
+
+	for {
+		switch p.peek().Type {
+		case tokenDot:
+			p.next()
+			if key := p.peek(); key.Type != tokenIdentifier && key.Type != tokenString {
+				return nil, p.unexpected("expected member name")
+			}
+			key := p.next()
+			path.Steps = append(path.Steps, Step{Type: MemberStep, Key: key.Text})
+		case tokenLeftBracket:
+			p.next()
+			if _, err := p.expect(tokenStar, "* (only the wildcard array accessor is supported)"); err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokenRightBracket, "]"); err != nil {
+				return nil, err
+			}
+			path.Steps = append(path.Steps, Step{Type: WildcardArrayStep})
+		case tokenQuestion:
+			p.next()
+			if _, err := p.expect(tokenLeftParen, "("); err != nil {
+				return nil, err
+			}
+			filter, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(tokenRightParen, ")"); err != nil {
+				return nil, err
+			}
+			path.Steps = append(path.Steps, Step{Type: FilterStep, Filter: filter})
+		default:
+			return path, nil
+		}
+	}
+}
+
+func (p *parser) parseOr() (Predicate, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().Type == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = Or{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Predicate, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().Type == tokenAnd {
+		p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		left = And{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parsePrimary() (Predicate, error) {
+	t := p.peek()
+
+	switch {
+	case t.Type == tokenLeftParen:
+		p.next()
+		predicate, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return predicate, nil
+	case p.isKeyword(keywordExists):
+		p.next()
+		if _, err := p.expect(tokenLeftParen, "("); err != nil {
+			return nil, err
+		}
+		if p.peek().Type != tokenAt && p.peek().Type != tokenDollar {
+			return nil, p.unexpected("expected path")
+		}
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return Exists{Path: path}, nil
+	case t.Type == tokenAt || t.Type == tokenDollar:
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		return p.parsePathPredicate(path)
+	case p.isLiteral():
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		operator, err := p.expect(tokenComparison, "comparison operator")
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().Type != tokenAt && p.peek().Type != tokenDollar {
+			return nil, p.unexpected("expected path")
+		}
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		return newComparison(path, reversedOperators[operator.Text], value, t.Position)
+	}
+
+	return nil, p.unexpected("expected predicate")
+}
+
+// parsePathPredicate parses the part of the predicate following its path
+func (p *parser) parsePathPredicate(path *Path) (Predicate, error) {
+	t := p.peek()
+
+	switch {
+	case t.Type == tokenComparison:
+		p.next()
+		valuePosition := p.peek().Position
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		return newComparison(path, t.Text, value, valuePosition)
+	case p.isKeyword(keywordIn):
+		p.next()
+		if _, err := p.expect(tokenLeftParen, "("); err != nil {
+			return nil, err
+		}
+		var values []Literal
+		for {
+			value, err := p.parseLiteral()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+			if p.peek().Type != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(tokenRightParen, ", or )"); err != nil {
+			return nil, err
+		}
+		return In{Path: path, Values: values}, nil
+	case p.isKeyword(keywordLikeRegex):
+		p.next()
+		pattern, err := p.expect(tokenString, "pattern string")
+		if err != nil {
+			return nil, err
+		}
+		likeRegex := LikeRegex{Path: path, Pattern: pattern.Text}
+		if p.isKeyword(keywordFlag) {
+			p.next()
+			flag, err := p.expect(tokenString, "flag string")
+			if err != nil {
+				return nil, err
+			}
+			if flag.Text != "i" {
+				return nil, newSyntaxError(flag.Position, "unsupported flag %q, only \"i\" is supported", flag.Text)
+			}
+			likeRegex.CaseInsensitive = true
+		}
+		return likeRegex, nil
+	}
+
+	return nil, p.unexpected("expected comparison operator, in or like_regex")
+}
+
+var reversedOperators = map[string]string{
+	"==": "==",
+	"!=": "!=",
+	"<>": "<>",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+func newComparison(path *Path, operator string, value Literal, valuePosition int) (Predicate, error) {
+	switch operator {
+	case "<", "<=", ">", ">=":
+		if value.Type != NumberLiteral {
+			return nil, newSyntaxError(valuePosition, "operator %s requires a number", operator)
+		}
+	}
+
+	return Comparison{Path: path, Operator: operator, Value: value}, nil
+}
+
+func (p *parser) isLiteral() bool {
+	t := p.peek()
+	return t.Type == tokenString || t.Type == tokenNumber || p.isKeyword(keywordTrue) || p.isKeyword(keywordFalse) || p.isKeyword(keywordNull)
+}
+
+func (p *parser) parseLiteral() (Literal, error) {
+	if !p.isLiteral() {
+		return Literal{}, p.unexpected("expected string, number, true, false or null")
+	}
+
+	t := p.next()
+	switch t.Type {
+	case tokenString:
+		value, err := json.Marshal(t.Text)
+		if err != nil {
+			return Literal{}, newSyntaxError(t.Position, "invalid string")
+		}
+		return Literal{Type: StringLiteral, JSON: string(value)}, nil
+	case tokenNumber:
+		return Literal{Type: NumberLiteral, JSON: t.Text}, nil
+	}
+
+	if t.Text == keywordNull {
+		return Literal{Type: NullLiteral, JSON: t.Text}, nil
+	}
+	return Literal{Type: BoolLiteral, JSON: t.Text}, nil
+}
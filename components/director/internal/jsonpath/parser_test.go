@@ -0,0 +1,195 @@
+package jsonpath_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/jsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	current := &jsonpath.Path{Relative: true}
+	str := func(value string) jsonpath.Literal {
+		return jsonpath.Literal{Type: jsonpath.StringLiteral, JSON: value}
+	}
+	num := func(value string) jsonpath.Literal {
+		return jsonpath.Literal{Type: jsonpath.NumberLiteral, JSON: value}
+	}
+	filter := func(predicate jsonpath.Predicate) *jsonpath.Path {
+		return &jsonpath.Path{Steps: []jsonpath.Step{
+			{Type: jsonpath.WildcardArrayStep},
+			{Type: jsonpath.FilterStep, Filter: predicate},
+		}}
+	}
+
+	testCases := []struct {
+		Name     string
+		Query    string
+		Expected *jsonpath.Path
+	}{
+		{
+			Name:     "Root",
+			Query:    `$`,
+			Expected: &jsonpath.Path{},
+		},
+		{
+			Name:  "Member accessors",
+			Query: `$.foo."bar baz".in`,
+			Expected: &jsonpath.Path{Steps: []jsonpath.Step{
+				{Type: jsonpath.MemberStep, Key: "foo"},
+				{Type: jsonpath.MemberStep, Key: "bar baz"},
+				{Type: jsonpath.MemberStep, Key: "in"},
+			}},
+		},
+		{
+			Name:     "Equality",
+			Query:    `$[*] ? (@ == "foo")`,
+			Expected: filter(jsonpath.Comparison{Path: current, Operator: "==", Value: str(`"foo"`)}),
+		},
+		{
+			Name:     "Escaped string",
+			Query:    `$[*] ? (@ == "say \"hi\"")`,
+			Expected: filter(jsonpath.Comparison{Path: current, Operator: "==", Value: str(`"say \"hi\""`)}),
+		},
+		{
+			Name:     "Reversed comparison",
+			Query:    `$[*] ? (10 < @.size)`,
+			Expected: filter(jsonpath.Comparison{Path: &jsonpath.Path{Relative: true, Steps: []jsonpath.Step{{Type: jsonpath.MemberStep, Key: "size"}}}, Operator: ">", Value: num("10")}),
+		},
+		{
+			Name:  "In",
+			Query: `$[*] ? (@ in ("foo", -1.5e3, true, null))`,
+			Expected: filter(jsonpath.In{Path: current, Values: []jsonpath.Literal{
+				str(`"foo"`),
+				num("-1.5e3"),
+				{Type: jsonpath.BoolLiteral, JSON: "true"},
+				{Type: jsonpath.NullLiteral, JSON: "null"},
+			}}),
+		},
+		{
+			Name:     "Exists",
+			Query:    `$[*] ? (exists(@.foo))`,
+			Expected: filter(jsonpath.Exists{Path: &jsonpath.Path{Relative: true, Steps: []jsonpath.Step{{Type: jsonpath.MemberStep, Key: "foo"}}}}),
+		},
+		{
+			Name:     "Like regex",
+			Query:    `$[*] ? (@ like_regex "^foo" flag "i")`,
+			Expected: filter(jsonpath.LikeRegex{Path: current, Pattern: "^foo", CaseInsensitive: true}),
+		},
+		{
+			Name:  "And binds stronger than or",
+			Query: `$[*] ? (@ == "a" || @ == "b" && @ != "c")`,
+			Expected: filter(jsonpath.Or{
+				Left: jsonpath.Comparison{Path: current, Operator: "==", Value: str(`"a"`)},
+				Right: jsonpath.And{
+					Left:  jsonpath.Comparison{Path: current, Operator: "==", Value: str(`"b"`)},
+					Right: jsonpath.Comparison{Path: current, Operator: "!=", Value: str(`"c"`)},
+				},
+			}),
+		},
+		{
+			Name:  "Parentheses",
+			Query: `$[*] ? ((@ == "a" || @ == "b") && @ != "c")`,
+			Expected: filter(jsonpath.And{
+				Left: jsonpath.Or{
+					Left:  jsonpath.Comparison{Path: current, Operator: "==", Value: str(`"a"`)},
+					Right: jsonpath.Comparison{Path: current, Operator: "==", Value: str(`"b"`)},
+				},
+				Right: jsonpath.Comparison{Path: current, Operator: "!=", Value: str(`"c"`)},
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			path, err := jsonpath.Parse(testCase.Query)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, path)
+		})
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Query         string
+		ExpectedError string
+	}{
+		{
+			Name:          "Empty query",
+			Query:         ``,
+			ExpectedError: `unexpected end of query, expected $ at position 1`,
+		},
+		{
+			Name:          "JSON value instead of path",
+			Query:         `["foo"]`,
+			ExpectedError: `unexpected "[", expected $ at position 1`,
+		},
+		{
+			Name:          "Array index",
+			Query:         `$[0]`,
+			ExpectedError: `unexpected "0", expected * (only the wildcard array accessor is supported) at position 3`,
+		},
+		{
+			Name:          "Missing predicate",
+			Query:         `$[*] ? (@ == "foo" &&)`,
+			ExpectedError: `unexpected ")", expected predicate at position 22`,
+		},
+		{
+			Name:          "Unclosed filter",
+			Query:         `$[*] ? (@ == "foo"`,
+			ExpectedError: `unexpected end of query, expected ) at position 19`,
+		},
+		{
+			Name:          "Unterminated string",
+			Query:         `$[*] ? (@ == "foo)`,
+			ExpectedError: `unterminated string at position 14`,
+		},
+		{
+			Name:          "Unknown character",
+			Query:         `$[*] ? (@ = "foo")`,
+			ExpectedError: `unexpected character '=' at position 11`,
+		},
+		{
+			Name:          "Number comparison with string",
+			Query:         `$[*] ? (@ > "foo")`,
+			ExpectedError: `operator > requires a number at position 13`,
+		},
+		{
+			Name:          "Unsupported flag",
+			Query:         `$[*] ? (@ like_regex "foo" flag "x")`,
+			ExpectedError: `unsupported flag "x", only "i" is supported at position 33`,
+		},
+		{
+			Name:          "Missing member name",
+			Query:         `$.`,
+			ExpectedError: `unexpected end of query, expected member name at position 3`,
+		},
+		{
+			Name:          "Wildcard member accessor",
+			Query:         `$.*`,
+			ExpectedError: `unexpected "*", expected member name at position 3`,
+		},
+		{
+			Name:          "Trailing tokens",
+			Query:         `$.foo bar`,
+			ExpectedError: `unexpected "bar", expected path step at position 7`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			_, err := jsonpath.Parse(testCase.Query)
+
+			// then
+			require.Error(t, err)
+			assert.EqualError(t, err, testCase.ExpectedError)
+			assert.IsType(t, &jsonpath.SyntaxError{}, err)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToSQL translates the path to the SQL condition which is true when the path returns any item from the jsonb column.
+// The path is evaluated in the lax mode, so arrays are unwrapped by accessors, filters and comparisons.
+// Keys and literals are passed as query arguments, which are numbered starting from argsStartIdx.
+func (p *Path) ToSQL(column string, argsStartIdx int) (string, []interface{}) {
+	t := &translator{argsStartIdx: argsStartIdx}
+	set := t.path(p, column, column)
+	return t.exists(set), t.args
+}
+
+type translator struct {
+	args         []interface{}
+	argsStartIdx int
+	aliasCount   int
+}
+
+// itemSet describes items returned by the path as a set of rows from the set returning functions
+type itemSet struct {
+	from       []string
+	conditions []string
+	item       string
+}
+
+func (t *translator) bind(value interface{}) string {
+	t.args = append(t.args, value)
+	return fmt.Sprintf("$%d", t.argsStartIdx+len(t.args)-1)
+}
+
+// unwrap replaces the item of the set with its array elements or with itself, if it is not an array
+func (t *translator) unwrap(set *itemSet) {
+	alias := fmt.Sprintf("jp%d", t.aliasCount)
+	t.aliasCount++
+
+	set.from = append(set.from, fmt.Sprintf("jsonb_array_elements(CASE WHEN jsonb_typeof(%[1]s) = 'array' THEN %[1]s ELSE jsonb_build_array(%[1]s) END) AS %[2]s(item)", set.item, alias))
+	set.item = alias + ".item"
+}
+
+func (t *translator) path(p *Path, root, current string) itemSet {
+	set := itemSet{item: root}
+	if p.Relative {
+		set.item = current
+	}
+
+	for i, step := range p.Steps {
+		switch step.Type {
+		case MemberStep:
+			t.unwrap(&set)
+			set.item = fmt.Sprintf("(%s -> %s::text)", set.item, t.bind(step.Key))
+			set.conditions = append(set.conditions, set.item+" IS NOT NULL")
+		case WildcardArrayStep:
+			t.unwrap(&set)
+		case FilterStep:
+			// items returned by the wildcard array accessor or by another filter are already unwrapped
+			if i == 0 || p.Steps[i-1].Type == MemberStep {
+				t.unwrap(&set)
+			}
+			set.conditions = append(set.conditions, t.predicate(step.Filter, root, set.item))
+		}
+	}
+
+	return set
+}
+
+func (t *translator) exists(set itemSet, conditions ...string) string {
+	conditions = append(set.conditions, conditions...)
+
+	if len(set.from) == 0 {
+		if len(conditions) == 0 {
+			return "true"
+		}
+		return "(" + strings.Join(conditions, " AND ") + ")"
+	}
+
+	stmt := "EXISTS (SELECT 1 FROM " + strings.Join(set.from, ", ")
+	if len(conditions) > 0 {
+		stmt += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return stmt + ")"
+}
+
+func (t *translator) predicate(predicate Predicate, root, current string) string {
+	switch p := predicate.(type) {
+	case And:
+		return fmt.Sprintf("(%s AND %s)", t.predicate(p.Left, root, current), t.predicate(p.Right, root, current))
+	case Or:
+		return fmt.Sprintf("(%s OR %s)", t.predicate(p.Left, root, current), t.predicate(p.Right, root, current))
+	case Exists:
+		return t.exists(t.path(p.Path, root, current))
+	case Comparison:
+		set := t.path(p.Path, root, current)
+		t.unwrap(&set)
+		return t.exists(set, t.comparison(set.item, p.Operator, p.Value))
+	case In:
+		set := t.path(p.Path, root, current)
+		t.unwrap(&set)
+		var values []string
+		for _, value := range p.Values {
+			values = append(values, t.bind(value.JSON)+"::jsonb")
+		}
+		return t.exists(set, fmt.Sprintf("%s IN (%s)", set.item, strings.Join(values, ", ")))
+	case LikeRegex:
+		set := t.path(p.Path, root, current)
+		t.unwrap(&set)
+		operator := "~"
+		if p.CaseInsensitive {
+			operator = "~*"
+		}
+		return t.exists(set, fmt.Sprintf("(CASE WHEN jsonb_typeof(%[1]s) = 'string' THEN (%[1]s #>> '{}') %[2]s %[3]s::text ELSE false END)", set.item, operator, t.bind(p.Pattern)))
+	}
+
+	return "false"
+}
+
+func (t *translator) comparison(item, operator string, value Literal) string {
+	switch operator {
+	case "==":
+		return fmt.Sprintf("%s = %s::jsonb", item, t.bind(value.JSON))
+	case "!=", "<>":
+		arg := t.bind(value.JSON)
+		return fmt.Sprintf("(jsonb_typeof(%[1]s) = jsonb_typeof(%[2]s::jsonb) AND %[1]s <> %[2]s::jsonb)", item, arg)
+	}
+
+	return fmt.Sprintf("(CASE WHEN jsonb_typeof(%[1]s) = 'number' THEN (%[1]s #>> '{}')::numeric %[2]s %[3]s::numeric ELSE false END)", item, operator, t.bind(value.JSON))
+}
@@ -0,0 +1,92 @@
+package jsonpath_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/jsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath_ToSQL(t *testing.T) {
+	unwrap := func(item, alias string) string {
+		return fmt.Sprintf(`jsonb_array_elements(CASE WHEN jsonb_typeof(%[1]s) = 'array' THEN %[1]s ELSE jsonb_build_array(%[1]s) END) AS %[2]s(item)`, item, alias)
+	}
+	filter := func(condition string) string {
+		return `EXISTS (SELECT 1 FROM ` + unwrap(`"value"`, "jp0") + ` WHERE EXISTS (SELECT 1 FROM ` + unwrap("jp0.item", "jp1") + ` WHERE ` + condition + `))`
+	}
+
+	testCases := []struct {
+		Name         string
+		Query        string
+		ExpectedSQL  string
+		ExpectedArgs []interface{}
+	}{
+		{
+			Name:        "Root",
+			Query:       `$`,
+			ExpectedSQL: `true`,
+		},
+		{
+			Name:         "Member accessor",
+			Query:        `$.foo`,
+			ExpectedSQL:  `EXISTS (SELECT 1 FROM ` + unwrap(`"value"`, "jp0") + ` WHERE (jp0.item -> $3::text) IS NOT NULL)`,
+			ExpectedArgs: []interface{}{"foo"},
+		},
+		{
+			Name:         "Equality",
+			Query:        `$[*] ? (@ == "foo")`,
+			ExpectedSQL:  filter(`jp1.item = $3::jsonb`),
+			ExpectedArgs: []interface{}{`"foo"`},
+		},
+		{
+			Name:         "Inequality",
+			Query:        `$[*] ? (@ != "foo")`,
+			ExpectedSQL:  filter(`(jsonb_typeof(jp1.item) = jsonb_typeof($3::jsonb) AND jp1.item <> $3::jsonb)`),
+			ExpectedArgs: []interface{}{`"foo"`},
+		},
+		{
+			Name:         "Number comparison",
+			Query:        `$[*] ? (@ <= 1.5)`,
+			ExpectedSQL:  filter(`(CASE WHEN jsonb_typeof(jp1.item) = 'number' THEN (jp1.item #>> '{}')::numeric <= $3::numeric ELSE false END)`),
+			ExpectedArgs: []interface{}{"1.5"},
+		},
+		{
+			Name:         "In",
+			Query:        `$[*] ? (@ in ("foo", 1))`,
+			ExpectedSQL:  filter(`jp1.item IN ($3::jsonb, $4::jsonb)`),
+			ExpectedArgs: []interface{}{`"foo"`, "1"},
+		},
+		{
+			Name:         "Like regex",
+			Query:        `$[*] ? (@ like_regex "^foo'" flag "i")`,
+			ExpectedSQL:  filter(`(CASE WHEN jsonb_typeof(jp1.item) = 'string' THEN (jp1.item #>> '{}') ~* $3::text ELSE false END)`),
+			ExpectedArgs: []interface{}{"^foo'"},
+		},
+		{
+			Name:  "Exists, and, or",
+			Query: `$[*] ? (exists(@.foo) && (@.bar == 1 || @.bar == 2))`,
+			ExpectedSQL: `EXISTS (SELECT 1 FROM ` + unwrap(`"value"`, "jp0") + ` WHERE (` +
+				`EXISTS (SELECT 1 FROM ` + unwrap("jp0.item", "jp1") + ` WHERE (jp1.item -> $3::text) IS NOT NULL) AND (` +
+				`EXISTS (SELECT 1 FROM ` + unwrap("jp0.item", "jp2") + `, ` + unwrap("(jp2.item -> $4::text)", "jp3") + ` WHERE (jp2.item -> $4::text) IS NOT NULL AND jp3.item = $5::jsonb) OR ` +
+				`EXISTS (SELECT 1 FROM ` + unwrap("jp0.item", "jp4") + `, ` + unwrap("(jp4.item -> $6::text)", "jp5") + ` WHERE (jp4.item -> $6::text) IS NOT NULL AND jp5.item = $7::jsonb))))`,
+			ExpectedArgs: []interface{}{"foo", "bar", "1", "bar", "2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			path, err := jsonpath.Parse(testCase.Query)
+			require.NoError(t, err)
+
+			// when
+			sql, args := path.ToSQL(`"value"`, 3)
+
+			// then
+			assert.Equal(t, testCase.ExpectedSQL, sql)
+			assert.Equal(t, testCase.ExpectedArgs, args)
+		})
+	}
+}
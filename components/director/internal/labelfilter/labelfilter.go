@@ -1,10 +1,31 @@
 package labelfilter
 
-import "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+import (
+	"encoding/json"
+	"fmt"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+// LabelFilter matches objects labeled with Key, optionally only if the label value matches Query.
+//
+// A group filter has exactly one of And, Or and Not set instead of Key and Query, and combines the nested filters.
 type LabelFilter struct {
-	Key   string
-	Query *string
+	Key   string  `json:"key,omitempty"`
+	Query *string `json:"query,omitempty"`
+
+	And []*LabelFilter `json:"and,omitempty"`
+	Or  []*LabelFilter `json:"or,omitempty"`
+	Not *LabelFilter   `json:"not,omitempty"`
+}
+
+// NewForArrayElement returns a filter matching objects labeled with key, whose value is an array containing the element.
+// The element is written in the query as a JSON string, so it cannot change the query.
+func NewForArrayElement(key, element string) *LabelFilter {
+	quoted, _ := json.Marshal(element) // marshalling a string never fails
+	query := fmt.Sprintf(`$[*] ? (@ == %s)`, quoted)
+	return &LabelFilter{Key: key, Query: &query}
 }
 
 func FromGraphQL(in *graphql.LabelFilter) *LabelFilter {
@@ -23,3 +44,70 @@ func MultipleFromGraphQL(in []*graphql.LabelFilter) []*LabelFilter {
 
 	return filters
 }
+
+// MultipleWithExpressionFromGraphQL converts the label filters and the label filter expression, which are matched together
+func MultipleWithExpressionFromGraphQL(in []*graphql.LabelFilter, expression *graphql.LabelFilterExpression) ([]*LabelFilter, error) {
+	filters := MultipleFromGraphQL(in)
+	if expression == nil {
+		return filters, nil
+	}
+
+	filter, err := ExpressionFromGraphQL(expression)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting label filter expression")
+	}
+
+	return append(filters, filter), nil
+}
+
+func ExpressionFromGraphQL(in *graphql.LabelFilterExpression) (*LabelFilter, error) {
+	fieldsCount := 0
+	for _, isSet := range []bool{in.Label != nil, in.And != nil, in.Or != nil, in.Not != nil} {
+		if isSet {
+			fieldsCount++
+		}
+	}
+	if fieldsCount != 1 {
+		return nil, errors.New("exactly one of label, and, or, not has to be provided")
+	}
+
+	switch {
+	case in.Label != nil:
+		return FromGraphQL(in.Label), nil
+	case in.And != nil:
+		and, err := multipleExpressionsFromGraphQL("and", in.And)
+		if err != nil {
+			return nil, err
+		}
+		return &LabelFilter{And: and}, nil
+	case in.Or != nil:
+		or, err := multipleExpressionsFromGraphQL("or", in.Or)
+		if err != nil {
+			return nil, err
+		}
+		return &LabelFilter{Or: or}, nil
+	}
+
+	not, err := ExpressionFromGraphQL(in.Not)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting not")
+	}
+	return &LabelFilter{Not: not}, nil
+}
+
+func multipleExpressionsFromGraphQL(operator string, in []*graphql.LabelFilterExpression) ([]*LabelFilter, error) {
+	if len(in) == 0 {
+		return nil, errors.Errorf("%s cannot be empty", operator)
+	}
+
+	var filters []*LabelFilter
+	for i, expression := range in {
+		filter, err := ExpressionFromGraphQL(expression)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting %s[%d]", operator, i)
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
@@ -6,8 +6,29 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func TestNewForArrayElement(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		query := `$[*] ? (@ == "foo")`
+		expected := &labelfilter.LabelFilter{Key: "scenarios", Query: &query}
+
+		result := labelfilter.NewForArrayElement("scenarios", "foo")
+
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Element with quotes", func(t *testing.T) {
+		query := `$[*] ? (@ == "foo\") || (@ == \"bar")`
+		expected := &labelfilter.LabelFilter{Key: "scenarios", Query: &query}
+
+		result := labelfilter.NewForArrayElement("scenarios", `foo") || (@ == "bar`)
+
+		assert.Equal(t, expected, result)
+	})
+}
+
 func TestFromGraphQL(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		query := "foo"
@@ -72,3 +93,102 @@ func TestMultipleFromGraphQL(t *testing.T) {
 
 	assert.Equal(t, expected, result)
 }
+
+func TestMultipleWithExpressionFromGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		in := []*graphql.LabelFilter{{Key: "foo"}}
+		expression := &graphql.LabelFilterExpression{
+			Not: &graphql.LabelFilterExpression{Label: &graphql.LabelFilter{Key: "bar"}},
+		}
+
+		expected := []*labelfilter.LabelFilter{
+			{Key: "foo"},
+			{Not: &labelfilter.LabelFilter{Key: "bar"}},
+		}
+
+		result, err := labelfilter.MultipleWithExpressionFromGraphQL(in, expression)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Nil expression", func(t *testing.T) {
+		in := []*graphql.LabelFilter{{Key: "foo"}}
+
+		result, err := labelfilter.MultipleWithExpressionFromGraphQL(in, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*labelfilter.LabelFilter{{Key: "foo"}}, result)
+	})
+
+	t.Run("Error when expression is invalid", func(t *testing.T) {
+		expression := &graphql.LabelFilterExpression{}
+
+		_, err := labelfilter.MultipleWithExpressionFromGraphQL(nil, expression)
+
+		require.EqualError(t, err, "while converting label filter expression: exactly one of label, and, or, not has to be provided")
+	})
+}
+
+func TestExpressionFromGraphQL(t *testing.T) {
+	query := "$[*] ? (@ == \"foo\")"
+	foo := &graphql.LabelFilterExpression{Label: &graphql.LabelFilter{Key: "foo", Query: &query}}
+	bar := &graphql.LabelFilterExpression{Label: &graphql.LabelFilter{Key: "bar"}}
+
+	testCases := []struct {
+		Name          string
+		Input         *graphql.LabelFilterExpression
+		Expected      *labelfilter.LabelFilter
+		ExpectedError string
+	}{
+		{
+			Name:     "Label",
+			Input:    foo,
+			Expected: &labelfilter.LabelFilter{Key: "foo", Query: &query},
+		},
+		{
+			Name:  "Nested groups",
+			Input: &graphql.LabelFilterExpression{Or: []*graphql.LabelFilterExpression{foo, {And: []*graphql.LabelFilterExpression{bar, {Not: foo}}}}},
+			Expected: &labelfilter.LabelFilter{Or: []*labelfilter.LabelFilter{
+				{Key: "foo", Query: &query},
+				{And: []*labelfilter.LabelFilter{
+					{Key: "bar"},
+					{Not: &labelfilter.LabelFilter{Key: "foo", Query: &query}},
+				}},
+			}},
+		},
+		{
+			Name:          "Error when no field is provided",
+			Input:         &graphql.LabelFilterExpression{},
+			ExpectedError: "exactly one of label, and, or, not has to be provided",
+		},
+		{
+			Name:          "Error when many fields are provided",
+			Input:         &graphql.LabelFilterExpression{Label: foo.Label, Not: bar},
+			ExpectedError: "exactly one of label, and, or, not has to be provided",
+		},
+		{
+			Name:          "Error when group is empty",
+			Input:         &graphql.LabelFilterExpression{And: []*graphql.LabelFilterExpression{}},
+			ExpectedError: "and cannot be empty",
+		},
+		{
+			Name:          "Error when nested expression is invalid",
+			Input:         &graphql.LabelFilterExpression{Or: []*graphql.LabelFilterExpression{foo, {Not: &graphql.LabelFilterExpression{}}}},
+			ExpectedError: "while converting or[1]: while converting not: exactly one of label, and, or, not has to be provided",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			result, err := labelfilter.ExpressionFromGraphQL(testCase.Input)
+
+			if testCase.ExpectedError != "" {
+				require.EqualError(t, err, testCase.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
@@ -49,6 +49,15 @@ type APIDefinitionInput struct {
 	DefaultAuth *AuthInput
 }
 
+// FetchRequestInput returns the Fetch Request of the specification, if there is any
+func (a *APIDefinitionInput) FetchRequestInput() *FetchRequestInput {
+	if a == nil || a.Spec == nil {
+		return nil
+	}
+
+	return a.Spec.FetchRequest
+}
+
 type APISpecInput struct {
 	Data         *string
 	Type         APISpecType
@@ -0,0 +1,53 @@
+package model
+
+type APIDiff struct {
+	FromID   string
+	ToID     string
+	Breaking bool
+	Changes  []*APIChange
+}
+
+type APIChange struct {
+	Kind        APIChangeKind
+	Type        APIChangeType
+	Path        string
+	Breaking    bool
+	Description string
+}
+
+type APIChangeKind string
+
+const (
+	APIChangeKindOperation APIChangeKind = "OPERATION"
+	APIChangeKindMessage   APIChangeKind = "MESSAGE"
+	APIChangeKindSchema    APIChangeKind = "SCHEMA"
+)
+
+type APIChangeType string
+
+const (
+	APIChangeTypeAdded   APIChangeType = "ADDED"
+	APIChangeTypeRemoved APIChangeType = "REMOVED"
+	APIChangeTypeChanged APIChangeType = "CHANGED"
+)
+
+func NewAPIDiff(fromID, toID string, changes []*APIChange) *APIDiff {
+	breaking := false
+	for _, change := range changes {
+		if change.Breaking {
+			breaking = true
+			break
+		}
+	}
+
+	if changes == nil {
+		changes = []*APIChange{}
+	}
+
+	return &APIDiff{
+		FromID:   fromID,
+		ToID:     toID,
+		Breaking: breaking,
+		Changes:  changes,
+	}
+}
@@ -1,6 +1,14 @@
 package model
 
-import "github.com/kyma-incubator/compass/components/director/pkg/pagination"
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/pkg/errors"
+)
 
 type ApplicationTemplate struct {
 	ID                   string
@@ -9,6 +17,8 @@ type ApplicationTemplate struct {
 	ApplicationInputJSON string
 	Placeholders         []ApplicationTemplatePlaceholder
 	AccessLevel          ApplicationTemplateAccessLevel
+	Tenant               *string
+	Version              int
 }
 
 type ApplicationTemplatePage struct {
@@ -29,11 +39,88 @@ type ApplicationTemplateAccessLevel string
 
 const (
 	GlobalApplicationTemplateAccessLevel ApplicationTemplateAccessLevel = "GLOBAL"
+	TenantApplicationTemplateAccessLevel ApplicationTemplateAccessLevel = "TENANT"
 )
 
 type ApplicationTemplatePlaceholder struct {
 	Name        string
 	Description *string
+	JSONSchema  *interface{}
+	Regex       *string
+	Required    bool
+	Default     *string
+	Sensitive   bool
+}
+
+// Validate checks if JSON schema and regex of the placeholder are correct and if its default value satisfies them
+func (p ApplicationTemplatePlaceholder) Validate() error {
+	if p.JSONSchema != nil {
+		if _, err := jsonschema.NewValidatorFromRawSchema(*p.JSONSchema); err != nil {
+			return errors.Wrapf(err, "while validating JSON schema of placeholder [name=%s]", p.Name)
+		}
+	}
+
+	if p.Regex != nil {
+		if _, err := regexp.Compile(*p.Regex); err != nil {
+			return errors.Wrapf(err, "while validating regex of placeholder [name=%s]", p.Name)
+		}
+	}
+
+	if p.Default != nil {
+		if err := p.ValidateValue(*p.Default); err != nil {
+			return errors.Wrap(err, "while validating default value")
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue checks if the whole value matches the regex of the placeholder and if it is valid against its JSON schema,
+// either as a string or, when it is a JSON document, as the decoded value. Errors never contain details of sensitive values.
+func (p ApplicationTemplatePlaceholder) ValidateValue(value string) error {
+	if p.Regex != nil {
+		matched, err := regexp.MatchString(fmt.Sprintf("^(?:%s)$", *p.Regex), value)
+		if err != nil {
+			return errors.Wrapf(err, "while matching value for placeholder [name=%s]", p.Name)
+		}
+		if !matched {
+			return fmt.Errorf("value for placeholder [name=%s] does not match regex %s", p.Name, *p.Regex)
+		}
+	}
+
+	if p.JSONSchema == nil {
+		return nil
+	}
+
+	validator, err := jsonschema.NewValidatorFromRawSchema(*p.JSONSchema)
+	if err != nil {
+		return errors.Wrapf(err, "while creating JSON schema validator for placeholder [name=%s]", p.Name)
+	}
+
+	result, err := validator.ValidateRaw(value)
+	if err != nil {
+		return errors.Wrapf(err, "while validating value for placeholder [name=%s]", p.Name)
+	}
+
+	if !result.Valid && json.Valid([]byte(value)) {
+		decodedResult, err := validator.ValidateString(value)
+		if err != nil {
+			return errors.Wrapf(err, "while validating value for placeholder [name=%s]", p.Name)
+		}
+		if decodedResult.Valid {
+			return nil
+		}
+	}
+
+	if result.Valid {
+		return nil
+	}
+
+	if p.Sensitive {
+		return fmt.Errorf("value for placeholder [name=%s] is not valid against its JSON schema", p.Name)
+	}
+
+	return errors.Wrapf(result.Error, "value for placeholder [name=%s] is not valid against its JSON schema", p.Name)
 }
 
 type ApplicationTemplateValueInput struct {
@@ -0,0 +1,140 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationTemplatePlaceholder_Validate(t *testing.T) {
+	// GIVEN
+	testCases := []struct {
+		Name               string
+		Input              model.ApplicationTemplatePlaceholder
+		ExpectedErrMessage string
+	}{
+		{
+			Name:  "Success without constraints",
+			Input: model.ApplicationTemplatePlaceholder{Name: "foo"},
+		},
+		{
+			Name:  "Success when default satisfies constraints",
+			Input: model.ApplicationTemplatePlaceholder{Name: "foo", Regex: strPtr("[a-z]+"), JSONSchema: schema(map[string]interface{}{"type": "string"}), Default: strPtr("bar")},
+		},
+		{
+			Name:               "Error when regex is invalid",
+			Input:              model.ApplicationTemplatePlaceholder{Name: "foo", Regex: strPtr("[a-z")},
+			ExpectedErrMessage: "while validating regex of placeholder [name=foo]",
+		},
+		{
+			Name:               "Error when JSON schema is invalid",
+			Input:              model.ApplicationTemplatePlaceholder{Name: "foo", JSONSchema: schema(map[string]interface{}{"type": "unknown"})},
+			ExpectedErrMessage: "while validating JSON schema of placeholder [name=foo]",
+		},
+		{
+			Name:               "Error when default does not satisfy constraints",
+			Input:              model.ApplicationTemplatePlaceholder{Name: "foo", Regex: strPtr("[a-z]+"), Default: strPtr("123")},
+			ExpectedErrMessage: "while validating default value: value for placeholder [name=foo] does not match regex [a-z]+",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Input.Validate()
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplicationTemplatePlaceholder_ValidateValue(t *testing.T) {
+	// GIVEN
+	integerSchema := schema(map[string]interface{}{"type": "integer", "maximum": 10})
+	stringSchema := schema(map[string]interface{}{"type": "string", "maxLength": 3})
+
+	testCases := []struct {
+		Name               string
+		Placeholder        model.ApplicationTemplatePlaceholder
+		Value              string
+		ExpectedErrMessage string
+		NotExpectedInError string
+	}{
+		{
+			Name:        "Success when whole value matches regex",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "foo", Regex: strPtr("[a-z]+")},
+			Value:       "abc",
+		},
+		{
+			Name:               "Error when only part of value matches regex",
+			Placeholder:        model.ApplicationTemplatePlaceholder{Name: "foo", Regex: strPtr("[a-z]+")},
+			Value:              "abc1",
+			ExpectedErrMessage: "value for placeholder [name=foo] does not match regex [a-z]+",
+		},
+		{
+			Name:        "Success when value is valid as string",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "foo", JSONSchema: stringSchema},
+			Value:       "123",
+		},
+		{
+			Name:        "Success when decoded value is valid",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "foo", JSONSchema: integerSchema},
+			Value:       "5",
+		},
+		{
+			Name:               "Error when value is not valid against JSON schema",
+			Placeholder:        model.ApplicationTemplatePlaceholder{Name: "foo", JSONSchema: integerSchema},
+			Value:              "50",
+			ExpectedErrMessage: "value for placeholder [name=foo] is not valid against its JSON schema: ",
+		},
+		{
+			Name:               "Error without details when sensitive value is not valid against JSON schema",
+			Placeholder:        model.ApplicationTemplatePlaceholder{Name: "foo", JSONSchema: stringSchema, Sensitive: true},
+			Value:              "secret",
+			ExpectedErrMessage: "value for placeholder [name=foo] is not valid against its JSON schema",
+			NotExpectedInError: "secret",
+		},
+		{
+			Name:               "Error without value when sensitive value does not match regex",
+			Placeholder:        model.ApplicationTemplatePlaceholder{Name: "foo", Regex: strPtr("[0-9]+"), Sensitive: true},
+			Value:              "secret",
+			ExpectedErrMessage: "value for placeholder [name=foo] does not match regex [0-9]+",
+			NotExpectedInError: "secret",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Placeholder.ValidateValue(testCase.Value)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				if testCase.NotExpectedInError != "" {
+					assert.NotContains(t, err.Error(), testCase.NotExpectedInError)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func strPtr(in string) *string {
+	return &in
+}
+
+func schema(in map[string]interface{}) *interface{} {
+	var out interface{} = in
+	return &out
+}
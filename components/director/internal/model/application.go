@@ -11,13 +11,17 @@ import (
 )
 
 type Application struct {
-	ID                  string
-	Tenant              string
-	Name                string
-	Description         *string
-	Status              *ApplicationStatus
-	HealthCheckURL      *string
-	IntegrationSystemID *string
+	ID                         string
+	Tenant                     string
+	Name                       string
+	Description                *string
+	Status                     *ApplicationStatus
+	HealthCheckURL             *string
+	HealthCheckAuth            *Auth
+	IntegrationSystemID        *string
+	ApplicationTemplateID      *string
+	ApplicationTemplateVersion *int
+	ApplicationTemplateValues  []*ApplicationTemplateValueInput
 }
 
 type ApplicationStatus struct {
@@ -28,12 +32,34 @@ type ApplicationStatus struct {
 type ApplicationStatusCondition string
 
 const (
-	ApplicationStatusConditionInitial ApplicationStatusCondition = "INITIAL"
-	ApplicationStatusConditionUnknown ApplicationStatusCondition = "UNKNOWN"
-	ApplicationStatusConditionReady   ApplicationStatusCondition = "READY"
-	ApplicationStatusConditionFailed  ApplicationStatusCondition = "FAILED"
+	ApplicationStatusConditionInitial  ApplicationStatusCondition = "INITIAL"
+	ApplicationStatusConditionUnknown  ApplicationStatusCondition = "UNKNOWN"
+	ApplicationStatusConditionReady    ApplicationStatusCondition = "READY"
+	ApplicationStatusConditionFailed   ApplicationStatusCondition = "FAILED"
+	ApplicationStatusConditionDeleting ApplicationStatusCondition = "DELETING"
 )
 
+var applicationStatusTransitions = map[ApplicationStatusCondition][]ApplicationStatusCondition{
+	ApplicationStatusConditionInitial: {ApplicationStatusConditionReady, ApplicationStatusConditionUnknown, ApplicationStatusConditionFailed, ApplicationStatusConditionDeleting},
+	ApplicationStatusConditionReady:   {ApplicationStatusConditionUnknown, ApplicationStatusConditionFailed, ApplicationStatusConditionDeleting},
+	ApplicationStatusConditionUnknown: {ApplicationStatusConditionReady, ApplicationStatusConditionFailed, ApplicationStatusConditionDeleting},
+	ApplicationStatusConditionFailed:  {ApplicationStatusConditionReady, ApplicationStatusConditionUnknown, ApplicationStatusConditionDeleting},
+}
+
+// ApplicationStatusConditionsBeforeConnection are the conditions in which the Application becomes READY when it authenticates with its system auth.
+// An UNKNOWN Application becomes READY only when its health check succeeds.
+var ApplicationStatusConditionsBeforeConnection = []ApplicationStatusCondition{ApplicationStatusConditionInitial}
+
+func (c ApplicationStatusCondition) CanTransitionTo(next ApplicationStatusCondition) bool {
+	for _, allowed := range applicationStatusTransitions[c] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
 const applicationNameMaxLength = 36
 
 type ApplicationPage struct {
@@ -43,15 +69,37 @@ type ApplicationPage struct {
 }
 
 type ApplicationCreateInput struct {
-	Name                string
-	Description         *string
-	Labels              map[string]interface{}
-	HealthCheckURL      *string
-	Webhooks            []*WebhookInput
-	Apis                []*APIDefinitionInput
-	EventAPIs           []*EventAPIDefinitionInput
-	Documents           []*DocumentInput
-	IntegrationSystemID *string
+	Name                       string
+	Description                *string
+	Labels                     map[string]interface{}
+	HealthCheckURL             *string
+	HealthCheckAuth            *AuthInput
+	Webhooks                   []*WebhookInput
+	Apis                       []*APIDefinitionInput
+	EventAPIs                  []*EventAPIDefinitionInput
+	Documents                  []*DocumentInput
+	IntegrationSystemID        *string
+	ApplicationTemplateID      *string
+	ApplicationTemplateVersion *int
+	ApplicationTemplateValues  []*ApplicationTemplateValueInput
+}
+
+// FetchRequestInputs returns Fetch Requests of all APIs, Event APIs and Documents of the Application
+func (i *ApplicationCreateInput) FetchRequestInputs() []*FetchRequestInput {
+	var inputs []*FetchRequestInput
+	for _, api := range i.Apis {
+		inputs = append(inputs, api.FetchRequestInput())
+	}
+	for _, eventAPI := range i.EventAPIs {
+		inputs = append(inputs, eventAPI.FetchRequestInput())
+	}
+	for _, doc := range i.Documents {
+		if doc != nil {
+			inputs = append(inputs, doc.FetchRequest)
+		}
+	}
+
+	return inputs
 }
 
 func (i *ApplicationCreateInput) ToApplication(timestamp time.Time, condition ApplicationStatusCondition, id, tenant string) *Application {
@@ -60,12 +108,16 @@ func (i *ApplicationCreateInput) ToApplication(timestamp time.Time, condition Ap
 	}
 
 	return &Application{
-		ID:                  id,
-		Name:                i.Name,
-		Description:         i.Description,
-		Tenant:              tenant,
-		HealthCheckURL:      i.HealthCheckURL,
-		IntegrationSystemID: i.IntegrationSystemID,
+		ID:                         id,
+		Name:                       i.Name,
+		Description:                i.Description,
+		Tenant:                     tenant,
+		HealthCheckURL:             i.HealthCheckURL,
+		HealthCheckAuth:            i.HealthCheckAuth.ToAuth(),
+		IntegrationSystemID:        i.IntegrationSystemID,
+		ApplicationTemplateID:      i.ApplicationTemplateID,
+		ApplicationTemplateVersion: i.ApplicationTemplateVersion,
+		ApplicationTemplateValues:  i.ApplicationTemplateValues,
 		Status: &ApplicationStatus{
 			Condition: condition,
 			Timestamp: timestamp,
@@ -81,6 +133,7 @@ type ApplicationUpdateInput struct {
 	Name                string
 	Description         *string
 	HealthCheckURL      *string
+	HealthCheckAuth     *AuthInput
 	IntegrationSystemID *string
 }
 
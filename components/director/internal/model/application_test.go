@@ -35,6 +35,7 @@ func TestApplicationCreateInput_ToApplication(t *testing.T) {
 					},
 				},
 				HealthCheckURL:      &url,
+				HealthCheckAuth:     &model.AuthInput{Credential: &model.CredentialDataInput{Basic: &model.BasicCredentialDataInput{Username: "foo", Password: "bar"}}},
 				IntegrationSystemID: &intSysID,
 			},
 			Expected: &model.Application{
@@ -43,6 +44,7 @@ func TestApplicationCreateInput_ToApplication(t *testing.T) {
 				Tenant:              tenant,
 				Description:         &desc,
 				HealthCheckURL:      &url,
+				HealthCheckAuth:     &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "foo", Password: "bar"}}},
 				IntegrationSystemID: &intSysID,
 				Status: &model.ApplicationStatus{
 					Timestamp: timestamp,
@@ -69,6 +71,33 @@ func TestApplicationCreateInput_ToApplication(t *testing.T) {
 	}
 }
 
+func TestApplicationCreateInput_FetchRequestInputs(t *testing.T) {
+	// given
+	apiFR := &model.FetchRequestInput{URL: "api"}
+	eventAPIFR := &model.FetchRequestInput{URL: "eventapi"}
+	docFR := &model.FetchRequestInput{URL: "doc"}
+	in := model.ApplicationCreateInput{
+		Apis: []*model.APIDefinitionInput{
+			{Spec: &model.APISpecInput{FetchRequest: apiFR}},
+			{Spec: &model.APISpecInput{}},
+			{},
+		},
+		EventAPIs: []*model.EventAPIDefinitionInput{
+			{Spec: &model.EventAPISpecInput{FetchRequest: eventAPIFR}},
+		},
+		Documents: []*model.DocumentInput{
+			{FetchRequest: docFR},
+			{},
+		},
+	}
+
+	// when
+	result := in.FetchRequestInputs()
+
+	// then
+	assert.Equal(t, []*model.FetchRequestInput{apiFR, nil, nil, eventAPIFR, docFR, nil}, result)
+}
+
 func TestApplicationCreateInput_ValidateInput(t *testing.T) {
 	//GIVEN
 	testError := errors.New("a DNS-1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")
@@ -168,3 +197,26 @@ func TestApplicationUpdateInput_ValidateInput(t *testing.T) {
 		})
 	}
 }
+
+func TestApplicationStatusCondition_CanTransitionTo(t *testing.T) {
+	testCases := []struct {
+		From     model.ApplicationStatusCondition
+		To       model.ApplicationStatusCondition
+		Expected bool
+	}{
+		{From: model.ApplicationStatusConditionInitial, To: model.ApplicationStatusConditionReady, Expected: true},
+		{From: model.ApplicationStatusConditionReady, To: model.ApplicationStatusConditionUnknown, Expected: true},
+		{From: model.ApplicationStatusConditionUnknown, To: model.ApplicationStatusConditionFailed, Expected: true},
+		{From: model.ApplicationStatusConditionFailed, To: model.ApplicationStatusConditionReady, Expected: true},
+		{From: model.ApplicationStatusConditionReady, To: model.ApplicationStatusConditionDeleting, Expected: true},
+		{From: model.ApplicationStatusConditionReady, To: model.ApplicationStatusConditionInitial, Expected: false},
+		{From: model.ApplicationStatusConditionReady, To: model.ApplicationStatusConditionReady, Expected: false},
+		{From: model.ApplicationStatusConditionDeleting, To: model.ApplicationStatusConditionReady, Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%s to %s", testCase.From, testCase.To), func(t *testing.T) {
+			assert.Equal(t, testCase.Expected, testCase.From.CanTransitionTo(testCase.To))
+		})
+	}
+}
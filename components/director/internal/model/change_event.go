@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+type ChangeEventObjectType string
+
+const (
+	ChangeEventObjectTypeApplication ChangeEventObjectType = "APPLICATION"
+	ChangeEventObjectTypeRuntime     ChangeEventObjectType = "RUNTIME"
+)
+
+type ChangeEventResourceType string
+
+const (
+	ChangeEventResourceTypeApplication ChangeEventResourceType = "APPLICATION"
+	ChangeEventResourceTypeRuntime     ChangeEventResourceType = "RUNTIME"
+	ChangeEventResourceTypeAPI         ChangeEventResourceType = "API"
+	ChangeEventResourceTypeEventAPI    ChangeEventResourceType = "EVENT_API"
+	ChangeEventResourceTypeDocument    ChangeEventResourceType = "DOCUMENT"
+	ChangeEventResourceTypeWebhook     ChangeEventResourceType = "WEBHOOK"
+	ChangeEventResourceTypeLabel       ChangeEventResourceType = "LABEL"
+)
+
+// ChangeEvent is recorded by the database in the same transaction as the change of an Application or Runtime.
+// For labels, the ResourceID is the label key.
+type ChangeEvent struct {
+	ID           int64
+	Tenant       string
+	ObjectType   ChangeEventObjectType
+	ObjectID     string
+	ResourceType ChangeEventResourceType
+	ResourceID   string
+	Operation    ConfigurationChangeOperation
+	CreatedAt    time.Time
+}
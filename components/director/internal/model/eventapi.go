@@ -43,6 +43,15 @@ type EventAPIDefinitionInput struct {
 	Version     *VersionInput
 }
 
+// FetchRequestInput returns the Fetch Request of the specification, if there is any
+func (e *EventAPIDefinitionInput) FetchRequestInput() *FetchRequestInput {
+	if e == nil || e.Spec == nil {
+		return nil
+	}
+
+	return e.Spec.FetchRequest
+}
+
 type EventAPISpecInput struct {
 	Data          *string
 	EventSpecType EventAPISpecType
@@ -2,7 +2,7 @@ package model
 
 import "time"
 
-//  Compass performs fetch to validate if request is correct and stores a copy
+// Compass performs fetch to validate if request is correct and stores a copy
 type FetchRequest struct {
 	ID         string
 	Tenant     string
@@ -24,8 +24,11 @@ const (
 )
 
 type FetchRequestStatus struct {
-	Condition FetchRequestStatusCondition
-	Timestamp time.Time
+	Condition   FetchRequestStatusCondition
+	Message     *string
+	Timestamp   time.Time
+	LastChecked *time.Time
+	LastChanged *time.Time
 }
 
 type FetchMode string
@@ -76,3 +79,16 @@ func (f *FetchRequestInput) ToFetchRequest(timestamp time.Time, id, tenant strin
 		ObjectID:   objectID,
 	}
 }
+
+// FetchRequestsFromInputs converts the inputs to Fetch Requests, which are not bound to any object yet. Nil inputs are skipped.
+func FetchRequestsFromInputs(inputs []*FetchRequestInput) []*FetchRequest {
+	var fetchRequests []*FetchRequest
+	for _, in := range inputs {
+		if in == nil {
+			continue
+		}
+		fetchRequests = append(fetchRequests, in.ToFetchRequest(time.Time{}, "", "", "", ""))
+	}
+
+	return fetchRequests
+}
@@ -95,3 +95,32 @@ func TestFetchRequestInput_ToFetchRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchRequestsFromInputs(t *testing.T) {
+	// given
+	mode := model.FetchModePackage
+	filter := "*.yaml"
+	inputs := []*model.FetchRequestInput{
+		{URL: "foo"},
+		nil,
+		{URL: "bar", Mode: &mode, Filter: &filter},
+	}
+
+	// when
+	result := model.FetchRequestsFromInputs(inputs)
+
+	// then
+	assert.Equal(t, []*model.FetchRequest{
+		{
+			URL:    "foo",
+			Mode:   model.FetchModeSingle,
+			Status: &model.FetchRequestStatus{Condition: model.FetchRequestStatusConditionInitial},
+		},
+		{
+			URL:    "bar",
+			Mode:   mode,
+			Filter: &filter,
+			Status: &model.FetchRequestStatus{Condition: model.FetchRequestStatusConditionInitial},
+		},
+	}, result)
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+type HealthCheckType string
+
+const (
+	HealthCheckTypeManagementPlaneApplicationHealthcheck HealthCheckType = "MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK"
+)
+
+type HealthCheckStatusCondition string
+
+const (
+	HealthCheckStatusConditionSucceeded HealthCheckStatusCondition = "SUCCEEDED"
+	HealthCheckStatusConditionFailed    HealthCheckStatusCondition = "FAILED"
+)
+
+type HealthCheck struct {
+	ID        string
+	Tenant    string
+	Type      HealthCheckType
+	Condition HealthCheckStatusCondition
+	Origin    *string
+	Message   *string
+	Timestamp time.Time
+}
+
+type HealthCheckPage struct {
+	Data       []*HealthCheck
+	PageInfo   *pagination.Page
+	TotalCount int
+}
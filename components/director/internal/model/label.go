@@ -30,6 +30,9 @@ func (i *LabelInput) ToLabel(id, tenant string) *Label {
 type LabelableObject string
 
 const (
-	RuntimeLabelableObject     LabelableObject = "Runtime"
-	ApplicationLabelableObject LabelableObject = "Application"
+	RuntimeLabelableObject            LabelableObject = "Runtime"
+	ApplicationLabelableObject        LabelableObject = "Application"
+	APIDefinitionLabelableObject      LabelableObject = "APIDefinition"
+	EventAPIDefinitionLabelableObject LabelableObject = "EventAPIDefinition"
+	IntegrationSystemLabelableObject  LabelableObject = "IntegrationSystem"
 )
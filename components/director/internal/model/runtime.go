@@ -16,6 +16,7 @@ type Runtime struct {
 	Description *string
 	Tenant      string
 	Status      *RuntimeStatus
+	Heartbeat   *RuntimeHeartbeat
 }
 
 type RuntimeStatus struct {
@@ -31,6 +32,28 @@ const (
 	RuntimeStatusConditionFailed  RuntimeStatusCondition = "FAILED"
 )
 
+var runtimeStatusTransitions = map[RuntimeStatusCondition][]RuntimeStatusCondition{
+	RuntimeStatusConditionInitial: {RuntimeStatusConditionReady, RuntimeStatusConditionFailed},
+	RuntimeStatusConditionReady:   {RuntimeStatusConditionFailed},
+	RuntimeStatusConditionFailed:  {RuntimeStatusConditionReady},
+}
+
+// RuntimeStatusConditionsBeforeConnection are the conditions in which the Runtime becomes READY when it authenticates with its system auth
+var RuntimeStatusConditionsBeforeConnection = []RuntimeStatusCondition{RuntimeStatusConditionInitial}
+
+// RuntimeStatusConditionsRestoredByHeartbeat are the conditions in which the Runtime becomes READY when it reports a heartbeat
+var RuntimeStatusConditionsRestoredByHeartbeat = []RuntimeStatusCondition{RuntimeStatusConditionFailed}
+
+func (c RuntimeStatusCondition) CanTransitionTo(next RuntimeStatusCondition) bool {
+	for _, allowed := range runtimeStatusTransitions[c] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
 type RuntimeInput struct {
 	Name        string
 	Description *string
@@ -58,6 +81,41 @@ func (i *RuntimeInput) Validate() error {
 	return nil
 }
 
+type RuntimeHeartbeat struct {
+	AgentVersion string
+	Connection   *RuntimeConnection
+	Timestamp    time.Time
+}
+
+type RuntimeConnection struct {
+	Endpoint             *string
+	CertificateExpiresAt *time.Time
+}
+
+type RuntimeHeartbeatInput struct {
+	AgentVersion string
+	Connection   *RuntimeConnection
+}
+
+func (i *RuntimeHeartbeatInput) ToRuntimeHeartbeat(timestamp time.Time) *RuntimeHeartbeat {
+	if i == nil {
+		return nil
+	}
+
+	return &RuntimeHeartbeat{
+		AgentVersion: i.AgentVersion,
+		Connection:   i.Connection,
+		Timestamp:    timestamp,
+	}
+}
+
+func (i *RuntimeHeartbeatInput) Validate() error {
+	if i.AgentVersion == "" {
+		return errors.New("agent version cannot be empty")
+	}
+	return nil
+}
+
 type RuntimePage struct {
 	Data       []*Runtime
 	PageInfo   *pagination.Page
@@ -3,6 +3,7 @@ package model_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -99,3 +100,75 @@ func TestRuntimeInput_ValidateInput(t *testing.T) {
 		})
 	}
 }
+
+func TestRuntimeStatusCondition_CanTransitionTo(t *testing.T) {
+	testCases := []struct {
+		From     model.RuntimeStatusCondition
+		To       model.RuntimeStatusCondition
+		Expected bool
+	}{
+		{From: model.RuntimeStatusConditionInitial, To: model.RuntimeStatusConditionReady, Expected: true},
+		{From: model.RuntimeStatusConditionInitial, To: model.RuntimeStatusConditionFailed, Expected: true},
+		{From: model.RuntimeStatusConditionReady, To: model.RuntimeStatusConditionFailed, Expected: true},
+		{From: model.RuntimeStatusConditionFailed, To: model.RuntimeStatusConditionReady, Expected: true},
+		{From: model.RuntimeStatusConditionReady, To: model.RuntimeStatusConditionInitial, Expected: false},
+		{From: model.RuntimeStatusConditionFailed, To: model.RuntimeStatusConditionInitial, Expected: false},
+		{From: model.RuntimeStatusConditionReady, To: model.RuntimeStatusConditionReady, Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%s to %s", testCase.From, testCase.To), func(t *testing.T) {
+			assert.Equal(t, testCase.Expected, testCase.From.CanTransitionTo(testCase.To))
+		})
+	}
+}
+
+func TestRuntimeHeartbeatInput_ToRuntimeHeartbeat(t *testing.T) {
+	// given
+	endpoint := "https://agent.kyma.local"
+	timestamp := time.Date(2019, 11, 29, 12, 0, 0, 0, time.UTC)
+	connection := &model.RuntimeConnection{Endpoint: &endpoint}
+
+	testCases := []struct {
+		Name     string
+		Input    *model.RuntimeHeartbeatInput
+		Expected *model.RuntimeHeartbeat
+	}{
+		{
+			Name:  "All properties given",
+			Input: &model.RuntimeHeartbeatInput{AgentVersion: "1.7.0", Connection: connection},
+			Expected: &model.RuntimeHeartbeat{
+				AgentVersion: "1.7.0",
+				Connection:   connection,
+				Timestamp:    timestamp,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			result := testCase.Input.ToRuntimeHeartbeat(timestamp)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestRuntimeHeartbeatInput_Validate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		in := model.RuntimeHeartbeatInput{AgentVersion: "1.7.0"}
+		assert.NoError(t, in.Validate())
+	})
+
+	t.Run("Returns error when agent version is empty", func(t *testing.T) {
+		in := model.RuntimeHeartbeatInput{}
+		assert.EqualError(t, in.Validate(), "agent version cannot be empty")
+	})
+}
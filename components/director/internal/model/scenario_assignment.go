@@ -0,0 +1,81 @@
+package model
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/pkg/errors"
+)
+
+// ScenarioAssignment assigns the scenario to every object of TargetType matching the label filter
+type ScenarioAssignment struct {
+	ID         string
+	Tenant     string
+	Scenario   string
+	TargetType LabelableObject
+	Filter     *labelfilter.LabelFilter
+}
+
+type ScenarioAssignmentPage struct {
+	Data       []*ScenarioAssignment
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+type ScenarioAssignmentInput struct {
+	Scenario   string
+	TargetType LabelableObject
+	Filter     *labelfilter.LabelFilter
+}
+
+func (i *ScenarioAssignmentInput) ToScenarioAssignment(id, tenant string) *ScenarioAssignment {
+	if i == nil {
+		return nil
+	}
+
+	return &ScenarioAssignment{
+		ID:         id,
+		Tenant:     tenant,
+		Scenario:   i.Scenario,
+		TargetType: i.TargetType,
+		Filter:     i.Filter,
+	}
+}
+
+func (i *ScenarioAssignmentInput) Validate() error {
+	if i.Scenario == "" {
+		return errors.New("scenario cannot be empty")
+	}
+
+	if i.TargetType != ApplicationLabelableObject && i.TargetType != RuntimeLabelableObject {
+		return errors.Errorf("invalid target type %s", i.TargetType)
+	}
+
+	if i.Filter == nil {
+		return errors.New("filter cannot be empty")
+	}
+
+	// Assignments change the scenarios label, so a filter on it could make assignments depend on each other
+	if filterUsesKey(i.Filter, ScenariosKey) {
+		return errors.Errorf("filter cannot use the %s label", ScenariosKey)
+	}
+
+	return nil
+}
+
+func filterUsesKey(filter *labelfilter.LabelFilter, key string) bool {
+	if filter == nil {
+		return false
+	}
+
+	if filter.Key == key || filterUsesKey(filter.Not, key) {
+		return true
+	}
+
+	for _, f := range append(filter.And, filter.Or...) {
+		if filterUsesKey(f, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarioAssignmentInput_ToScenarioAssignment(t *testing.T) {
+	// given
+	filter := &labelfilter.LabelFilter{Key: "region"}
+	in := &model.ScenarioAssignmentInput{
+		Scenario:   "foo",
+		TargetType: model.RuntimeLabelableObject,
+		Filter:     filter,
+	}
+
+	// when
+	result := in.ToScenarioAssignment("id", "tenant")
+
+	// then
+	assert.Equal(t, &model.ScenarioAssignment{
+		ID:         "id",
+		Tenant:     "tenant",
+		Scenario:   "foo",
+		TargetType: model.RuntimeLabelableObject,
+		Filter:     filter,
+	}, result)
+}
+
+func TestScenarioAssignmentInput_Validate(t *testing.T) {
+	filter := &labelfilter.LabelFilter{Key: "region"}
+
+	testCases := []struct {
+		Name          string
+		Input         model.ScenarioAssignmentInput
+		ExpectedError string
+	}{
+		{
+			Name:  "Success",
+			Input: model.ScenarioAssignmentInput{Scenario: "foo", TargetType: model.ApplicationLabelableObject, Filter: filter},
+		},
+		{
+			Name:          "Empty scenario",
+			Input:         model.ScenarioAssignmentInput{TargetType: model.ApplicationLabelableObject, Filter: filter},
+			ExpectedError: "scenario cannot be empty",
+		},
+		{
+			Name:          "Invalid target type",
+			Input:         model.ScenarioAssignmentInput{Scenario: "foo", TargetType: "API", Filter: filter},
+			ExpectedError: "invalid target type API",
+		},
+		{
+			Name:          "Empty filter",
+			Input:         model.ScenarioAssignmentInput{Scenario: "foo", TargetType: model.RuntimeLabelableObject},
+			ExpectedError: "filter cannot be empty",
+		},
+		{
+			Name:          "Filter on scenarios",
+			Input:         model.ScenarioAssignmentInput{Scenario: "foo", TargetType: model.RuntimeLabelableObject, Filter: &labelfilter.LabelFilter{Key: "scenarios"}},
+			ExpectedError: "filter cannot use the scenarios label",
+		},
+		{
+			Name: "Nested filter on scenarios",
+			Input: model.ScenarioAssignmentInput{Scenario: "foo", TargetType: model.RuntimeLabelableObject, Filter: &labelfilter.LabelFilter{
+				And: []*labelfilter.LabelFilter{filter, {Not: &labelfilter.LabelFilter{Key: "scenarios"}}},
+			}},
+			ExpectedError: "filter cannot use the scenarios label",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Input.Validate()
+
+			if testCase.ExpectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, testCase.ExpectedError)
+		})
+	}
+}
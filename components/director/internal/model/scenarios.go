@@ -1,5 +1,11 @@
 package model
 
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
 const (
 	ScenariosKey = "scenarios"
 )
@@ -62,3 +68,49 @@ var (
 		},
 	}
 )
+
+// ScenariosFromSchema returns the scenarios enumerated in the schema of the scenarios Label Definition
+func ScenariosFromSchema(schema interface{}) ([]string, error) {
+	marshalled, err := json.Marshal(schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling scenarios schema")
+	}
+
+	var scenariosSchema struct {
+		Items struct {
+			Enum []string `json:"enum"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(marshalled, &scenariosSchema); err != nil {
+		return nil, errors.Wrap(err, "while reading scenarios from schema")
+	}
+
+	return scenariosSchema.Items.Enum, nil
+}
+
+// SchemaWithScenarios returns a copy of the scenarios Label Definition schema which enumerates given scenarios
+func SchemaWithScenarios(schema interface{}, scenarios []string) (interface{}, error) {
+	marshalled, err := json.Marshal(schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling scenarios schema")
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(marshalled, &result); err != nil {
+		return nil, errors.Wrap(err, "while reading scenarios schema")
+	}
+
+	items, ok := result["items"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("scenarios schema does not define items")
+	}
+	items["enum"] = scenarios
+
+	return result, nil
+}
+
+type Scenario struct {
+	Name             string
+	ApplicationCount int
+	RuntimeCount     int
+}
@@ -18,3 +18,62 @@ func Test_SchemaForSchema(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, result.Valid)
 }
+
+func TestScenariosFromSchema(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		scenarios, err := model.ScenariosFromSchema(model.ScenariosSchema)
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []string{"DEFAULT"}, scenarios)
+	})
+
+	t.Run("Success for schema read from database", func(t *testing.T) {
+		// GIVEN
+		schema := map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"type": "string",
+				"enum": []interface{}{"DEFAULT", "foo"},
+			},
+		}
+		// WHEN
+		scenarios, err := model.ScenariosFromSchema(schema)
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []string{"DEFAULT", "foo"}, scenarios)
+	})
+
+	t.Run("Error when enum is not a list of strings", func(t *testing.T) {
+		// GIVEN
+		schema := map[string]interface{}{
+			"items": map[string]interface{}{
+				"enum": []interface{}{1},
+			},
+		}
+		// WHEN
+		_, err := model.ScenariosFromSchema(schema)
+		// THEN
+		require.Error(t, err)
+	})
+}
+
+func TestSchemaWithScenarios(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		schema, err := model.SchemaWithScenarios(model.ScenariosSchema, []string{"DEFAULT", "foo"})
+		// THEN
+		require.NoError(t, err)
+		scenarios, err := model.ScenariosFromSchema(schema)
+		require.NoError(t, err)
+		require.Equal(t, []string{"DEFAULT", "foo"}, scenarios)
+		require.Equal(t, []string{"DEFAULT"}, model.ScenariosSchema["items"].(map[string]interface{})["enum"])
+	})
+
+	t.Run("Error when schema does not define items", func(t *testing.T) {
+		// WHEN
+		_, err := model.SchemaWithScenarios(map[string]interface{}{"type": "array"}, []string{"foo"})
+		// THEN
+		require.EqualError(t, err, "scenarios schema does not define items")
+	})
+}
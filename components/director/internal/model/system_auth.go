@@ -9,6 +9,9 @@ type SystemAuth struct {
 	RuntimeID           *string
 	IntegrationSystemID *string
 	Value               *Auth
+	// ReferenceObjectStatusCondition is the status condition of the referenced Application or Runtime,
+	// it is loaded only when the system auth is fetched globally to authenticate its owner
+	ReferenceObjectStatusCondition string
 }
 
 func (sa SystemAuth) GetReferenceObjectType() (SystemAuthReferenceObjectType, error) {
@@ -18,7 +18,9 @@ type WebhookInput struct {
 type WebhookType string
 
 const (
-	WebhookTypeConfigurationChanged WebhookType = "CONFIGURATION_CHANGED"
+	WebhookTypeConfigurationChanged  WebhookType = "CONFIGURATION_CHANGED"
+	WebhookTypeRegisterApplication   WebhookType = "REGISTER_APPLICATION"
+	WebhookTypeUnregisterApplication WebhookType = "UNREGISTER_APPLICATION"
 )
 
 func (i *WebhookInput) ToWebhook(id, tenant, applicationID string) *Webhook {
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+type WebhookDelivery struct {
+	ID            string
+	Tenant        string
+	WebhookID     string
+	Payload       string
+	Status        WebhookDeliveryStatus
+	AttemptCount  int
+	NextAttemptAt *time.Time
+	CreatedAt     time.Time
+}
+
+type WebhookDeliveryPage struct {
+	Data       []*WebhookDelivery
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+type WebhookDeliveryAttempt struct {
+	ID           string
+	Tenant       string
+	DeliveryID   string
+	Timestamp    time.Time
+	ResponseCode *int
+	Error        *string
+}
+
+type ConfigurationChangeResourceType string
+
+const (
+	ConfigurationChangeResourceTypeApplication ConfigurationChangeResourceType = "APPLICATION"
+	ConfigurationChangeResourceTypeAPI         ConfigurationChangeResourceType = "API"
+	ConfigurationChangeResourceTypeEventAPI    ConfigurationChangeResourceType = "EVENT_API"
+	ConfigurationChangeResourceTypeDocument    ConfigurationChangeResourceType = "DOCUMENT"
+	ConfigurationChangeResourceTypeLabel       ConfigurationChangeResourceType = "LABEL"
+	ConfigurationChangeResourceTypeScenarios   ConfigurationChangeResourceType = "SCENARIOS"
+)
+
+type ConfigurationChangeOperation string
+
+const (
+	ConfigurationChangeOperationCreated ConfigurationChangeOperation = "CREATED"
+	ConfigurationChangeOperationUpdated ConfigurationChangeOperation = "UPDATED"
+	ConfigurationChangeOperationDeleted ConfigurationChangeOperation = "DELETED"
+)
+
+// ConfigurationChange describes the change of the Application reported to its CONFIGURATION_CHANGED Webhooks
+type ConfigurationChange struct {
+	ResourceType ConfigurationChangeResourceType
+	ResourceID   string
+	Operation    ConfigurationChangeOperation
+}
+
+// NewLabelConfigurationChange returns the change of the Application label; change of the scenarios label is reported as the change of scenario assignment
+func NewLabelConfigurationChange(key string, operation ConfigurationChangeOperation) ConfigurationChange {
+	resourceType := ConfigurationChangeResourceTypeLabel
+	if key == ScenariosKey {
+		resourceType = ConfigurationChangeResourceTypeScenarios
+	}
+
+	return ConfigurationChange{
+		ResourceType: resourceType,
+		ResourceID:   key,
+		Operation:    operation,
+	}
+}
@@ -0,0 +1,46 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLabelConfigurationChange(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Key      string
+		Expected model.ConfigurationChange
+	}{
+		{
+			Name: "Label",
+			Key:  "foo",
+			Expected: model.ConfigurationChange{
+				ResourceType: model.ConfigurationChangeResourceTypeLabel,
+				ResourceID:   "foo",
+				Operation:    model.ConfigurationChangeOperationUpdated,
+			},
+		},
+		{
+			Name: "Scenarios",
+			Key:  model.ScenariosKey,
+			Expected: model.ConfigurationChange{
+				ResourceType: model.ConfigurationChangeResourceTypeScenarios,
+				ResourceID:   model.ScenariosKey,
+				Operation:    model.ConfigurationChangeOperationUpdated,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			result := model.NewLabelConfigurationChange(testCase.Key, model.ConfigurationChangeOperationUpdated)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
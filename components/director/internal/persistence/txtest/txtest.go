@@ -89,3 +89,37 @@ func (g txCtxGenerator) ThatDoesntStartTransaction() (*automock.PersistenceTx, *
 
 	return persistTx, transact
 }
+
+func (g txCtxGenerator) ThatSucceedsMultipleTimes(times int) (*automock.PersistenceTx, *automock.Transactioner) {
+	persistTx := &automock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Times(times)
+
+	transact := &automock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Times(times)
+	transact.On("RollbackUnlessCommited", persistTx).Return().Times(times)
+
+	return persistTx, transact
+}
+
+func (g txCtxGenerator) ThatDoesntExpectCommitAfterSucceeding(times int) (*automock.PersistenceTx, *automock.Transactioner) {
+	persistTx := &automock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Times(times)
+
+	transact := &automock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Times(times + 1)
+	transact.On("RollbackUnlessCommited", persistTx).Return().Times(times + 1)
+
+	return persistTx, transact
+}
+
+func (g txCtxGenerator) ThatFailsOnCommitAfterSucceeding(times int) (*automock.PersistenceTx, *automock.Transactioner) {
+	persistTx := &automock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Times(times)
+	persistTx.On("Commit").Return(g.returnedError).Once()
+
+	transact := &automock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Times(times + 1)
+	transact.On("RollbackUnlessCommited", persistTx).Return().Times(times + 1)
+
+	return persistTx, transact
+}
@@ -14,10 +14,12 @@ import (
 
 type PageableQuerier interface {
 	List(ctx context.Context, tenant string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...string) (*pagination.Page, int, error)
+	ListWithArgs(ctx context.Context, tenant string, pageSize int, cursor string, orderByColumn string, dest Collection, conditions []string, args []interface{}) (*pagination.Page, int, error)
 }
 
 type PageableQuerierGlobal interface {
 	ListGlobal(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...string) (*pagination.Page, int, error)
+	ListGlobalWithArgs(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection, conditions []string, args []interface{}) (*pagination.Page, int, error)
 }
 
 type universalPageableQuerier struct {
@@ -47,14 +49,26 @@ type Collection interface {
 
 // List returns Page, TotalCount or error
 func (g *universalPageableQuerier) List(ctx context.Context, tenant string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...string) (*pagination.Page, int, error) {
-	return g.unsafeList(ctx, str.Ptr(tenant), pageSize, cursor, orderByColumn, dest, additionalConditions...)
+	return g.unsafeList(ctx, str.Ptr(tenant), pageSize, cursor, orderByColumn, dest, additionalConditions, nil)
+}
+
+// ListWithArgs works like List, but the conditions can contain placeholders for the given args.
+// The tenant is always the first argument, so the placeholders of the conditions start from $2.
+func (g *universalPageableQuerier) ListWithArgs(ctx context.Context, tenant string, pageSize int, cursor string, orderByColumn string, dest Collection, conditions []string, args []interface{}) (*pagination.Page, int, error) {
+	return g.unsafeList(ctx, str.Ptr(tenant), pageSize, cursor, orderByColumn, dest, conditions, args)
 }
 
 func (g *universalPageableQuerier) ListGlobal(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...string) (*pagination.Page, int, error) {
-	return g.unsafeList(ctx, nil, pageSize, cursor, orderByColumn, dest, additionalConditions...)
+	return g.unsafeList(ctx, nil, pageSize, cursor, orderByColumn, dest, additionalConditions, nil)
+}
+
+// ListGlobalWithArgs works like ListGlobal, but the conditions can contain placeholders for the given args,
+// which start from $1.
+func (g *universalPageableQuerier) ListGlobalWithArgs(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection, conditions []string, args []interface{}) (*pagination.Page, int, error) {
+	return g.unsafeList(ctx, nil, pageSize, cursor, orderByColumn, dest, conditions, args)
 }
 
-func (g *universalPageableQuerier) unsafeList(ctx context.Context, tenant *string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...string) (*pagination.Page, int, error) {
+func (g *universalPageableQuerier) unsafeList(ctx context.Context, tenant *string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions []string, conditionArgs []interface{}) (*pagination.Page, int, error) {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
 		return nil, -1, err
@@ -77,6 +91,7 @@ func (g *universalPageableQuerier) unsafeList(ctx context.Context, tenant *strin
 	if tenant != nil {
 		args = append(args, *tenant)
 	}
+	args = append(args, conditionArgs...)
 
 	err = persist.Select(dest, stmtWithPagination, args...)
 	if err != nil {
@@ -141,6 +141,25 @@ func TestListPageable(t *testing.T) {
 		assert.NotEmpty(t, actualPage.EndCursor)
 	})
 
+	t.Run("returns page with conditions using args", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "tenant_col", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_col, first_name, last_name, age FROM users WHERE tenant_col=$1 AND first_name=$2 AND age > $3 ORDER BY id_col LIMIT 2 OFFSET 0`)).WithArgs(givenTenant, "Peter", 18).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_col=$1 AND first_name=$2 AND age > $3`)).WithArgs(givenTenant, "Peter", 18).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		actualPage, actualTotal, err := sut.ListWithArgs(ctx, givenTenant, 2, "", "id_col", &dest, []string{"first_name=$2", "age > $3"}, []interface{}{"Peter", 18})
+		require.NoError(t, err)
+		assert.Equal(t, 100, actualTotal)
+		assert.Len(t, dest, 1)
+		assert.True(t, actualPage.HasNextPage)
+		assert.NotEmpty(t, actualPage.EndCursor)
+	})
+
 	t.Run("returns empty page", func(t *testing.T) {
 		db, mock := testdb.MockDatabase(t)
 		defer mock.AssertExpectations(t)
@@ -327,6 +346,25 @@ func TestListPageableGlobal(t *testing.T) {
 		assert.NotEmpty(t, actualPage.EndCursor)
 	})
 
+	t.Run("returns page with conditions using args", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, first_name, last_name, age FROM users WHERE first_name=$1 AND age > $2 ORDER BY id_col LIMIT 2 OFFSET 0`)).WithArgs("Peter", 18).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE first_name=$1 AND age > $2`)).WithArgs("Peter", 18).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		actualPage, actualTotal, err := sut.ListGlobalWithArgs(ctx, 2, "", "id_col", &dest, []string{"first_name=$1", "age > $2"}, []interface{}{"Peter", 18})
+		require.NoError(t, err)
+		assert.Equal(t, 100, actualTotal)
+		assert.Len(t, dest, 1)
+		assert.True(t, actualPage.HasNextPage)
+		assert.NotEmpty(t, actualPage.EndCursor)
+	})
+
 	t.Run("returns empty page", func(t *testing.T) {
 		db, mock := testdb.MockDatabase(t)
 		defer mock.AssertExpectations(t)
@@ -35,6 +35,15 @@ func NewValidNullableBool(boolean bool) sql.NullBool {
 	}
 }
 
+func NewNullableInt(integer *int) sql.NullInt64 {
+	var sqlInt sql.NullInt64
+	if integer != nil {
+		sqlInt = sql.NullInt64{Valid: true, Int64: int64(*integer)}
+	}
+
+	return sqlInt
+}
+
 func StringPtrFromNullableString(sqlString sql.NullString) *string {
 	if sqlString.Valid {
 		return &sqlString.String
@@ -49,3 +58,11 @@ func BoolPtrFromNullableBool(sqlBool sql.NullBool) *bool {
 	}
 	return nil
 }
+
+func IntPtrFromNullableInt(sqlInt sql.NullInt64) *int {
+	if sqlInt.Valid {
+		integer := int(sqlInt.Int64)
+		return &integer
+	}
+	return nil
+}
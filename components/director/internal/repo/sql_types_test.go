@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,3 +54,39 @@ func TestNewNullableString(t *testing.T) {
 		assert.False(t, result.Valid)
 	})
 }
+
+func TestNewNullableInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		//GIVEN
+		input := 3
+		//WHEN
+		result := NewNullableInt(&input)
+		//THEN
+		assert.True(t, result.Valid)
+		assert.Equal(t, int64(input), result.Int64)
+	})
+
+	t.Run("return not valid when nil int", func(t *testing.T) {
+		//WHEN
+		result := NewNullableInt(nil)
+		//THEN
+		assert.False(t, result.Valid)
+	})
+}
+
+func TestIntPtrFromNullableInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		//WHEN
+		result := IntPtrFromNullableInt(sql.NullInt64{Valid: true, Int64: 3})
+		//THEN
+		assert.NotNil(t, result)
+		assert.Equal(t, 3, *result)
+	})
+
+	t.Run("return nil when not valid", func(t *testing.T) {
+		//WHEN
+		result := IntPtrFromNullableInt(sql.NullInt64{})
+		//THEN
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,38 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIService is an autogenerated mock type for the APIService type
+type APIService struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *APIService) Get(ctx context.Context, id string) (*model.APIDefinition, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.APIDefinition
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.APIDefinition); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIDefinition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,201 @@
+package specdownload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/specformat"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	IDVar       = "id"
+	FormatParam = "format"
+
+	apiScopesDefinition      = "graphql.query.api"
+	eventAPIScopesDefinition = "graphql.query.eventAPI"
+)
+
+//go:generate mockery -name=APIService -output=automock -outpkg=automock -case=underscore
+type APIService interface {
+	Get(ctx context.Context, id string) (*model.APIDefinition, error)
+}
+
+//go:generate mockery -name=EventAPIService -output=automock -outpkg=automock -case=underscore
+type EventAPIService interface {
+	Get(ctx context.Context, id string) (*model.EventAPIDefinition, error)
+}
+
+//go:generate mockery -name=ScopesGetter -output=automock -outpkg=automock -case=underscore
+type ScopesGetter interface {
+	GetRequiredScopes(scopesDefinition string) ([]string, error)
+}
+
+type spec struct {
+	data   *string
+	format model.SpecFormat
+}
+
+type Handler struct {
+	transact     persistence.Transactioner
+	apiSvc       APIService
+	eventAPISvc  EventAPIService
+	scopesGetter ScopesGetter
+}
+
+func NewHandler(transact persistence.Transactioner, apiSvc APIService, eventAPISvc EventAPIService, scopesGetter ScopesGetter) *Handler {
+	return &Handler{
+		transact:     transact,
+		apiSvc:       apiSvc,
+		eventAPISvc:  eventAPISvc,
+		scopesGetter: scopesGetter,
+	}
+}
+
+// APISpec writes raw spec of the API Definition with ID given in the request path
+func (h *Handler) APISpec(writer http.ResponseWriter, req *http.Request) {
+	h.serveSpec(writer, req, apiScopesDefinition, func(ctx context.Context, id string) (*spec, error) {
+		api, err := h.apiSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if api.Spec == nil {
+			return nil, nil
+		}
+		return &spec{data: api.Spec.Data, format: api.Spec.Format}, nil
+	})
+}
+
+// EventAPISpec writes raw spec of the Event API Definition with ID given in the request path
+func (h *Handler) EventAPISpec(writer http.ResponseWriter, req *http.Request) {
+	h.serveSpec(writer, req, eventAPIScopesDefinition, func(ctx context.Context, id string) (*spec, error) {
+		eventAPI, err := h.eventAPISvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if eventAPI.Spec == nil {
+			return nil, nil
+		}
+		return &spec{data: eventAPI.Spec.Data, format: eventAPI.Spec.Format}, nil
+	})
+}
+
+func (h *Handler) serveSpec(writer http.ResponseWriter, req *http.Request, scopesDefinition string, getSpecFn func(ctx context.Context, id string) (*spec, error)) {
+	if req.Method != http.MethodGet {
+		http.Error(writer, fmt.Sprintf("Bad request method. Got %s, expected GET", req.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := h.verifyScopes(req.Context(), scopesDefinition)
+	if err != nil {
+		respondWithError(writer, http.StatusForbidden, err, "while verifying scopes")
+		return
+	}
+
+	tnt, err := tenant.LoadFromContext(req.Context())
+	if err != nil || tnt == "" {
+		http.Error(writer, "Tenant is required", http.StatusForbidden)
+		return
+	}
+
+	tx, err := h.transact.Begin()
+	if err != nil {
+		respondWithError(writer, http.StatusInternalServerError, err, "while opening the db transaction")
+		return
+	}
+	defer h.transact.RollbackUnlessCommited(tx)
+
+	ctx := persistence.SaveToContext(req.Context(), tx)
+
+	id := mux.Vars(req)[IDVar]
+	s, err := getSpecFn(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			http.Error(writer, err.Error(), http.StatusNotFound)
+			return
+		}
+		respondWithError(writer, http.StatusInternalServerError, err, "while getting spec")
+		return
+	}
+
+	if s == nil || s.data == nil {
+		http.Error(writer, fmt.Sprintf("Spec for %s not found", id), http.StatusNotFound)
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		respondWithError(writer, http.StatusInternalServerError, err, "while committing the db transaction")
+		return
+	}
+
+	data, format := *s.data, s.format
+	if requestedFormat := req.URL.Query().Get(FormatParam); requestedFormat != "" {
+		format = model.SpecFormat(strings.ToUpper(requestedFormat))
+		data, err = specformat.Convert(data, s.format, format)
+		if err != nil {
+			respondWithError(writer, http.StatusBadRequest, err, "while converting spec")
+			return
+		}
+	}
+
+	etag := computeETag(data)
+	writer.Header().Set("ETag", etag)
+	if req.Header.Get("If-None-Match") == etag {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	writer.Header().Set("Content-Type", specformat.ContentType(format))
+	_, err = writer.Write([]byte(data))
+	if err != nil {
+		log.Error(errors.Wrap(err, "while writing spec"))
+	}
+}
+
+func (h *Handler) verifyScopes(ctx context.Context, scopesDefinition string) error {
+	actualScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	requiredScopes, err := h.scopesGetter.GetRequiredScopes(scopesDefinition)
+	if err != nil {
+		return errors.Wrap(err, "while getting required scopes")
+	}
+
+	actual := make(map[string]struct{}, len(actualScopes))
+	for _, s := range actualScopes {
+		actual[s] = struct{}{}
+	}
+	for _, s := range requiredScopes {
+		if _, ok := actual[s]; !ok {
+			return scope.InsufficientScopesError(requiredScopes, actualScopes)
+		}
+	}
+
+	return nil
+}
+
+func computeETag(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:]))
+}
+
+func respondWithError(writer http.ResponseWriter, httpErrorCode int, err error, wrapperStr string) {
+	wrappedErr := errors.Wrap(err, wrapperStr)
+	log.Error(wrappedErr)
+
+	http.Error(writer, wrappedErr.Error(), httpErrorCode)
+}
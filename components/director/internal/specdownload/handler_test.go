@@ -0,0 +1,276 @@
+package specdownload_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/internal/specdownload"
+	"github.com/kyma-incubator/compass/components/director/internal/specdownload/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTenant = "tenant"
+	testID     = "foo"
+	specData   = "openapi: 3.0.0\n"
+	specETag   = `"344e4b2f7f15b76b5606be45d8031fc43f473f8d63f0e02c61dbf89a97f85e69"`
+)
+
+func TestHandler_APISpec(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	data := specData
+	api := &model.APIDefinition{ID: testID, Spec: &model.APISpec{Data: &data, Format: model.SpecFormatYaml, Type: model.APISpecTypeOpenAPI}}
+
+	testCases := []struct {
+		Name                string
+		TxFn                func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn           func() *automock.APIService
+		ScopesGetterFn      func() *automock.ScopesGetter
+		Method              string
+		Query               string
+		Tenant              *string
+		Scopes              []string
+		IfNoneMatch         string
+		ExpectedStatus      int
+		ExpectedBody        string
+		ExpectedContentType string
+	}{
+		{
+			Name:                "Success",
+			TxFn:                txGen.ThatSucceeds,
+			ServiceFn:           fixAPIServiceThatReturns(api, nil),
+			ScopesGetterFn:      fixScopesGetter(),
+			ExpectedStatus:      http.StatusOK,
+			ExpectedBody:        specData,
+			ExpectedContentType: "application/x-yaml",
+		},
+		{
+			Name:                "Success in requested format",
+			TxFn:                txGen.ThatSucceeds,
+			ServiceFn:           fixAPIServiceThatReturns(api, nil),
+			ScopesGetterFn:      fixScopesGetter(),
+			Query:               "?format=JSON",
+			ExpectedStatus:      http.StatusOK,
+			ExpectedBody:        "{\n  \"openapi\": \"3.0.0\"\n}",
+			ExpectedContentType: "application/json",
+		},
+		{
+			Name:                "Success in requested format written in lowercase",
+			TxFn:                txGen.ThatSucceeds,
+			ServiceFn:           fixAPIServiceThatReturns(api, nil),
+			ScopesGetterFn:      fixScopesGetter(),
+			Query:               "?format=json",
+			ExpectedStatus:      http.StatusOK,
+			ExpectedBody:        "{\n  \"openapi\": \"3.0.0\"\n}",
+			ExpectedContentType: "application/json",
+		},
+		{
+			Name:           "Not modified when ETag matches",
+			TxFn:           txGen.ThatSucceeds,
+			ServiceFn:      fixAPIServiceThatReturns(api, nil),
+			ScopesGetterFn: fixScopesGetter(),
+			IfNoneMatch:    specETag,
+			ExpectedStatus: http.StatusNotModified,
+		},
+		{
+			Name:           "Returns bad request when conversion is not supported",
+			TxFn:           txGen.ThatSucceeds,
+			ServiceFn:      fixAPIServiceThatReturns(api, nil),
+			ScopesGetterFn: fixScopesGetter(),
+			Query:          "?format=XML",
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   "while converting spec: conversion of spec from YAML to XML is not supported\n",
+		},
+		{
+			Name:           "Returns not found when API Definition does not exist",
+			TxFn:           txGen.ThatDoesntExpectCommit,
+			ServiceFn:      fixAPIServiceThatReturns(nil, apperrors.NewNotFoundError(testID)),
+			ScopesGetterFn: fixScopesGetter(),
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "Returns not found when API Definition has no spec",
+			TxFn:           txGen.ThatDoesntExpectCommit,
+			ServiceFn:      fixAPIServiceThatReturns(&model.APIDefinition{ID: testID}, nil),
+			ScopesGetterFn: fixScopesGetter(),
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   "Spec for foo not found\n",
+		},
+		{
+			Name:           "Returns internal server error when getting API Definition failed",
+			TxFn:           txGen.ThatDoesntExpectCommit,
+			ServiceFn:      fixAPIServiceThatReturns(nil, testErr),
+			ScopesGetterFn: fixScopesGetter(),
+			ExpectedStatus: http.StatusInternalServerError,
+			ExpectedBody:   "while getting spec: test error\n",
+		},
+		{
+			Name:           "Returns internal server error when beginning transaction failed",
+			TxFn:           txGen.ThatFailsOnBegin,
+			ServiceFn:      fixEmptyAPIService,
+			ScopesGetterFn: fixScopesGetter(),
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+		{
+			Name:           "Returns internal server error when committing transaction failed",
+			TxFn:           txGen.ThatFailsOnCommit,
+			ServiceFn:      fixAPIServiceThatReturns(api, nil),
+			ScopesGetterFn: fixScopesGetter(),
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+		{
+			Name:           "Returns forbidden when tenant is missing",
+			TxFn:           txGen.ThatDoesntStartTransaction,
+			ServiceFn:      fixEmptyAPIService,
+			ScopesGetterFn: fixScopesGetter(),
+			Tenant:         strPtr(""),
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedBody:   "Tenant is required\n",
+		},
+		{
+			Name:           "Returns forbidden when scopes are insufficient",
+			TxFn:           txGen.ThatDoesntStartTransaction,
+			ServiceFn:      fixEmptyAPIService,
+			ScopesGetterFn: fixScopesGetter(),
+			Scopes:         []string{"runtime:read"},
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "Returns method not allowed for POST",
+			TxFn:           txGen.ThatDoesntStartTransaction,
+			ServiceFn:      fixEmptyAPIService,
+			ScopesGetterFn: func() *automock.ScopesGetter { return &automock.ScopesGetter{} },
+			Method:         http.MethodPost,
+			ExpectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			scopesGetter := testCase.ScopesGetterFn()
+
+			handler := specdownload.NewHandler(transact, svc, nil, scopesGetter)
+
+			method := testCase.Method
+			if method == "" {
+				method = http.MethodGet
+			}
+			tnt := testTenant
+			if testCase.Tenant != nil {
+				tnt = *testCase.Tenant
+			}
+			scopes := testCase.Scopes
+			if scopes == nil {
+				scopes = []string{"application:read"}
+			}
+
+			req := httptest.NewRequest(method, "/specs/api/"+testID+testCase.Query, nil)
+			req = req.WithContext(scope.SaveToContext(tenant.SaveToContext(context.TODO(), tnt), scopes))
+			req = mux.SetURLVars(req, map[string]string{specdownload.IDVar: testID})
+			if testCase.IfNoneMatch != "" {
+				req.Header.Set("If-None-Match", testCase.IfNoneMatch)
+			}
+			w := httptest.NewRecorder()
+
+			// WHEN
+			handler.APISpec(w, req)
+
+			// THEN
+			resp := w.Result()
+			assert.Equal(t, testCase.ExpectedStatus, resp.StatusCode)
+			if testCase.ExpectedBody != "" {
+				body, err := ioutil.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedBody, string(body))
+			}
+			if testCase.ExpectedContentType != "" {
+				assert.Equal(t, testCase.ExpectedContentType, resp.Header.Get("Content-Type"))
+				assert.NotEmpty(t, resp.Header.Get("ETag"))
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			scopesGetter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_EventAPISpec(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+	persist, transact := txGen.ThatSucceeds()
+
+	data := `{"asyncapi": "2.0.0"}`
+	eventAPI := &model.EventAPIDefinition{ID: testID, Spec: &model.EventAPISpec{Data: &data, Format: model.SpecFormatJSON, Type: model.EventAPISpecTypeAsyncAPI}}
+
+	svc := &automock.EventAPIService{}
+	svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(eventAPI, nil).Once()
+
+	scopesGetter := &automock.ScopesGetter{}
+	scopesGetter.On("GetRequiredScopes", "graphql.query.eventAPI").Return([]string{"application:read"}, nil).Once()
+
+	handler := specdownload.NewHandler(transact, nil, svc, scopesGetter)
+
+	req := httptest.NewRequest(http.MethodGet, "/specs/eventapi/"+testID, nil)
+	req = req.WithContext(scope.SaveToContext(tenant.SaveToContext(context.TODO(), testTenant), []string{"application:read"}))
+	req = mux.SetURLVars(req, map[string]string{specdownload.IDVar: testID})
+	w := httptest.NewRecorder()
+
+	// WHEN
+	handler.EventAPISpec(w, req)
+
+	// THEN
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, data, string(body))
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	persist.AssertExpectations(t)
+	transact.AssertExpectations(t)
+	svc.AssertExpectations(t)
+	scopesGetter.AssertExpectations(t)
+}
+
+func fixAPIServiceThatReturns(api *model.APIDefinition, err error) func() *automock.APIService {
+	return func() *automock.APIService {
+		svc := &automock.APIService{}
+		svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(api, err).Once()
+		return svc
+	}
+}
+
+func fixEmptyAPIService() *automock.APIService {
+	return &automock.APIService{}
+}
+
+func fixScopesGetter() func() *automock.ScopesGetter {
+	return func() *automock.ScopesGetter {
+		scopesGetter := &automock.ScopesGetter{}
+		scopesGetter.On("GetRequiredScopes", "graphql.query.api").Return([]string{"application:read"}, nil).Once()
+		return scopesGetter
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
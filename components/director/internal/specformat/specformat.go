@@ -0,0 +1,57 @@
+package specformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+// Convert converts spec data between JSON and YAML. XML specs can be returned only in their original format.
+func Convert(data string, from, to model.SpecFormat) (string, error) {
+	if from == to {
+		return data, nil
+	}
+
+	switch {
+	case from == model.SpecFormatYaml && to == model.SpecFormatJSON:
+		jsonData, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			return "", errors.Wrapf(err, "while converting %s spec to %s", from, to)
+		}
+
+		var out bytes.Buffer
+		err = json.Indent(&out, jsonData, "", "  ")
+		if err != nil {
+			return "", errors.Wrapf(err, "while indenting %s spec", to)
+		}
+
+		return out.String(), nil
+	case from == model.SpecFormatJSON && to == model.SpecFormatYaml:
+		yamlData, err := yaml.JSONToYAML([]byte(data))
+		if err != nil {
+			return "", errors.Wrapf(err, "while converting %s spec to %s", from, to)
+		}
+
+		return string(yamlData), nil
+	}
+
+	return "", fmt.Errorf("conversion of spec from %s to %s is not supported", from, to)
+}
+
+// ContentType returns MIME type of spec in given format
+func ContentType(format model.SpecFormat) string {
+	switch format {
+	case model.SpecFormatJSON:
+		return "application/json"
+	case model.SpecFormatYaml:
+		return "application/x-yaml"
+	case model.SpecFormatXML:
+		return "application/xml"
+	}
+
+	return "text/plain"
+}
@@ -0,0 +1,92 @@
+package specformat_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specformat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	// GIVEN
+	yamlSpec := "info:\n  title: Pets\nopenapi: 3.0.0\n"
+	jsonSpec := "{\n  \"info\": {\n    \"title\": \"Pets\"\n  },\n  \"openapi\": \"3.0.0\"\n}"
+
+	testCases := []struct {
+		Name               string
+		Data               string
+		From               model.SpecFormat
+		To                 model.SpecFormat
+		Expected           string
+		ExpectedErrMessage string
+	}{
+		{
+			Name:     "YAML to JSON",
+			Data:     yamlSpec,
+			From:     model.SpecFormatYaml,
+			To:       model.SpecFormatJSON,
+			Expected: jsonSpec,
+		},
+		{
+			Name:     "JSON to YAML",
+			Data:     `{"openapi": "3.0.0", "info": {"title": "Pets"}}`,
+			From:     model.SpecFormatJSON,
+			To:       model.SpecFormatYaml,
+			Expected: yamlSpec,
+		},
+		{
+			Name:     "Same format returns data untouched",
+			Data:     "<xml></xml>",
+			From:     model.SpecFormatXML,
+			To:       model.SpecFormatXML,
+			Expected: "<xml></xml>",
+		},
+		{
+			Name:               "Returns error when YAML is invalid",
+			Data:               "info: [",
+			From:               model.SpecFormatYaml,
+			To:                 model.SpecFormatJSON,
+			ExpectedErrMessage: "while converting YAML spec to JSON",
+		},
+		{
+			Name:               "Returns error when JSON is invalid",
+			Data:               "{",
+			From:               model.SpecFormatJSON,
+			To:                 model.SpecFormatYaml,
+			ExpectedErrMessage: "while converting JSON spec to YAML",
+		},
+		{
+			Name:               "Returns error when converting XML",
+			Data:               "<xml></xml>",
+			From:               model.SpecFormatXML,
+			To:                 model.SpecFormatJSON,
+			ExpectedErrMessage: "conversion of spec from XML to JSON is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result, err := specformat.Convert(testCase.Data, testCase.From, testCase.To)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestContentType(t *testing.T) {
+	assert.Equal(t, "application/json", specformat.ContentType(model.SpecFormatJSON))
+	assert.Equal(t, "application/x-yaml", specformat.ContentType(model.SpecFormatYaml))
+	assert.Equal(t, "application/xml", specformat.ContentType(model.SpecFormatXML))
+	assert.Equal(t, "text/plain", specformat.ContentType(""))
+}
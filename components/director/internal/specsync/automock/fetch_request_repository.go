@@ -0,0 +1,91 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// FetchRequestRepository is an autogenerated mock type for the FetchRequestRepository type
+type FetchRequestRepository struct {
+	mock.Mock
+}
+
+// LockIfSyncStartedAt provides a mock function with given fields: ctx, tenant, id, startedAt
+func (_m *FetchRequestRepository) LockIfSyncStartedAt(ctx context.Context, tenant string, id string, startedAt time.Time) (*model.FetchRequest, error) {
+	ret := _m.Called(ctx, tenant, id, startedAt)
+
+	var r0 *model.FetchRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *model.FetchRequest); ok {
+		r0 = rf(ctx, tenant, id, startedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FetchRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, tenant, id, startedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockNextForSync provides a mock function with given fields: ctx, checkedBefore, startedBefore
+func (_m *FetchRequestRepository) LockNextForSync(ctx context.Context, checkedBefore time.Time, startedBefore time.Time) (*model.FetchRequest, error) {
+	ret := _m.Called(ctx, checkedBefore, startedBefore)
+
+	var r0 *model.FetchRequest
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) *model.FetchRequest); ok {
+		r0 = rf(ctx, checkedBefore, startedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FetchRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, checkedBefore, startedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkSyncStarted provides a mock function with given fields: ctx, tenant, id, startedAt
+func (_m *FetchRequestRepository) MarkSyncStarted(ctx context.Context, tenant string, id string, startedAt time.Time) error {
+	ret := _m.Called(ctx, tenant, id, startedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, tenant, id, startedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *FetchRequestRepository) Update(ctx context.Context, item *model.FetchRequest) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FetchRequest) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
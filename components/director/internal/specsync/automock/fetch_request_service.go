@@ -0,0 +1,40 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FetchRequestService is an autogenerated mock type for the FetchRequestService type
+type FetchRequestService struct {
+	mock.Mock
+}
+
+// FetchSpec provides a mock function with given fields: ctx, fr
+func (_m *FetchRequestService) FetchSpec(ctx context.Context, fr *model.FetchRequest) (*string, *model.FetchRequestStatus) {
+	ret := _m.Called(ctx, fr)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FetchRequest) *string); ok {
+		r0 = rf(ctx, fr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 *model.FetchRequestStatus
+	if rf, ok := ret.Get(1).(func(context.Context, *model.FetchRequest) *model.FetchRequestStatus); ok {
+		r1 = rf(ctx, fr)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.FetchRequestStatus)
+		}
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,8 @@
+package specsync
+
+import "time"
+
+type Config struct {
+	Period        time.Duration `envconfig:"default=1h"`
+	LeaseDuration time.Duration `envconfig:"default=5m"`
+}
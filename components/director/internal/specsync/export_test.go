@@ -0,0 +1,7 @@
+package specsync
+
+import "time"
+
+func (s *synchronizer) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
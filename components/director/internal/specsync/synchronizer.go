@@ -0,0 +1,404 @@
+package specsync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=FetchRequestRepository -output=automock -outpkg=automock -case=underscore
+type FetchRequestRepository interface {
+	LockNextForSync(ctx context.Context, checkedBefore, startedBefore time.Time) (*model.FetchRequest, error)
+	MarkSyncStarted(ctx context.Context, tenant, id string, startedAt time.Time) error
+	LockIfSyncStartedAt(ctx context.Context, tenant, id string, startedAt time.Time) (*model.FetchRequest, error)
+	Update(ctx context.Context, item *model.FetchRequest) error
+}
+
+//go:generate mockery -name=FetchRequestService -output=automock -outpkg=automock -case=underscore
+type FetchRequestService interface {
+	FetchSpec(ctx context.Context, fr *model.FetchRequest) (*string, *model.FetchRequestStatus)
+}
+
+//go:generate mockery -name=APIRepository -output=automock -outpkg=automock -case=underscore
+type APIRepository interface {
+	GetByID(ctx context.Context, tenantID, id string) (*model.APIDefinition, error)
+	Update(ctx context.Context, item *model.APIDefinition) error
+}
+
+//go:generate mockery -name=EventAPIRepository -output=automock -outpkg=automock -case=underscore
+type EventAPIRepository interface {
+	GetByID(ctx context.Context, tenantID, id string) (*model.EventAPIDefinition, error)
+	Update(ctx context.Context, item *model.EventAPIDefinition) error
+}
+
+//go:generate mockery -name=DocumentRepository -output=automock -outpkg=automock -case=underscore
+type DocumentRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Document, error)
+	Update(ctx context.Context, item *model.Document) error
+}
+
+//go:generate mockery -name=WebhookNotifier -output=automock -outpkg=automock -case=underscore
+type WebhookNotifier interface {
+	NotifyConfigurationChanged(ctx context.Context, applicationID string, change model.ConfigurationChange) error
+}
+
+// invalidSpecError marks fetched content which was rejected by spec validation
+type invalidSpecError struct {
+	error
+}
+
+type synchronizer struct {
+	transact            persistence.Transactioner
+	fetchRequestRepo    FetchRequestRepository
+	fetchRequestService FetchRequestService
+	apiRepo             APIRepository
+	eventAPIRepo        EventAPIRepository
+	documentRepo        DocumentRepository
+	notifier            WebhookNotifier
+	period              time.Duration
+	leaseDuration       time.Duration
+	timestampGen        timestamp.Generator
+}
+
+func NewSynchronizer(transact persistence.Transactioner, fetchRequestRepo FetchRequestRepository, fetchRequestService FetchRequestService, apiRepo APIRepository, eventAPIRepo EventAPIRepository, documentRepo DocumentRepository, notifier WebhookNotifier, period, leaseDuration time.Duration) *synchronizer {
+	return &synchronizer{
+		transact:            transact,
+		fetchRequestRepo:    fetchRequestRepo,
+		fetchRequestService: fetchRequestService,
+		apiRepo:             apiRepo,
+		eventAPIRepo:        eventAPIRepo,
+		documentRepo:        documentRepo,
+		notifier:            notifier,
+		period:              period,
+		leaseDuration:       leaseDuration,
+		timestampGen:        timestamp.DefaultGenerator(),
+	}
+}
+
+// SynchronizeAll re-fetches every Fetch Request and stores the fetched content if it differs from the stored one.
+// Fetch Requests checked during the last half of the period are skipped, so that they are not processed again
+// by other director replicas running the same cycle at a slightly different time.
+//
+// No transaction is open while the content is fetched. Instead, the Fetch Request is claimed for the lease duration beforehand,
+// and the result is stored only if the Fetch Request was not claimed by another replica in the meantime.
+//
+// A Fetch Request which fails to synchronize is marked as failed and checked, so that it does not block the other ones,
+// and all such failures are returned together after the cycle.
+func (s *synchronizer) SynchronizeAll(ctx context.Context) error {
+	checkedBefore := s.timestampGen().Add(-s.period / 2)
+
+	var failures []string
+	for {
+		fr, startedAt, err := s.synchronizeNext(ctx, checkedBefore)
+		if err != nil {
+			if fr == nil {
+				return err
+			}
+
+			log.Errorf("Synchronizing FetchRequest with ID %s failed: %s", fr.ID, err)
+			failures = append(failures, err.Error())
+
+			err = s.markFailed(ctx, fr, startedAt, err)
+			if err != nil {
+				return errors.Wrapf(err, "while marking FetchRequest with ID %s as failed", fr.ID)
+			}
+			continue
+		}
+		if fr == nil {
+			break
+		}
+	}
+
+	if len(failures) > 0 {
+		return errors.Errorf("synchronizing %d FetchRequests failed: [%s]", len(failures), strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// synchronizeNext returns the processed Fetch Request as it was claimed together with the time of the claim, or nil if there is nothing left to synchronize.
+// If the error is returned together with the Fetch Request, the Fetch Request was claimed, but storing its result failed.
+func (s *synchronizer) synchronizeNext(ctx context.Context, checkedBefore time.Time) (*model.FetchRequest, time.Time, error) {
+	fr, startedAt, err := s.claimNext(ctx, checkedBefore)
+	if err != nil || fr == nil {
+		return nil, startedAt, err
+	}
+
+	data, status := s.fetchRequestService.FetchSpec(ctx, fr)
+
+	err = s.storeResult(ctx, fr, startedAt, data, status)
+	if err != nil {
+		return fr, startedAt, errors.Wrapf(err, "while synchronizing FetchRequest with ID %s", fr.ID)
+	}
+
+	return fr, startedAt, nil
+}
+
+func (s *synchronizer) claimNext(ctx context.Context, checkedBefore time.Time) (*model.FetchRequest, time.Time, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "while opening transaction")
+	}
+	defer s.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	startedAt := s.timestampGen()
+	fr, err := s.fetchRequestRepo.LockNextForSync(ctx, checkedBefore, startedAt.Add(-s.leaseDuration))
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, time.Time{}, nil
+		}
+		return nil, time.Time{}, errors.Wrap(err, "while getting FetchRequest to synchronize")
+	}
+
+	err = s.fetchRequestRepo.MarkSyncStarted(ctx, fr.Tenant, fr.ID, startedAt)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrapf(err, "while claiming FetchRequest with ID %s", fr.ID)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "while committing transaction")
+	}
+
+	return fr, startedAt, nil
+}
+
+func (s *synchronizer) storeResult(ctx context.Context, claimed *model.FetchRequest, startedAt time.Time, data *string, status *model.FetchRequestStatus) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer s.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	fr, err := s.fetchRequestRepo.LockIfSyncStartedAt(ctx, claimed.Tenant, claimed.ID, startedAt)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			log.Infof("FetchRequest with ID %s was deleted or claimed by another replica, skipping the fetched content", claimed.ID)
+			return nil
+		}
+		return errors.Wrap(err, "while locking FetchRequest")
+	}
+
+	err = s.synchronize(ctx, fr, data, status)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *synchronizer) markFailed(ctx context.Context, claimed *model.FetchRequest, startedAt time.Time, syncErr error) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer s.transact.RollbackUnlessCommited(tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	fr, err := s.fetchRequestRepo.LockIfSyncStartedAt(ctx, claimed.Tenant, claimed.ID, startedAt)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil
+		}
+		return errors.Wrap(err, "while locking FetchRequest")
+	}
+
+	var lastChanged *time.Time
+	if fr.Status != nil {
+		lastChanged = fr.Status.LastChanged
+	}
+
+	now := s.timestampGen()
+	message := syncErr.Error()
+	fr.Status = &model.FetchRequestStatus{
+		Condition:   model.FetchRequestStatusConditionFailed,
+		Message:     &message,
+		Timestamp:   now,
+		LastChecked: &now,
+		LastChanged: lastChanged,
+	}
+
+	err = s.fetchRequestRepo.Update(ctx, fr)
+	if err != nil {
+		return errors.Wrap(err, "while updating FetchRequest status")
+	}
+
+	return tx.Commit()
+}
+
+func (s *synchronizer) synchronize(ctx context.Context, fr *model.FetchRequest, data *string, status *model.FetchRequestStatus) error {
+	var lastChanged *time.Time
+	if fr.Status != nil {
+		lastChanged = fr.Status.LastChanged
+	}
+	status.LastChanged = lastChanged
+
+	if status.Condition == model.FetchRequestStatusConditionSucceeded {
+		changed, err := s.updateStoredData(ctx, fr, data)
+		switch err.(type) {
+		case nil:
+			if changed {
+				log.Infof("Content fetched by FetchRequest with ID %s has changed", fr.ID)
+				status.LastChanged = status.LastChecked
+			}
+		case invalidSpecError:
+			log.Warnf("Content fetched by FetchRequest with ID %s is not a valid spec: %s", fr.ID, err)
+			message := err.Error()
+			status.Condition = model.FetchRequestStatusConditionFailed
+			status.Message = &message
+		default:
+			return err
+		}
+	}
+
+	fr.Status = status
+	err := s.fetchRequestRepo.Update(ctx, fr)
+	if err != nil {
+		return errors.Wrap(err, "while updating FetchRequest status")
+	}
+
+	return nil
+}
+
+func (s *synchronizer) updateStoredData(ctx context.Context, fr *model.FetchRequest, data *string) (bool, error) {
+	switch fr.ObjectType {
+	case model.APIFetchRequestReference:
+		return s.updateAPISpec(ctx, fr.Tenant, fr.ObjectID, data)
+	case model.EventAPIFetchRequestReference:
+		return s.updateEventAPISpec(ctx, fr.Tenant, fr.ObjectID, data)
+	case model.DocumentFetchRequestReference:
+		return s.updateDocumentData(ctx, fr.Tenant, fr.ObjectID, data)
+	}
+
+	return false, errors.Errorf("Invalid type of the Fetch Request reference object: %s", fr.ObjectType)
+}
+
+func (s *synchronizer) updateAPISpec(ctx context.Context, tenant, id string, data *string) (bool, error) {
+	api, err := s.apiRepo.GetByID(ctx, tenant, id)
+	if err != nil {
+		return false, errors.Wrapf(err, "while getting APIDefinition with ID %s", id)
+	}
+
+	if api.Spec == nil {
+		api.Spec = &model.APISpec{}
+	}
+	if contentHash(api.Spec.Data) == contentHash(data) {
+		return false, nil
+	}
+
+	spec := *api.Spec
+	spec.Data = data
+	err = specvalidation.ValidateAPISpec(&spec)
+	if err != nil {
+		return false, invalidSpecError{err}
+	}
+	api.Spec.Data = data
+
+	err = s.apiRepo.Update(ctx, api)
+	if err != nil {
+		return false, errors.Wrapf(err, "while updating APIDefinition with ID %s", id)
+	}
+
+	err = s.notifyUpdated(ctx, api.ApplicationID, model.ConfigurationChangeResourceTypeAPI, id)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *synchronizer) updateEventAPISpec(ctx context.Context, tenant, id string, data *string) (bool, error) {
+	eventAPI, err := s.eventAPIRepo.GetByID(ctx, tenant, id)
+	if err != nil {
+		return false, errors.Wrapf(err, "while getting EventAPIDefinition with ID %s", id)
+	}
+
+	if eventAPI.Spec == nil {
+		eventAPI.Spec = &model.EventAPISpec{}
+	}
+	if contentHash(eventAPI.Spec.Data) == contentHash(data) {
+		return false, nil
+	}
+
+	spec := *eventAPI.Spec
+	spec.Data = data
+	err = specvalidation.ValidateEventAPISpec(&spec)
+	if err != nil {
+		return false, invalidSpecError{err}
+	}
+	eventAPI.Spec.Data = data
+
+	err = s.eventAPIRepo.Update(ctx, eventAPI)
+	if err != nil {
+		return false, errors.Wrapf(err, "while updating EventAPIDefinition with ID %s", id)
+	}
+
+	err = s.notifyUpdated(ctx, eventAPI.ApplicationID, model.ConfigurationChangeResourceTypeEventAPI, id)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *synchronizer) updateDocumentData(ctx context.Context, tenant, id string, data *string) (bool, error) {
+	document, err := s.documentRepo.GetByID(ctx, tenant, id)
+	if err != nil {
+		return false, errors.Wrapf(err, "while getting Document with ID %s", id)
+	}
+
+	if contentHash(document.Data) == contentHash(data) {
+		return false, nil
+	}
+	document.Data = data
+
+	err = s.documentRepo.Update(ctx, document)
+	if err != nil {
+		return false, errors.Wrapf(err, "while updating Document with ID %s", id)
+	}
+
+	err = s.notifyUpdated(ctx, document.ApplicationID, model.ConfigurationChangeResourceTypeDocument, id)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// notifyUpdated records the change of the fetched content in the same transaction, so that Application Webhooks are called only if it is stored
+func (s *synchronizer) notifyUpdated(ctx context.Context, applicationID string, resourceType model.ConfigurationChangeResourceType, id string) error {
+	err := s.notifier.NotifyConfigurationChanged(ctx, applicationID, model.ConfigurationChange{
+		ResourceType: resourceType,
+		ResourceID:   id,
+		Operation:    model.ConfigurationChangeOperationUpdated,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while notifying about change of %s with ID %s", resourceType, id)
+	}
+
+	return nil
+}
+
+func contentHash(data *string) string {
+	if data == nil {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(*data))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,556 @@
+package specsync_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/internal/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/persistence/txtest"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specsync"
+	"github.com/kyma-incubator/compass/components/director/internal/specsync/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	frID     = "frID"
+	tenantID = "tenant"
+	objectID = "objectID"
+	appID    = "appID"
+	period   = time.Hour
+	lease    = 5 * time.Minute
+)
+
+var (
+	previousCheck  = time.Date(2019, time.November, 12, 12, 0, 0, 0, time.UTC)
+	previousChange = time.Date(2019, time.November, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func TestSynchronizer_SynchronizeAll(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	now := time.Now()
+	checkedBefore := now.Add(-period / 2)
+	startedBefore := now.Add(-lease)
+
+	oldData := "{swagger: '2.0', info: {title: foo, version: v1}, paths: {}}"
+	newData := "{swagger: '2.0', info: {title: foo, version: v2}, paths: {}}"
+	invalidData := "{swagger: '2.0', paths: {}}"
+	failedMessage := "failed"
+	invalidMessage := "invalid OpenAPI spec: root (line 1, column 1): info is required"
+
+	succeededStatus := func() *model.FetchRequestStatus {
+		return &model.FetchRequestStatus{Condition: model.FetchRequestStatusConditionSucceeded, Timestamp: now, LastChecked: &now}
+	}
+	failedStatus := func() *model.FetchRequestStatus {
+		return &model.FetchRequestStatus{Condition: model.FetchRequestStatusConditionFailed, Message: &failedMessage, Timestamp: now, LastChecked: &now}
+	}
+	invalidStatus := func() *model.FetchRequestStatus {
+		return &model.FetchRequestStatus{Condition: model.FetchRequestStatusConditionFailed, Message: &invalidMessage, Timestamp: now, LastChecked: &now}
+	}
+	markedFailedFr := func(objectType model.FetchRequestReferenceObjectType) interface{} {
+		return mock.MatchedBy(func(fr *model.FetchRequest) bool {
+			return fr.ObjectType == objectType && fr.Status != nil &&
+				fr.Status.Condition == model.FetchRequestStatusConditionFailed &&
+				fr.Status.Message != nil && strings.Contains(*fr.Status.Message, testErr.Error()) &&
+				fr.Status.LastChecked != nil && fr.Status.LastChecked.Equal(now) &&
+				fr.Status.LastChanged != nil && fr.Status.LastChanged.Equal(previousChange)
+		})
+	}
+	notifierFor := func(resourceType model.ConfigurationChangeResourceType, err error) func() *automock.WebhookNotifier {
+		return func() *automock.WebhookNotifier {
+			notifier := &automock.WebhookNotifier{}
+			notifier.On("NotifyConfigurationChanged", txtest.CtxWithDBMatcher(), appID, model.ConfigurationChange{ResourceType: resourceType, ResourceID: objectID, Operation: model.ConfigurationChangeOperationUpdated}).Return(err).Once()
+			return notifier
+		}
+	}
+	expectedFr := func(objectType model.FetchRequestReferenceObjectType, status *model.FetchRequestStatus, lastChanged *time.Time) *model.FetchRequest {
+		fr := fixFetchRequest(objectType)
+		fr.Status = status
+		fr.Status.LastChanged = lastChanged
+		return fr
+	}
+
+	testCases := []struct {
+		Name                  string
+		TransactionerFn       func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		FetchRequestServiceFn func() *automock.FetchRequestService
+		APIRepoFn             func() *automock.APIRepository
+		EventAPIRepoFn        func() *automock.EventAPIRepository
+		DocumentRepoFn        func() *automock.DocumentRepository
+		NotifierFn            func() *automock.WebhookNotifier
+		ExpectedErr           error
+	}{
+		{
+			Name:            "Success - API Spec changed",
+			TransactionerFn: transactionerForCycles(1),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.APIFetchRequestReference, succeededStatus(), &now)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(&newData, succeededStatus()).Once()
+				return svc
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, objectID).Return(&model.APIDefinition{ID: objectID, ApplicationID: appID, Spec: fixAPISpec(&oldData)}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), &model.APIDefinition{ID: objectID, ApplicationID: appID, Spec: fixAPISpec(&newData)}).Return(nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+			NotifierFn:     notifierFor(model.ConfigurationChangeResourceTypeAPI, nil),
+		},
+		{
+			Name:            "Error - notifying about changed API Spec",
+			TransactionerFn: transactionerForFailedCycle(nil, 1),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), markedFailedFr(model.APIFetchRequestReference)).Return(nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(&newData, succeededStatus()).Once()
+				return svc
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, objectID).Return(&model.APIDefinition{ID: objectID, ApplicationID: appID, Spec: fixAPISpec(&oldData)}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), &model.APIDefinition{ID: objectID, ApplicationID: appID, Spec: fixAPISpec(&newData)}).Return(nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+			NotifierFn:     notifierFor(model.ConfigurationChangeResourceTypeAPI, testErr),
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Success - EventAPI Spec not changed",
+			TransactionerFn: transactionerForCycles(1),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.EventAPIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.EventAPIFetchRequestReference), nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.EventAPIFetchRequestReference, succeededStatus(), &previousChange)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(&oldData, succeededStatus()).Once()
+				return svc
+			},
+			APIRepoFn: emptyAPIRepo,
+			EventAPIRepoFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, objectID).Return(&model.EventAPIDefinition{ID: objectID, Spec: &model.EventAPISpec{Data: &oldData}}, nil).Once()
+				return repo
+			},
+			DocumentRepoFn: emptyDocumentRepo,
+		},
+		{
+			Name:            "Success - fetched API Spec invalid",
+			TransactionerFn: transactionerForCycles(1),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.APIFetchRequestReference, invalidStatus(), &previousChange)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(&invalidData, succeededStatus()).Once()
+				return svc
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, objectID).Return(&model.APIDefinition{ID: objectID, Spec: fixAPISpec(&oldData)}, nil).Once()
+				return repo
+			},
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+		},
+		{
+			Name:            "Success - Document data changed",
+			TransactionerFn: transactionerForCycles(1),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.DocumentFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.DocumentFetchRequestReference), nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.DocumentFetchRequestReference, succeededStatus(), &now)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(&newData, succeededStatus()).Once()
+				return svc
+			},
+			APIRepoFn:      emptyAPIRepo,
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, objectID).Return(&model.Document{ID: objectID, ApplicationID: appID}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), &model.Document{ID: objectID, ApplicationID: appID, Data: &newData}).Return(nil).Once()
+				return repo
+			},
+			NotifierFn: notifierFor(model.ConfigurationChangeResourceTypeDocument, nil),
+		},
+		{
+			Name:            "Success - fetching failed",
+			TransactionerFn: transactionerForCycles(1),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.APIFetchRequestReference, failedStatus(), &previousChange)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(nil, failedStatus()).Once()
+				return svc
+			},
+			APIRepoFn:      emptyAPIRepo,
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+		},
+		{
+			Name:            "Success - nothing to synchronize",
+			TransactionerFn: transactionerForCycles(0),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				return repo
+			},
+			FetchRequestServiceFn: emptyFetchRequestService,
+			APIRepoFn:             emptyAPIRepo,
+			EventAPIRepoFn:        emptyEventAPIRepo,
+			DocumentRepoFn:        emptyDocumentRepo,
+		},
+		{
+			Name:            "Success - fetched content skipped when FetchRequest was claimed by another replica",
+			TransactionerFn: transactionerForSkippedCycle,
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil, apperrors.NewNotFoundError(frID)).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(&newData, succeededStatus()).Once()
+				return svc
+			},
+			APIRepoFn:      emptyAPIRepo,
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+		},
+		{
+			Name:            "Error - claiming FetchRequest",
+			TransactionerFn: txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit,
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestServiceFn: emptyFetchRequestService,
+			APIRepoFn:             emptyAPIRepo,
+			EventAPIRepoFn:        emptyEventAPIRepo,
+			DocumentRepoFn:        emptyDocumentRepo,
+			ExpectedErr:           testErr,
+		},
+		{
+			Name:            "Error - locking FetchRequest",
+			TransactionerFn: txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit,
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, testErr).Once()
+				return repo
+			},
+			FetchRequestServiceFn: emptyFetchRequestService,
+			APIRepoFn:             emptyAPIRepo,
+			EventAPIRepoFn:        emptyEventAPIRepo,
+			DocumentRepoFn:        emptyDocumentRepo,
+			ExpectedErr:           testErr,
+		},
+		{
+			Name:            "Error - updating API does not block other FetchRequests",
+			TransactionerFn: transactionerForFailedCycle(nil, 3),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), markedFailedFr(model.APIFetchRequestReference)).Return(nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.DocumentFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.DocumentFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.DocumentFetchRequestReference, failedStatus(), &previousChange)).Return(nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(&newData, succeededStatus()).Once()
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(nil, failedStatus()).Once()
+				return svc
+			},
+			APIRepoFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, objectID).Return(&model.APIDefinition{ID: objectID, Spec: fixAPISpec(nil)}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), &model.APIDefinition{ID: objectID, Spec: fixAPISpec(&newData)}).Return(testErr).Once()
+				return repo
+			},
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Error - updating FetchRequest",
+			TransactionerFn: transactionerForFailedCycle(nil, 1),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.APIFetchRequestReference, failedStatus(), &previousChange)).Return(testErr).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), markedFailedFr(model.APIFetchRequestReference)).Return(nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(nil, failedStatus()).Once()
+				return svc
+			},
+			APIRepoFn:      emptyAPIRepo,
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Error - commit",
+			TransactionerFn: transactionerForFailedCycle(testErr, 1),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.APIFetchRequestReference, failedStatus(), &previousChange)).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), markedFailedFr(model.APIFetchRequestReference)).Return(nil).Once()
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(nil, apperrors.NewNotFoundError("")).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(nil, failedStatus()).Once()
+				return svc
+			},
+			APIRepoFn:      emptyAPIRepo,
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Error - marking FetchRequest as failed",
+			TransactionerFn: transactionerForFailedCycle(nil, 0),
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("LockNextForSync", txtest.CtxWithDBMatcher(), checkedBefore, startedBefore).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("MarkSyncStarted", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(nil).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), expectedFr(model.APIFetchRequestReference, failedStatus(), &previousChange)).Return(testErr).Once()
+				repo.On("LockIfSyncStartedAt", txtest.CtxWithDBMatcher(), tenantID, frID, now).Return(fixFetchRequest(model.APIFetchRequestReference), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), markedFailedFr(model.APIFetchRequestReference)).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("FetchSpec", context.TODO(), mock.Anything).Return(nil, failedStatus()).Once()
+				return svc
+			},
+			APIRepoFn:      emptyAPIRepo,
+			EventAPIRepoFn: emptyEventAPIRepo,
+			DocumentRepoFn: emptyDocumentRepo,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:                  "Error - begin transaction",
+			TransactionerFn:       txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin,
+			FetchRequestRepoFn:    func() *automock.FetchRequestRepository { return &automock.FetchRequestRepository{} },
+			FetchRequestServiceFn: emptyFetchRequestService,
+			APIRepoFn:             emptyAPIRepo,
+			EventAPIRepoFn:        emptyEventAPIRepo,
+			DocumentRepoFn:        emptyDocumentRepo,
+			ExpectedErr:           testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx, transact := testCase.TransactionerFn()
+			frRepo := testCase.FetchRequestRepoFn()
+			frSvc := testCase.FetchRequestServiceFn()
+			apiRepo := testCase.APIRepoFn()
+			eventAPIRepo := testCase.EventAPIRepoFn()
+			docRepo := testCase.DocumentRepoFn()
+			notifier := &automock.WebhookNotifier{}
+			if testCase.NotifierFn != nil {
+				notifier = testCase.NotifierFn()
+			}
+
+			synchronizer := specsync.NewSynchronizer(transact, frRepo, frSvc, apiRepo, eventAPIRepo, docRepo, notifier, period, lease)
+			synchronizer.SetTimestampGen(func() time.Time { return now })
+
+			// WHEN
+			err := synchronizer.SynchronizeAll(context.TODO())
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persistTx.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			frRepo.AssertExpectations(t)
+			frSvc.AssertExpectations(t)
+			apiRepo.AssertExpectations(t)
+			eventAPIRepo.AssertExpectations(t)
+			docRepo.AssertExpectations(t)
+			notifier.AssertExpectations(t)
+		})
+	}
+}
+
+// transactionerForCycles returns transactioner expecting the given number of FetchRequests, each claimed and stored in separate committed transactions,
+// followed by the last transaction, which does not find anything to synchronize
+func transactionerForCycles(synchronized int) func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	return func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+		committed := 2 * synchronized
+
+		persistTx := &persistenceautomock.PersistenceTx{}
+		if committed > 0 {
+			persistTx.On("Commit").Return(nil).Times(committed)
+		}
+
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Times(committed + 1)
+		transact.On("RollbackUnlessCommited", persistTx).Return().Times(committed + 1)
+
+		return persistTx, transact
+	}
+}
+
+// transactionerForSkippedCycle returns transactioner expecting a FetchRequest claimed in a committed transaction, whose result is not stored,
+// followed by the last transaction, which does not find anything to synchronize
+func transactionerForSkippedCycle() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	persistTx := &persistenceautomock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Once()
+
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Times(3)
+	transact.On("RollbackUnlessCommited", persistTx).Return().Times(3)
+
+	return persistTx, transact
+}
+
+// transactionerForFailedCycle returns transactioner expecting a FetchRequest claimed in a committed transaction and failing to be stored, optionally on commit,
+// followed by the given number of committed transactions, of which the first one marks the FetchRequest as failed, and the last one,
+// which does not find anything to synchronize. With no committed transactions, marking the FetchRequest as failed is expected to fail.
+func transactionerForFailedCycle(commitErr error, committed int) func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	return func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+		persistTx := &persistenceautomock.PersistenceTx{}
+		persistTx.On("Commit").Return(nil).Once()
+		if commitErr != nil {
+			persistTx.On("Commit").Return(commitErr).Once()
+		}
+		if committed > 0 {
+			persistTx.On("Commit").Return(nil).Times(committed)
+		}
+
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Times(committed + 3)
+		transact.On("RollbackUnlessCommited", persistTx).Return().Times(committed + 3)
+
+		return persistTx, transact
+	}
+}
+
+func fixFetchRequest(objectType model.FetchRequestReferenceObjectType) *model.FetchRequest {
+	return &model.FetchRequest{
+		ID:     frID,
+		Tenant: tenantID,
+		URL:    "http://foo.bar/spec",
+		Mode:   model.FetchModeSingle,
+		Status: &model.FetchRequestStatus{
+			Condition:   model.FetchRequestStatusConditionSucceeded,
+			Timestamp:   previousCheck,
+			LastChecked: &previousCheck,
+			LastChanged: &previousChange,
+		},
+		ObjectType: objectType,
+		ObjectID:   objectID,
+	}
+}
+
+func fixAPISpec(data *string) *model.APISpec {
+	return &model.APISpec{
+		Data:   data,
+		Type:   model.APISpecTypeOpenAPI,
+		Format: model.SpecFormatYaml,
+	}
+}
+
+func emptyFetchRequestService() *automock.FetchRequestService {
+	return &automock.FetchRequestService{}
+}
+
+func emptyAPIRepo() *automock.APIRepository {
+	return &automock.APIRepository{}
+}
+
+func emptyEventAPIRepo() *automock.EventAPIRepository {
+	return &automock.EventAPIRepository{}
+}
+
+func emptyDocumentRepo() *automock.DocumentRepository {
+	return &automock.DocumentRepository{}
+}
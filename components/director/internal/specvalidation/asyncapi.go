@@ -0,0 +1,79 @@
+package specvalidation
+
+import "regexp"
+
+var (
+	asyncAPI1VersionRegex = regexp.MustCompile(`^1\.\d+\.\d+$`)
+	asyncAPI2VersionRegex = regexp.MustCompile(`^2\.\d+\.\d+$`)
+	asyncAPIOperations    = []string{"publish", "subscribe"}
+)
+
+func validateAsyncAPI(doc map[string]interface{}, source *sourceNode) error {
+	v := newViolations(source)
+
+	version, ok := requireString(v, doc, nil, "asyncapi")
+	if !ok {
+		return v.toError("AsyncAPI")
+	}
+
+	validateInfo(v, doc)
+	switch {
+	case asyncAPI1VersionRegex.MatchString(version):
+		validateAsyncAPI1(v, doc)
+	case asyncAPI2VersionRegex.MatchString(version):
+		validateAsyncAPI2(v, doc)
+	default:
+		v.add(path{"asyncapi"}, "has to be a 1.x.y or 2.x.y version string")
+	}
+
+	return v.toError("AsyncAPI")
+}
+
+func validateAsyncAPI1(v *violations, doc map[string]interface{}) {
+	_, hasTopics := doc["topics"]
+	_, hasStream := doc["stream"]
+	_, hasEvents := doc["events"]
+	if !hasTopics && !hasStream && !hasEvents {
+		v.add(nil, "one of topics, stream or events is required")
+		return
+	}
+
+	topics, _ := optionalObject(v, doc, nil, "topics")
+
+	for _, topicName := range sortedKeys(topics) {
+		topicPath := path{"topics", topicName}
+		topic, ok := asObject(v, topics[topicName], topicPath)
+		if !ok {
+			continue
+		}
+
+		_, hasPublish := topic["publish"]
+		_, hasSubscribe := topic["subscribe"]
+		if !hasPublish && !hasSubscribe {
+			v.add(topicPath, "publish or subscribe is required")
+		}
+	}
+}
+
+func validateAsyncAPI2(v *violations, doc map[string]interface{}) {
+	channels, ok := requireObject(v, doc, nil, "channels")
+	if !ok {
+		return
+	}
+
+	for _, channelName := range sortedKeys(channels) {
+		channelPath := path{"channels", channelName}
+		channel, ok := asObject(v, channels[channelName], channelPath)
+		if !ok {
+			continue
+		}
+
+		for _, operationName := range asyncAPIOperations {
+			operation, ok := optionalObject(v, channel, channelPath, operationName)
+			if !ok {
+				continue
+			}
+			optionalObject(v, operation, channelPath.child(operationName), "message")
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package specvalidation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// violations collects structural problems found in the spec, each one prefixed with the location of the offending element
+type violations struct {
+	messages []string
+	source   *sourceNode
+}
+
+func newViolations(source *sourceNode) *violations {
+	return &violations{source: source}
+}
+
+// add reports problem with the element pointed by the path together with its position in the spec data
+func (v *violations) add(p path, format string, args ...interface{}) {
+	location := p.String()
+	if line, column, ok := v.source.locate(p); ok {
+		location = fmt.Sprintf("%s (line %d, column %d)", location, line, column)
+	}
+	v.addAt(location, format, args...)
+}
+
+func (v *violations) addAt(location, format string, args ...interface{}) {
+	v.messages = append(v.messages, fmt.Sprintf("%s: %s", location, fmt.Sprintf(format, args...)))
+}
+
+func (v *violations) toError(kind string) error {
+	if len(v.messages) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("invalid %s spec: %s", kind, strings.Join(v.messages, "; "))
+}
+
+// path points to an element of the document, each segment is either an object key or an array index
+type path []interface{}
+
+func (p path) child(segment interface{}) path {
+	return append(p[:len(p):len(p)], segment)
+}
+
+func (p path) String() string {
+	if len(p) == 0 {
+		return "root"
+	}
+
+	var result string
+	for i, segment := range p {
+		switch s := segment.(type) {
+		case int:
+			result += fmt.Sprintf("[%d]", s)
+		case string:
+			switch {
+			case i == 0:
+				result = s
+			case strings.ContainsAny(s, "./[]"):
+				result += fmt.Sprintf("[%q]", s)
+			default:
+				result += "." + s
+			}
+		}
+	}
+	return result
+}
+
+func requireObject(v *violations, parent map[string]interface{}, parentPath path, key string) (map[string]interface{}, bool) {
+	value, exists := parent[key]
+	if !exists {
+		v.add(parentPath, "%s is required", key)
+		return nil, false
+	}
+
+	return asObject(v, value, parentPath.child(key))
+}
+
+func optionalObject(v *violations, parent map[string]interface{}, parentPath path, key string) (map[string]interface{}, bool) {
+	value, exists := parent[key]
+	if !exists {
+		return nil, false
+	}
+
+	return asObject(v, value, parentPath.child(key))
+}
+
+func asObject(v *violations, value interface{}, p path) (map[string]interface{}, bool) {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		v.add(p, "has to be an object")
+		return nil, false
+	}
+
+	return obj, true
+}
+
+func requireString(v *violations, parent map[string]interface{}, parentPath path, key string) (string, bool) {
+	value, exists := parent[key]
+	if !exists {
+		v.add(parentPath, "%s is required", key)
+		return "", false
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		v.add(parentPath.child(key), "has to be a string")
+		return "", false
+	}
+
+	return str, true
+}
+
+// versionString returns version field value, which in YAML documents is often written as a number
+func versionString(value interface{}) (string, bool) {
+	switch version := value.(type) {
+	case string:
+		return version, true
+	case float64:
+		return fmt.Sprint(version), true
+	}
+
+	return "", false
+}
+
+func validateInfo(v *violations, doc map[string]interface{}) {
+	info, ok := requireObject(v, doc, nil, "info")
+	if !ok {
+		return
+	}
+
+	infoPath := path{"info"}
+	requireString(v, info, infoPath, "title")
+	if _, exists := info["version"]; !exists {
+		v.add(infoPath, "version is required")
+	} else if _, ok := versionString(info["version"]); !ok {
+		v.add(infoPath.child("version"), "has to be a string")
+	}
+}
@@ -0,0 +1,102 @@
+package specvalidation
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+var edmxNamespaces = map[string]struct{}{
+	"http://docs.oasis-open.org/odata/ns/edmx":      {},
+	"http://schemas.microsoft.com/ado/2007/06/edmx": {},
+}
+
+// validateEDMX checks if the data is an OData EDMX metadata document with at least one schema
+func validateEDMX(data string) error {
+	v := newViolations(nil)
+	decoder := xml.NewDecoder(strings.NewReader(data))
+
+	var root *xml.StartElement
+	var rootPosition string
+	var stack []xml.Name
+	dataServicesFound := false
+	schemas := 0
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrapf(err, "while parsing %s spec", model.SpecFormatXML)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			line, column := position([]byte(data), offset)
+			elementPosition := fmt.Sprintf("line %d, column %d", line, column)
+
+			switch {
+			case len(stack) == 0 && root != nil:
+				v.addAt(elementPosition, "only one root element is allowed")
+			case len(stack) == 0:
+				element := t.Copy()
+				root = &element
+				rootPosition = elementPosition
+				validateEDMXRoot(v, elementPosition, t)
+			case len(stack) == 1 && t.Name.Local == "DataServices" && t.Name.Space == root.Name.Space:
+				dataServicesFound = true
+			case len(stack) == 2 && stack[1].Local == "DataServices" && t.Name.Local == "Schema":
+				schemas++
+				if attr(t, "Namespace") == "" {
+					v.addAt(elementPosition, "Schema element has to have Namespace attribute")
+				}
+			}
+
+			stack = append(stack, t.Name)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) == 0 && len(strings.TrimSpace(string(t))) > 0 {
+				line, column := position([]byte(data), offset)
+				v.addAt(fmt.Sprintf("line %d, column %d", line, column), "text is not allowed outside of the root element")
+			}
+		}
+	}
+
+	switch {
+	case root == nil:
+		v.addAt("root", "EDMX document has to contain Edmx root element")
+	case !dataServicesFound:
+		v.addAt(rootPosition, "DataServices element is required")
+	case schemas == 0:
+		v.addAt(rootPosition, "at least one Schema element is required in DataServices")
+	}
+
+	return v.toError("OData")
+}
+
+func validateEDMXRoot(v *violations, elementPosition string, root xml.StartElement) {
+	if _, ok := edmxNamespaces[root.Name.Space]; !ok || root.Name.Local != "Edmx" {
+		v.addAt(elementPosition, "root element has to be Edmx element from EDMX namespace, got %s", root.Name.Local)
+		return
+	}
+
+	if attr(root, "Version") == "" {
+		v.addAt(elementPosition, "Edmx element has to have Version attribute")
+	}
+}
+
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == name && a.Name.Space == "" {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package specvalidation
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	openAPI3VersionRegex = regexp.MustCompile(`^3\.\d+\.\d+$`)
+	openAPI2Operations   = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+	openAPI3Operations   = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+)
+
+func validateOpenAPI(doc map[string]interface{}, source *sourceNode) error {
+	v := newViolations(source)
+
+	swagger, isV2 := doc["swagger"]
+	openapi, isV3 := doc["openapi"]
+	switch {
+	case isV2:
+		version, _ := versionString(swagger)
+		if version != "2.0" && version != "2" {
+			v.add(path{"swagger"}, "has to be \"2.0\"")
+		}
+		validateOpenAPIDocument(v, doc, openAPI2Operations)
+		if basePath, ok := doc["basePath"].(string); ok && !strings.HasPrefix(basePath, "/") {
+			v.add(path{"basePath"}, "has to start with \"/\"")
+		}
+	case isV3:
+		version, _ := openapi.(string)
+		if !openAPI3VersionRegex.MatchString(version) {
+			v.add(path{"openapi"}, "has to be a 3.x.y version string")
+		}
+		validateOpenAPIDocument(v, doc, openAPI3Operations)
+		validateServers(v, doc)
+	default:
+		v.add(nil, "either swagger or openapi version field is required")
+	}
+
+	return v.toError("OpenAPI")
+}
+
+func validateOpenAPIDocument(v *violations, doc map[string]interface{}, operations []string) {
+	validateInfo(v, doc)
+
+	paths, ok := requireObject(v, doc, nil, "paths")
+	if !ok {
+		return
+	}
+
+	for _, pathName := range sortedKeys(paths) {
+		itemPath := path{"paths", pathName}
+		if !strings.HasPrefix(pathName, "/") {
+			v.add(itemPath, "path has to start with \"/\"")
+		}
+
+		item, ok := asObject(v, paths[pathName], itemPath)
+		if !ok {
+			continue
+		}
+
+		for _, operationName := range operations {
+			operation, ok := optionalObject(v, item, itemPath, operationName)
+			if !ok {
+				continue
+			}
+
+			operationPath := itemPath.child(operationName)
+			responses, ok := requireObject(v, operation, operationPath, "responses")
+			if ok && len(responses) == 0 {
+				v.add(operationPath.child("responses"), "at least one response is required")
+			}
+		}
+	}
+}
+
+func validateServers(v *violations, doc map[string]interface{}) {
+	value, exists := doc["servers"]
+	if !exists {
+		return
+	}
+
+	servers, ok := value.([]interface{})
+	if !ok {
+		v.add(path{"servers"}, "has to be an array")
+		return
+	}
+
+	for i, server := range servers {
+		serverPath := path{"servers", i}
+		obj, ok := asObject(v, server, serverPath)
+		if !ok {
+			continue
+		}
+		requireString(v, obj, serverPath, "url")
+	}
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,72 @@
+package specvalidation
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// sourceNode holds the position of a document element in the spec data, so violations found in the parsed document
+// can point to the line and column where the offending element is written
+type sourceNode struct {
+	line   int
+	column int
+	keys   map[string]*sourceNode
+	items  []*sourceNode
+}
+
+// locate returns position of the element pointed by the path, or of its closest ancestor present in the source
+func (n *sourceNode) locate(p path) (int, int, bool) {
+	if n == nil {
+		return 0, 0, false
+	}
+
+	current := n
+	for _, segment := range p {
+		var next *sourceNode
+		switch s := segment.(type) {
+		case string:
+			next = current.keys[s]
+		case int:
+			if s >= 0 && s < len(current.items) {
+				next = current.items[s]
+			}
+		}
+		if next == nil {
+			break
+		}
+		current = next
+	}
+
+	return current.line, current.column, current.line > 0
+}
+
+// indexSource builds position index of the spec data, which is parsed as YAML, as JSON is a subset of it.
+// If the data cannot be parsed as YAML, violations are reported without positions.
+func indexSource(data string) *sourceNode {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(data), &document); err != nil || len(document.Content) == 0 {
+		return nil
+	}
+
+	return indexNode(document.Content[0], document.Content[0].Line, document.Content[0].Column)
+}
+
+// indexNode records the node at the given position, object members are located at their keys
+func indexNode(node *yaml.Node, line, column int) *sourceNode {
+	source := &sourceNode{line: line, column: column}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		source.keys = make(map[string]*sourceNode, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			source.keys[key.Value] = indexNode(value, key.Line, key.Column)
+		}
+	case yaml.SequenceNode:
+		source.items = make([]*sourceNode, 0, len(node.Content))
+		for _, item := range node.Content {
+			source.items = append(source.items, indexNode(item, item.Line, item.Column))
+		}
+	}
+
+	return source
+}
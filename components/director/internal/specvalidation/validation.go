@@ -0,0 +1,149 @@
+package specvalidation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+// ValidateAPISpec checks if the spec data is a valid document of the declared type written in the declared format.
+// Spec without data is always valid.
+func ValidateAPISpec(spec *model.APISpec) error {
+	if spec == nil || spec.Data == nil {
+		return nil
+	}
+
+	switch spec.Type {
+	case model.APISpecTypeOpenAPI:
+		doc, err := ParseDocument(spec.Format, *spec.Data)
+		if err != nil {
+			return err
+		}
+		return validateOpenAPI(doc, indexSource(*spec.Data))
+	case model.APISpecTypeOdata:
+		if spec.Format != model.SpecFormatXML {
+			return fmt.Errorf("%s spec has to be in %s format, got %s", spec.Type, model.SpecFormatXML, spec.Format)
+		}
+		return validateEDMX(*spec.Data)
+	}
+
+	return fmt.Errorf("unsupported API spec type %s", spec.Type)
+}
+
+// ValidateEventAPISpec checks if the spec data is a valid document of the declared type written in the declared format.
+// Spec without data is always valid.
+func ValidateEventAPISpec(spec *model.EventAPISpec) error {
+	if spec == nil || spec.Data == nil {
+		return nil
+	}
+
+	switch spec.Type {
+	case model.EventAPISpecTypeAsyncAPI:
+		doc, err := ParseDocument(spec.Format, *spec.Data)
+		if err != nil {
+			return err
+		}
+		return validateAsyncAPI(doc, indexSource(*spec.Data))
+	}
+
+	return fmt.Errorf("unsupported Event API spec type %s", spec.Type)
+}
+
+// APISpecValidator returns function validating data fetched for the spec, the spec itself is not modified
+func APISpecValidator(spec *model.APISpec) func(data string) error {
+	return func(data string) error {
+		fetched := model.APISpec{}
+		if spec != nil {
+			fetched = *spec
+		}
+		fetched.Data = &data
+		return ValidateAPISpec(&fetched)
+	}
+}
+
+// EventAPISpecValidator returns function validating data fetched for the spec, the spec itself is not modified
+func EventAPISpecValidator(spec *model.EventAPISpec) func(data string) error {
+	return func(data string) error {
+		fetched := model.EventAPISpec{}
+		if spec != nil {
+			fetched = *spec
+		}
+		fetched.Data = &data
+		return ValidateEventAPISpec(&fetched)
+	}
+}
+
+// ParseDocument parses JSON or YAML spec and checks that its root is an object.
+// JSON document declared as YAML is accepted, as JSON is a subset of YAML.
+func ParseDocument(format model.SpecFormat, data string) (map[string]interface{}, error) {
+	var jsonData []byte
+	switch format {
+	case model.SpecFormatJSON:
+		jsonData = []byte(data)
+	case model.SpecFormatYaml:
+		if looksLikeJSON(data) {
+			jsonData = []byte(data)
+			break
+		}
+
+		var err error
+		jsonData, err = yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing %s spec", model.SpecFormatYaml)
+		}
+	default:
+		return nil, fmt.Errorf("spec has to be in %s or %s format, got %s", model.SpecFormatJSON, model.SpecFormatYaml, format)
+	}
+
+	var doc interface{}
+	err := json.Unmarshal(jsonData, &doc)
+	if err != nil {
+		if format == model.SpecFormatJSON {
+			return nil, jsonSyntaxError(data, err)
+		}
+		return nil, errors.Wrapf(err, "while parsing %s spec", format)
+	}
+
+	obj, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("spec declared as %s does not contain an object at the top level", format)
+	}
+
+	return obj, nil
+}
+
+func looksLikeJSON(data string) bool {
+	trimmed := strings.TrimSpace(data)
+	return strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed))
+}
+
+func jsonSyntaxError(data string, err error) error {
+	syntaxErr, ok := err.(*json.SyntaxError)
+	if !ok {
+		return errors.Wrapf(err, "while parsing %s spec", model.SpecFormatJSON)
+	}
+
+	// offset points right after the invalid character
+	line, column := position([]byte(data), syntaxErr.Offset-1)
+	return fmt.Errorf("while parsing %s spec: line %d, column %d: %s", model.SpecFormatJSON, line, column, syntaxErr.Error())
+}
+
+// position converts byte offset to 1-based line and column
+func position(data []byte, offset int64) (int, int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := len(before) - bytes.LastIndexByte(before, '\n')
+	return line, column
+}
@@ -0,0 +1,267 @@
+package specvalidation_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	openAPI2YAML = `swagger: "2.0"
+info:
+  title: Pets
+  version: "1.0"
+basePath: /v1
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: OK
+`
+	openAPI3JSON = `{
+  "openapi": "3.0.2",
+  "info": {"title": "Pets", "version": "1.0"},
+  "servers": [{"url": "http://pets.local"}],
+  "paths": {"/pets": {"get": {"responses": {"200": {"description": "OK"}}}}}
+}`
+	edmx = `<?xml version="1.0" encoding="utf-8"?>
+<edmx:Edmx xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx" Version="4.0">
+  <edmx:DataServices>
+    <Schema xmlns="http://docs.oasis-open.org/odata/ns/edm" Namespace="Pets"/>
+  </edmx:DataServices>
+</edmx:Edmx>`
+	asyncAPI1YAML = `asyncapi: 1.2.0
+info:
+  title: Pets
+  version: "1.0"
+topics:
+  pet.created:
+    subscribe:
+      $ref: "#/components/messages/PetCreated"
+`
+	asyncAPI2JSON = `{
+  "asyncapi": "2.0.0",
+  "info": {"title": "Pets", "version": "1.0"},
+  "channels": {"pet/created": {"subscribe": {"message": {"payload": {"type": "string"}}}}}
+}`
+)
+
+func TestValidateAPISpec(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Spec        *model.APISpec
+		ExpectedErr string
+	}{
+		{
+			Name: "Success - OpenAPI v2 in YAML",
+			Spec: fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatYaml, openAPI2YAML),
+		},
+		{
+			Name: "Success - OpenAPI v3 in JSON",
+			Spec: fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatJSON, openAPI3JSON),
+		},
+		{
+			Name: "Success - OpenAPI v3 in JSON declared as YAML",
+			Spec: fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatYaml, openAPI3JSON),
+		},
+		{
+			Name: "Success - OData EDMX",
+			Spec: fixAPISpec(model.APISpecTypeOdata, model.SpecFormatXML, edmx),
+		},
+		{
+			Name: "Success - nil data",
+			Spec: &model.APISpec{Type: model.APISpecTypeOpenAPI, Format: model.SpecFormatJSON},
+		},
+		{
+			Name: "Success - nil spec",
+		},
+		{
+			Name:        "Error - JSON syntax error",
+			Spec:        fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatJSON, "{\n  \"openapi\": 3.0.0\n}"),
+			ExpectedErr: "while parsing JSON spec: line 2, column 17: invalid character '.' after object key:value pair",
+		},
+		{
+			Name:        "Error - YAML syntax error",
+			Spec:        fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatYaml, "swagger: \"2.0\"\ninfo:\n  title: [Pets\n"),
+			ExpectedErr: "yaml: line 3",
+		},
+		{
+			Name:        "Error - XML declared as JSON",
+			Spec:        fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatJSON, edmx),
+			ExpectedErr: "while parsing JSON spec: line 1, column 1: invalid character '<' looking for beginning of value",
+		},
+		{
+			Name:        "Error - XML declared as YAML",
+			Spec:        fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatYaml, "<edmx:Edmx/>"),
+			ExpectedErr: "spec declared as YAML does not contain an object at the top level",
+		},
+		{
+			Name:        "Error - OpenAPI in XML",
+			Spec:        fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatXML, edmx),
+			ExpectedErr: "spec has to be in JSON or YAML format, got XML",
+		},
+		{
+			Name:        "Error - OpenAPI without version",
+			Spec:        fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatJSON, `{"info": {"title": "Pets", "version": "1.0"}, "paths": {}}`),
+			ExpectedErr: "invalid OpenAPI spec: root (line 1, column 1): either swagger or openapi version field is required",
+		},
+		{
+			Name: "Error - OpenAPI structure",
+			Spec: fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatJSON,
+				`{"openapi": "3.0", "info": {"title": "Pets"}, "servers": [{}], "paths": {"pets": {"get": {}, "post": {"responses": {}}}}}`),
+			ExpectedErr: `invalid OpenAPI spec: openapi (line 1, column 2): has to be a 3.x.y version string; info (line 1, column 20): version is required; ` +
+				`paths.pets (line 1, column 74): path has to start with "/"; paths.pets.get (line 1, column 83): responses is required; ` +
+				`paths.pets.post.responses (line 1, column 103): at least one response is required; servers[0] (line 1, column 59): url is required`,
+		},
+		{
+			Name: "Error - OpenAPI structure in YAML",
+			Spec: fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatYaml,
+				"openapi: 3.0.0\ninfo:\n  title: Pets\n  version: [1]\nservers:\n- description: Local\npaths:\n  /pets:\n    get:\n      description: |\n        responses:\n      responses: {}\n"),
+			ExpectedErr: `invalid OpenAPI spec: info.version (line 4, column 3): has to be a string; ` +
+				`paths["/pets"].get.responses (line 12, column 7): at least one response is required; servers[0] (line 6, column 3): url is required`,
+		},
+		{
+			Name: "Error - OpenAPI structure in YAML flow collections",
+			Spec: fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatYaml,
+				"openapi: 3.0.0\ninfo: {title: Pets, version: 1.0.0}\nservers: [{description: Local}]\npaths: {/pets: {get: {responses: {}}}}\n"),
+			ExpectedErr: `invalid OpenAPI spec: paths["/pets"].get.responses (line 4, column 23): at least one response is required; servers[0] (line 3, column 11): url is required`,
+		},
+		{
+			Name:        "Error - OpenAPI path with special characters",
+			Spec:        fixAPISpec(model.APISpecTypeOpenAPI, model.SpecFormatJSON, `{"swagger": "2.0", "info": {"title": "Pets", "version": "1.0"}, "paths": {"/pets": {"get": []}}}`),
+			ExpectedErr: `invalid OpenAPI spec: paths["/pets"].get (line 1, column 85): has to be an object`,
+		},
+		{
+			Name:        "Error - OData in JSON",
+			Spec:        fixAPISpec(model.APISpecTypeOdata, model.SpecFormatJSON, "{}"),
+			ExpectedErr: "ODATA spec has to be in XML format, got JSON",
+		},
+		{
+			Name:        "Error - malformed XML",
+			Spec:        fixAPISpec(model.APISpecTypeOdata, model.SpecFormatXML, "<edmx:Edmx>\n<edmx:DataServices>\n</edmx:Edmx>"),
+			ExpectedErr: "while parsing XML spec: XML syntax error on line 3",
+		},
+		{
+			Name:        "Error - not EDMX",
+			Spec:        fixAPISpec(model.APISpecTypeOdata, model.SpecFormatXML, "<?xml version=\"1.0\"?>\n  <definitions/>"),
+			ExpectedErr: "invalid OData spec: line 2, column 3: root element has to be Edmx element from EDMX namespace, got definitions; line 2, column 3: DataServices element is required",
+		},
+		{
+			Name: "Error - EDMX without schema",
+			Spec: fixAPISpec(model.APISpecTypeOdata, model.SpecFormatXML,
+				`<edmx:Edmx xmlns:edmx="http://schemas.microsoft.com/ado/2007/06/edmx" Version="1.0"><edmx:DataServices/></edmx:Edmx>`),
+			ExpectedErr: "invalid OData spec: line 1, column 1: at least one Schema element is required in DataServices",
+		},
+		{
+			Name:        "Error - unsupported type",
+			Spec:        fixAPISpec("RAML", model.SpecFormatYaml, "title: Pets"),
+			ExpectedErr: "unsupported API spec type RAML",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := specvalidation.ValidateAPISpec(testCase.Spec)
+
+			// THEN
+			if testCase.ExpectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.ExpectedErr)
+		})
+	}
+}
+
+func TestValidateEventAPISpec(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Spec        *model.EventAPISpec
+		ExpectedErr string
+	}{
+		{
+			Name: "Success - AsyncAPI v1 in YAML",
+			Spec: fixEventAPISpec(model.EventAPISpecTypeAsyncAPI, model.SpecFormatYaml, asyncAPI1YAML),
+		},
+		{
+			Name: "Success - AsyncAPI v2 in JSON",
+			Spec: fixEventAPISpec(model.EventAPISpecTypeAsyncAPI, model.SpecFormatJSON, asyncAPI2JSON),
+		},
+		{
+			Name: "Success - nil data",
+			Spec: &model.EventAPISpec{Type: model.EventAPISpecTypeAsyncAPI, Format: model.SpecFormatJSON},
+		},
+		{
+			Name:        "Error - AsyncAPI without version",
+			Spec:        fixEventAPISpec(model.EventAPISpecTypeAsyncAPI, model.SpecFormatJSON, `{"info": {"title": "Pets", "version": "1.0"}}`),
+			ExpectedErr: "invalid AsyncAPI spec: root (line 1, column 1): asyncapi is required",
+		},
+		{
+			Name:        "Error - AsyncAPI v1 without topics",
+			Spec:        fixEventAPISpec(model.EventAPISpecTypeAsyncAPI, model.SpecFormatJSON, `{"asyncapi": "1.0.0", "info": {"title": "Pets", "version": "1.0"}}`),
+			ExpectedErr: "invalid AsyncAPI spec: root (line 1, column 1): one of topics, stream or events is required",
+		},
+		{
+			Name:        "Error - AsyncAPI v1 topic without operation",
+			Spec:        fixEventAPISpec(model.EventAPISpecTypeAsyncAPI, model.SpecFormatJSON, `{"asyncapi": "1.0.0", "info": {"title": "Pets", "version": "1.0"}, "topics": {"pet.created": {}}}`),
+			ExpectedErr: `invalid AsyncAPI spec: topics["pet.created"] (line 1, column 79): publish or subscribe is required`,
+		},
+		{
+			Name:        "Error - AsyncAPI v2 structure",
+			Spec:        fixEventAPISpec(model.EventAPISpecTypeAsyncAPI, model.SpecFormatJSON, `{"asyncapi": "2.0.0", "info": {"version": "1.0"}, "channels": {"pets": {"publish": {"message": "pet"}}}}`),
+			ExpectedErr: "invalid AsyncAPI spec: info (line 1, column 23): title is required; channels.pets.publish.message (line 1, column 85): has to be an object",
+		},
+		{
+			Name:        "Error - unsupported AsyncAPI version",
+			Spec:        fixEventAPISpec(model.EventAPISpecTypeAsyncAPI, model.SpecFormatJSON, `{"asyncapi": "3.0.0", "info": {"title": "Pets", "version": "1.0"}}`),
+			ExpectedErr: "invalid AsyncAPI spec: asyncapi (line 1, column 2): has to be a 1.x.y or 2.x.y version string",
+		},
+		{
+			Name:        "Error - AsyncAPI in XML",
+			Spec:        fixEventAPISpec(model.EventAPISpecTypeAsyncAPI, model.SpecFormatXML, "<asyncapi/>"),
+			ExpectedErr: "spec has to be in JSON or YAML format, got XML",
+		},
+		{
+			Name:        "Error - unsupported type",
+			Spec:        fixEventAPISpec("CLOUD_EVENTS", model.SpecFormatJSON, "{}"),
+			ExpectedErr: "unsupported Event API spec type CLOUD_EVENTS",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := specvalidation.ValidateEventAPISpec(testCase.Spec)
+
+			// THEN
+			if testCase.ExpectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.ExpectedErr)
+		})
+	}
+}
+
+func fixAPISpec(specType model.APISpecType, format model.SpecFormat, data string) *model.APISpec {
+	return &model.APISpec{
+		Type:   specType,
+		Format: format,
+		Data:   &data,
+	}
+}
+
+func fixEventAPISpec(specType model.EventAPISpecType, format model.SpecFormat, data string) *model.EventAPISpec {
+	return &model.EventAPISpec{
+		Type:   specType,
+		Format: format,
+		Data:   &data,
+	}
+}
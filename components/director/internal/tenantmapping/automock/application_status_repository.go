@@ -0,0 +1,29 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationStatusRepository is an autogenerated mock type for the ApplicationStatusRepository type
+type ApplicationStatusRepository struct {
+	mock.Mock
+}
+
+// UpdateStatusIfCondition provides a mock function with given fields: ctx, tenant, id, status, currentConditions
+func (_m *ApplicationStatusRepository) UpdateStatusIfCondition(ctx context.Context, tenant string, id string, status model.ApplicationStatus, currentConditions []model.ApplicationStatusCondition) error {
+	ret := _m.Called(ctx, tenant, id, status, currentConditions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ApplicationStatus, []model.ApplicationStatusCondition) error); ok {
+		r0 = rf(ctx, tenant, id, status, currentConditions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
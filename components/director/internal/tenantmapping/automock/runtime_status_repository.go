@@ -0,0 +1,29 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RuntimeStatusRepository is an autogenerated mock type for the RuntimeStatusRepository type
+type RuntimeStatusRepository struct {
+	mock.Mock
+}
+
+// UpdateStatusIfCondition provides a mock function with given fields: ctx, tenant, id, status, currentConditions
+func (_m *RuntimeStatusRepository) UpdateStatusIfCondition(ctx context.Context, tenant string, id string, status model.RuntimeStatus, currentConditions []model.RuntimeStatusCondition) error {
+	ret := _m.Called(ctx, tenant, id, status, currentConditions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.RuntimeStatus, []model.RuntimeStatusCondition) error); ok {
+		r0 = rf(ctx, tenant, id, status, currentConditions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,7 @@
+package tenantmapping
+
+import "time"
+
+func (m *mapperForSystemAuth) SetTimestampGen(timestampGen func() time.Time) {
+	m.timestampGen = timestampGen
+}
@@ -78,6 +78,12 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		respondWithError(writer, http.StatusInternalServerError, err, "while committing the db transaction")
+		return
+	}
+
 	reqData.Body.Extra["tenant"] = objCtx.TenantID
 	reqData.Body.Extra["scope"] = objCtx.Scopes
 	reqData.Body.Extra["objectID"] = objCtx.ObjectID
@@ -6,22 +6,39 @@ import (
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/pkg/errors"
 )
 
-func NewMapperForSystemAuth(systemAuthSvc systemauth.SystemAuthService, scopesGetter ScopesGetter) *mapperForSystemAuth {
+//go:generate mockery -name=ApplicationStatusRepository -output=automock -outpkg=automock -case=underscore
+type ApplicationStatusRepository interface {
+	UpdateStatusIfCondition(ctx context.Context, tenant, id string, status model.ApplicationStatus, currentConditions []model.ApplicationStatusCondition) error
+}
+
+//go:generate mockery -name=RuntimeStatusRepository -output=automock -outpkg=automock -case=underscore
+type RuntimeStatusRepository interface {
+	UpdateStatusIfCondition(ctx context.Context, tenant, id string, status model.RuntimeStatus, currentConditions []model.RuntimeStatusCondition) error
+}
+
+func NewMapperForSystemAuth(systemAuthSvc systemauth.SystemAuthService, scopesGetter ScopesGetter, appStatusRepo ApplicationStatusRepository, runtimeStatusRepo RuntimeStatusRepository) *mapperForSystemAuth {
 	return &mapperForSystemAuth{
-		systemAuthSvc: systemAuthSvc,
-		scopesGetter:  scopesGetter,
+		systemAuthSvc:     systemAuthSvc,
+		scopesGetter:      scopesGetter,
+		appStatusRepo:     appStatusRepo,
+		runtimeStatusRepo: runtimeStatusRepo,
+		timestampGen:      timestamp.DefaultGenerator(),
 	}
 }
 
 type mapperForSystemAuth struct {
-	systemAuthSvc systemauth.SystemAuthService
-	scopesGetter  ScopesGetter
+	systemAuthSvc     systemauth.SystemAuthService
+	scopesGetter      ScopesGetter
+	appStatusRepo     ApplicationStatusRepository
+	runtimeStatusRepo RuntimeStatusRepository
+	timestampGen      timestamp.Generator
 }
 
 func (m *mapperForSystemAuth) GetObjectContext(ctx context.Context, reqData ReqData, authID string, authFlow AuthFlow) (ObjectContext, error) {
@@ -56,6 +73,11 @@ func (m *mapperForSystemAuth) GetObjectContext(ctx context.Context, reqData ReqD
 		return ObjectContext{}, errors.Wrap(err, "while getting context object")
 	}
 
+	err = m.markAsConnected(ctx, sysAuth, refObjType, tenant, refObjID)
+	if err != nil {
+		return ObjectContext{}, errors.Wrapf(err, "while updating status of %s with ID %s", refObjType, refObjID)
+	}
+
 	return NewObjectContext(scopes, tenant, refObjID, string(refObjType)), nil
 }
 
@@ -113,6 +135,31 @@ func (m *mapperForSystemAuth) getTenantAndScopesForApplicationOrRuntime(sysAuth
 	return tenant, scopes, nil
 }
 
+// markAsConnected makes the Application or Runtime READY if it has not connected yet
+func (m *mapperForSystemAuth) markAsConnected(ctx context.Context, sysAuth *model.SystemAuth, refObjType model.SystemAuthReferenceObjectType, tenant, refObjID string) error {
+	switch refObjType {
+	case model.ApplicationReference:
+		if sysAuth.ReferenceObjectStatusCondition == string(model.ApplicationStatusConditionReady) {
+			return nil
+		}
+	case model.RuntimeReference:
+		if sysAuth.ReferenceObjectStatusCondition == string(model.RuntimeStatusConditionReady) {
+			return nil
+		}
+	}
+
+	switch refObjType {
+	case model.ApplicationReference:
+		status := model.ApplicationStatus{Condition: model.ApplicationStatusConditionReady, Timestamp: m.timestampGen()}
+		return m.appStatusRepo.UpdateStatusIfCondition(ctx, tenant, refObjID, status, model.ApplicationStatusConditionsBeforeConnection)
+	case model.RuntimeReference:
+		status := model.RuntimeStatus{Condition: model.RuntimeStatusConditionReady, Timestamp: m.timestampGen()}
+		return m.runtimeStatusRepo.UpdateStatusIfCondition(ctx, tenant, refObjID, status, model.RuntimeStatusConditionsBeforeConnection)
+	}
+
+	return nil
+}
+
 func buildPath(refObjectType model.SystemAuthReferenceObjectType) string {
 	lowerCaseType := strings.ToLower(string(refObjectType))
 	transformedObjType := strings.ReplaceAll(lowerCaseType, " ", "_")
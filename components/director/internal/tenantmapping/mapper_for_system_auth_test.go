@@ -2,8 +2,10 @@ package tenantmapping_test
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -19,6 +21,9 @@ import (
 )
 
 func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
+	timestamp := time.Now()
+	readyAppStatus := model.ApplicationStatus{Condition: model.ApplicationStatusConditionReady, Timestamp: timestamp}
+
 	t.Run("returns tenant and scopes in the Application or Runtime SystemAuth case for Certificate flow", func(t *testing.T) {
 		authID := uuid.New()
 		refObjID := uuid.New()
@@ -37,7 +42,11 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		scopesGetterMock := getScopesGetterMock()
 		scopesGetterMock.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.application").Return(expectedScopes, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, scopesGetterMock)
+		appStatusRepoMock := &tenantmappingmock.ApplicationStatusRepository{}
+		appStatusRepoMock.On("UpdateStatusIfCondition", mock.Anything, expectedTenantID.String(), refObjID.String(), readyAppStatus, model.ApplicationStatusConditionsBeforeConnection).Return(nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, scopesGetterMock, appStatusRepoMock, nil)
+		mapper.SetTimestampGen(func() time.Time { return timestamp })
 
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.CertificateFlow)
 
@@ -47,7 +56,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		require.Equal(t, refObjID.String(), objCtx.ObjectID)
 		require.Equal(t, "Application", objCtx.ObjectType)
 
-		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, scopesGetterMock)
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, scopesGetterMock, appStatusRepoMock)
 	})
 
 	t.Run("returns tenant and scopes from the ReqData in the Integration System SystemAuth case", func(t *testing.T) {
@@ -72,7 +81,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -106,7 +115,11 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		appStatusRepoMock := &tenantmappingmock.ApplicationStatusRepository{}
+		appStatusRepoMock.On("UpdateStatusIfCondition", mock.Anything, expectedTenantID.String(), refObjID.String(), readyAppStatus, model.ApplicationStatusConditionsBeforeConnection).Return(nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, appStatusRepoMock, nil)
+		mapper.SetTimestampGen(func() time.Time { return timestamp })
 
 		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -116,7 +129,109 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		require.Equal(t, refObjID.String(), objCtx.ObjectID)
 		require.Equal(t, "Application", objCtx.ObjectType)
 
-		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, appStatusRepoMock)
+	})
+
+	t.Run("marks Runtime as connected in the Runtime SystemAuth case", func(t *testing.T) {
+		authID := uuid.New()
+		refObjID := uuid.New()
+		expectedTenantID := uuid.New()
+		sysAuth := &model.SystemAuth{
+			ID:        authID.String(),
+			TenantID:  expectedTenantID.String(),
+			RuntimeID: str.Ptr(refObjID.String()),
+		}
+		reqData := tenantmapping.ReqData{
+			Body: tenantmapping.ReqBody{
+				Extra: map[string]interface{}{
+					tenantmapping.ScopesKey: "runtime:read",
+				},
+			},
+		}
+		readyRuntimeStatus := model.RuntimeStatus{Condition: model.RuntimeStatusConditionReady, Timestamp: timestamp}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		runtimeStatusRepoMock := &tenantmappingmock.RuntimeStatusRepository{}
+		runtimeStatusRepoMock.On("UpdateStatusIfCondition", mock.Anything, expectedTenantID.String(), refObjID.String(), readyRuntimeStatus, model.RuntimeStatusConditionsBeforeConnection).Return(nil).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, runtimeStatusRepoMock)
+		mapper.SetTimestampGen(func() time.Time { return timestamp })
+
+		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
+
+		require.NoError(t, err)
+		require.Equal(t, refObjID.String(), objCtx.ObjectID)
+		require.Equal(t, "Runtime", objCtx.ObjectType)
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, runtimeStatusRepoMock)
+	})
+
+	t.Run("does not update status of the Application which is already READY", func(t *testing.T) {
+		authID := uuid.New()
+		refObjID := uuid.New()
+		expectedTenantID := uuid.New()
+		sysAuth := &model.SystemAuth{
+			ID:                             authID.String(),
+			TenantID:                       expectedTenantID.String(),
+			AppID:                          str.Ptr(refObjID.String()),
+			ReferenceObjectStatusCondition: string(model.ApplicationStatusConditionReady),
+		}
+		reqData := tenantmapping.ReqData{
+			Body: tenantmapping.ReqBody{
+				Extra: map[string]interface{}{
+					tenantmapping.ScopesKey: "application:read",
+				},
+			},
+		}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		appStatusRepoMock := &tenantmappingmock.ApplicationStatusRepository{}
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, appStatusRepoMock, nil)
+
+		objCtx, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
+
+		require.NoError(t, err)
+		require.Equal(t, refObjID.String(), objCtx.ObjectID)
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, appStatusRepoMock)
+	})
+
+	t.Run("returns error when unable to update the Application status", func(t *testing.T) {
+		authID := uuid.New()
+		refObjID := uuid.New()
+		expectedTenantID := uuid.New()
+		sysAuth := &model.SystemAuth{
+			ID:       authID.String(),
+			TenantID: expectedTenantID.String(),
+			AppID:    str.Ptr(refObjID.String()),
+		}
+		reqData := tenantmapping.ReqData{
+			Body: tenantmapping.ReqBody{
+				Extra: map[string]interface{}{
+					tenantmapping.ScopesKey: "application:read",
+				},
+			},
+		}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		appStatusRepoMock := &tenantmappingmock.ApplicationStatusRepository{}
+		appStatusRepoMock.On("UpdateStatusIfCondition", mock.Anything, expectedTenantID.String(), refObjID.String(), readyAppStatus, model.ApplicationStatusConditionsBeforeConnection).Return(errors.New("some-error")).Once()
+
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, appStatusRepoMock, nil)
+		mapper.SetTimestampGen(func() time.Time { return timestamp })
+
+		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
+
+		require.EqualError(t, err, fmt.Sprintf("while updating status of Application with ID %s: some-error", refObjID.String()))
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, appStatusRepoMock)
 	})
 
 	t.Run("returns error when unable to get SystemAuth from the service", func(t *testing.T) {
@@ -127,7 +242,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(&model.SystemAuth{}, errors.New("some-error")).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -145,7 +260,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -167,7 +282,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -196,7 +311,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -223,7 +338,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -253,7 +368,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -276,7 +391,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, nil, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.OAuth2Flow)
 
@@ -302,7 +417,7 @@ func TestMapperForSystemAuthGetObjectContext(t *testing.T) {
 		scopesGetterMock := getScopesGetterMock()
 		scopesGetterMock.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.application").Return([]string{}, errors.New("some-error")).Once()
 
-		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, scopesGetterMock)
+		mapper := tenantmapping.NewMapperForSystemAuth(systemAuthSvcMock, scopesGetterMock, nil, nil)
 
 		_, err := mapper.GetObjectContext(context.TODO(), reqData, authID.String(), tenantmapping.CertificateFlow)
 
@@ -151,3 +151,32 @@ func IsKeyDoesNotExist(err error) bool {
 	_, ok := err.(KeyDoesNotExist)
 	return ok
 }
+
+type FetchFailed interface {
+	FetchFailed()
+}
+
+type fetchFailedError struct {
+	reason string
+}
+
+func NewFetchFailedError(reason string) *fetchFailedError {
+	return &fetchFailedError{
+		reason: reason,
+	}
+}
+
+func (e *fetchFailedError) Error() string {
+	return fmt.Sprintf("fetching Spec failed: %s", e.reason)
+}
+
+func (fetchFailedError) FetchFailed() {}
+
+func IsFetchFailed(err error) bool {
+	if cause := errors.Cause(err); cause != nil {
+		err = cause
+	}
+
+	_, ok := err.(FetchFailed)
+	return ok
+}
@@ -211,3 +211,44 @@ func TestConstraintViolationError(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchFailedError(t *testing.T) {
+	fetchFailedError := &fetchFailedError{}
+	wrappedFetchFailedError := errors.Wrap(fetchFailedError, "wrapped text")
+	multiWrappedFetchFailedError := errors.Wrap(wrappedFetchFailedError, "multi wrapped")
+	testErr := errors.New("test")
+
+	testCases := []struct {
+		Name           string
+		Error          error
+		expectedResult bool
+	}{
+		{
+			Name:           "Unwrapped FetchFailed error",
+			Error:          fetchFailedError,
+			expectedResult: true,
+		},
+		{
+			Name:           "Wrapped FetchFailed error",
+			Error:          wrappedFetchFailedError,
+			expectedResult: true,
+		},
+		{
+			Name:           "Multi wrapped FetchFailed error",
+			Error:          multiWrappedFetchFailedError,
+			expectedResult: true,
+		},
+		{
+			Name:           "Different error",
+			Error:          testErr,
+			expectedResult: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			result := IsFetchFailed(testCase.Error)
+			assert.Equal(t, testCase.expectedResult, result)
+		})
+	}
+}
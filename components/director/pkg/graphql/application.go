@@ -1,12 +1,15 @@
 package graphql
 
 type Application struct {
-	ID                  string             `json:"id"`
-	Name                string             `json:"name"`
-	IntegrationSystemID *string            `json:"integrationSystemID"`
-	Description         *string            `json:"description"`
-	Status              *ApplicationStatus `json:"status"`
-	HealthCheckURL      *string            `json:"healthCheckURL"`
+	ID                         string             `json:"id"`
+	Name                       string             `json:"name"`
+	IntegrationSystemID        *string            `json:"integrationSystemID"`
+	ApplicationTemplateID      *string            `json:"applicationTemplateID"`
+	ApplicationTemplateVersion *int               `json:"applicationTemplateVersion"`
+	Description                *string            `json:"description"`
+	Status                     *ApplicationStatus `json:"status"`
+	HealthCheckURL             *string            `json:"healthCheckURL"`
+	HealthCheckAuth            *Auth              `json:"healthCheckAuth"`
 }
 
 // Extended types used by external API
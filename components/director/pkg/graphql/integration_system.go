@@ -15,5 +15,6 @@ type IntegrationSystemPageExt struct {
 
 type IntegrationSystemExt struct {
 	IntegrationSystem
-	Auths []*SystemAuth `json:"auths"`
+	Labels Labels        `json:"labels"`
+	Auths  []*SystemAuth `json:"auths"`
 }
@@ -20,6 +20,17 @@ type Pageable interface {
 	IsPageable()
 }
 
+type APIChange struct {
+	Kind APIChangeKind `json:"kind"`
+	Type APIChangeType `json:"type"`
+	// Operation like `GET /pets` or `SUBSCRIBE pet/created`, or reference to the message or schema like `#/components/schemas/Pet`
+	Path string `json:"path"`
+	// Changes restricting request data, like a new required property, break requests, while changes extending response data, like a new enum value, break responses.
+	// Named schemas, which can be used by both, are breaking if they break any of them.
+	Breaking    bool   `json:"breaking"`
+	Description string `json:"description"`
+}
+
 type APIDefinitionInput struct {
 	Name        string        `json:"name"`
 	Description *string       `json:"description"`
@@ -38,11 +49,21 @@ type APIDefinitionPage struct {
 
 func (APIDefinitionPage) IsPageable() {}
 
+type APIDiff struct {
+	FromID string `json:"fromID"`
+	ToID   string `json:"toID"`
+	// True if at least one of the changes is breaking
+	Breaking bool         `json:"breaking"`
+	Changes  []*APIChange `json:"changes"`
+}
+
 type APIRuntimeAuth struct {
 	RuntimeID string `json:"runtimeID"`
 	Auth      *Auth  `json:"auth"`
 }
 
+// The data has to be a valid document of the given type written in the given format, otherwise the change is rejected.
+// Errors point to the line and column of the invalid element. JSON document can be declared as YAML, as JSON is valid YAML.
 type APISpecInput struct {
 	Data         *CLOB              `json:"data"`
 	Type         APISpecType        `json:"type"`
@@ -51,17 +72,26 @@ type APISpecInput struct {
 }
 
 type ApplicationCreateInput struct {
-	Name                string                     `json:"name"`
-	Description         *string                    `json:"description"`
-	Labels              *Labels                    `json:"labels"`
-	Webhooks            []*WebhookInput            `json:"webhooks"`
-	HealthCheckURL      *string                    `json:"healthCheckURL"`
+	Name           string          `json:"name"`
+	Description    *string         `json:"description"`
+	Labels         *Labels         `json:"labels"`
+	Webhooks       []*WebhookInput `json:"webhooks"`
+	HealthCheckURL *string         `json:"healthCheckURL"`
+	// Credentials used by the Director to call healthCheckURL
+	HealthCheckAuth     *AuthInput                 `json:"healthCheckAuth"`
 	Apis                []*APIDefinitionInput      `json:"apis"`
 	EventAPIs           []*EventAPIDefinitionInput `json:"eventAPIs"`
 	Documents           []*DocumentInput           `json:"documents"`
 	IntegrationSystemID *string                    `json:"integrationSystemID"`
 }
 
+type ApplicationEvent struct {
+	// Empty if the change affects all Applications, for example when the scenarios of the Runtime change
+	ApplicationID *string         `json:"applicationID"`
+	Operation     ChangeOperation `json:"operation"`
+	Timestamp     Timestamp       `json:"timestamp"`
+}
+
 type ApplicationEventConfiguration struct {
 	DefaultURL string `json:"defaultURL"`
 }
@@ -86,6 +116,8 @@ type ApplicationTemplate struct {
 	ApplicationInput string                         `json:"applicationInput"`
 	Placeholders     []*PlaceholderDefinition       `json:"placeholders"`
 	AccessLevel      ApplicationTemplateAccessLevel `json:"accessLevel"`
+	// Incremented on every update of the template
+	Version int `json:"version"`
 }
 
 type ApplicationTemplateInput struct {
@@ -105,10 +137,12 @@ type ApplicationTemplatePage struct {
 func (ApplicationTemplatePage) IsPageable() {}
 
 type ApplicationUpdateInput struct {
-	Name                string  `json:"name"`
-	Description         *string `json:"description"`
-	HealthCheckURL      *string `json:"healthCheckURL"`
-	IntegrationSystemID *string `json:"integrationSystemID"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description"`
+	HealthCheckURL *string `json:"healthCheckURL"`
+	// Credentials used by the Director to call healthCheckURL
+	HealthCheckAuth     *AuthInput `json:"healthCheckAuth"`
+	IntegrationSystemID *string    `json:"integrationSystemID"`
 }
 
 type Auth struct {
@@ -189,6 +223,7 @@ type EventAPIDefinition struct {
 	Description   *string `json:"description"`
 	// group allows you to find the same API but in different version
 	Group   *string       `json:"group"`
+	Labels  Labels        `json:"labels"`
 	Spec    *EventAPISpec `json:"spec"`
 	Version *Version      `json:"version"`
 }
@@ -209,6 +244,8 @@ type EventAPIDefinitionPage struct {
 
 func (EventAPIDefinitionPage) IsPageable() {}
 
+// The data has to be a valid document of the given type written in the given format, otherwise the change is rejected.
+// Errors point to the line and column of the invalid element. JSON document can be declared as YAML, as JSON is valid YAML.
 type EventAPISpecInput struct {
 	Data          *CLOB              `json:"data"`
 	EventSpecType EventAPISpecType   `json:"eventSpecType"`
@@ -225,16 +262,29 @@ type FetchRequest struct {
 	Status *FetchRequestStatus `json:"status"`
 }
 
+// The document is downloaded before the change is stored, and its size is limited by the Director configuration.
+// If the download fails or the downloaded spec is not valid, the data provided in the input is kept
+// and the failure is reported in the status of the Fetch Request.
 type FetchRequestInput struct {
-	URL    string     `json:"url"`
-	Auth   *AuthInput `json:"auth"`
-	Mode   *FetchMode `json:"mode"`
-	Filter *string    `json:"filter"`
+	URL  string     `json:"url"`
+	Auth *AuthInput `json:"auth"`
+	// SINGLE fetches the document from the URL. PACKAGE fetches zip or tar.gz archive and picks a single file from it.
+	// INDEX fetches JSON array of document URLs (relative URLs are resolved against the index URL) and picks a single entry from it.
+	// The picked entry must have the same scheme and host as the index.
+	Mode *FetchMode `json:"mode"`
+	// Glob pattern matched against the file path (or its last element) in PACKAGE mode or the entry URL (or its last element) in INDEX mode.
+	// Required when there is more than one candidate. A filter matching more than one candidate is rejected. Not supported in SINGLE mode.
+	Filter *string `json:"filter"`
 }
 
 type FetchRequestStatus struct {
 	Condition FetchRequestStatusCondition `json:"condition"`
+	Message   *string                     `json:"message"`
 	Timestamp Timestamp                   `json:"timestamp"`
+	// Time when the content was fetched and compared with the stored one for the last time
+	LastChecked *Timestamp `json:"lastChecked"`
+	// Time when the fetched content differed from the stored one for the last time
+	LastChanged *Timestamp `json:"lastChanged"`
 }
 
 type HealthCheck struct {
@@ -281,14 +331,33 @@ type LabelDefinitionInput struct {
 	Schema *JSONSchema `json:"schema"`
 }
 
+type LabelEvent struct {
+	ObjectType LabelableObject `json:"objectType"`
+	ObjectID   string          `json:"objectID"`
+	Key        string          `json:"key"`
+	Operation  ChangeOperation `json:"operation"`
+	Timestamp  Timestamp       `json:"timestamp"`
+}
+
 type LabelFilter struct {
 	// Label key. If query for the filter is not provided, returns every object with given label key regardless of its value.
 	Key string `json:"key"`
 	// Optional SQL/JSON Path expression. If query is not provided, returns every object with given label key regardless of its value.
-	// Currently only a limited subset of expressions is supported.
+	// Currently only a limited subset of expressions is supported, see the labeling documentation for details.
 	Query *string `json:"query"`
 }
 
+// Combines label filters with boolean operators. Exactly one of the fields has to be provided.
+type LabelFilterExpression struct {
+	Label *LabelFilter `json:"label"`
+	// Matches objects matching all of the expressions.
+	And []*LabelFilterExpression `json:"and"`
+	// Matches objects matching any of the expressions.
+	Or []*LabelFilterExpression `json:"or"`
+	// Matches objects not matching the expression.
+	Not *LabelFilterExpression `json:"not"`
+}
+
 type OAuthCredentialData struct {
 	ClientID     string `json:"clientId"`
 	ClientSecret string `json:"clientSecret"`
@@ -316,13 +385,51 @@ type PageInfo struct {
 }
 
 type PlaceholderDefinition struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
+	Name        string      `json:"name"`
+	Description *string     `json:"description"`
+	JSONSchema  *JSONSchema `json:"jsonSchema"`
+	Regex       *string     `json:"regex"`
+	Required    bool        `json:"required"`
+	// Always empty for sensitive placeholders.
+	Default   *string `json:"default"`
+	Sensitive bool    `json:"sensitive"`
 }
 
 type PlaceholderDefinitionInput struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
+	// JSON Schema the value has to be valid against. The value is validated as a string or, if it is a JSON document, as the decoded value.
+	JSONSchema *JSONSchema `json:"jsonSchema"`
+	// Regular expression the whole value has to match.
+	Regex *string `json:"regex"`
+	// Optional placeholder without a value and a default is replaced with an empty string.
+	Required *bool   `json:"required"`
+	Default  *string `json:"default"`
+	// Default value of a sensitive placeholder is not returned and its values are never included in error messages.
+	Sensitive *bool `json:"sensitive"`
+}
+
+type RuntimeConnection struct {
+	Endpoint             *string    `json:"endpoint"`
+	CertificateExpiresAt *Timestamp `json:"certificateExpiresAt"`
+}
+
+type RuntimeConnectionInput struct {
+	// URL under which the Runtime Agent is reachable.
+	Endpoint *string `json:"endpoint"`
+	// Expiration time of the client certificate used by the Runtime Agent.
+	CertificateExpiresAt *Timestamp `json:"certificateExpiresAt"`
+}
+
+type RuntimeHeartbeat struct {
+	AgentVersion string             `json:"agentVersion"`
+	Connection   *RuntimeConnection `json:"connection"`
+	Timestamp    Timestamp          `json:"timestamp"`
+}
+
+type RuntimeHeartbeatInput struct {
+	AgentVersion string                  `json:"agentVersion"`
+	Connection   *RuntimeConnectionInput `json:"connection"`
 }
 
 type RuntimeInput struct {
@@ -344,6 +451,35 @@ type RuntimeStatus struct {
 	Timestamp Timestamp              `json:"timestamp"`
 }
 
+type Scenario struct {
+	Name             string `json:"name"`
+	ApplicationCount int    `json:"applicationCount"`
+	RuntimeCount     int    `json:"runtimeCount"`
+}
+
+type ScenarioAssignment struct {
+	ID         string                       `json:"id"`
+	Scenario   string                       `json:"scenario"`
+	TargetType ScenarioAssignmentTargetType `json:"targetType"`
+	// Label filter expression in the shape of LabelFilterExpression input
+	Filter interface{} `json:"filter"`
+}
+
+type ScenarioAssignmentInput struct {
+	// Scenario which is added to the scenarios label of every matching object. It has to be defined in the scenarios Label Definition.
+	Scenario   string                       `json:"scenario"`
+	TargetType ScenarioAssignmentTargetType `json:"targetType"`
+	Filter     *LabelFilterExpression       `json:"filter"`
+}
+
+type ScenarioAssignmentPage struct {
+	Data       []*ScenarioAssignment `json:"data"`
+	PageInfo   *PageInfo             `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (ScenarioAssignmentPage) IsPageable() {}
+
 type SystemAuth struct {
 	ID   string `json:"id"`
 	Auth *Auth  `json:"auth"`
@@ -379,12 +515,113 @@ type Webhook struct {
 	Auth          *Auth                  `json:"auth"`
 }
 
+type WebhookDeliveryAttempt struct {
+	Timestamp Timestamp `json:"timestamp"`
+	// HTTP status code of the response, empty if the Webhook could not be called
+	ResponseCode *int    `json:"responseCode"`
+	Error        *string `json:"error"`
+}
+
+type WebhookDeliveryPage struct {
+	Data       []*WebhookDelivery `json:"data"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (WebhookDeliveryPage) IsPageable() {}
+
 type WebhookInput struct {
 	Type ApplicationWebhookType `json:"type"`
 	URL  string                 `json:"url"`
 	Auth *AuthInput             `json:"auth"`
 }
 
+type APIChangeKind string
+
+const (
+	APIChangeKindOperation APIChangeKind = "OPERATION"
+	APIChangeKindMessage   APIChangeKind = "MESSAGE"
+	APIChangeKindSchema    APIChangeKind = "SCHEMA"
+)
+
+var AllAPIChangeKind = []APIChangeKind{
+	APIChangeKindOperation,
+	APIChangeKindMessage,
+	APIChangeKindSchema,
+}
+
+func (e APIChangeKind) IsValid() bool {
+	switch e {
+	case APIChangeKindOperation, APIChangeKindMessage, APIChangeKindSchema:
+		return true
+	}
+	return false
+}
+
+func (e APIChangeKind) String() string {
+	return string(e)
+}
+
+func (e *APIChangeKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APIChangeKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid APIChangeKind", str)
+	}
+	return nil
+}
+
+func (e APIChangeKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type APIChangeType string
+
+const (
+	APIChangeTypeAdded   APIChangeType = "ADDED"
+	APIChangeTypeRemoved APIChangeType = "REMOVED"
+	APIChangeTypeChanged APIChangeType = "CHANGED"
+)
+
+var AllAPIChangeType = []APIChangeType{
+	APIChangeTypeAdded,
+	APIChangeTypeRemoved,
+	APIChangeTypeChanged,
+}
+
+func (e APIChangeType) IsValid() bool {
+	switch e {
+	case APIChangeTypeAdded, APIChangeTypeRemoved, APIChangeTypeChanged:
+		return true
+	}
+	return false
+}
+
+func (e APIChangeType) String() string {
+	return string(e)
+}
+
+func (e *APIChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APIChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid APIChangeType", str)
+	}
+	return nil
+}
+
+func (e APIChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type APISpecType string
 
 const (
@@ -429,10 +666,11 @@ func (e APISpecType) MarshalGQL(w io.Writer) {
 type ApplicationStatusCondition string
 
 const (
-	ApplicationStatusConditionInitial ApplicationStatusCondition = "INITIAL"
-	ApplicationStatusConditionUnknown ApplicationStatusCondition = "UNKNOWN"
-	ApplicationStatusConditionReady   ApplicationStatusCondition = "READY"
-	ApplicationStatusConditionFailed  ApplicationStatusCondition = "FAILED"
+	ApplicationStatusConditionInitial  ApplicationStatusCondition = "INITIAL"
+	ApplicationStatusConditionUnknown  ApplicationStatusCondition = "UNKNOWN"
+	ApplicationStatusConditionReady    ApplicationStatusCondition = "READY"
+	ApplicationStatusConditionFailed   ApplicationStatusCondition = "FAILED"
+	ApplicationStatusConditionDeleting ApplicationStatusCondition = "DELETING"
 )
 
 var AllApplicationStatusCondition = []ApplicationStatusCondition{
@@ -440,11 +678,12 @@ var AllApplicationStatusCondition = []ApplicationStatusCondition{
 	ApplicationStatusConditionUnknown,
 	ApplicationStatusConditionReady,
 	ApplicationStatusConditionFailed,
+	ApplicationStatusConditionDeleting,
 }
 
 func (e ApplicationStatusCondition) IsValid() bool {
 	switch e {
-	case ApplicationStatusConditionInitial, ApplicationStatusConditionUnknown, ApplicationStatusConditionReady, ApplicationStatusConditionFailed:
+	case ApplicationStatusConditionInitial, ApplicationStatusConditionUnknown, ApplicationStatusConditionReady, ApplicationStatusConditionFailed, ApplicationStatusConditionDeleting:
 		return true
 	}
 	return false
@@ -471,19 +710,22 @@ func (e ApplicationStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// GLOBAL templates are visible for all tenants and require additional scopes to be managed. TENANT templates are visible only for the tenant that created them.
 type ApplicationTemplateAccessLevel string
 
 const (
 	ApplicationTemplateAccessLevelGlobal ApplicationTemplateAccessLevel = "GLOBAL"
+	ApplicationTemplateAccessLevelTenant ApplicationTemplateAccessLevel = "TENANT"
 )
 
 var AllApplicationTemplateAccessLevel = []ApplicationTemplateAccessLevel{
 	ApplicationTemplateAccessLevelGlobal,
+	ApplicationTemplateAccessLevelTenant,
 }
 
 func (e ApplicationTemplateAccessLevel) IsValid() bool {
 	switch e {
-	case ApplicationTemplateAccessLevelGlobal:
+	case ApplicationTemplateAccessLevelGlobal, ApplicationTemplateAccessLevelTenant:
 		return true
 	}
 	return false
@@ -513,16 +755,20 @@ func (e ApplicationTemplateAccessLevel) MarshalGQL(w io.Writer) {
 type ApplicationWebhookType string
 
 const (
-	ApplicationWebhookTypeConfigurationChanged ApplicationWebhookType = "CONFIGURATION_CHANGED"
+	ApplicationWebhookTypeConfigurationChanged  ApplicationWebhookType = "CONFIGURATION_CHANGED"
+	ApplicationWebhookTypeRegisterApplication   ApplicationWebhookType = "REGISTER_APPLICATION"
+	ApplicationWebhookTypeUnregisterApplication ApplicationWebhookType = "UNREGISTER_APPLICATION"
 )
 
 var AllApplicationWebhookType = []ApplicationWebhookType{
 	ApplicationWebhookTypeConfigurationChanged,
+	ApplicationWebhookTypeRegisterApplication,
+	ApplicationWebhookTypeUnregisterApplication,
 }
 
 func (e ApplicationWebhookType) IsValid() bool {
 	switch e {
-	case ApplicationWebhookTypeConfigurationChanged:
+	case ApplicationWebhookTypeConfigurationChanged, ApplicationWebhookTypeRegisterApplication, ApplicationWebhookTypeUnregisterApplication:
 		return true
 	}
 	return false
@@ -549,6 +795,49 @@ func (e ApplicationWebhookType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ChangeOperation string
+
+const (
+	ChangeOperationCreated ChangeOperation = "CREATED"
+	ChangeOperationUpdated ChangeOperation = "UPDATED"
+	ChangeOperationDeleted ChangeOperation = "DELETED"
+)
+
+var AllChangeOperation = []ChangeOperation{
+	ChangeOperationCreated,
+	ChangeOperationUpdated,
+	ChangeOperationDeleted,
+}
+
+func (e ChangeOperation) IsValid() bool {
+	switch e {
+	case ChangeOperationCreated, ChangeOperationUpdated, ChangeOperationDeleted:
+		return true
+	}
+	return false
+}
+
+func (e ChangeOperation) String() string {
+	return string(e)
+}
+
+func (e *ChangeOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeOperation", str)
+	}
+	return nil
+}
+
+func (e ChangeOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type DocumentFormat string
 
 const (
@@ -793,6 +1082,47 @@ func (e HealthCheckType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type LabelableObject string
+
+const (
+	LabelableObjectApplication LabelableObject = "APPLICATION"
+	LabelableObjectRuntime     LabelableObject = "RUNTIME"
+)
+
+var AllLabelableObject = []LabelableObject{
+	LabelableObjectApplication,
+	LabelableObjectRuntime,
+}
+
+func (e LabelableObject) IsValid() bool {
+	switch e {
+	case LabelableObjectApplication, LabelableObjectRuntime:
+		return true
+	}
+	return false
+}
+
+func (e LabelableObject) String() string {
+	return string(e)
+}
+
+func (e *LabelableObject) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LabelableObject(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LabelableObject", str)
+	}
+	return nil
+}
+
+func (e LabelableObject) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RuntimeStatusCondition string
 
 const (
@@ -836,6 +1166,47 @@ func (e RuntimeStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ScenarioAssignmentTargetType string
+
+const (
+	ScenarioAssignmentTargetTypeApplication ScenarioAssignmentTargetType = "APPLICATION"
+	ScenarioAssignmentTargetTypeRuntime     ScenarioAssignmentTargetType = "RUNTIME"
+)
+
+var AllScenarioAssignmentTargetType = []ScenarioAssignmentTargetType{
+	ScenarioAssignmentTargetTypeApplication,
+	ScenarioAssignmentTargetTypeRuntime,
+}
+
+func (e ScenarioAssignmentTargetType) IsValid() bool {
+	switch e {
+	case ScenarioAssignmentTargetTypeApplication, ScenarioAssignmentTargetTypeRuntime:
+		return true
+	}
+	return false
+}
+
+func (e ScenarioAssignmentTargetType) String() string {
+	return string(e)
+}
+
+func (e *ScenarioAssignmentTargetType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScenarioAssignmentTargetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScenarioAssignmentTargetType", str)
+	}
+	return nil
+}
+
+func (e ScenarioAssignmentTargetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SpecFormat string
 
 const (
@@ -878,3 +1249,46 @@ func (e *SpecFormat) UnmarshalGQL(v interface{}) error {
 func (e SpecFormat) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
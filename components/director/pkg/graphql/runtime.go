@@ -1,10 +1,11 @@
 package graphql
 
 type Runtime struct {
-	ID          string         `json:"id"`
-	Name        string         `json:"name"`
-	Description *string        `json:"description"`
-	Status      *RuntimeStatus `json:"status"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description *string           `json:"description"`
+	Status      *RuntimeStatus    `json:"status"`
+	Heartbeat   *RuntimeHeartbeat `json:"heartbeat"`
 }
 
 // Extended types used by external API
@@ -0,0 +1,10 @@
+package graphql
+
+type WebhookDelivery struct {
+	ID            string                `json:"id"`
+	WebhookID     string                `json:"webhookID"`
+	Payload       string                `json:"payload"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	CreatedAt     Timestamp             `json:"createdAt"`
+	NextAttemptAt *Timestamp            `json:"nextAttemptAt"`
+}
@@ -93,4 +93,5 @@ APP_OAUTH20_CLIENT_ENDPOINT="https://oauth2-admin.kyma.local/clients" \
 APP_OAUTH20_PUBLIC_ACCESS_TOKEN_ENDPOINT="https://oauth2.kyma.local/oauth2/token" \
 APP_ONE_TIME_TOKEN_URL="http://connector.not.configured.url/graphql" \
 APP_CONNECTOR_URL="http://connector.not.configured.url/graphql" \
+APP_WEBHOOK_DELIVERY_SIGNING_KEY="local-signing-key" \
 go run ${ROOT_PATH}/cmd/main.go
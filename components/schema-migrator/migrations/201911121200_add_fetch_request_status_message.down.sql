@@ -0,0 +1 @@
+ALTER TABLE fetch_requests DROP COLUMN status_message;
@@ -0,0 +1 @@
+ALTER TABLE fetch_requests ADD COLUMN status_message text;
@@ -0,0 +1,3 @@
+ALTER TABLE fetch_requests
+    DROP COLUMN status_last_checked,
+    DROP COLUMN status_last_changed;
@@ -0,0 +1,5 @@
+ALTER TABLE fetch_requests
+    ADD COLUMN status_last_checked timestamp,
+    ADD COLUMN status_last_changed timestamp;
+
+CREATE INDEX ON fetch_requests (status_last_checked);
@@ -0,0 +1,2 @@
+ALTER TABLE applications DROP CONSTRAINT applications_app_template_id_fk;
+ALTER TABLE applications DROP COLUMN app_template_id;
@@ -0,0 +1,4 @@
+ALTER TABLE applications ADD COLUMN app_template_id uuid;
+ALTER TABLE applications
+    ADD CONSTRAINT applications_app_template_id_fk
+        FOREIGN KEY (app_template_id) REFERENCES app_templates (id) ON DELETE SET NULL;
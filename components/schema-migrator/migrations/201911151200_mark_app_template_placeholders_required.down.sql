@@ -0,0 +1,3 @@
+UPDATE app_templates
+SET placeholders = (SELECT jsonb_agg(placeholder - 'JSONSchema' - 'Regex' - 'Required' - 'Default' - 'Sensitive') FROM jsonb_array_elements(placeholders) placeholder)
+WHERE jsonb_typeof(placeholders) = 'array' AND jsonb_array_length(placeholders) > 0;
@@ -0,0 +1,12 @@
+DELETE FROM app_templates WHERE access_level = 'TENANT';
+
+ALTER TYPE app_templates_access_level RENAME TO app_templates_access_level_old;
+
+CREATE TYPE app_templates_access_level AS ENUM (
+    'GLOBAL'
+);
+
+ALTER TABLE app_templates
+    ALTER COLUMN access_level TYPE app_templates_access_level USING access_level::text::app_templates_access_level;
+
+DROP TYPE app_templates_access_level_old;
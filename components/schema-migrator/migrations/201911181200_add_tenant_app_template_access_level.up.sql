@@ -0,0 +1 @@
+ALTER TYPE app_templates_access_level ADD VALUE 'TENANT';
@@ -0,0 +1,4 @@
+DELETE FROM app_templates WHERE tenant_id IS NOT NULL;
+
+ALTER TABLE app_templates DROP CONSTRAINT app_templates_tenant_id_access_level_check;
+ALTER TABLE app_templates DROP COLUMN tenant_id;
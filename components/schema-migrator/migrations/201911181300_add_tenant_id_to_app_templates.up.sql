@@ -0,0 +1,6 @@
+ALTER TABLE app_templates ADD COLUMN tenant_id uuid;
+
+ALTER TABLE app_templates
+    ADD CONSTRAINT app_templates_tenant_id_access_level_check CHECK ((access_level = 'GLOBAL') = (tenant_id IS NULL));
+
+CREATE INDEX ON app_templates (tenant_id);
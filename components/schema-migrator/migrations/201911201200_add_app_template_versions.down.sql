@@ -0,0 +1,6 @@
+ALTER TABLE applications DROP COLUMN app_template_values;
+ALTER TABLE applications DROP COLUMN app_template_version;
+
+DROP TABLE app_template_versions;
+
+ALTER TABLE app_templates DROP COLUMN version;
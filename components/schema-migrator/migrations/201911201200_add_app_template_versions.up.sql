@@ -0,0 +1,19 @@
+ALTER TABLE app_templates ADD COLUMN version integer NOT NULL DEFAULT 1;
+
+CREATE TABLE app_template_versions (
+    app_template_id uuid NOT NULL,
+    version integer NOT NULL,
+    name varchar(256) NOT NULL,
+    description text,
+    application_input JSONB NOT NULL,
+    placeholders JSONB,
+    created_at timestamp NOT NULL DEFAULT now(),
+    PRIMARY KEY (app_template_id, version),
+    FOREIGN KEY (app_template_id) REFERENCES app_templates (id) ON DELETE CASCADE
+);
+
+INSERT INTO app_template_versions (app_template_id, version, name, description, application_input, placeholders)
+SELECT id, version, name, description, application_input, placeholders FROM app_templates;
+
+ALTER TABLE applications ADD COLUMN app_template_version integer;
+ALTER TABLE applications ADD COLUMN app_template_values JSONB;
@@ -0,0 +1,5 @@
+DROP TABLE webhook_delivery_attempts;
+
+DROP TABLE webhook_deliveries;
+
+DROP TYPE webhook_delivery_status;
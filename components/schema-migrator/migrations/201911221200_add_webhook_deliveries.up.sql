@@ -0,0 +1,30 @@
+CREATE TYPE webhook_delivery_status AS ENUM (
+    'PENDING',
+    'SUCCEEDED',
+    'FAILED'
+);
+
+CREATE TABLE webhook_deliveries (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid NOT NULL,
+    webhook_id uuid NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    payload jsonb NOT NULL,
+    status webhook_delivery_status NOT NULL,
+    attempt_count integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp,
+    created_at timestamp NOT NULL
+);
+
+CREATE INDEX ON webhook_deliveries (tenant_id, webhook_id);
+CREATE INDEX ON webhook_deliveries (next_attempt_at) WHERE status = 'PENDING';
+
+CREATE TABLE webhook_delivery_attempts (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid NOT NULL,
+    delivery_id uuid NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+    timestamp timestamp NOT NULL,
+    response_code integer,
+    error text
+);
+
+CREATE INDEX ON webhook_delivery_attempts (tenant_id, delivery_id);
@@ -0,0 +1,32 @@
+DROP INDEX applications_deleting_status_timestamp_idx;
+
+DELETE FROM webhooks WHERE type IN ('REGISTER_APPLICATION', 'UNREGISTER_APPLICATION');
+
+ALTER TYPE webhook_type RENAME TO webhook_type_old;
+
+CREATE TYPE webhook_type AS ENUM (
+    'CONFIGURATION_CHANGED'
+);
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE webhook_type USING type::text::webhook_type;
+
+DROP TYPE webhook_type_old;
+
+UPDATE applications SET status_condition = 'UNKNOWN' WHERE status_condition = 'DELETING';
+
+ALTER TYPE application_status_condition RENAME TO application_status_condition_old;
+
+CREATE TYPE application_status_condition AS ENUM (
+    'INITIAL',
+    'UNKNOWN',
+    'READY',
+    'FAILED'
+);
+
+ALTER TABLE applications
+    ALTER COLUMN status_condition DROP DEFAULT,
+    ALTER COLUMN status_condition TYPE application_status_condition USING status_condition::text::application_status_condition,
+    ALTER COLUMN status_condition SET DEFAULT 'INITIAL';
+
+DROP TYPE application_status_condition_old;
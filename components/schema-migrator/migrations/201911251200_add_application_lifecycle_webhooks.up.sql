@@ -0,0 +1,31 @@
+ALTER TYPE webhook_type RENAME TO webhook_type_old;
+
+CREATE TYPE webhook_type AS ENUM (
+    'CONFIGURATION_CHANGED',
+    'REGISTER_APPLICATION',
+    'UNREGISTER_APPLICATION'
+);
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE webhook_type USING type::text::webhook_type;
+
+DROP TYPE webhook_type_old;
+
+ALTER TYPE application_status_condition RENAME TO application_status_condition_old;
+
+CREATE TYPE application_status_condition AS ENUM (
+    'INITIAL',
+    'UNKNOWN',
+    'READY',
+    'FAILED',
+    'DELETING'
+);
+
+ALTER TABLE applications
+    ALTER COLUMN status_condition DROP DEFAULT,
+    ALTER COLUMN status_condition TYPE application_status_condition USING status_condition::text::application_status_condition,
+    ALTER COLUMN status_condition SET DEFAULT 'INITIAL';
+
+DROP TYPE application_status_condition_old;
+
+CREATE INDEX applications_deleting_status_timestamp_idx ON applications (status_timestamp) WHERE status_condition = 'DELETING';
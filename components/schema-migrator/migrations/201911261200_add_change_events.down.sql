@@ -0,0 +1,33 @@
+DROP TRIGGER labels_created ON labels;
+DROP TRIGGER labels_updated ON labels;
+DROP TRIGGER labels_deleted ON labels;
+
+DROP TRIGGER webhooks_created ON webhooks;
+DROP TRIGGER webhooks_updated ON webhooks;
+DROP TRIGGER webhooks_deleted ON webhooks;
+
+DROP TRIGGER documents_created ON documents;
+DROP TRIGGER documents_updated ON documents;
+DROP TRIGGER documents_deleted ON documents;
+
+DROP TRIGGER event_api_definitions_created ON event_api_definitions;
+DROP TRIGGER event_api_definitions_updated ON event_api_definitions;
+DROP TRIGGER event_api_definitions_deleted ON event_api_definitions;
+
+DROP TRIGGER api_definitions_created ON api_definitions;
+DROP TRIGGER api_definitions_updated ON api_definitions;
+DROP TRIGGER api_definitions_deleted ON api_definitions;
+
+DROP TRIGGER runtimes_created ON runtimes;
+DROP TRIGGER runtimes_updated ON runtimes;
+DROP TRIGGER runtimes_deleted ON runtimes;
+
+DROP TRIGGER applications_created ON applications;
+DROP TRIGGER applications_updated ON applications;
+DROP TRIGGER applications_deleted ON applications;
+
+DROP FUNCTION record_label_change();
+DROP FUNCTION record_application_resource_change();
+DROP FUNCTION record_object_change();
+
+DROP TABLE change_events;
@@ -0,0 +1,135 @@
+CREATE TABLE change_events (
+    id bigserial PRIMARY KEY,
+    tenant_id uuid NOT NULL,
+    object_type varchar(256) NOT NULL,
+    object_id uuid NOT NULL,
+    resource_type varchar(256) NOT NULL,
+    resource_id varchar(256) NOT NULL,
+    operation varchar(256) NOT NULL,
+    created_at timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX ON change_events (created_at);
+
+CREATE FUNCTION record_object_change() RETURNS trigger AS $$
+DECLARE
+    obj record;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        obj := OLD;
+    ELSE
+        obj := NEW;
+    END IF;
+
+    INSERT INTO change_events (tenant_id, object_type, object_id, resource_type, resource_id, operation)
+    VALUES (obj.tenant_id, TG_ARGV[0], obj.id, TG_ARGV[0], obj.id, TG_ARGV[1]);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION record_application_resource_change() RETURNS trigger AS $$
+DECLARE
+    obj record;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        obj := OLD;
+        -- skip resources removed together with their Application
+        IF NOT EXISTS (SELECT 1 FROM applications WHERE tenant_id = obj.tenant_id AND id = obj.app_id) THEN
+            RETURN NULL;
+        END IF;
+    ELSE
+        obj := NEW;
+    END IF;
+
+    INSERT INTO change_events (tenant_id, object_type, object_id, resource_type, resource_id, operation)
+    VALUES (obj.tenant_id, 'APPLICATION', obj.app_id, TG_ARGV[0], obj.id, TG_ARGV[1]);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION record_label_change() RETURNS trigger AS $$
+DECLARE
+    obj record;
+    obj_type varchar;
+    obj_id uuid;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        obj := OLD;
+    ELSE
+        obj := NEW;
+    END IF;
+
+    IF obj.app_id IS NOT NULL THEN
+        obj_type := 'APPLICATION';
+        obj_id := obj.app_id;
+    ELSE
+        obj_type := 'RUNTIME';
+        obj_id := obj.runtime_id;
+    END IF;
+
+    -- skip labels removed together with their Application or Runtime
+    IF TG_OP = 'DELETE' AND NOT EXISTS (
+        SELECT 1 FROM applications WHERE tenant_id = obj.tenant_id AND id = obj_id
+        UNION ALL
+        SELECT 1 FROM runtimes WHERE tenant_id = obj.tenant_id AND id = obj_id
+    ) THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO change_events (tenant_id, object_type, object_id, resource_type, resource_id, operation)
+    VALUES (obj.tenant_id, obj_type, obj_id, 'LABEL', obj.key, TG_ARGV[0]);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER applications_created AFTER INSERT ON applications
+    FOR EACH ROW EXECUTE PROCEDURE record_object_change('APPLICATION', 'CREATED');
+CREATE TRIGGER applications_updated AFTER UPDATE ON applications
+    FOR EACH ROW EXECUTE PROCEDURE record_object_change('APPLICATION', 'UPDATED');
+CREATE TRIGGER applications_deleted AFTER DELETE ON applications
+    FOR EACH ROW EXECUTE PROCEDURE record_object_change('APPLICATION', 'DELETED');
+
+CREATE TRIGGER runtimes_created AFTER INSERT ON runtimes
+    FOR EACH ROW EXECUTE PROCEDURE record_object_change('RUNTIME', 'CREATED');
+CREATE TRIGGER runtimes_updated AFTER UPDATE ON runtimes
+    FOR EACH ROW EXECUTE PROCEDURE record_object_change('RUNTIME', 'UPDATED');
+CREATE TRIGGER runtimes_deleted AFTER DELETE ON runtimes
+    FOR EACH ROW EXECUTE PROCEDURE record_object_change('RUNTIME', 'DELETED');
+
+CREATE TRIGGER api_definitions_created AFTER INSERT ON api_definitions
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('API', 'CREATED');
+CREATE TRIGGER api_definitions_updated AFTER UPDATE ON api_definitions
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('API', 'UPDATED');
+CREATE TRIGGER api_definitions_deleted AFTER DELETE ON api_definitions
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('API', 'DELETED');
+
+CREATE TRIGGER event_api_definitions_created AFTER INSERT ON event_api_definitions
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('EVENT_API', 'CREATED');
+CREATE TRIGGER event_api_definitions_updated AFTER UPDATE ON event_api_definitions
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('EVENT_API', 'UPDATED');
+CREATE TRIGGER event_api_definitions_deleted AFTER DELETE ON event_api_definitions
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('EVENT_API', 'DELETED');
+
+CREATE TRIGGER documents_created AFTER INSERT ON documents
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('DOCUMENT', 'CREATED');
+CREATE TRIGGER documents_updated AFTER UPDATE ON documents
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('DOCUMENT', 'UPDATED');
+CREATE TRIGGER documents_deleted AFTER DELETE ON documents
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('DOCUMENT', 'DELETED');
+
+CREATE TRIGGER webhooks_created AFTER INSERT ON webhooks
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('WEBHOOK', 'CREATED');
+CREATE TRIGGER webhooks_updated AFTER UPDATE ON webhooks
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('WEBHOOK', 'UPDATED');
+CREATE TRIGGER webhooks_deleted AFTER DELETE ON webhooks
+    FOR EACH ROW EXECUTE PROCEDURE record_application_resource_change('WEBHOOK', 'DELETED');
+
+CREATE TRIGGER labels_created AFTER INSERT ON labels
+    FOR EACH ROW EXECUTE PROCEDURE record_label_change('CREATED');
+CREATE TRIGGER labels_updated AFTER UPDATE ON labels
+    FOR EACH ROW EXECUTE PROCEDURE record_label_change('UPDATED');
+CREATE TRIGGER labels_deleted AFTER DELETE ON labels
+    FOR EACH ROW EXECUTE PROCEDURE record_label_change('DELETED');
@@ -0,0 +1,7 @@
+ALTER TABLE applications DROP COLUMN healthcheck_auth;
+
+DROP TABLE health_checks;
+
+DROP TYPE health_check_status_condition;
+
+DROP TYPE health_check_type;
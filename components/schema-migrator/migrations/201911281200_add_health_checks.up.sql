@@ -0,0 +1,24 @@
+CREATE TYPE health_check_type AS ENUM (
+    'MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK'
+);
+
+CREATE TYPE health_check_status_condition AS ENUM (
+    'SUCCEEDED',
+    'FAILED'
+);
+
+CREATE TABLE health_checks (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid NOT NULL,
+    type health_check_type NOT NULL,
+    condition health_check_status_condition NOT NULL,
+    origin uuid,
+    message text,
+    timestamp timestamp NOT NULL
+);
+
+CREATE INDEX ON health_checks (tenant_id, origin);
+CREATE INDEX ON health_checks (origin, timestamp);
+CREATE INDEX ON health_checks (timestamp);
+
+ALTER TABLE applications ADD COLUMN healthcheck_auth jsonb;
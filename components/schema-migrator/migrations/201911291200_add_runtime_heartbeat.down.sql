@@ -0,0 +1,9 @@
+DROP TRIGGER runtimes_updated ON runtimes;
+
+CREATE TRIGGER runtimes_updated AFTER UPDATE ON runtimes
+    FOR EACH ROW EXECUTE PROCEDURE record_object_change('RUNTIME', 'UPDATED');
+
+ALTER TABLE runtimes
+    DROP COLUMN heartbeat_timestamp,
+    DROP COLUMN heartbeat_connection,
+    DROP COLUMN heartbeat_agent_version;
@@ -0,0 +1,14 @@
+ALTER TABLE runtimes
+    ADD COLUMN heartbeat_agent_version varchar(256),
+    ADD COLUMN heartbeat_connection jsonb,
+    ADD COLUMN heartbeat_timestamp timestamp;
+
+CREATE INDEX ON runtimes (status_condition, heartbeat_timestamp);
+
+-- a heartbeat alone is not a change of the Runtime
+DROP TRIGGER runtimes_updated ON runtimes;
+
+CREATE TRIGGER runtimes_updated AFTER UPDATE ON runtimes
+    FOR EACH ROW
+    WHEN ((OLD.name, OLD.description, OLD.status_condition, OLD.status_timestamp) IS DISTINCT FROM (NEW.name, NEW.description, NEW.status_condition, NEW.status_timestamp))
+    EXECUTE PROCEDURE record_object_change('RUNTIME', 'UPDATED');
@@ -0,0 +1,5 @@
+DROP TABLE scenario_assignment_targets;
+
+DROP TABLE scenario_assignments;
+
+DROP TYPE scenario_assignment_target_type;
@@ -0,0 +1,27 @@
+CREATE TYPE scenario_assignment_target_type AS ENUM (
+    'Application',
+    'Runtime'
+);
+
+CREATE TABLE scenario_assignments (
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id uuid NOT NULL,
+    scenario varchar(128) NOT NULL,
+    target_type scenario_assignment_target_type NOT NULL,
+    filter jsonb NOT NULL
+);
+
+CREATE INDEX ON scenario_assignments (tenant_id, target_type);
+
+-- Objects to which the scenario was added by the assignment, so that deleting the assignment removes only these scenarios
+CREATE TABLE scenario_assignment_targets (
+    assignment_id uuid NOT NULL REFERENCES scenario_assignments (id) ON DELETE CASCADE,
+    tenant_id uuid NOT NULL,
+    app_id uuid REFERENCES applications (id) ON DELETE CASCADE,
+    runtime_id uuid REFERENCES runtimes (id) ON DELETE CASCADE,
+    CONSTRAINT scenario_assignment_targets_object_check CHECK ((app_id IS NULL) <> (runtime_id IS NULL))
+);
+
+CREATE UNIQUE INDEX ON scenario_assignment_targets (assignment_id, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'));
+CREATE INDEX ON scenario_assignment_targets (tenant_id, app_id);
+CREATE INDEX ON scenario_assignment_targets (tenant_id, runtime_id);
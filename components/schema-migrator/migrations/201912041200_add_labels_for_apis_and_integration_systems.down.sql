@@ -0,0 +1,47 @@
+CREATE OR REPLACE FUNCTION record_label_change() RETURNS trigger AS $$
+DECLARE
+    obj record;
+    obj_type varchar;
+    obj_id uuid;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        obj := OLD;
+    ELSE
+        obj := NEW;
+    END IF;
+
+    IF obj.app_id IS NOT NULL THEN
+        obj_type := 'APPLICATION';
+        obj_id := obj.app_id;
+    ELSE
+        obj_type := 'RUNTIME';
+        obj_id := obj.runtime_id;
+    END IF;
+
+    -- skip labels removed together with their Application or Runtime
+    IF TG_OP = 'DELETE' AND NOT EXISTS (
+        SELECT 1 FROM applications WHERE tenant_id = obj.tenant_id AND id = obj_id
+        UNION ALL
+        SELECT 1 FROM runtimes WHERE tenant_id = obj.tenant_id AND id = obj_id
+    ) THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO change_events (tenant_id, object_type, object_id, resource_type, resource_id, operation)
+    VALUES (obj.tenant_id, obj_type, obj_id, 'LABEL', obj.key, TG_ARGV[0]);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DELETE FROM labels WHERE app_id IS NULL AND runtime_id IS NULL;
+
+DROP INDEX labels_tenant_id_key_object_idx;
+CREATE UNIQUE INDEX ON labels (tenant_id, key, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'));
+
+ALTER TABLE labels
+    DROP CONSTRAINT valid_refs,
+    ADD CONSTRAINT valid_refs CHECK (app_id IS NOT NULL OR runtime_id IS NOT NULL),
+    DROP COLUMN int_sys_id,
+    DROP COLUMN event_api_def_id,
+    DROP COLUMN api_def_id;
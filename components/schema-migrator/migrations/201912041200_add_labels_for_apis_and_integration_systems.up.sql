@@ -0,0 +1,54 @@
+ALTER TABLE labels
+    ADD COLUMN api_def_id uuid,
+    ADD FOREIGN KEY (tenant_id, api_def_id) REFERENCES api_definitions (tenant_id, id) ON DELETE CASCADE,
+    ADD COLUMN event_api_def_id uuid,
+    ADD FOREIGN KEY (tenant_id, event_api_def_id) REFERENCES event_api_definitions (tenant_id, id) ON DELETE CASCADE,
+    ADD COLUMN int_sys_id uuid,
+    ADD FOREIGN KEY (int_sys_id) REFERENCES integration_systems (id) ON DELETE CASCADE,
+    DROP CONSTRAINT valid_refs,
+    ADD CONSTRAINT valid_refs CHECK (num_nonnulls(app_id, runtime_id, api_def_id, event_api_def_id, int_sys_id) = 1);
+
+DROP INDEX labels_tenant_id_key_coalesce_coalesce1_idx;
+-- We use coalesce to handle nullable columns https://stackoverflow.com/a/8289327
+CREATE UNIQUE INDEX labels_tenant_id_key_object_idx ON labels (tenant_id, key, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), coalesce(api_def_id, '00000000-0000-0000-0000-000000000000'), coalesce(event_api_def_id, '00000000-0000-0000-0000-000000000000'), coalesce(int_sys_id, '00000000-0000-0000-0000-000000000000'));
+
+CREATE OR REPLACE FUNCTION record_label_change() RETURNS trigger AS $$
+DECLARE
+    obj record;
+    obj_type varchar;
+    obj_id uuid;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        obj := OLD;
+    ELSE
+        obj := NEW;
+    END IF;
+
+    -- change events are recorded only for Application and Runtime labels
+    IF obj.app_id IS NULL AND obj.runtime_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    IF obj.app_id IS NOT NULL THEN
+        obj_type := 'APPLICATION';
+        obj_id := obj.app_id;
+    ELSE
+        obj_type := 'RUNTIME';
+        obj_id := obj.runtime_id;
+    END IF;
+
+    -- skip labels removed together with their Application or Runtime
+    IF TG_OP = 'DELETE' AND NOT EXISTS (
+        SELECT 1 FROM applications WHERE tenant_id = obj.tenant_id AND id = obj_id
+        UNION ALL
+        SELECT 1 FROM runtimes WHERE tenant_id = obj.tenant_id AND id = obj_id
+    ) THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO change_events (tenant_id, object_type, object_id, resource_type, resource_id, operation)
+    VALUES (obj.tenant_id, obj_type, obj_id, 'LABEL', obj.key, TG_ARGV[0]);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
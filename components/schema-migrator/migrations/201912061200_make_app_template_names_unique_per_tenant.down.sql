@@ -0,0 +1,6 @@
+DROP INDEX app_templates_global_name_unique;
+
+ALTER TABLE app_templates DROP CONSTRAINT app_templates_tenant_id_name_unique;
+
+ALTER TABLE app_templates
+    ADD CONSTRAINT application_template_name_unique UNIQUE (name);
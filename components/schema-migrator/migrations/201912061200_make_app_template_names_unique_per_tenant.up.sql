@@ -0,0 +1,7 @@
+ALTER TABLE app_templates DROP CONSTRAINT application_template_name_unique;
+
+ALTER TABLE app_templates
+    ADD CONSTRAINT app_templates_tenant_id_name_unique UNIQUE (tenant_id, name);
+
+-- global templates have no tenant, so they are not covered by the constraint above
+CREATE UNIQUE INDEX app_templates_global_name_unique ON app_templates (name) WHERE tenant_id IS NULL;
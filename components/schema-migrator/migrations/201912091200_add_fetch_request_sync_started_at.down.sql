@@ -0,0 +1,2 @@
+ALTER TABLE fetch_requests
+    DROP COLUMN sync_started_at;
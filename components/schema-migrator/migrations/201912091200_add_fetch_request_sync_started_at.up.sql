@@ -0,0 +1,2 @@
+ALTER TABLE fetch_requests
+    ADD COLUMN sync_started_at timestamp;
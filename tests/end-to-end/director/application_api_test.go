@@ -118,7 +118,7 @@ func TestCreateApplicationWithAPIs(t *testing.T) {
 				Spec: &graphql.APISpecInput{
 					Type:   graphql.APISpecTypeOpenAPI,
 					Format: graphql.SpecFormatYaml,
-					Data:   ptr.CLOB(graphql.CLOB("openapi")),
+					Data:   ptr.CLOB(graphql.CLOB("{openapi: '3.0.0', info: {title: comments, version: v1}, paths: {}}")),
 				},
 			},
 			{
@@ -150,7 +150,7 @@ func TestCreateApplicationWithAPIs(t *testing.T) {
 				Spec: &graphql.APISpecInput{
 					Type:   graphql.APISpecTypeOdata,
 					Format: graphql.SpecFormatXML,
-					Data:   ptr.CLOB(graphql.CLOB("odata")),
+					Data:   ptr.CLOB(graphql.CLOB("<edmx:Edmx xmlns:edmx='http://docs.oasis-open.org/odata/ns/edmx' Version='4.0'><edmx:DataServices><Schema xmlns='http://docs.oasis-open.org/odata/ns/edm' Namespace='Reviews'/></edmx:DataServices></edmx:Edmx>")),
 				},
 			},
 		},
@@ -199,7 +199,7 @@ func TestCreateApplicationWithEventAPIs(t *testing.T) {
 				Spec: &graphql.EventAPISpecInput{
 					EventSpecType: graphql.EventAPISpecTypeAsyncAPI,
 					Format:        graphql.SpecFormatYaml,
-					Data:          ptr.CLOB(graphql.CLOB([]byte("asyncapi"))),
+					Data:          ptr.CLOB(graphql.CLOB([]byte("{asyncapi: '2.0.0', info: {title: comments, version: v1}, channels: {}}"))),
 				},
 			},
 			{
@@ -322,6 +322,36 @@ func TestCreateApplicationWithNonExistentIntegrationSystem(t *testing.T) {
 	require.Contains(t, err.Error(), "does not exist")
 }
 
+func TestCreateApplicationWithInvalidAPISpec(t *testing.T) {
+	// GIVEN
+	ctx := context.Background()
+	in := graphql.ApplicationCreateInput{
+		Name: "create-application-with-invalid-api-spec",
+		Apis: []*graphql.APIDefinitionInput{
+			{
+				Name:      "comments/v1",
+				TargetURL: "http://mywordpress.com/comments",
+				Spec: &graphql.APISpecInput{
+					Type:   graphql.APISpecTypeOpenAPI,
+					Format: graphql.SpecFormatYaml,
+					Data:   ptr.CLOB(graphql.CLOB("{openapi: '3.0.0', paths: {}}")),
+				},
+			},
+		},
+	}
+	appInputGQL, err := tc.graphqlizer.ApplicationCreateInputToGQL(in)
+	require.NoError(t, err)
+	actualApp := graphql.ApplicationExt{}
+
+	request := fixCreateApplicationRequest(appInputGQL)
+	// WHEN
+	err = tc.RunOperation(ctx, request, &actualApp)
+
+	//THEN
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid OpenAPI spec: root (line 1, column 1): info is required")
+}
+
 func TestAddDependentObjectsWhenAppDoesNotExist(t *testing.T) {
 	applicationId := "cf889c38-490d-4896-96a7-c0721eca9932"
 